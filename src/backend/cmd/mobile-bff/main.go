@@ -31,7 +31,9 @@ import (
 	"github.com/platepilot/backend/internal/bff/client"
 	"github.com/platepilot/backend/internal/bff/handler"
 	bffmiddleware "github.com/platepilot/backend/internal/bff/middleware"
+	"github.com/platepilot/backend/internal/common/blobstore"
 	"github.com/platepilot/backend/internal/common/config"
+	"github.com/platepilot/backend/internal/common/media"
 	"github.com/platepilot/backend/internal/recipe/repository"
 )
 
@@ -94,8 +96,17 @@ func main() {
 	// Create repositories
 	shoppingListRepo := repository.NewShoppingListRepository(dbPool)
 
+	// Create the media store for uploaded images
+	mediaStore, err := blobstore.NewLocalStore(cfg.Media.Root, cfg.Media.BaseURL)
+	if err != nil {
+		slog.Error("failed to open media store", "root", cfg.Media.Root, "error", err)
+		os.Exit(1)
+	}
+
 	// Create handlers
-	recipeHandler := handler.NewRecipeHandler(recipeClient, logger)
+	recipeHandler := handler.NewRecipeHandler(recipeClient, logger).
+		WithImageUploader(media.NewUploader(mediaStore, cfg.Media.MaxUploadBytes))
+	mediaHandler := handler.NewMediaHandler(mediaStore, logger)
 	mealPlanHandler := handler.NewMealPlanHandler(mealPlannerClient, logger)
 	authHandler := handler.NewAuthHandler(authService, logger)
 	shoppingListHandler := handler.NewShoppingListHandler(shoppingListRepo, logger)
@@ -123,6 +134,9 @@ func main() {
 		w.Write([]byte("Ready"))
 	})
 
+	// Uploaded images
+	r.Get("/media/*", mediaHandler.Serve)
+
 	// API v1 routes
 	r.Route("/v1", func(r chi.Router) {
 		r.Route("/auth", func(r chi.Router) {
@@ -140,10 +154,83 @@ func main() {
 				r.Get("/", recipeHandler.List)
 				r.Get("/similar", recipeHandler.GetSimilar)
 				r.Post("/", recipeHandler.Create)
+				r.Post("/import", recipeHandler.Import)
+				r.Post("/images", recipeHandler.UploadImage)
+				r.Get("/export", recipeHandler.ExportAll)
+				r.Post("/bulk-import", recipeHandler.BulkImport)
+				r.Get("/bulk-export", recipeHandler.BulkExport)
+				r.Get("/shared-with-me", recipeHandler.ListSharedWithMe)
+				r.Get("/shared-by-me", recipeHandler.ListSharedByMe)
+				r.Get("/trash", recipeHandler.ListTrash)
+				r.Delete("/trash/{id}", recipeHandler.Purge)
+				r.Get("/cook-log", recipeHandler.ListCookLog)
+				r.Put("/cook-log/{entryId}", recipeHandler.UpdateCookLogEntry)
+				r.Delete("/cook-log/{entryId}", recipeHandler.DeleteCookLogEntry)
+				r.Get("/cook-sessions", recipeHandler.ListCookSessions)
+				r.Get("/cook-sessions/{sessionId}", recipeHandler.GetCookSession)
+				r.Delete("/cook-sessions/{sessionId}", recipeHandler.DeleteCookSession)
+				r.Post("/cook-sessions/{sessionId}/next", recipeHandler.NextCookSessionStep)
+				r.Post("/cook-sessions/{sessionId}/previous", recipeHandler.PreviousCookSessionStep)
+				r.Put("/cook-sessions/{sessionId}/step", recipeHandler.SetCookSessionStep)
+				r.Post("/cook-sessions/{sessionId}/timers/{stepIndex}/start", recipeHandler.StartCookTimer)
+				r.Post("/cook-sessions/{sessionId}/timers/{stepIndex}/pause", recipeHandler.PauseCookTimer)
+				r.Post("/cook-sessions/{sessionId}/complete", recipeHandler.CompleteCookSession)
+				r.Get("/collections", recipeHandler.ListCollections)
+				r.Post("/collections", recipeHandler.CreateCollection)
+				r.Get("/collections/{collectionId}", recipeHandler.GetCollection)
+				r.Put("/collections/{collectionId}", recipeHandler.UpdateCollection)
+				r.Delete("/collections/{collectionId}", recipeHandler.DeleteCollection)
+				r.Post("/collections/{collectionId}/recipes", recipeHandler.AddCollectionRecipe)
+				r.Delete("/collections/{collectionId}/recipes/{recipeId}", recipeHandler.RemoveCollectionRecipe)
+				r.Put("/collections/{collectionId}/order", recipeHandler.ReorderCollection)
+				r.Get("/collections/{collectionId}/shares", recipeHandler.ListCollectionShares)
+				r.Post("/collections/{collectionId}/shares", recipeHandler.ShareCollection)
+				r.Delete("/collections/{collectionId}/shares/{userId}", recipeHandler.RevokeCollectionShare)
+				r.Get("/{id}/export", recipeHandler.Export)
+				r.Get("/{id}/revisions", recipeHandler.ListRevisions)
+				r.Get("/{id}/revisions/diff", recipeHandler.DiffRevisions)
+				r.Get("/{id}/revisions/{revision}", recipeHandler.GetRevision)
+				r.Post("/{id}/revisions/{revision}/restore", recipeHandler.RestoreRevision)
+				r.Post("/{id}/restore", recipeHandler.Restore)
+				r.Post("/{id}/duplicate", recipeHandler.Duplicate)
+				r.Post("/{id}/pull-upstream", recipeHandler.PullUpstream)
+				r.Get("/{id}/substitutions", recipeHandler.ListSubstitutions)
+				r.Post("/{id}/substitute", recipeHandler.Substitute)
+				r.Post("/{id}/image", recipeHandler.SetImage)
+				r.Post("/{id}/steps/{stepIndex}/media", recipeHandler.SetStepMedia)
+				r.Post("/{id}/shares", recipeHandler.Share)
+				r.Get("/{id}/cooks", recipeHandler.ListRecipeCooks)
+				r.Post("/{id}/cooks", recipeHandler.LogCook)
+				r.Post("/{id}/cook-sessions", recipeHandler.StartCookSession)
+				r.Delete("/{id}/shares/{userId}", recipeHandler.RevokeShare)
 				r.Put("/{id}", recipeHandler.Update)
 				r.Delete("/{id}", recipeHandler.Delete)
+				r.Get("/allergies", recipeHandler.ListAllergies)
 				r.Get("/cuisines", recipeHandler.GetCuisines)
 				r.Post("/cuisines", recipeHandler.CreateCuisine)
+				r.Put("/cuisines/{cuisineId}", recipeHandler.UpdateCuisine)
+				r.Delete("/cuisines/{cuisineId}", recipeHandler.DeleteCuisine)
+				r.Post("/cuisines/{cuisineId}/merge", recipeHandler.MergeCuisine)
+				r.Get("/tags", recipeHandler.ListTags)
+				r.Get("/tags/autocomplete", recipeHandler.AutocompleteTags)
+				r.Post("/tags/merge", recipeHandler.MergeTags)
+				r.Put("/tags/{tag}", recipeHandler.RenameTag)
+				r.Delete("/tags/{tag}", recipeHandler.DeleteTag)
+				r.Put("/tags/{tag}/group", recipeHandler.SetTagGroup)
+				r.Get("/ingredient-matches", recipeHandler.ListIngredientMatches)
+				r.Put("/ingredient-matches/{ingredientId}", recipeHandler.ResolveIngredientMatch)
+				r.Get("/ingredient-categories", recipeHandler.ListIngredientCategories)
+				r.Get("/ingredients", recipeHandler.ListIngredients)
+				r.Get("/ingredients/duplicates", recipeHandler.ListDuplicateIngredients)
+				r.Put("/ingredients/{ingredientId}/name", recipeHandler.RenameIngredient)
+				r.Put("/ingredients/{ingredientId}/category", recipeHandler.SetIngredientCategory)
+				r.Post("/ingredients/{ingredientId}/merge", recipeHandler.MergeIngredient)
+				r.Get("/ingredients/{ingredientId}/dietary", recipeHandler.GetIngredientDietaryAttributes)
+				r.Put("/ingredients/{ingredientId}/dietary", recipeHandler.SetIngredientDietaryAttributes)
+			})
+			r.Route("/profile", func(r chi.Router) {
+				r.Get("/dietary", recipeHandler.GetDietaryProfile)
+				r.Put("/dietary", recipeHandler.UpdateDietaryProfile)
 			})
 			r.Route("/mealplan", func(r chi.Router) {
 				r.Get("/week", mealPlanHandler.GetWeek)
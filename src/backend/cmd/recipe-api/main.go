@@ -15,19 +15,29 @@ import (
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/platepilot/backend/internal/common/blobstore"
 	"github.com/platepilot/backend/internal/common/config"
 	"github.com/platepilot/backend/internal/common/vector"
+	"github.com/platepilot/backend/internal/recipe/dietary"
 	"github.com/platepilot/backend/internal/recipe/events"
+	"github.com/platepilot/backend/internal/recipe/fooddata"
 	"github.com/platepilot/backend/internal/recipe/handler"
 	pb "github.com/platepilot/backend/internal/recipe/pb"
 	"github.com/platepilot/backend/internal/recipe/repository"
 	"github.com/platepilot/backend/internal/recipe/seed"
+	"github.com/platepilot/backend/internal/recipe/substitution"
+	"github.com/platepilot/backend/internal/recipe/trash"
 )
 
 func main() {
 	// Parse command line flags
 	seedFile := flag.String("seed", "", "Path to seed file (e.g., recipes.json)")
-	seedOnly := flag.Bool("seed-only", false, "Exit after seeding (use with -seed)")
+	seedUser := flag.String("seed-user", "", "Email of the registered user to seed into (default: the seed user)")
+	seedShare := flag.Bool("seed-share", false, "Publish the seeded recipes as a public library collection every user can read")
+	seedOnly := flag.Bool("seed-only", false, "Exit after seeding and importing (use with -seed or -nutrition-csv)")
+	nutritionFile := flag.String("nutrition-csv", "", "Path to a FoodData Central or CIQUAL nutrition CSV to import")
+	nutritionSource := flag.String("nutrition-source", "fdc", "Source name of the nutrition CSV (e.g., fdc, ciqual)")
+	matchIngredients := flag.Bool("match-ingredients", false, "Match ingredients to the nutrition reference (implied by -nutrition-csv)")
 	flag.Parse()
 
 	// Load configuration
@@ -94,31 +104,87 @@ func main() {
 		}
 	}
 
+	// Initialize gRPC handler; the seeder imports recipes through it
+	// Explicitly handle nil publisher to avoid interface-wrapping-nil issue
+	var eventPublisher handler.EventPublisher
+	if publisher != nil {
+		eventPublisher = publisher
+	}
+	grpcHandler := handler.NewGRPCHandler(repo, vectorGen, eventPublisher, logger)
+	if cfg.RecipeAPI.CookSessionTTL > 0 {
+		grpcHandler.WithCookSessionTTL(cfg.RecipeAPI.CookSessionTTL)
+	}
+
 	// Run seeder if seed file is specified
 	if *seedFile != "" {
-		seeder := seed.NewSeeder(repo, vectorGen, publisher, logger)
-		if err := seeder.SeedFromFile(ctx, *seedFile); err != nil {
+		seeder := seed.NewSeeder(repo, grpcHandler, logger)
+		summary, err := seeder.SeedFromFile(ctx, *seedFile, seed.Options{
+			UserEmail: *seedUser,
+			Share:     *seedShare,
+		})
+		if err != nil {
 			slog.Error("failed to seed database", "error", err)
 			os.Exit(1)
 		}
+		slog.Info("seed changes",
+			"created", summary.Created,
+			"updated", summary.Updated,
+			"skipped", summary.Skipped,
+			"failed", summary.Failed,
+			"unchanged", summary.Unchanged,
+		)
+	}
+
+	// Keep the substitution catalog in sync with the bundled data file
+	substitutionCount, err := substitution.Seed(ctx, repo)
+	if err != nil {
+		slog.Error("failed to seed substitutions", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("substitution catalog seeded", "count", substitutionCount)
 
-		// Exit after seeding if --seed-only is specified
-		if *seedOnly {
-			slog.Info("seed-only mode: exiting after successful seeding")
-			if publisher != nil {
-				_ = publisher.Close()
-			}
-			os.Exit(0)
+	// Classify ingredients the bundled dietary catalog has learned about
+	if _, err := dietary.Backfill(ctx, repo, grpcHandler, logger); err != nil {
+		slog.Error("failed to backfill ingredient dietary attributes", "error", err)
+		os.Exit(1)
+	}
+
+	// Import nutrition reference data and link ingredients to it
+	if *nutritionFile != "" {
+		importer := fooddata.NewImporter(repo, logger)
+		if _, err := importer.ImportFile(ctx, *nutritionFile, *nutritionSource); err != nil {
+			slog.Error("failed to import nutrition data", "error", err)
+			os.Exit(1)
+		}
+	}
+	if *nutritionFile != "" || *matchIngredients {
+		matcher := fooddata.NewMatcher(repo, logger)
+		if _, err := matcher.MatchIngredients(ctx); err != nil {
+			slog.Error("failed to match ingredients", "error", err)
+			os.Exit(1)
 		}
 	}
 
-	// Initialize gRPC handler
-	// Explicitly handle nil publisher to avoid interface-wrapping-nil issue
-	var eventPublisher handler.EventPublisher
-	if publisher != nil {
-		eventPublisher = publisher
+	// Exit after seeding if --seed-only is specified
+	if *seedOnly && (*seedFile != "" || *nutritionFile != "") {
+		slog.Info("seed-only mode: exiting after successful seeding")
+		if publisher != nil {
+			_ = publisher.Close()
+		}
+		os.Exit(0)
+	}
+
+	// Uploaded images are deleted once the recipes using them are purged
+	var mediaStore blobstore.Store
+	if cfg.Media.Root != "" {
+		store, err := blobstore.NewLocalStore(cfg.Media.Root, cfg.Media.BaseURL)
+		if err != nil {
+			slog.Error("failed to open media store", "root", cfg.Media.Root, "error", err)
+			os.Exit(1)
+		}
+		mediaStore = store
+		grpcHandler.WithMediaStore(mediaStore)
 	}
-	grpcHandler := handler.NewGRPCHandler(repo, vectorGen, eventPublisher, logger)
 
 	// Create gRPC server
 	grpcServer := grpc.NewServer()
@@ -146,6 +212,19 @@ func main() {
 		}
 	}()
 
+	// Purge recipes that have been in the trash longer than the retention window
+	if cfg.RecipeAPI.TrashRetention > 0 && cfg.RecipeAPI.TrashPurgeInterval > 0 {
+		purger := trash.NewPurger(repo, cfg.RecipeAPI.TrashRetention, cfg.RecipeAPI.TrashPurgeInterval, logger)
+		if mediaStore != nil {
+			purger.WithMediaStore(mediaStore)
+		}
+		go purger.Run(ctx)
+		slog.Info("trash purge scheduled",
+			"retention", cfg.RecipeAPI.TrashRetention,
+			"interval", cfg.RecipeAPI.TrashPurgeInterval,
+		)
+	}
+
 	slog.Info("recipe-api started successfully")
 
 	// Wait for interrupt signal
@@ -61,6 +61,7 @@ type ListResponse struct {
 	PageSize   int32
 	TotalCount int32
 	TotalPages int32
+	NextCursor string
 }
 
 // ListRecipes retrieves recipes with pagination and optional filters.
@@ -78,6 +79,7 @@ func (c *RecipeClient) ListRecipes(ctx context.Context, req *recipepb.ListRecipe
 		PageSize:   resp.GetPageSize(),
 		TotalCount: resp.GetTotalCount(),
 		TotalPages: resp.GetTotalPages(),
+		NextCursor: resp.GetNextCursor(),
 	}, nil
 }
 
@@ -117,20 +119,921 @@ func (c *RecipeClient) Delete(ctx context.Context, req *recipepb.DeleteRecipeReq
 	return nil
 }
 
+// ListDeleted retrieves a page of the user's trash
+func (c *RecipeClient) ListDeleted(ctx context.Context, userID string, pageIndex, pageSize int32) (*ListResponse, error) {
+	c.logger.Debug("listing deleted recipes", "pageIndex", pageIndex, "pageSize", pageSize, "userId", userID)
+
+	resp, err := c.client.ListDeletedRecipes(ctx, &recipepb.ListDeletedRecipesRequest{
+		UserId:    userID,
+		PageIndex: pageIndex,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list deleted recipes: %w", err)
+	}
+
+	return &ListResponse{
+		Recipes:    resp.GetRecipes(),
+		PageIndex:  resp.GetPageIndex(),
+		PageSize:   resp.GetPageSize(),
+		TotalCount: resp.GetTotalCount(),
+		TotalPages: resp.GetTotalPages(),
+	}, nil
+}
+
+// Restore moves a recipe out of the user's trash
+func (c *RecipeClient) Restore(ctx context.Context, userID, recipeID string) (*recipepb.Recipe, error) {
+	c.logger.Debug("restoring recipe", "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.RestoreRecipe(ctx, &recipepb.RestoreRecipeRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restore recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// Purge permanently deletes a recipe from the user's trash
+func (c *RecipeClient) Purge(ctx context.Context, userID, recipeID string) error {
+	c.logger.Debug("purging recipe", "recipeId", recipeID, "userId", userID)
+
+	_, err := c.client.PurgeRecipe(ctx, &recipepb.PurgeRecipeRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+	})
+	if err != nil {
+		return fmt.Errorf("purge recipe: %w", err)
+	}
+
+	return nil
+}
+
+// Duplicate copies a recipe into the user's library
+func (c *RecipeClient) Duplicate(ctx context.Context, userID, recipeID, name string) (*recipepb.Recipe, error) {
+	c.logger.Debug("duplicating recipe", "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.DuplicateRecipe(ctx, &recipepb.DuplicateRecipeRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+		Name:     name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("duplicate recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// PullUpstream updates a duplicated recipe from the recipe it was copied from
+func (c *RecipeClient) PullUpstream(ctx context.Context, userID, recipeID string) (*recipepb.Recipe, error) {
+	c.logger.Debug("pulling upstream recipe", "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.PullUpstreamRecipe(ctx, &recipepb.PullUpstreamRecipeRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pull upstream recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetImage sets the image of a recipe, or of one of its steps when stepIndex is set
+func (c *RecipeClient) SetImage(ctx context.Context, userID, recipeID string, stepIndex int32, imageURL string) (*recipepb.Recipe, error) {
+	c.logger.Debug("setting recipe image", "recipeId", recipeID, "stepIndex", stepIndex, "userId", userID)
+
+	resp, err := c.client.SetRecipeImage(ctx, &recipepb.SetRecipeImageRequest{
+		RecipeId:  recipeID,
+		UserId:    userID,
+		ImageUrl:  imageURL,
+		StepIndex: stepIndex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set recipe image: %w", err)
+	}
+
+	return resp, nil
+}
+
 // GetSimilar retrieves recipes similar to a given recipe
-func (c *RecipeClient) GetSimilar(ctx context.Context, userID, recipeID string, amount int32) ([]*recipepb.Recipe, error) {
+func (c *RecipeClient) GetSimilar(ctx context.Context, userID, recipeID string, amount int32, ignoreDietaryProfile bool) ([]*recipepb.Recipe, error) {
 	c.logger.Debug("getting similar recipes", "recipeId", recipeID, "amount", amount, "userId", userID)
 
-	resp, err := c.client.GetSimilarRecipes(ctx, &recipepb.GetSimilarRecipesRequest{
+	resp, err := c.client.GetSimilarRecipes(ctx, &recipepb.GetSimilarRecipesRequest{
+		RecipeId:             recipeID,
+		Amount:               amount,
+		UserId:               userID,
+		IgnoreDietaryProfile: ignoreDietaryProfile,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get similar recipes: %w", err)
+	}
+
+	return resp.GetRecipes(), nil
+}
+
+// ImportRecipe extracts a schema.org recipe from a document or URL.
+func (c *RecipeClient) ImportRecipe(ctx context.Context, req *recipepb.ImportRecipeRequest) (*recipepb.ImportRecipeResponse, error) {
+	c.logger.Debug("importing recipe", "url", req.GetUrl(), "create", req.GetCreate(), "userId", req.GetUserId())
+
+	resp, err := c.client.ImportRecipe(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("import recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ScaleRecipe retrieves a recipe scaled to the given number of servings.
+func (c *RecipeClient) ScaleRecipe(ctx context.Context, userID, recipeID string, servings int32) (*recipepb.ScaleRecipeResponse, error) {
+	c.logger.Debug("scaling recipe", "recipeId", recipeID, "servings", servings, "userId", userID)
+
+	resp, err := c.client.ScaleRecipe(ctx, &recipepb.ScaleRecipeRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+		Servings: servings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("scale recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListRecipeRevisions retrieves the saved versions of a recipe.
+func (c *RecipeClient) ListRecipeRevisions(ctx context.Context, userID, recipeID string) (*recipepb.ListRecipeRevisionsResponse, error) {
+	c.logger.Debug("listing recipe revisions", "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.ListRecipeRevisions(ctx, &recipepb.ListRecipeRevisionsRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list recipe revisions: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetRecipeRevision retrieves a recipe as it was saved at a revision.
+func (c *RecipeClient) GetRecipeRevision(ctx context.Context, userID, recipeID string, revision int32) (*recipepb.RecipeRevision, error) {
+	c.logger.Debug("getting recipe revision", "recipeId", recipeID, "revision", revision, "userId", userID)
+
+	resp, err := c.client.GetRecipeRevision(ctx, &recipepb.GetRecipeRevisionRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+		Revision: revision,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get recipe revision: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DiffRecipeRevisions retrieves the changes between two revisions of a recipe.
+func (c *RecipeClient) DiffRecipeRevisions(ctx context.Context, userID, recipeID string, from, to int32) (*recipepb.RecipeDiff, error) {
+	c.logger.Debug("diffing recipe revisions", "recipeId", recipeID, "from", from, "to", to, "userId", userID)
+
+	resp, err := c.client.DiffRecipeRevisions(ctx, &recipepb.DiffRecipeRevisionsRequest{
+		RecipeId:     recipeID,
+		UserId:       userID,
+		FromRevision: from,
+		ToRevision:   to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("diff recipe revisions: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RestoreRecipeRevision saves a past revision as the current version of a recipe.
+func (c *RecipeClient) RestoreRecipeRevision(ctx context.Context, userID, recipeID string, revision int32) (*recipepb.Recipe, error) {
+	c.logger.Debug("restoring recipe revision", "recipeId", recipeID, "revision", revision, "userId", userID)
+
+	resp, err := c.client.RestoreRecipeRevision(ctx, &recipepb.RestoreRecipeRevisionRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+		Revision: revision,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("restore recipe revision: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListIngredientMatches retrieves the user's ingredient matches with the given status.
+func (c *RecipeClient) ListIngredientMatches(ctx context.Context, userID, status string) (*recipepb.ListIngredientMatchesResponse, error) {
+	c.logger.Debug("listing ingredient matches", "status", status, "userId", userID)
+
+	resp, err := c.client.ListIngredientMatches(ctx, &recipepb.ListIngredientMatchesRequest{
+		UserId: userID,
+		Status: status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list ingredient matches: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ResolveIngredientMatch links an ingredient to a reference food, or rejects all
+// candidates when foodID is empty.
+func (c *RecipeClient) ResolveIngredientMatch(ctx context.Context, userID, ingredientID, foodID string) (*recipepb.IngredientMatch, error) {
+	c.logger.Debug("resolving ingredient match", "ingredientId", ingredientID, "foodId", foodID, "userId", userID)
+
+	resp, err := c.client.ResolveIngredientMatch(ctx, &recipepb.ResolveIngredientMatchRequest{
+		UserId:       userID,
+		IngredientId: ingredientID,
+		FoodId:       foodID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("resolve ingredient match: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetIngredientDietaryAttributes retrieves what one of the user's ingredients contains.
+func (c *RecipeClient) GetIngredientDietaryAttributes(ctx context.Context, userID, ingredientID string) (*recipepb.IngredientDietaryAttributes, error) {
+	c.logger.Debug("getting ingredient dietary attributes", "ingredientId", ingredientID, "userId", userID)
+
+	resp, err := c.client.GetIngredientDietaryAttributes(ctx, &recipepb.GetIngredientDietaryAttributesRequest{
+		UserId:       userID,
+		IngredientId: ingredientID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get ingredient dietary attributes: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetIngredientDietaryAttributes replaces what one of the user's ingredients
+// contains and relabels the recipes using it.
+func (c *RecipeClient) SetIngredientDietaryAttributes(ctx context.Context, userID, ingredientID string, attributes []string) (*recipepb.IngredientDietaryAttributes, error) {
+	c.logger.Debug("setting ingredient dietary attributes", "ingredientId", ingredientID, "attributes", attributes, "userId", userID)
+
+	resp, err := c.client.SetIngredientDietaryAttributes(ctx, &recipepb.SetIngredientDietaryAttributesRequest{
+		UserId:       userID,
+		IngredientId: ingredientID,
+		Attributes:   attributes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set ingredient dietary attributes: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListIngredients retrieves a page of the user's ingredients, ranked by fuzzy
+// name similarity when a query is given.
+func (c *RecipeClient) ListIngredients(ctx context.Context, userID, query string, pageIndex, pageSize int32) (*recipepb.ListIngredientsResponse, error) {
+	c.logger.Debug("listing ingredients", "query", query, "pageIndex", pageIndex, "pageSize", pageSize, "userId", userID)
+
+	resp, err := c.client.ListIngredients(ctx, &recipepb.ListIngredientsRequest{
+		UserId:    userID,
+		Query:     query,
+		PageIndex: pageIndex,
+		PageSize:  pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list ingredients: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RenameIngredient renames one of the user's ingredients.
+func (c *RecipeClient) RenameIngredient(ctx context.Context, userID, ingredientID, name string) (*recipepb.Ingredient, error) {
+	c.logger.Debug("renaming ingredient", "ingredientId", ingredientID, "userId", userID)
+
+	resp, err := c.client.RenameIngredient(ctx, &recipepb.RenameIngredientRequest{
+		UserId:       userID,
+		IngredientId: ingredientID,
+		Name:         name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("rename ingredient: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetIngredientCategory files one of the user's ingredients under a category,
+// or clears its category when categoryID is empty.
+func (c *RecipeClient) SetIngredientCategory(ctx context.Context, userID, ingredientID, categoryID string) (*recipepb.Ingredient, error) {
+	c.logger.Debug("setting ingredient category", "ingredientId", ingredientID, "categoryId", categoryID, "userId", userID)
+
+	resp, err := c.client.SetIngredientCategory(ctx, &recipepb.SetIngredientCategoryRequest{
+		UserId:       userID,
+		IngredientId: ingredientID,
+		CategoryId:   categoryID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set ingredient category: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListIngredientCategories retrieves the categories ingredients can be filed under.
+func (c *RecipeClient) ListIngredientCategories(ctx context.Context, userID string) ([]*recipepb.IngredientCategory, error) {
+	c.logger.Debug("listing ingredient categories", "userId", userID)
+
+	resp, err := c.client.ListIngredientCategories(ctx, &recipepb.ListIngredientCategoriesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list ingredient categories: %w", err)
+	}
+
+	return resp.GetCategories(), nil
+}
+
+// MergeIngredients replaces every use of the source ingredient with the target
+// and deletes the source.
+func (c *RecipeClient) MergeIngredients(ctx context.Context, userID, sourceID, targetID string) (*recipepb.MergeIngredientsResponse, error) {
+	c.logger.Debug("merging ingredients", "sourceId", sourceID, "targetId", targetID, "userId", userID)
+
+	resp, err := c.client.MergeIngredients(ctx, &recipepb.MergeIngredientsRequest{
+		UserId:             userID,
+		SourceIngredientId: sourceID,
+		TargetIngredientId: targetID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("merge ingredients: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListDuplicateIngredients retrieves pairs of the user's ingredients with
+// similar names.
+func (c *RecipeClient) ListDuplicateIngredients(ctx context.Context, userID string, minSimilarity float64, limit int32) ([]*recipepb.IngredientDuplicate, error) {
+	c.logger.Debug("listing duplicate ingredients", "minSimilarity", minSimilarity, "limit", limit, "userId", userID)
+
+	resp, err := c.client.ListDuplicateIngredients(ctx, &recipepb.ListDuplicateIngredientsRequest{
+		UserId:        userID,
+		MinSimilarity: minSimilarity,
+		Limit:         limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list duplicate ingredients: %w", err)
+	}
+
+	return resp.GetDuplicates(), nil
+}
+
+// ShareRecipe shares a recipe with the user registered under email.
+func (c *RecipeClient) ShareRecipe(ctx context.Context, userID, recipeID, email, permission string) (*recipepb.RecipeShare, error) {
+	c.logger.Debug("sharing recipe", "recipeId", recipeID, "permission", permission, "userId", userID)
+
+	resp, err := c.client.ShareRecipe(ctx, &recipepb.ShareRecipeRequest{
+		RecipeId:   recipeID,
+		UserId:     userID,
+		Email:      email,
+		Permission: permission,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("share recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListSharedWithMe retrieves the recipes other users shared with the user.
+func (c *RecipeClient) ListSharedWithMe(ctx context.Context, userID string) (*recipepb.ListRecipeSharesResponse, error) {
+	c.logger.Debug("listing recipes shared with user", "userId", userID)
+
+	resp, err := c.client.ListSharedWithMe(ctx, &recipepb.ListRecipeSharesRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("list recipes shared with user: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListSharedByMe retrieves who the user's recipes are shared with.
+func (c *RecipeClient) ListSharedByMe(ctx context.Context, userID string) (*recipepb.ListRecipeSharesResponse, error) {
+	c.logger.Debug("listing recipes shared by user", "userId", userID)
+
+	resp, err := c.client.ListSharedByMe(ctx, &recipepb.ListRecipeSharesRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("list recipes shared by user: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RevokeRecipeShare removes another user's access to a recipe.
+func (c *RecipeClient) RevokeRecipeShare(ctx context.Context, userID, recipeID, sharedWithUserID string) error {
+	c.logger.Debug("revoking recipe share", "recipeId", recipeID, "sharedWithUserId", sharedWithUserID, "userId", userID)
+
+	_, err := c.client.RevokeRecipeShare(ctx, &recipepb.RevokeRecipeShareRequest{
+		RecipeId:         recipeID,
+		UserId:           userID,
+		SharedWithUserId: sharedWithUserID,
+	})
+	if err != nil {
+		return fmt.Errorf("revoke recipe share: %w", err)
+	}
+
+	return nil
+}
+
+// ListCollections retrieves the user's collections and those shared with them.
+func (c *RecipeClient) ListCollections(ctx context.Context, userID string) ([]*recipepb.Collection, error) {
+	c.logger.Debug("listing collections", "userId", userID)
+
+	resp, err := c.client.ListCollections(ctx, &recipepb.ListCollectionsRequest{UserId: userID})
+	if err != nil {
+		return nil, fmt.Errorf("list collections: %w", err)
+	}
+
+	return resp.GetCollections(), nil
+}
+
+// GetCollection retrieves a collection with its recipes in order.
+func (c *RecipeClient) GetCollection(ctx context.Context, userID, collectionID string) (*recipepb.Collection, error) {
+	c.logger.Debug("getting collection", "collectionId", collectionID, "userId", userID)
+
+	resp, err := c.client.GetCollection(ctx, &recipepb.GetCollectionRequest{
+		CollectionId: collectionID,
+		UserId:       userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CreateCollection creates an empty collection.
+func (c *RecipeClient) CreateCollection(ctx context.Context, userID string, input *recipepb.CollectionInput) (*recipepb.Collection, error) {
+	c.logger.Debug("creating collection", "name", input.GetName(), "userId", userID)
+
+	resp, err := c.client.CreateCollection(ctx, &recipepb.CreateCollectionRequest{
+		Collection: input,
+		UserId:     userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UpdateCollection changes the details of a collection.
+func (c *RecipeClient) UpdateCollection(ctx context.Context, userID, collectionID string, input *recipepb.CollectionInput) (*recipepb.Collection, error) {
+	c.logger.Debug("updating collection", "collectionId", collectionID, "userId", userID)
+
+	resp, err := c.client.UpdateCollection(ctx, &recipepb.UpdateCollectionRequest{
+		CollectionId: collectionID,
+		Collection:   input,
+		UserId:       userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeleteCollection deletes a collection.
+func (c *RecipeClient) DeleteCollection(ctx context.Context, userID, collectionID string) error {
+	c.logger.Debug("deleting collection", "collectionId", collectionID, "userId", userID)
+
+	_, err := c.client.DeleteCollection(ctx, &recipepb.DeleteCollectionRequest{
+		CollectionId: collectionID,
+		UserId:       userID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete collection: %w", err)
+	}
+
+	return nil
+}
+
+// AddRecipeToCollection appends a recipe to a collection.
+func (c *RecipeClient) AddRecipeToCollection(ctx context.Context, userID, collectionID, recipeID string) (*recipepb.Collection, error) {
+	c.logger.Debug("adding recipe to collection", "collectionId", collectionID, "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.AddRecipeToCollection(ctx, &recipepb.CollectionRecipeRequest{
+		CollectionId: collectionID,
+		RecipeId:     recipeID,
+		UserId:       userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("add recipe to collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RemoveRecipeFromCollection removes a recipe from a collection.
+func (c *RecipeClient) RemoveRecipeFromCollection(ctx context.Context, userID, collectionID, recipeID string) (*recipepb.Collection, error) {
+	c.logger.Debug("removing recipe from collection", "collectionId", collectionID, "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.RemoveRecipeFromCollection(ctx, &recipepb.CollectionRecipeRequest{
+		CollectionId: collectionID,
+		RecipeId:     recipeID,
+		UserId:       userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remove recipe from collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ReorderCollection puts the recipes of a collection in the given order.
+func (c *RecipeClient) ReorderCollection(ctx context.Context, userID, collectionID string, recipeIDs []string) (*recipepb.Collection, error) {
+	c.logger.Debug("reordering collection", "collectionId", collectionID, "recipes", len(recipeIDs), "userId", userID)
+
+	resp, err := c.client.ReorderCollection(ctx, &recipepb.ReorderCollectionRequest{
+		CollectionId: collectionID,
+		RecipeIds:    recipeIDs,
+		UserId:       userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reorder collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ShareCollection shares a collection with the user registered under email.
+func (c *RecipeClient) ShareCollection(ctx context.Context, userID, collectionID, email, permission string) (*recipepb.CollectionShare, error) {
+	c.logger.Debug("sharing collection", "collectionId", collectionID, "permission", permission, "userId", userID)
+
+	resp, err := c.client.ShareCollection(ctx, &recipepb.ShareCollectionRequest{
+		CollectionId: collectionID,
+		UserId:       userID,
+		Email:        email,
+		Permission:   permission,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("share collection: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListCollectionShares retrieves who a collection is shared with.
+func (c *RecipeClient) ListCollectionShares(ctx context.Context, userID, collectionID string) ([]*recipepb.CollectionShare, error) {
+	c.logger.Debug("listing collection shares", "collectionId", collectionID, "userId", userID)
+
+	resp, err := c.client.ListCollectionShares(ctx, &recipepb.ListCollectionSharesRequest{
+		CollectionId: collectionID,
+		UserId:       userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list collection shares: %w", err)
+	}
+
+	return resp.GetShares(), nil
+}
+
+// RevokeCollectionShare removes another user's access to a collection.
+func (c *RecipeClient) RevokeCollectionShare(ctx context.Context, userID, collectionID, sharedWithUserID string) error {
+	c.logger.Debug("revoking collection share", "collectionId", collectionID, "sharedWithUserId", sharedWithUserID, "userId", userID)
+
+	_, err := c.client.RevokeCollectionShare(ctx, &recipepb.RevokeCollectionShareRequest{
+		CollectionId:     collectionID,
+		UserId:           userID,
+		SharedWithUserId: sharedWithUserID,
+	})
+	if err != nil {
+		return fmt.Errorf("revoke collection share: %w", err)
+	}
+
+	return nil
+}
+
+// LogCook records that the user cooked a recipe.
+func (c *RecipeClient) LogCook(ctx context.Context, userID, recipeID string, entry *recipepb.CookLogEntryInput) (*recipepb.CookLogEntry, error) {
+	c.logger.Debug("logging cook", "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.LogCook(ctx, &recipepb.LogCookRequest{
 		RecipeId: recipeID,
-		Amount:   amount,
 		UserId:   userID,
+		Entry:    entry,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("get similar recipes: %w", err)
+		return nil, fmt.Errorf("log cook: %w", err)
 	}
 
-	return resp.GetRecipes(), nil
+	return resp, nil
+}
+
+// ListCookLog retrieves the user's cook log, optionally for a single recipe.
+func (c *RecipeClient) ListCookLog(ctx context.Context, userID, recipeID string, limit int32) (*recipepb.ListCookLogResponse, error) {
+	c.logger.Debug("listing cook log", "recipeId", recipeID, "userId", userID)
+
+	resp, err := c.client.ListCookLog(ctx, &recipepb.ListCookLogRequest{
+		UserId:   userID,
+		RecipeId: recipeID,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list cook log: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UpdateCookLogEntry changes one of the user's cook log entries.
+func (c *RecipeClient) UpdateCookLogEntry(ctx context.Context, userID, entryID string, entry *recipepb.CookLogEntryInput) (*recipepb.CookLogEntry, error) {
+	c.logger.Debug("updating cook log entry", "entryId", entryID, "userId", userID)
+
+	resp, err := c.client.UpdateCookLogEntry(ctx, &recipepb.UpdateCookLogEntryRequest{
+		EntryId: entryID,
+		UserId:  userID,
+		Entry:   entry,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update cook log entry: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeleteCookLogEntry deletes one of the user's cook log entries.
+func (c *RecipeClient) DeleteCookLogEntry(ctx context.Context, userID, entryID string) error {
+	c.logger.Debug("deleting cook log entry", "entryId", entryID, "userId", userID)
+
+	_, err := c.client.DeleteCookLogEntry(ctx, &recipepb.DeleteCookLogEntryRequest{
+		EntryId: entryID,
+		UserId:  userID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete cook log entry: %w", err)
+	}
+
+	return nil
+}
+
+// StartCookSession starts cooking a recipe, or joins the user's running session for it.
+func (c *RecipeClient) StartCookSession(ctx context.Context, userID, recipeID string, servings int32) (*recipepb.CookSession, error) {
+	c.logger.Debug("starting cook session", "recipeId", recipeID, "servings", servings, "userId", userID)
+
+	resp, err := c.client.StartCookSession(ctx, &recipepb.StartCookSessionRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+		Servings: servings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start cook session: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetCookSession retrieves the current state of a cook session.
+func (c *RecipeClient) GetCookSession(ctx context.Context, userID, sessionID string) (*recipepb.CookSession, error) {
+	c.logger.Debug("getting cook session", "sessionId", sessionID, "userId", userID)
+
+	resp, err := c.client.GetCookSession(ctx, &recipepb.CookSessionRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get cook session: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListCookSessions retrieves the user's active cook sessions.
+func (c *RecipeClient) ListCookSessions(ctx context.Context, userID string) ([]*recipepb.CookSession, error) {
+	c.logger.Debug("listing cook sessions", "userId", userID)
+
+	resp, err := c.client.ListCookSessions(ctx, &recipepb.ListCookSessionsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list cook sessions: %w", err)
+	}
+
+	return resp.GetSessions(), nil
+}
+
+// MoveCookSessionStep moves a cook session by delta steps, or to stepIndex when it is set.
+func (c *RecipeClient) MoveCookSessionStep(ctx context.Context, userID, sessionID string, delta, stepIndex int32) (*recipepb.CookSession, error) {
+	c.logger.Debug("moving cook session step", "sessionId", sessionID, "delta", delta, "stepIndex", stepIndex, "userId", userID)
+
+	resp, err := c.client.MoveCookSessionStep(ctx, &recipepb.MoveCookSessionStepRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+		Delta:     delta,
+		StepIndex: stepIndex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("move cook session step: %w", err)
+	}
+
+	return resp, nil
+}
+
+// StartCookTimer starts or resumes the timer of a step.
+func (c *RecipeClient) StartCookTimer(ctx context.Context, userID, sessionID string, stepIndex int32) (*recipepb.CookSession, error) {
+	c.logger.Debug("starting cook timer", "sessionId", sessionID, "stepIndex", stepIndex, "userId", userID)
+
+	resp, err := c.client.StartCookTimer(ctx, &recipepb.CookTimerRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+		StepIndex: stepIndex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("start cook timer: %w", err)
+	}
+
+	return resp, nil
+}
+
+// PauseCookTimer pauses the timer of a step.
+func (c *RecipeClient) PauseCookTimer(ctx context.Context, userID, sessionID string, stepIndex int32) (*recipepb.CookSession, error) {
+	c.logger.Debug("pausing cook timer", "sessionId", sessionID, "stepIndex", stepIndex, "userId", userID)
+
+	resp, err := c.client.PauseCookTimer(ctx, &recipepb.CookTimerRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+		StepIndex: stepIndex,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("pause cook timer: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CompleteCookSession finishes a cook session and logs the cook.
+func (c *RecipeClient) CompleteCookSession(ctx context.Context, userID, sessionID string, entry *recipepb.CookLogEntryInput) (*recipepb.CookSession, error) {
+	c.logger.Debug("completing cook session", "sessionId", sessionID, "userId", userID)
+
+	resp, err := c.client.CompleteCookSession(ctx, &recipepb.CompleteCookSessionRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+		Entry:     entry,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("complete cook session: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeleteCookSession abandons a cook session.
+func (c *RecipeClient) DeleteCookSession(ctx context.Context, userID, sessionID string) error {
+	c.logger.Debug("deleting cook session", "sessionId", sessionID, "userId", userID)
+
+	_, err := c.client.DeleteCookSession(ctx, &recipepb.CookSessionRequest{
+		SessionId: sessionID,
+		UserId:    userID,
+	})
+	if err != nil {
+		return fmt.Errorf("delete cook session: %w", err)
+	}
+
+	return nil
+}
+
+// ListRecipeSubstitutions retrieves the substitutions for a recipe's ingredients.
+func (c *RecipeClient) ListRecipeSubstitutions(ctx context.Context, userID, recipeID, allergyID string) ([]*recipepb.IngredientSubstitutions, error) {
+	c.logger.Debug("listing recipe substitutions", "recipeId", recipeID, "allergyId", allergyID, "userId", userID)
+
+	resp, err := c.client.ListRecipeSubstitutions(ctx, &recipepb.ListRecipeSubstitutionsRequest{
+		RecipeId:  recipeID,
+		UserId:    userID,
+		AllergyId: allergyID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list recipe substitutions: %w", err)
+	}
+
+	return resp.GetIngredients(), nil
+}
+
+// SubstituteRecipe creates a copy of a recipe with the given substitutions applied.
+func (c *RecipeClient) SubstituteRecipe(ctx context.Context, userID, recipeID string, substitutionIDs []string, name string) (*recipepb.Recipe, error) {
+	c.logger.Debug("substituting recipe", "recipeId", recipeID, "substitutions", len(substitutionIDs), "userId", userID)
+
+	resp, err := c.client.SubstituteRecipe(ctx, &recipepb.SubstituteRecipeRequest{
+		RecipeId:        recipeID,
+		UserId:          userID,
+		SubstitutionIds: substitutionIDs,
+		Name:            name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("substitute recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetDietaryProfile retrieves the user's dietary profile.
+func (c *RecipeClient) GetDietaryProfile(ctx context.Context, userID string) (*recipepb.DietaryProfile, error) {
+	c.logger.Debug("getting dietary profile", "userId", userID)
+
+	resp, err := c.client.GetDietaryProfile(ctx, &recipepb.GetDietaryProfileRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get dietary profile: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UpdateDietaryProfile replaces the user's dietary profile.
+func (c *RecipeClient) UpdateDietaryProfile(ctx context.Context, req *recipepb.UpdateDietaryProfileRequest) (*recipepb.DietaryProfile, error) {
+	c.logger.Debug("updating dietary profile", "userId", req.GetUserId())
+
+	resp, err := c.client.UpdateDietaryProfile(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("update dietary profile: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListAllergies retrieves the allergies a dietary profile can declare.
+func (c *RecipeClient) ListAllergies(ctx context.Context, userID string) ([]*recipepb.Allergy, error) {
+	c.logger.Debug("listing allergies", "userId", userID)
+
+	resp, err := c.client.ListAllergies(ctx, &recipepb.ListAllergiesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list allergies: %w", err)
+	}
+
+	return resp.GetAllergies(), nil
+}
+
+// ExportRecipe renders a recipe in the requested export format.
+func (c *RecipeClient) ExportRecipe(ctx context.Context, userID, recipeID, format string) (*recipepb.ExportRecipeResponse, error) {
+	c.logger.Debug("exporting recipe", "recipeId", recipeID, "format", format, "userId", userID)
+
+	resp, err := c.client.ExportRecipe(ctx, &recipepb.ExportRecipeRequest{
+		RecipeId: recipeID,
+		UserId:   userID,
+		Format:   format,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export recipe: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ExportRecipeArchive opens a stream of zip archive chunks with all of the user's recipes.
+func (c *RecipeClient) ExportRecipeArchive(ctx context.Context, userID, format string) (grpc.ServerStreamingClient[recipepb.ExportChunk], error) {
+	c.logger.Debug("exporting recipe archive", "format", format, "userId", userID)
+
+	stream, err := c.client.ExportRecipeArchive(ctx, &recipepb.ExportRecipeArchiveRequest{
+		UserId: userID,
+		Format: format,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export recipe archive: %w", err)
+	}
+
+	return stream, nil
+}
+
+// BulkImportRecipes opens a bulk import stream. The caller sends the options
+// first, then one message per recipe.
+func (c *RecipeClient) BulkImportRecipes(ctx context.Context) (grpc.ClientStreamingClient[recipepb.BulkImportRecipesRequest, recipepb.BulkImportRecipesResponse], error) {
+	c.logger.Debug("opening bulk recipe import")
+
+	stream, err := c.client.BulkImportRecipes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bulk import recipes: %w", err)
+	}
+
+	return stream, nil
+}
+
+// ExportRecipes opens a stream of all of the user's recipes in importable form.
+func (c *RecipeClient) ExportRecipes(ctx context.Context, userID string) (grpc.ServerStreamingClient[recipepb.PortableRecipe], error) {
+	c.logger.Debug("exporting recipes", "userId", userID)
+
+	stream, err := c.client.ExportRecipes(ctx, &recipepb.ExportRecipesRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export recipes: %w", err)
+	}
+
+	return stream, nil
 }
 
 // GetCuisines retrieves available cuisines.
@@ -161,3 +1064,135 @@ func (c *RecipeClient) CreateCuisine(ctx context.Context, userID, name string) (
 
 	return resp, nil
 }
+
+// UpdateCuisine renames a cuisine.
+func (c *RecipeClient) UpdateCuisine(ctx context.Context, userID, cuisineID, name string) (*recipepb.Cuisine, error) {
+	c.logger.Debug("updating cuisine", "cuisineId", cuisineID, "name", name, "userId", userID)
+
+	resp, err := c.client.UpdateCuisine(ctx, &recipepb.UpdateCuisineRequest{
+		UserId:    userID,
+		CuisineId: cuisineID,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update cuisine: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeleteCuisine deletes a cuisine, moving its recipes to reassignToID. It
+// returns the number of recipes moved.
+func (c *RecipeClient) DeleteCuisine(ctx context.Context, userID, cuisineID, reassignToID string) (int32, error) {
+	c.logger.Debug("deleting cuisine", "cuisineId", cuisineID, "reassignToId", reassignToID, "userId", userID)
+
+	resp, err := c.client.DeleteCuisine(ctx, &recipepb.DeleteCuisineRequest{
+		UserId:              userID,
+		CuisineId:           cuisineID,
+		ReassignToCuisineId: reassignToID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete cuisine: %w", err)
+	}
+
+	return resp.GetUpdatedRecipes(), nil
+}
+
+// MergeCuisines moves every recipe of the source cuisine to the target and
+// deletes the source.
+func (c *RecipeClient) MergeCuisines(ctx context.Context, userID, sourceID, targetID string) (*recipepb.MergeCuisinesResponse, error) {
+	c.logger.Debug("merging cuisines", "sourceId", sourceID, "targetId", targetID, "userId", userID)
+
+	resp, err := c.client.MergeCuisines(ctx, &recipepb.MergeCuisinesRequest{
+		UserId:          userID,
+		SourceCuisineId: sourceID,
+		TargetCuisineId: targetID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("merge cuisines: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListTags lists the user's tags with their usage counts, optionally narrowed
+// to a prefix or a group.
+func (c *RecipeClient) ListTags(ctx context.Context, userID, prefix, group string, limit int32) ([]*recipepb.Tag, error) {
+	c.logger.Debug("listing tags", "userId", userID, "prefix", prefix, "group", group, "limit", limit)
+
+	resp, err := c.client.ListTags(ctx, &recipepb.ListTagsRequest{
+		UserId: userID,
+		Prefix: prefix,
+		Group:  group,
+		Limit:  limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+
+	return resp.GetTags(), nil
+}
+
+// RenameTag renames a tag on every recipe of the user.
+func (c *RecipeClient) RenameTag(ctx context.Context, userID, tag, name string) (int32, error) {
+	c.logger.Debug("renaming tag", "tag", tag, "name", name, "userId", userID)
+
+	resp, err := c.client.RenameTag(ctx, &recipepb.RenameTagRequest{
+		UserId: userID,
+		Tag:    tag,
+		Name:   name,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("rename tag: %w", err)
+	}
+
+	return resp.GetUpdatedRecipes(), nil
+}
+
+// MergeTags replaces the source tags with the target on every recipe of the
+// user.
+func (c *RecipeClient) MergeTags(ctx context.Context, userID string, sources []string, target string) (int32, error) {
+	c.logger.Debug("merging tags", "sources", sources, "target", target, "userId", userID)
+
+	resp, err := c.client.MergeTags(ctx, &recipepb.MergeTagsRequest{
+		UserId:     userID,
+		SourceTags: sources,
+		TargetTag:  target,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("merge tags: %w", err)
+	}
+
+	return resp.GetUpdatedRecipes(), nil
+}
+
+// DeleteTag removes a tag from every recipe of the user.
+func (c *RecipeClient) DeleteTag(ctx context.Context, userID, tag string) (int32, error) {
+	c.logger.Debug("deleting tag", "tag", tag, "userId", userID)
+
+	resp, err := c.client.DeleteTag(ctx, &recipepb.DeleteTagRequest{
+		UserId: userID,
+		Tag:    tag,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("delete tag: %w", err)
+	}
+
+	return resp.GetUpdatedRecipes(), nil
+}
+
+// SetTagGroup files a tag under a group, or ungroups it when group is empty.
+func (c *RecipeClient) SetTagGroup(ctx context.Context, userID, tag, group string) (*recipepb.Tag, error) {
+	c.logger.Debug("setting tag group", "tag", tag, "group", group, "userId", userID)
+
+	resp, err := c.client.SetTagGroup(ctx, &recipepb.SetTagGroupRequest{
+		UserId: userID,
+		Tag:    tag,
+		Group:  group,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set tag group: %w", err)
+	}
+
+	return resp, nil
+}
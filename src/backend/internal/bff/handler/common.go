@@ -2,10 +2,13 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/platepilot/backend/internal/bff/middleware"
 )
@@ -41,3 +44,39 @@ func requireUserID(r *http.Request) (uuid.UUID, bool) {
 	userID, ok := middleware.UserIDFromContext(r.Context())
 	return userID, ok
 }
+
+// httpStatusFromError maps a gRPC status code to the closest HTTP status.
+func httpStatusFromError(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.FailedPrecondition:
+		return http.StatusUnprocessableEntity
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// errorMessage returns the client-facing gRPC status message of a wrapped
+// error, falling back to a default for internal failures.
+func errorMessage(err error, fallback string) string {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &grpcErr) {
+		return fallback
+	}
+	st := grpcErr.GRPCStatus()
+	if st.Code() == codes.Internal || st.Code() == codes.Unknown || st.Message() == "" {
+		return fallback
+	}
+	return st.Message()
+}
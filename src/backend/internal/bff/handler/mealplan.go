@@ -64,7 +64,7 @@ func (h *MealPlanHandler) GetWeek(w http.ResponseWriter, r *http.Request) {
 
 // Suggest handles POST /v1/mealplan/suggest
 // @Summary      Suggest recipes for meal planning
-// @Description  Suggests recipes based on constraints and already selected recipes
+// @Description  Suggests recipes based on constraints and already selected recipes. Recipes conflicting with the user's dietary profile are left out unless ignoreDietaryProfile is set.
 // @Tags         mealplan
 // @Accept       json
 // @Produce      json
@@ -166,6 +166,12 @@ type SuggestRequest struct {
 	DailyConstraints         []DailyConstraint `json:"dailyConstraints"`
 	AlreadySelectedRecipeIDs []string          `json:"alreadySelectedRecipeIds"`
 	Amount                   int32             `json:"amount"`
+	// IgnoreDietaryProfile includes recipes conflicting with the user's
+	// dietary profile.
+	IgnoreDietaryProfile bool `json:"ignoreDietaryProfile"`
+	// DietLabels limits suggestions to recipes carrying every label, e.g.
+	// vegan or gluten-free.
+	DietLabels []string `json:"dietLabels,omitempty"`
 }
 
 // DailyConstraint represents constraints for a single day
@@ -208,6 +214,8 @@ func (r *SuggestRequest) ToProto(userID string) *mealplannerpb.SuggestionsReques
 		DailyConstraints:         dailyConstraints,
 		AlreadySelectedRecipeIds: r.AlreadySelectedRecipeIDs,
 		Amount:                   r.Amount,
+		IgnoreDietaryProfile:     r.IgnoreDietaryProfile,
+		DietLabels:               sanitizeStrings(r.DietLabels),
 	}
 }
 
@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/platepilot/backend/internal/common/blobstore"
+)
+
+// MediaHandler serves uploaded images from the blob store.
+type MediaHandler struct {
+	store  blobstore.Store
+	logger *slog.Logger
+}
+
+// NewMediaHandler creates a new media handler.
+func NewMediaHandler(store blobstore.Store, logger *slog.Logger) *MediaHandler {
+	return &MediaHandler{
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Serve handles GET /media/*. Media is public so that image URLs work in
+// plain <img> tags; uploads are never modified, so responses are cached
+// indefinitely.
+func (h *MediaHandler) Serve(w http.ResponseWriter, r *http.Request) {
+	key := chi.URLParam(r, "*")
+
+	blob, contentType, err := h.store.Open(r.Context(), key)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) || errors.Is(err, blobstore.ErrInvalidKey) {
+			http.NotFound(w, r)
+			return
+		}
+		h.logger.Error("failed to open media", "key", key, "error", err)
+		http.Error(w, "failed to read media", http.StatusInternalServerError)
+		return
+	}
+	defer blob.Close()
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, blob); err != nil {
+		h.logger.Debug("failed to write media", "key", key, "error", err)
+	}
+}
@@ -11,12 +11,14 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/platepilot/backend/internal/bff/client"
+	"github.com/platepilot/backend/internal/common/media"
 	recipepb "github.com/platepilot/backend/internal/recipe/pb"
 )
 
 // RecipeHandler handles REST requests for recipes.
 type RecipeHandler struct {
 	client *client.RecipeClient
+	images *media.Uploader
 	logger *slog.Logger
 }
 
@@ -34,10 +36,12 @@ func NewRecipeHandler(client *client.RecipeClient, logger *slog.Logger) *RecipeH
 // @Tags         recipes
 // @Accept       json
 // @Produce      json
-// @Param        id   path      string  true  "Recipe ID (UUID)"
+// @Param        id        path      string  true   "Recipe ID (UUID)"
+// @Param        servings  query     int     false  "Scale quantities, yield and nutrition total to this many servings"
 // @Success      200  {object}  RecipeJSON
 // @Failure      400  {object}  ErrorResponse
 // @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
 // @Router       /recipe/{id} [get]
 func (h *RecipeHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 	userID, ok := requireUserID(r)
@@ -52,6 +56,27 @@ func (h *RecipeHandler) GetByID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.URL.Query().Has("servings") {
+		servings, err := strconv.Atoi(r.URL.Query().Get("servings"))
+		if err != nil || servings <= 0 {
+			writeError(w, http.StatusBadRequest, "servings must be a positive integer")
+			return
+		}
+
+		resp, err := h.client.ScaleRecipe(r.Context(), userID.String(), id, int32(servings))
+		if err != nil {
+			h.logger.Error("failed to scale recipe", "id", id, "servings", servings, "error", err)
+			writeError(w, httpStatusFromError(err), errorMessage(err, "failed to scale recipe"))
+			return
+		}
+
+		recipe := toRecipeJSON(resp.GetRecipe())
+		factor := resp.GetFactor()
+		recipe.ScaleFactor = &factor
+		writeJSON(w, http.StatusOK, recipe)
+		return
+	}
+
 	recipe, err := h.client.GetByID(r.Context(), userID.String(), id)
 	if err != nil {
 		h.logger.Error("failed to get recipe", "id", id, "error", err)
@@ -69,21 +94,35 @@ type PaginatedRecipesJSON struct {
 	PageSize   int32        `json:"pageSize"`
 	TotalCount int32        `json:"totalCount"`
 	TotalPages int32        `json:"totalPages"`
+	// NextCursor continues the listing after this page; empty on the last
+	// page or when the sort does not support cursors.
+	NextCursor string `json:"nextCursor,omitempty"`
 }
 
 // List handles GET /v1/recipe
 // @Summary      List recipes (paginated)
-// @Description  Retrieves a paginated list of recipes with optional filters
+// @Description  Retrieves a paginated list of recipes with optional filters. Recipes conflicting with the user's dietary profile are left out unless ignoreDietaryProfile is set. For infinite scroll, pass the nextCursor of the previous page as cursor: keyset pages neither repeat nor skip recipes when the list changes in between, but carry no totals. Cursors work with the column sorts and with the default order when neither q nor collectionId is set.
 // @Tags         recipes
 // @Accept       json
 // @Produce      json
-// @Param        pageIndex   query     int     false  "Page number (1-indexed)"  default(1)
+// @Param        pageIndex   query     int     false  "Page number (1-indexed); ignored with a cursor"  default(1)
 // @Param        pageSize    query     int     false  "Items per page (max 100)" default(20)
 // @Param        cuisineId   query     string  false  "Cuisine ID filter"
 // @Param        ingredientId query    string  false  "Ingredient ID filter"
 // @Param        allergyId   query     string  false  "Allergy ID filter (exclude)"
 // @Param        tags        query     string  false  "Comma-separated tags filter"
+// @Param        q           query     string  false  "Free-text search; results are ranked by relevance"
+// @Param        collectionId query    string  false  "Collection ID filter; results keep the collection order"
+// @Param        sort        query     string  false  "Sort: last_cooked, most_cooked, top_rated, least_recent, or the column sorts name, created_at, updated_at, total_time, calories and rating"
+// @Param        sortOrder   query     string  false  "asc or desc for a column sort; defaults to A-Z, quickest, lightest, newest and best rated first"
+// @Param        cursor      query     string  false  "nextCursor of the previous page; switches to keyset pagination"
+// @Param        minRating   query     number  false  "Minimum average rating (1-5) from the user's cook log"
+// @Param        cookedSince query     string  false  "Only recipes cooked on or after this date (YYYY-MM-DD)"
+// @Param        notCookedSince query  string  false  "Only recipes not cooked since this date (YYYY-MM-DD), including never cooked"
+// @Param        ignoreDietaryProfile query  bool  false  "Include recipes conflicting with the user's dietary profile"
+// @Param        dietLabels  query     string  false  "Comma-separated diet labels every recipe must carry: vegan, vegetarian, pescatarian, gluten-free, dairy-free or nut-free"
 // @Success      200  {object}  PaginatedRecipesJSON
+// @Failure      400  {object}  ErrorResponse
 // @Failure      500  {object}  ErrorResponse
 // @Router       /recipe [get]
 func (h *RecipeHandler) List(w http.ResponseWriter, r *http.Request) {
@@ -107,12 +146,30 @@ func (h *RecipeHandler) List(w http.ResponseWriter, r *http.Request) {
 		IngredientId: strings.TrimSpace(r.URL.Query().Get("ingredientId")),
 		AllergyId: strings.TrimSpace(r.URL.Query().Get("allergyId")),
 		Tags:      splitCommaList(r.URL.Query().Get("tags")),
+		Query:     strings.TrimSpace(r.URL.Query().Get("q")),
+		CollectionId: strings.TrimSpace(r.URL.Query().Get("collectionId")),
+		Sort:         strings.TrimSpace(r.URL.Query().Get("sort")),
+		CookedSince:  strings.TrimSpace(r.URL.Query().Get("cookedSince")),
+		NotCookedSince: strings.TrimSpace(r.URL.Query().Get("notCookedSince")),
+		IgnoreDietaryProfile: parseBoolParam(r, "ignoreDietaryProfile", false),
+		DietLabels:   splitCommaList(r.URL.Query().Get("dietLabels")),
+		SortOrder:    strings.TrimSpace(r.URL.Query().Get("sortOrder")),
+		Cursor:       strings.TrimSpace(r.URL.Query().Get("cursor")),
+	}
+
+	if value := strings.TrimSpace(r.URL.Query().Get("minRating")); value != "" {
+		rating, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "minRating must be a number")
+			return
+		}
+		req.MinRating = wrapperspb.Double(rating)
 	}
 
 	resp, err := h.client.ListRecipes(r.Context(), req)
 	if err != nil {
 		h.logger.Error("failed to list recipes", "error", err)
-		writeError(w, http.StatusInternalServerError, "failed to fetch recipes")
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch recipes"))
 		return
 	}
 
@@ -122,17 +179,19 @@ func (h *RecipeHandler) List(w http.ResponseWriter, r *http.Request) {
 		PageSize:   resp.PageSize,
 		TotalCount: resp.TotalCount,
 		TotalPages: resp.TotalPages,
+		NextCursor: resp.NextCursor,
 	})
 }
 
 // GetSimilar handles GET /v1/recipe/similar
 // @Summary      Get similar recipes
-// @Description  Finds recipes similar to the specified recipe using vector search
+// @Description  Finds recipes similar to the specified recipe using vector search. Recipes conflicting with the user's dietary profile are left out unless ignoreDietaryProfile is set.
 // @Tags         recipes
 // @Accept       json
 // @Produce      json
 // @Param        recipe  query     string  true   "Recipe ID to find similar recipes for"
 // @Param        amount  query     int     false  "Number of similar recipes to return (max 50)" default(5)
+// @Param        ignoreDietaryProfile  query  bool  false  "Include recipes conflicting with the user's dietary profile"
 // @Success      200  {array}   RecipeJSON
 // @Failure      400  {object}  ErrorResponse
 // @Failure      500  {object}  ErrorResponse
@@ -155,7 +214,8 @@ func (h *RecipeHandler) GetSimilar(w http.ResponseWriter, r *http.Request) {
 		amount = 50
 	}
 
-	recipes, err := h.client.GetSimilar(r.Context(), userID.String(), recipeID, int32(amount))
+	ignoreProfile := parseBoolParam(r, "ignoreDietaryProfile", false)
+	recipes, err := h.client.GetSimilar(r.Context(), userID.String(), recipeID, int32(amount), ignoreProfile)
 	if err != nil {
 		h.logger.Error("failed to get similar recipes", "recipeId", recipeID, "error", err)
 		writeError(w, http.StatusInternalServerError, "failed to fetch similar recipes")
@@ -214,6 +274,8 @@ func (h *RecipeHandler) Create(w http.ResponseWriter, r *http.Request) {
 // @Param        recipe  body      RecipeInputJSON   true  "Recipe to update"
 // @Success      200  {object}  RecipeJSON
 // @Failure      400  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
 // @Failure      500  {object}  ErrorResponse
 // @Router       /recipe/{id} [put]
 func (h *RecipeHandler) Update(w http.ResponseWriter, r *http.Request) {
@@ -243,7 +305,7 @@ func (h *RecipeHandler) Update(w http.ResponseWriter, r *http.Request) {
 	recipe, err := h.client.Update(r.Context(), req.ToUpdateProto(userID.String(), id))
 	if err != nil {
 		h.logger.Error("failed to update recipe", "id", id, "error", err)
-		writeError(w, http.StatusInternalServerError, "failed to update recipe")
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to update recipe"))
 		return
 	}
 
@@ -252,7 +314,7 @@ func (h *RecipeHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 // Delete handles DELETE /v1/recipe/{id}
 // @Summary      Delete a recipe
-// @Description  Deletes a recipe by ID
+// @Description  Moves a recipe to the trash; it can be restored until the trash is purged
 // @Tags         recipes
 // @Param        id   path      string  true  "Recipe ID (UUID)"
 // @Success      204
@@ -499,7 +561,8 @@ func (s *RecipeStepJSON) ToProto() *recipepb.RecipeStepInput {
 	}
 }
 
-// RecipeNutritionJSON represents recipe nutrition info.
+// RecipeNutritionJSON represents recipe nutrition info. Unless isOverride is
+// set on input, the recipe-api computes it from the ingredient lines.
 type RecipeNutritionJSON struct {
 	CaloriesTotal      int     `json:"caloriesTotal,omitempty"`
 	CaloriesPerServing int     `json:"caloriesPerServing,omitempty"`
@@ -509,6 +572,7 @@ type RecipeNutritionJSON struct {
 	FiberG             float64 `json:"fiberG,omitempty"`
 	SugarG             float64 `json:"sugarG,omitempty"`
 	SodiumMg           float64 `json:"sodiumMg,omitempty"`
+	IsOverride         bool    `json:"isOverride,omitempty"`
 }
 
 func nutritionToProto(n *RecipeNutritionJSON) *recipepb.RecipeNutrition {
@@ -524,6 +588,7 @@ func nutritionToProto(n *RecipeNutritionJSON) *recipepb.RecipeNutrition {
 		FiberG:             n.FiberG,
 		SugarG:             n.SugarG,
 		SodiumMg:           n.SodiumMg,
+		IsOverride:         n.IsOverride,
 	}
 }
 
@@ -543,8 +608,15 @@ type RecipeJSON struct {
 	IngredientLines  []IngredientLineJSON `json:"ingredientLines"`
 	Steps            []RecipeStepJSON     `json:"steps"`
 	Tags             []string             `json:"tags,omitempty"`
+	DietLabels       []string             `json:"dietLabels,omitempty"`
 	ImageURL         string               `json:"imageUrl,omitempty"`
 	Nutrition        RecipeNutritionJSON  `json:"nutrition"`
+	SearchScore      float64              `json:"searchScore,omitempty"`
+	CookStats        *RecipeCookStatsJSON `json:"cookStats,omitempty"`
+	ScaleFactor      *float64             `json:"scaleFactor,omitempty"`
+	DeletedAt        string               `json:"deletedAt,omitempty"`
+	ForkedFrom       *RecipeForkJSON      `json:"forkedFrom,omitempty"`
+	Allergies        []AllergyJSON        `json:"allergies,omitempty"`
 }
 
 // IngredientRefJSON is the JSON response for ingredient refs.
@@ -555,13 +627,21 @@ type IngredientRefJSON struct {
 
 // IngredientLineJSON is the JSON response for ingredient lines.
 type IngredientLineJSON struct {
-	Ingredient    IngredientRefJSON `json:"ingredient"`
-	QuantityValue *float64          `json:"quantityValue,omitempty"`
-	QuantityText  string            `json:"quantityText,omitempty"`
-	Unit          string            `json:"unit,omitempty"`
-	IsOptional    bool              `json:"isOptional,omitempty"`
-	Note          string            `json:"note,omitempty"`
-	SortOrder     int32             `json:"sortOrder,omitempty"`
+	Ingredient          IngredientRefJSON `json:"ingredient"`
+	QuantityValue       *float64          `json:"quantityValue,omitempty"`
+	QuantityText        string            `json:"quantityText,omitempty"`
+	Unit                string            `json:"unit,omitempty"`
+	IsOptional          bool              `json:"isOptional,omitempty"`
+	Note                string            `json:"note,omitempty"`
+	SortOrder           int32             `json:"sortOrder,omitempty"`
+	NotScaled           bool              `json:"notScaled,omitempty"`
+	NutritionUnresolved bool              `json:"nutritionUnresolved,omitempty"`
+}
+
+// AllergyJSON is the JSON response for an allergy.
+type AllergyJSON struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
 }
 
 // CuisinesResponse is the response for cuisine listing.
@@ -578,45 +658,12 @@ type CuisineJSON struct {
 func toRecipeJSON(r *recipepb.Recipe) RecipeJSON {
 	ingredientLines := make([]IngredientLineJSON, len(r.GetIngredientLines()))
 	for i, line := range r.GetIngredientLines() {
-		var quantityValue *float64
-		if line.GetQuantityValue() != nil {
-			value := line.GetQuantityValue().GetValue()
-			quantityValue = &value
-		}
-		ingredientLines[i] = IngredientLineJSON{
-			Ingredient: IngredientRefJSON{
-				ID:   line.GetIngredient().GetId(),
-				Name: line.GetIngredient().GetName(),
-			},
-			QuantityValue: quantityValue,
-			QuantityText:  line.GetQuantityText(),
-			Unit:          line.GetUnit(),
-			IsOptional:    line.GetIsOptional(),
-			Note:          line.GetNote(),
-			SortOrder:     line.GetSortOrder(),
-		}
+		ingredientLines[i] = toIngredientLineJSON(line)
 	}
 
 	steps := make([]RecipeStepJSON, len(r.GetSteps()))
 	for i, step := range r.GetSteps() {
-		var duration *int
-		if step.GetDurationSeconds() != nil {
-			value := int(step.GetDurationSeconds().GetValue())
-			duration = &value
-		}
-		var temperature *float64
-		if step.GetTemperatureValue() != nil {
-			value := step.GetTemperatureValue().GetValue()
-			temperature = &value
-		}
-		steps[i] = RecipeStepJSON{
-			StepIndex:        int(step.GetStepIndex()),
-			Instruction:      step.GetInstruction(),
-			DurationSeconds:  duration,
-			TemperatureValue: temperature,
-			TemperatureUnit:  step.GetTemperatureUnit(),
-			MediaURL:         step.GetMediaUrl(),
-		}
+		steps[i] = toRecipeStepJSON(step)
 	}
 
 	var yieldQuantity *float64
@@ -652,6 +699,7 @@ func toRecipeJSON(r *recipepb.Recipe) RecipeJSON {
 			FiberG:             r.GetNutrition().GetFiberG(),
 			SugarG:             r.GetNutrition().GetSugarG(),
 			SodiumMg:           r.GetNutrition().GetSodiumMg(),
+			IsOverride:         r.GetNutrition().GetIsOverride(),
 		}
 	}
 
@@ -670,8 +718,75 @@ func toRecipeJSON(r *recipepb.Recipe) RecipeJSON {
 		IngredientLines:  ingredientLines,
 		Steps:            steps,
 		Tags:             r.GetTags(),
+		DietLabels:       r.GetDietLabels(),
 		ImageURL:         r.GetImageUrl(),
 		Nutrition:        nutrition,
+		SearchScore:      r.GetSearchScore(),
+		CookStats:        toRecipeCookStatsJSON(r.GetCookStats()),
+		DeletedAt:        r.GetDeletedAt(),
+		ForkedFrom:       toRecipeForkJSON(r.GetForkedFrom()),
+		Allergies:        toAllergiesJSON(r.GetAllergies()),
+	}
+}
+
+func toAllergiesJSON(allergies []*recipepb.Allergy) []AllergyJSON {
+	if len(allergies) == 0 {
+		return nil
+	}
+	resp := make([]AllergyJSON, len(allergies))
+	for i, allergy := range allergies {
+		resp[i] = toAllergyJSON(allergy)
+	}
+	return resp
+}
+
+func toAllergyJSON(allergy *recipepb.Allergy) AllergyJSON {
+	return AllergyJSON{
+		ID:   allergy.GetId(),
+		Name: allergy.GetName(),
+	}
+}
+
+func toIngredientLineJSON(line *recipepb.IngredientLine) IngredientLineJSON {
+	var quantityValue *float64
+	if line.GetQuantityValue() != nil {
+		value := line.GetQuantityValue().GetValue()
+		quantityValue = &value
+	}
+	return IngredientLineJSON{
+		Ingredient: IngredientRefJSON{
+			ID:   line.GetIngredient().GetId(),
+			Name: line.GetIngredient().GetName(),
+		},
+		QuantityValue:       quantityValue,
+		QuantityText:        line.GetQuantityText(),
+		Unit:                line.GetUnit(),
+		IsOptional:          line.GetIsOptional(),
+		Note:                line.GetNote(),
+		SortOrder:           line.GetSortOrder(),
+		NotScaled:           line.GetNotScaled(),
+		NutritionUnresolved: line.GetNutritionUnresolved(),
+	}
+}
+
+func toRecipeStepJSON(step *recipepb.RecipeStep) RecipeStepJSON {
+	var duration *int
+	if step.GetDurationSeconds() != nil {
+		value := int(step.GetDurationSeconds().GetValue())
+		duration = &value
+	}
+	var temperature *float64
+	if step.GetTemperatureValue() != nil {
+		value := step.GetTemperatureValue().GetValue()
+		temperature = &value
+	}
+	return RecipeStepJSON{
+		StepIndex:        int(step.GetStepIndex()),
+		Instruction:      step.GetInstruction(),
+		DurationSeconds:  duration,
+		TemperatureValue: temperature,
+		TemperatureUnit:  step.GetTemperatureUnit(),
+		MediaURL:         step.GetMediaUrl(),
 	}
 }
 
@@ -706,6 +821,18 @@ func parseIntParam(r *http.Request, name string, defaultValue int) int {
 	return i
 }
 
+func parseBoolParam(r *http.Request, name string, defaultValue bool) bool {
+	val := r.URL.Query().Get(name)
+	if val == "" {
+		return defaultValue
+	}
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		return defaultValue
+	}
+	return b
+}
+
 func sanitizeStrings(values []string) []string {
 	cleaned := make([]string, 0, len(values))
 	for _, value := range values {
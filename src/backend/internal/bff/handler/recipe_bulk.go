@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// maxBulkImportBodyBytes bounds the size of an NDJSON library upload; each
+// line is bounded by maxImportBodyBytes.
+const maxBulkImportBodyBytes = 64 << 20
+
+// PortableRecipeJSON is one line of an NDJSON library export or import: a
+// recipe that references ingredients and the cuisine by name.
+type PortableRecipeJSON struct {
+	// ExternalID identifies the recipe in the library it comes from. Imports
+	// match it before the name to update or skip recipes brought over before.
+	ExternalID string `json:"externalId,omitempty"`
+	RecipeInputJSON
+}
+
+// BulkImportResultJSON is the outcome of one line of a bulk import.
+type BulkImportResultJSON struct {
+	// Line is the line number of the recipe in the upload, from 1.
+	Line       int    `json:"line"`
+	ExternalID string `json:"externalId,omitempty"`
+	Name       string `json:"name"`
+	// Status is created, updated, skipped or error.
+	Status   string `json:"status"`
+	RecipeID string `json:"recipeId,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// BulkImportJSON is the JSON response for a bulk import.
+type BulkImportJSON struct {
+	Results []BulkImportResultJSON `json:"results"`
+	Created int32                  `json:"created"`
+	Updated int32                  `json:"updated"`
+	Skipped int32                  `json:"skipped"`
+	Failed  int32                  `json:"failed"`
+	DryRun  bool                   `json:"dryRun"`
+}
+
+// BulkImport handles POST /v1/recipe/bulk-import
+// @Summary      Import a recipe library
+// @Description  Imports NDJSON with one recipe per line, as produced by the bulk export. Recipes are matched against the library by externalId, then by name; matches are skipped unless updateExisting is set. The whole upload is parsed before anything is imported, so a malformed line rejects it; otherwise each line succeeds or fails on its own.
+// @Tags         recipes
+// @Accept       application/x-ndjson
+// @Produce      json
+// @Param        dryRun          query     bool  false  "Report what would happen without writing anything"  default(false)
+// @Param        updateExisting  query     bool  false  "Update matching recipes instead of skipping them"   default(false)
+// @Success      200  {object}  BulkImportJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/bulk-import [post]
+func (h *RecipeHandler) BulkImport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	recipes, lineNumbers, err := readPortableRecipes(http.MaxBytesReader(w, r.Body, maxBulkImportBodyBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	stream, err := h.client.BulkImportRecipes(r.Context())
+	if err != nil {
+		h.logger.Error("failed to import recipes", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to import recipes"))
+		return
+	}
+
+	messages := make([]*recipepb.BulkImportRecipesRequest, 0, len(recipes)+1)
+	messages = append(messages, &recipepb.BulkImportRecipesRequest{
+		Payload: &recipepb.BulkImportRecipesRequest_Options{Options: &recipepb.BulkImportOptions{
+			UserId:         userID.String(),
+			DryRun:         parseBoolParam(r, "dryRun", false),
+			UpdateExisting: parseBoolParam(r, "updateExisting", false),
+		}},
+	})
+	for _, recipe := range recipes {
+		messages = append(messages, &recipepb.BulkImportRecipesRequest{
+			Payload: &recipepb.BulkImportRecipesRequest_Recipe{Recipe: &recipepb.PortableRecipe{
+				ExternalId: strings.TrimSpace(recipe.ExternalID),
+				Recipe:     recipe.toRecipeInputProto(),
+			}},
+		})
+	}
+	for _, message := range messages {
+		// A failed send means the stream broke; the cause is reported by
+		// CloseAndRecv.
+		if err := stream.Send(message); err != nil {
+			break
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		h.logger.Error("failed to import recipes", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to import recipes"))
+		return
+	}
+
+	result := BulkImportJSON{
+		Results: make([]BulkImportResultJSON, len(resp.GetResults())),
+		Created: resp.GetCreated(),
+		Updated: resp.GetUpdated(),
+		Skipped: resp.GetSkipped(),
+		Failed:  resp.GetFailed(),
+		DryRun:  resp.GetDryRun(),
+	}
+	for i, item := range resp.GetResults() {
+		var line int
+		if index := int(item.GetIndex()); index < len(lineNumbers) {
+			line = lineNumbers[index]
+		}
+		result.Results[i] = BulkImportResultJSON{
+			Line:       line,
+			ExternalID: item.GetExternalId(),
+			Name:       item.GetName(),
+			Status:     item.GetStatus(),
+			RecipeID:   item.GetRecipeId(),
+			Message:    item.GetMessage(),
+		}
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// readPortableRecipes parses an NDJSON upload, skipping blank lines. It
+// returns the recipes with the line number each was read from.
+func readPortableRecipes(body io.Reader) ([]PortableRecipeJSON, []int, error) {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxImportBodyBytes)
+
+	var recipes []PortableRecipeJSON
+	var lineNumbers []int
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var recipe PortableRecipeJSON
+		if err := json.Unmarshal(data, &recipe); err != nil {
+			return nil, nil, fmt.Errorf("line %d: invalid recipe JSON", line)
+		}
+		recipes = append(recipes, recipe)
+		lineNumbers = append(lineNumbers, line)
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			return nil, nil, errString("a line is too long")
+		}
+		return nil, nil, errString("invalid request body")
+	}
+	if len(recipes) == 0 {
+		return nil, nil, errString("no recipes to import")
+	}
+
+	return recipes, lineNumbers, nil
+}
+
+// BulkExport handles GET /v1/recipe/bulk-export
+// @Summary      Export the recipe library
+// @Description  Downloads NDJSON with one recipe per line, referencing ingredients and cuisines by name, that the bulk import accepts
+// @Tags         recipes
+// @Produce      application/x-ndjson
+// @Success      200  {file}    file
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/bulk-export [get]
+func (h *RecipeHandler) BulkExport(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	stream, err := h.client.ExportRecipes(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to export recipes", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to export recipes"))
+		return
+	}
+
+	// Receive the first recipe before committing to a response so that
+	// validation errors can still be reported as JSON.
+	recipe, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error("failed to export recipes", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to export recipes"))
+		return
+	}
+
+	filename := fmt.Sprintf("platepilot-recipes-%s.ndjson", time.Now().UTC().Format("2006-01-02"))
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	encoder := json.NewEncoder(w)
+	for err == nil {
+		line := PortableRecipeJSON{
+			ExternalID:      recipe.GetExternalId(),
+			RecipeInputJSON: toRecipeInputJSON(recipe.GetRecipe()),
+		}
+		if encodeErr := encoder.Encode(line); encodeErr != nil {
+			h.logger.Error("failed to write recipe export", "error", encodeErr)
+			return
+		}
+		recipe, err = stream.Recv()
+	}
+	if !errors.Is(err, io.EOF) {
+		h.logger.Error("recipe export stream failed", "error", err)
+	}
+}
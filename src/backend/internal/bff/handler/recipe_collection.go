@@ -0,0 +1,525 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// CollectionRequest is the request body for creating or updating a collection.
+type CollectionRequest struct {
+	Name          string `json:"name"`
+	Description   string `json:"description,omitempty"`
+	CoverImageURL string `json:"coverImageUrl,omitempty"`
+}
+
+// CollectionRecipeRequest is the request body for adding a recipe to a collection.
+type CollectionRecipeRequest struct {
+	RecipeID string `json:"recipeId"`
+}
+
+// ReorderCollectionRequest is the request body for reordering a collection.
+type ReorderCollectionRequest struct {
+	// RecipeIDs lists every recipe of the collection in the new order.
+	RecipeIDs []string `json:"recipeIds"`
+}
+
+// CollectionJSON is the JSON response for a collection.
+type CollectionJSON struct {
+	ID            string               `json:"id"`
+	UserID        string               `json:"userId"`
+	Name          string               `json:"name"`
+	Description   string               `json:"description,omitempty"`
+	CoverImageURL string               `json:"coverImageUrl,omitempty"`
+	RecipeCount   int32                `json:"recipeCount"`
+	Items         []CollectionItemJSON `json:"items,omitempty"`
+	// Permission is read or edit when the collection is shared with the user.
+	Permission string `json:"permission,omitempty"`
+	// Public collections, like the recipe library, are readable by everyone.
+	Public    bool   `json:"public,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// CollectionItemJSON is a recipe in a collection.
+type CollectionItemJSON struct {
+	RecipeID   string `json:"recipeId"`
+	RecipeName string `json:"recipeName"`
+	ImageURL   string `json:"imageUrl,omitempty"`
+	Position   int32  `json:"position"`
+}
+
+// CollectionListResponse is the response for listing collections.
+type CollectionListResponse struct {
+	Items []CollectionJSON `json:"items"`
+}
+
+// CollectionShareJSON is the JSON response for a collection shared with a user.
+type CollectionShareJSON struct {
+	CollectionID     string `json:"collectionId"`
+	CollectionName   string `json:"collectionName"`
+	OwnerID          string `json:"ownerId"`
+	OwnerEmail       string `json:"ownerEmail"`
+	SharedWithUserID string `json:"sharedWithUserId"`
+	SharedWithEmail  string `json:"sharedWithEmail"`
+	Permission       string `json:"permission"`
+	CreatedAt        string `json:"createdAt"`
+}
+
+// CollectionShareListResponse is the response for listing collection shares.
+type CollectionShareListResponse struct {
+	Items []CollectionShareJSON `json:"items"`
+}
+
+// ListCollections handles GET /v1/recipe/collections
+// @Summary      List collections
+// @Description  Lists the current user's collections and the collections shared with them
+// @Tags         collections
+// @Produce      json
+// @Success      200  {object}  CollectionListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/collections [get]
+func (h *RecipeHandler) ListCollections(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	resp, err := h.client.ListCollections(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to list collections", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list collections"))
+		return
+	}
+
+	items := make([]CollectionJSON, len(resp))
+	for i, collection := range resp {
+		items[i] = toCollectionJSON(collection)
+	}
+
+	writeJSON(w, http.StatusOK, CollectionListResponse{Items: items})
+}
+
+// GetCollection handles GET /v1/recipe/collections/{collectionId}
+// @Summary      Get a collection
+// @Description  Returns a collection with its recipes in order
+// @Tags         collections
+// @Produce      json
+// @Param        collectionId  path      string  true  "Collection ID (UUID)"
+// @Success      200  {object}  CollectionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId} [get]
+func (h *RecipeHandler) GetCollection(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	if collectionID == "" {
+		writeError(w, http.StatusBadRequest, "collection id is required")
+		return
+	}
+
+	resp, err := h.client.GetCollection(r.Context(), userID.String(), collectionID)
+	if err != nil {
+		h.logger.Error("failed to get collection", "collectionId", collectionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to get collection"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCollectionJSON(resp))
+}
+
+// CreateCollection handles POST /v1/recipe/collections
+// @Summary      Create a collection
+// @Description  Creates an empty collection owned by the current user
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        request  body      CollectionRequest  true  "Collection details"
+// @Success      201  {object}  CollectionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/collections [post]
+func (h *RecipeHandler) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req CollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.CreateCollection(r.Context(), userID.String(), toCollectionInput(req))
+	if err != nil {
+		h.logger.Error("failed to create collection", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to create collection"))
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toCollectionJSON(resp))
+}
+
+// UpdateCollection handles PUT /v1/recipe/collections/{collectionId}
+// @Summary      Update a collection
+// @Description  Changes the name, description and cover image of a collection
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        collectionId  path      string             true  "Collection ID (UUID)"
+// @Param        request       body      CollectionRequest  true  "Collection details"
+// @Success      200  {object}  CollectionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId} [put]
+func (h *RecipeHandler) UpdateCollection(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	if collectionID == "" {
+		writeError(w, http.StatusBadRequest, "collection id is required")
+		return
+	}
+
+	var req CollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.UpdateCollection(r.Context(), userID.String(), collectionID, toCollectionInput(req))
+	if err != nil {
+		h.logger.Error("failed to update collection", "collectionId", collectionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to update collection"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCollectionJSON(resp))
+}
+
+// DeleteCollection handles DELETE /v1/recipe/collections/{collectionId}
+// @Summary      Delete a collection
+// @Description  Deletes a collection owned by the current user. The recipes in it are kept.
+// @Tags         collections
+// @Param        collectionId  path      string  true  "Collection ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId} [delete]
+func (h *RecipeHandler) DeleteCollection(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	if collectionID == "" {
+		writeError(w, http.StatusBadRequest, "collection id is required")
+		return
+	}
+
+	if err := h.client.DeleteCollection(r.Context(), userID.String(), collectionID); err != nil {
+		h.logger.Error("failed to delete collection", "collectionId", collectionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to delete collection"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddCollectionRecipe handles POST /v1/recipe/collections/{collectionId}/recipes
+// @Summary      Add a recipe to a collection
+// @Description  Appends one of the collection owner's recipes to the end of the collection
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        collectionId  path      string                   true  "Collection ID (UUID)"
+// @Param        request       body      CollectionRecipeRequest  true  "Recipe to add"
+// @Success      200  {object}  CollectionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId}/recipes [post]
+func (h *RecipeHandler) AddCollectionRecipe(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	if collectionID == "" {
+		writeError(w, http.StatusBadRequest, "collection id is required")
+		return
+	}
+
+	var req CollectionRecipeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.AddRecipeToCollection(r.Context(), userID.String(), collectionID, req.RecipeID)
+	if err != nil {
+		h.logger.Error("failed to add recipe to collection", "collectionId", collectionID, "recipeId", req.RecipeID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to add recipe to collection"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCollectionJSON(resp))
+}
+
+// RemoveCollectionRecipe handles DELETE /v1/recipe/collections/{collectionId}/recipes/{recipeId}
+// @Summary      Remove a recipe from a collection
+// @Description  Removes a recipe from a collection. The recipe itself is kept.
+// @Tags         collections
+// @Produce      json
+// @Param        collectionId  path      string  true  "Collection ID (UUID)"
+// @Param        recipeId      path      string  true  "Recipe ID (UUID)"
+// @Success      200  {object}  CollectionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId}/recipes/{recipeId} [delete]
+func (h *RecipeHandler) RemoveCollectionRecipe(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	recipeID := chi.URLParam(r, "recipeId")
+	if collectionID == "" || recipeID == "" {
+		writeError(w, http.StatusBadRequest, "collection id and recipe id are required")
+		return
+	}
+
+	resp, err := h.client.RemoveRecipeFromCollection(r.Context(), userID.String(), collectionID, recipeID)
+	if err != nil {
+		h.logger.Error("failed to remove recipe from collection", "collectionId", collectionID, "recipeId", recipeID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to remove recipe from collection"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCollectionJSON(resp))
+}
+
+// ReorderCollection handles PUT /v1/recipe/collections/{collectionId}/order
+// @Summary      Reorder a collection
+// @Description  Puts the recipes of a collection in the given order. Every recipe in the collection must be listed exactly once.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        collectionId  path      string                    true  "Collection ID (UUID)"
+// @Param        request       body      ReorderCollectionRequest  true  "Recipes in the new order"
+// @Success      200  {object}  CollectionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId}/order [put]
+func (h *RecipeHandler) ReorderCollection(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	if collectionID == "" {
+		writeError(w, http.StatusBadRequest, "collection id is required")
+		return
+	}
+
+	var req ReorderCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.ReorderCollection(r.Context(), userID.String(), collectionID, req.RecipeIDs)
+	if err != nil {
+		h.logger.Error("failed to reorder collection", "collectionId", collectionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to reorder collection"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCollectionJSON(resp))
+}
+
+// ShareCollection handles POST /v1/recipe/collections/{collectionId}/shares
+// @Summary      Share a collection
+// @Description  Shares a collection with another user by email, read-only or with permission to edit. The user can read every recipe in the collection.
+// @Tags         collections
+// @Accept       json
+// @Produce      json
+// @Param        collectionId  path      string              true  "Collection ID (UUID)"
+// @Param        request       body      ShareRecipeRequest  true  "User to share with"
+// @Success      200  {object}  CollectionShareJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId}/shares [post]
+func (h *RecipeHandler) ShareCollection(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	if collectionID == "" {
+		writeError(w, http.StatusBadRequest, "collection id is required")
+		return
+	}
+
+	var req ShareRecipeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.ShareCollection(r.Context(), userID.String(), collectionID, req.Email, req.Permission)
+	if err != nil {
+		h.logger.Error("failed to share collection", "collectionId", collectionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to share collection"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCollectionShareJSON(resp))
+}
+
+// ListCollectionShares handles GET /v1/recipe/collections/{collectionId}/shares
+// @Summary      List collection shares
+// @Description  Lists who a collection owned by the current user is shared with
+// @Tags         collections
+// @Produce      json
+// @Param        collectionId  path      string  true  "Collection ID (UUID)"
+// @Success      200  {object}  CollectionShareListResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId}/shares [get]
+func (h *RecipeHandler) ListCollectionShares(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	if collectionID == "" {
+		writeError(w, http.StatusBadRequest, "collection id is required")
+		return
+	}
+
+	resp, err := h.client.ListCollectionShares(r.Context(), userID.String(), collectionID)
+	if err != nil {
+		h.logger.Error("failed to list collection shares", "collectionId", collectionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list collection shares"))
+		return
+	}
+
+	items := make([]CollectionShareJSON, len(resp))
+	for i, share := range resp {
+		items[i] = toCollectionShareJSON(share)
+	}
+
+	writeJSON(w, http.StatusOK, CollectionShareListResponse{Items: items})
+}
+
+// RevokeCollectionShare handles DELETE /v1/recipe/collections/{collectionId}/shares/{userId}
+// @Summary      Revoke a collection share
+// @Description  Removes another user's access to a collection
+// @Tags         collections
+// @Param        collectionId  path      string  true  "Collection ID (UUID)"
+// @Param        userId        path      string  true  "ID of the user the collection is shared with (UUID)"
+// @Success      204
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/collections/{collectionId}/shares/{userId} [delete]
+func (h *RecipeHandler) RevokeCollectionShare(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	collectionID := chi.URLParam(r, "collectionId")
+	sharedWithUserID := chi.URLParam(r, "userId")
+	if collectionID == "" || sharedWithUserID == "" {
+		writeError(w, http.StatusBadRequest, "collection id and user id are required")
+		return
+	}
+
+	if err := h.client.RevokeCollectionShare(r.Context(), userID.String(), collectionID, sharedWithUserID); err != nil {
+		h.logger.Error("failed to revoke collection share", "collectionId", collectionID, "sharedWithUserId", sharedWithUserID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to revoke collection share"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toCollectionInput(req CollectionRequest) *recipepb.CollectionInput {
+	return &recipepb.CollectionInput{
+		Name:          req.Name,
+		Description:   req.Description,
+		CoverImageUrl: req.CoverImageURL,
+	}
+}
+
+func toCollectionJSON(collection *recipepb.Collection) CollectionJSON {
+	resp := CollectionJSON{
+		ID:            collection.GetId(),
+		UserID:        collection.GetUserId(),
+		Name:          collection.GetName(),
+		Description:   collection.GetDescription(),
+		CoverImageURL: collection.GetCoverImageUrl(),
+		RecipeCount:   collection.GetRecipeCount(),
+		Permission:    collection.GetPermission(),
+		Public:        collection.GetPublic(),
+		CreatedAt:     collection.GetCreatedAt(),
+		UpdatedAt:     collection.GetUpdatedAt(),
+	}
+	if len(collection.GetItems()) > 0 {
+		resp.Items = make([]CollectionItemJSON, len(collection.GetItems()))
+		for i, item := range collection.GetItems() {
+			resp.Items[i] = CollectionItemJSON{
+				RecipeID:   item.GetRecipeId(),
+				RecipeName: item.GetRecipeName(),
+				ImageURL:   item.GetImageUrl(),
+				Position:   item.GetPosition(),
+			}
+		}
+	}
+	return resp
+}
+
+func toCollectionShareJSON(share *recipepb.CollectionShare) CollectionShareJSON {
+	return CollectionShareJSON{
+		CollectionID:     share.GetCollectionId(),
+		CollectionName:   share.GetCollectionName(),
+		OwnerID:          share.GetOwnerId(),
+		OwnerEmail:       share.GetOwnerEmail(),
+		SharedWithUserID: share.GetSharedWithUserId(),
+		SharedWithEmail:  share.GetSharedWithEmail(),
+		Permission:       share.GetPermission(),
+		CreatedAt:        share.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// CookLogEntryRequest is the request body for logging or updating a cook.
+type CookLogEntryRequest struct {
+	// CookedOn is YYYY-MM-DD and defaults to today.
+	CookedOn string `json:"cookedOn,omitempty"`
+	Servings int32  `json:"servings,omitempty"`
+	// Rating is 1 to 5 stars; omit it to leave the cook unrated.
+	Rating int32  `json:"rating,omitempty"`
+	Notes  string `json:"notes,omitempty"`
+}
+
+// CookLogEntryJSON is the JSON response for a cook log entry.
+type CookLogEntryJSON struct {
+	ID         string `json:"id"`
+	RecipeID   string `json:"recipeId"`
+	RecipeName string `json:"recipeName"`
+	CookedOn   string `json:"cookedOn"`
+	Servings   int32  `json:"servings,omitempty"`
+	Rating     int32  `json:"rating,omitempty"`
+	Notes      string `json:"notes,omitempty"`
+	CreatedAt  string `json:"createdAt"`
+	UpdatedAt  string `json:"updatedAt"`
+}
+
+// RecipeCookStatsJSON aggregates the user's cook log for a recipe.
+type RecipeCookStatsJSON struct {
+	TimesCooked   int32    `json:"timesCooked"`
+	LastCookedOn  string   `json:"lastCookedOn,omitempty"`
+	AverageRating *float64 `json:"averageRating,omitempty"`
+}
+
+// CookLogResponse is the response for listing the cook log.
+type CookLogResponse struct {
+	Items []CookLogEntryJSON `json:"items"`
+	// Stats is only set when listing the cook log of a single recipe.
+	Stats *RecipeCookStatsJSON `json:"stats,omitempty"`
+}
+
+// LogCook handles POST /v1/recipe/{id}/cooks
+// @Summary      Log a cook
+// @Description  Records that the current user cooked a recipe, with optional servings, 1-5 rating and notes
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string               true  "Recipe ID (UUID)"
+// @Param        request  body      CookLogEntryRequest  true  "Cook details"
+// @Success      201  {object}  CookLogEntryJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/cooks [post]
+func (h *RecipeHandler) LogCook(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	var req CookLogEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.LogCook(r.Context(), userID.String(), id, toCookLogEntryInput(req))
+	if err != nil {
+		h.logger.Error("failed to log cook", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to log cook"))
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toCookLogEntryJSON(resp))
+}
+
+// ListRecipeCooks handles GET /v1/recipe/{id}/cooks
+// @Summary      List cooks of a recipe
+// @Description  Lists the current user's cook log for a recipe, most recent first, with times cooked, last cooked date and average rating
+// @Tags         recipes
+// @Produce      json
+// @Param        id     path      string  true   "Recipe ID (UUID)"
+// @Param        limit  query     int     false  "Maximum number of entries (max 200)" default(50)
+// @Success      200  {object}  CookLogResponse
+// @Failure      400  {object}  ErrorResponse
+// @Router       /recipe/{id}/cooks [get]
+func (h *RecipeHandler) ListRecipeCooks(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	h.listCookLog(w, r, userID.String(), id)
+}
+
+// ListCookLog handles GET /v1/recipe/cook-log
+// @Summary      List cook log
+// @Description  Lists everything the current user cooked, most recent first
+// @Tags         recipes
+// @Produce      json
+// @Param        limit  query     int  false  "Maximum number of entries (max 200)" default(50)
+// @Success      200  {object}  CookLogResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/cook-log [get]
+func (h *RecipeHandler) ListCookLog(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	h.listCookLog(w, r, userID.String(), "")
+}
+
+func (h *RecipeHandler) listCookLog(w http.ResponseWriter, r *http.Request, userID, recipeID string) {
+	limit := parseIntParam(r, "limit", 50)
+
+	resp, err := h.client.ListCookLog(r.Context(), userID, recipeID, int32(limit))
+	if err != nil {
+		h.logger.Error("failed to list cook log", "recipeId", recipeID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list cook log"))
+		return
+	}
+
+	items := make([]CookLogEntryJSON, len(resp.GetEntries()))
+	for i, entry := range resp.GetEntries() {
+		items[i] = toCookLogEntryJSON(entry)
+	}
+
+	writeJSON(w, http.StatusOK, CookLogResponse{
+		Items: items,
+		Stats: toRecipeCookStatsJSON(resp.GetStats()),
+	})
+}
+
+// UpdateCookLogEntry handles PUT /v1/recipe/cook-log/{entryId}
+// @Summary      Update a cook log entry
+// @Description  Changes the date, servings, rating and notes of a cook log entry
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        entryId  path      string               true  "Cook log entry ID (UUID)"
+// @Param        request  body      CookLogEntryRequest  true  "Cook details"
+// @Success      200  {object}  CookLogEntryJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/cook-log/{entryId} [put]
+func (h *RecipeHandler) UpdateCookLogEntry(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	entryID := chi.URLParam(r, "entryId")
+	if entryID == "" {
+		writeError(w, http.StatusBadRequest, "entry id is required")
+		return
+	}
+
+	var req CookLogEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.UpdateCookLogEntry(r.Context(), userID.String(), entryID, toCookLogEntryInput(req))
+	if err != nil {
+		h.logger.Error("failed to update cook log entry", "entryId", entryID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to update cook log entry"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCookLogEntryJSON(resp))
+}
+
+// DeleteCookLogEntry handles DELETE /v1/recipe/cook-log/{entryId}
+// @Summary      Delete a cook log entry
+// @Description  Deletes a cook log entry; the recipe's aggregates are recomputed
+// @Tags         recipes
+// @Param        entryId  path      string  true  "Cook log entry ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/cook-log/{entryId} [delete]
+func (h *RecipeHandler) DeleteCookLogEntry(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	entryID := chi.URLParam(r, "entryId")
+	if entryID == "" {
+		writeError(w, http.StatusBadRequest, "entry id is required")
+		return
+	}
+
+	if err := h.client.DeleteCookLogEntry(r.Context(), userID.String(), entryID); err != nil {
+		h.logger.Error("failed to delete cook log entry", "entryId", entryID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to delete cook log entry"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toCookLogEntryInput(req CookLogEntryRequest) *recipepb.CookLogEntryInput {
+	return &recipepb.CookLogEntryInput{
+		CookedOn: strings.TrimSpace(req.CookedOn),
+		Servings: req.Servings,
+		Rating:   req.Rating,
+		Notes:    req.Notes,
+	}
+}
+
+func toCookLogEntryJSON(entry *recipepb.CookLogEntry) CookLogEntryJSON {
+	return CookLogEntryJSON{
+		ID:         entry.GetId(),
+		RecipeID:   entry.GetRecipeId(),
+		RecipeName: entry.GetRecipeName(),
+		CookedOn:   entry.GetCookedOn(),
+		Servings:   entry.GetServings(),
+		Rating:     entry.GetRating(),
+		Notes:      entry.GetNotes(),
+		CreatedAt:  entry.GetCreatedAt(),
+		UpdatedAt:  entry.GetUpdatedAt(),
+	}
+}
+
+func toRecipeCookStatsJSON(stats *recipepb.RecipeCookStats) *RecipeCookStatsJSON {
+	if stats == nil {
+		return nil
+	}
+	resp := &RecipeCookStatsJSON{
+		TimesCooked:  stats.GetTimesCooked(),
+		LastCookedOn: stats.GetLastCookedOn(),
+	}
+	if stats.GetAverageRating() != nil {
+		rating := stats.GetAverageRating().GetValue()
+		resp.AverageRating = &rating
+	}
+	return resp
+}
@@ -0,0 +1,407 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// StartCookSessionRequest is the request body for starting a cook session.
+type StartCookSessionRequest struct {
+	// Servings scales the recipe; omit it to cook the recipe as written.
+	Servings int32 `json:"servings,omitempty"`
+}
+
+// MoveCookSessionStepRequest is the request body for jumping to a step.
+type MoveCookSessionStepRequest struct {
+	StepIndex int32 `json:"stepIndex"`
+}
+
+// CookTimerJSON is the JSON representation of a step timer.
+type CookTimerJSON struct {
+	StepIndex        int32  `json:"stepIndex"`
+	Name             string `json:"name"`
+	DurationSeconds  int32  `json:"durationSeconds"`
+	RemainingSeconds int32  `json:"remainingSeconds"`
+	Running          bool   `json:"running"`
+	// EndsAt is set while the timer runs; compare it with serverTime to
+	// correct for the device's clock.
+	EndsAt string `json:"endsAt,omitempty"`
+}
+
+// CookSessionJSON is the JSON response for a cook session.
+type CookSessionJSON struct {
+	ID             string          `json:"id"`
+	RecipeID       string          `json:"recipeId"`
+	RecipeName     string          `json:"recipeName"`
+	Servings       int32           `json:"servings,omitempty"`
+	CurrentStep    int32           `json:"currentStep"`
+	Timers         []CookTimerJSON `json:"timers"`
+	Status         string          `json:"status"` // active or completed
+	Version        int32           `json:"version"`
+	CookLogEntryID string          `json:"cookLogEntryId,omitempty"`
+	StartedAt      string          `json:"startedAt"`
+	UpdatedAt      string          `json:"updatedAt"`
+	CompletedAt    string          `json:"completedAt,omitempty"`
+	ExpiresAt      string          `json:"expiresAt"`
+	ServerTime     string          `json:"serverTime"`
+	// Recipe is scaled to the session's servings; it is omitted in lists.
+	Recipe *RecipeJSON `json:"recipe,omitempty"`
+}
+
+// CookSessionListResponse is the response for listing cook sessions.
+type CookSessionListResponse struct {
+	Items []CookSessionJSON `json:"items"`
+}
+
+// StartCookSession handles POST /v1/recipe/{id}/cook-sessions
+// @Summary      Start cooking a recipe
+// @Description  Starts a step-by-step cook session, optionally scaled. If the recipe is already being cooked, the running session is returned so another device can join it.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                   true   "Recipe ID (UUID)"
+// @Param        request  body      StartCookSessionRequest  false  "Session options"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/{id}/cook-sessions [post]
+func (h *RecipeHandler) StartCookSession(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	var req StartCookSessionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.StartCookSession(r.Context(), userID.String(), id, req.Servings)
+	if err != nil {
+		h.logger.Error("failed to start cook session", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to start cook session"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCookSessionJSON(resp))
+}
+
+// ListCookSessions handles GET /v1/recipe/cook-sessions
+// @Summary      List cook sessions
+// @Description  Lists the current user's active cook sessions, most recently used first
+// @Tags         recipes
+// @Produce      json
+// @Success      200  {object}  CookSessionListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions [get]
+func (h *RecipeHandler) ListCookSessions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	sessions, err := h.client.ListCookSessions(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to list cook sessions", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list cook sessions"))
+		return
+	}
+
+	items := make([]CookSessionJSON, len(sessions))
+	for i, session := range sessions {
+		items[i] = toCookSessionJSON(session)
+	}
+
+	writeJSON(w, http.StatusOK, CookSessionListResponse{Items: items})
+}
+
+// GetCookSession handles GET /v1/recipe/cook-sessions/{sessionId}
+// @Summary      Get a cook session
+// @Description  Returns the current step and timers of a cook session; poll it to stay in sync with other devices
+// @Tags         recipes
+// @Produce      json
+// @Param        sessionId  path      string  true  "Cook session ID (UUID)"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId} [get]
+func (h *RecipeHandler) GetCookSession(w http.ResponseWriter, r *http.Request) {
+	userID, sessionID, ok := cookSessionParams(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.GetCookSession(r.Context(), userID, sessionID)
+	if err != nil {
+		h.logger.Error("failed to get cook session", "sessionId", sessionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to get cook session"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCookSessionJSON(resp))
+}
+
+// NextCookSessionStep handles POST /v1/recipe/cook-sessions/{sessionId}/next
+// @Summary      Go to the next step
+// @Description  Advances a cook session by one step; stays on the last step
+// @Tags         recipes
+// @Produce      json
+// @Param        sessionId  path      string  true  "Cook session ID (UUID)"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId}/next [post]
+func (h *RecipeHandler) NextCookSessionStep(w http.ResponseWriter, r *http.Request) {
+	h.moveCookSessionStep(w, r, 1, 0)
+}
+
+// PreviousCookSessionStep handles POST /v1/recipe/cook-sessions/{sessionId}/previous
+// @Summary      Go to the previous step
+// @Description  Rewinds a cook session by one step; stays on the first step
+// @Tags         recipes
+// @Produce      json
+// @Param        sessionId  path      string  true  "Cook session ID (UUID)"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId}/previous [post]
+func (h *RecipeHandler) PreviousCookSessionStep(w http.ResponseWriter, r *http.Request) {
+	h.moveCookSessionStep(w, r, -1, 0)
+}
+
+// SetCookSessionStep handles PUT /v1/recipe/cook-sessions/{sessionId}/step
+// @Summary      Jump to a step
+// @Description  Moves a cook session to the given step
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        sessionId  path      string                      true  "Cook session ID (UUID)"
+// @Param        request    body      MoveCookSessionStepRequest  true  "Step to show"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId}/step [put]
+func (h *RecipeHandler) SetCookSessionStep(w http.ResponseWriter, r *http.Request) {
+	var req MoveCookSessionStepRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.StepIndex < 1 {
+		writeError(w, http.StatusBadRequest, "step index must be a positive integer")
+		return
+	}
+
+	h.moveCookSessionStep(w, r, 0, req.StepIndex)
+}
+
+func (h *RecipeHandler) moveCookSessionStep(w http.ResponseWriter, r *http.Request, delta, stepIndex int32) {
+	userID, sessionID, ok := cookSessionParams(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.MoveCookSessionStep(r.Context(), userID, sessionID, delta, stepIndex)
+	if err != nil {
+		h.logger.Error("failed to move cook session step", "sessionId", sessionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to move cook session step"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCookSessionJSON(resp))
+}
+
+// StartCookTimer handles POST /v1/recipe/cook-sessions/{sessionId}/timers/{stepIndex}/start
+// @Summary      Start a step timer
+// @Description  Starts or resumes the timer of a step; a timer that ran out starts over
+// @Tags         recipes
+// @Produce      json
+// @Param        sessionId  path      string  true  "Cook session ID (UUID)"
+// @Param        stepIndex  path      int     true  "Step index (1-based)"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId}/timers/{stepIndex}/start [post]
+func (h *RecipeHandler) StartCookTimer(w http.ResponseWriter, r *http.Request) {
+	h.changeCookTimer(w, r, h.client.StartCookTimer, "start")
+}
+
+// PauseCookTimer handles POST /v1/recipe/cook-sessions/{sessionId}/timers/{stepIndex}/pause
+// @Summary      Pause a step timer
+// @Description  Pauses the timer of a step, keeping the time left
+// @Tags         recipes
+// @Produce      json
+// @Param        sessionId  path      string  true  "Cook session ID (UUID)"
+// @Param        stepIndex  path      int     true  "Step index (1-based)"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId}/timers/{stepIndex}/pause [post]
+func (h *RecipeHandler) PauseCookTimer(w http.ResponseWriter, r *http.Request) {
+	h.changeCookTimer(w, r, h.client.PauseCookTimer, "pause")
+}
+
+func (h *RecipeHandler) changeCookTimer(
+	w http.ResponseWriter,
+	r *http.Request,
+	change func(ctx context.Context, userID, sessionID string, stepIndex int32) (*recipepb.CookSession, error),
+	action string,
+) {
+	userID, sessionID, ok := cookSessionParams(w, r)
+	if !ok {
+		return
+	}
+
+	stepIndex, err := strconv.Atoi(chi.URLParam(r, "stepIndex"))
+	if err != nil || stepIndex < 1 {
+		writeError(w, http.StatusBadRequest, "step index must be a positive integer")
+		return
+	}
+
+	resp, err := change(r.Context(), userID, sessionID, int32(stepIndex))
+	if err != nil {
+		h.logger.Error("failed to "+action+" cook timer", "sessionId", sessionID, "stepIndex", stepIndex, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to "+action+" cook timer"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCookSessionJSON(resp))
+}
+
+// CompleteCookSession handles POST /v1/recipe/cook-sessions/{sessionId}/complete
+// @Summary      Complete a cook session
+// @Description  Finishes a cook session and records it in the cook log; servings default to the session's servings
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        sessionId  path      string               true   "Cook session ID (UUID)"
+// @Param        request    body      CookLogEntryRequest  false  "Cook details"
+// @Success      200  {object}  CookSessionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId}/complete [post]
+func (h *RecipeHandler) CompleteCookSession(w http.ResponseWriter, r *http.Request) {
+	userID, sessionID, ok := cookSessionParams(w, r)
+	if !ok {
+		return
+	}
+
+	var req CookLogEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.CompleteCookSession(r.Context(), userID, sessionID, toCookLogEntryInput(req))
+	if err != nil {
+		h.logger.Error("failed to complete cook session", "sessionId", sessionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to complete cook session"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toCookSessionJSON(resp))
+}
+
+// DeleteCookSession handles DELETE /v1/recipe/cook-sessions/{sessionId}
+// @Summary      Abandon a cook session
+// @Description  Deletes a cook session without logging a cook
+// @Tags         recipes
+// @Param        sessionId  path      string  true  "Cook session ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/cook-sessions/{sessionId} [delete]
+func (h *RecipeHandler) DeleteCookSession(w http.ResponseWriter, r *http.Request) {
+	userID, sessionID, ok := cookSessionParams(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.client.DeleteCookSession(r.Context(), userID, sessionID); err != nil {
+		h.logger.Error("failed to delete cook session", "sessionId", sessionID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to delete cook session"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// cookSessionParams reads the user and session ID of a cook session request,
+// writing the error response when one is missing.
+func cookSessionParams(w http.ResponseWriter, r *http.Request) (string, string, bool) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return "", "", false
+	}
+
+	sessionID := chi.URLParam(r, "sessionId")
+	if sessionID == "" {
+		writeError(w, http.StatusBadRequest, "session id is required")
+		return "", "", false
+	}
+
+	return userID.String(), sessionID, true
+}
+
+func toCookSessionJSON(session *recipepb.CookSession) CookSessionJSON {
+	resp := CookSessionJSON{
+		ID:             session.GetId(),
+		RecipeID:       session.GetRecipeId(),
+		RecipeName:     session.GetRecipeName(),
+		Servings:       session.GetServings(),
+		CurrentStep:    session.GetCurrentStep(),
+		Timers:         make([]CookTimerJSON, len(session.GetTimers())),
+		Status:         session.GetStatus(),
+		Version:        session.GetVersion(),
+		CookLogEntryID: session.GetCookLogEntryId(),
+		StartedAt:      session.GetStartedAt(),
+		UpdatedAt:      session.GetUpdatedAt(),
+		CompletedAt:    session.GetCompletedAt(),
+		ExpiresAt:      session.GetExpiresAt(),
+		ServerTime:     session.GetServerTime(),
+	}
+	for i, timer := range session.GetTimers() {
+		resp.Timers[i] = CookTimerJSON{
+			StepIndex:        timer.GetStepIndex(),
+			Name:             timer.GetName(),
+			DurationSeconds:  timer.GetDurationSeconds(),
+			RemainingSeconds: timer.GetRemainingSeconds(),
+			Running:          timer.GetRunning(),
+			EndsAt:           timer.GetEndsAt(),
+		}
+	}
+	if session.GetRecipe() != nil {
+		recipe := toRecipeJSON(session.GetRecipe())
+		resp.Recipe = &recipe
+	}
+	return resp
+}
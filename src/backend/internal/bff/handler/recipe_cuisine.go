@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// UpdateCuisineRequest is the request body for renaming a cuisine.
+type UpdateCuisineRequest struct {
+	Name string `json:"name"`
+}
+
+// MergeCuisinesRequest is the request body for merging a cuisine into
+// another.
+type MergeCuisinesRequest struct {
+	// IntoCuisineID is the cuisine that replaces the merged one.
+	IntoCuisineID string `json:"intoCuisineId"`
+}
+
+// DeleteCuisineJSON is the JSON response for a cuisine deletion.
+type DeleteCuisineJSON struct {
+	// UpdatedRecipes is the number of active recipes moved to another cuisine.
+	UpdatedRecipes int32 `json:"updatedRecipes"`
+}
+
+// MergeCuisinesJSON is the JSON response for a cuisine merge.
+type MergeCuisinesJSON struct {
+	Cuisine        CuisineJSON `json:"cuisine"`
+	UpdatedRecipes int32       `json:"updatedRecipes"`
+}
+
+// UpdateCuisine handles PUT /v1/recipe/cuisines/{cuisineId}
+// @Summary      Rename a cuisine
+// @Description  Renames one of the user's cuisines; recipes using it show the new name. Renaming to the name of another cuisine is refused; merge the two instead.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        cuisineId  path      string                true  "Cuisine ID (UUID)"
+// @Param        request    body      UpdateCuisineRequest  true  "New name"
+// @Success      200  {object}  CuisineJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Router       /recipe/cuisines/{cuisineId} [put]
+func (h *RecipeHandler) UpdateCuisine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	cuisineID := chi.URLParam(r, "cuisineId")
+	if cuisineID == "" {
+		writeError(w, http.StatusBadRequest, "cuisine id is required")
+		return
+	}
+
+	var req UpdateCuisineRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	cuisine, err := h.client.UpdateCuisine(r.Context(), userID.String(), cuisineID, name)
+	if err != nil {
+		h.logger.Error("failed to update cuisine", "cuisineId", cuisineID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to update cuisine"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, CuisineJSON{ID: cuisine.GetId(), Name: cuisine.GetName()})
+}
+
+// DeleteCuisine handles DELETE /v1/recipe/cuisines/{cuisineId}
+// @Summary      Delete a cuisine
+// @Description  Deletes one of the user's cuisines. Every recipe needs a cuisine, so while recipes use it another cuisine to move them to must be given.
+// @Tags         recipes
+// @Produce      json
+// @Param        cuisineId   path      string  true   "Cuisine ID (UUID)"
+// @Param        reassignTo  query     string  false  "Cuisine ID (UUID) to move the cuisine's recipes to"
+// @Success      200  {object}  DeleteCuisineJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/cuisines/{cuisineId} [delete]
+func (h *RecipeHandler) DeleteCuisine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	cuisineID := chi.URLParam(r, "cuisineId")
+	if cuisineID == "" {
+		writeError(w, http.StatusBadRequest, "cuisine id is required")
+		return
+	}
+
+	reassignTo := strings.TrimSpace(r.URL.Query().Get("reassignTo"))
+	updated, err := h.client.DeleteCuisine(r.Context(), userID.String(), cuisineID, reassignTo)
+	if err != nil {
+		h.logger.Error("failed to delete cuisine", "cuisineId", cuisineID, "reassignTo", reassignTo, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to delete cuisine"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, DeleteCuisineJSON{UpdatedRecipes: updated})
+}
+
+// MergeCuisine handles POST /v1/recipe/cuisines/{cuisineId}/merge
+// @Summary      Merge a cuisine into another
+// @Description  Moves every recipe of the cuisine to another cuisine and deletes it
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        cuisineId  path      string                true  "ID of the cuisine to merge away (UUID)"
+// @Param        request    body      MergeCuisinesRequest  true  "Cuisine to keep"
+// @Success      200  {object}  MergeCuisinesJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/cuisines/{cuisineId}/merge [post]
+func (h *RecipeHandler) MergeCuisine(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	cuisineID := chi.URLParam(r, "cuisineId")
+	if cuisineID == "" {
+		writeError(w, http.StatusBadRequest, "cuisine id is required")
+		return
+	}
+
+	var req MergeCuisinesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if strings.TrimSpace(req.IntoCuisineID) == "" {
+		writeError(w, http.StatusBadRequest, "intoCuisineId is required")
+		return
+	}
+
+	resp, err := h.client.MergeCuisines(r.Context(), userID.String(), cuisineID, strings.TrimSpace(req.IntoCuisineID))
+	if err != nil {
+		h.logger.Error("failed to merge cuisines", "cuisineId", cuisineID, "intoCuisineId", req.IntoCuisineID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to merge cuisines"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, MergeCuisinesJSON{
+		Cuisine:        CuisineJSON{ID: resp.GetCuisine().GetId(), Name: resp.GetCuisine().GetName()},
+		UpdatedRecipes: resp.GetUpdatedRecipes(),
+	})
+}
@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// IngredientDietaryAttributesJSON is the JSON response for what an ingredient
+// contains.
+type IngredientDietaryAttributesJSON struct {
+	Ingredient IngredientRefJSON `json:"ingredient"`
+	// Classified is false until attributes were set; recipes using an
+	// unclassified ingredient get no diet labels.
+	Classified bool     `json:"classified"`
+	Attributes []string `json:"attributes"`
+	UpdatedAt  string   `json:"updatedAt,omitempty"`
+	// ReclassifiedRecipes is the number of recipes whose diet labels changed.
+	ReclassifiedRecipes int32 `json:"reclassifiedRecipes,omitempty"`
+}
+
+// SetIngredientDietaryAttributesRequest is the request body for setting what
+// an ingredient contains.
+type SetIngredientDietaryAttributesRequest struct {
+	// Attributes are meat, fish, dairy, egg, animal_product, gluten or nuts;
+	// leave empty for an ingredient that contains none of them.
+	Attributes []string `json:"attributes"`
+}
+
+// GetIngredientDietaryAttributes handles GET /v1/recipe/ingredients/{ingredientId}/dietary
+// @Summary      Get ingredient dietary attributes
+// @Description  Retrieves what one of the user's ingredients contains, used to label recipes with the diets they fit
+// @Tags         recipes
+// @Produce      json
+// @Param        ingredientId  path      string  true  "Ingredient ID (UUID)"
+// @Success      200  {object}  IngredientDietaryAttributesJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/ingredients/{ingredientId}/dietary [get]
+func (h *RecipeHandler) GetIngredientDietaryAttributes(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ingredientID := chi.URLParam(r, "ingredientId")
+	if ingredientID == "" {
+		writeError(w, http.StatusBadRequest, "ingredient id is required")
+		return
+	}
+
+	resp, err := h.client.GetIngredientDietaryAttributes(r.Context(), userID.String(), ingredientID)
+	if err != nil {
+		h.logger.Error("failed to get ingredient dietary attributes", "ingredientId", ingredientID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch ingredient dietary attributes"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toIngredientDietaryAttributesJSON(resp))
+}
+
+// SetIngredientDietaryAttributes handles PUT /v1/recipe/ingredients/{ingredientId}/dietary
+// @Summary      Set ingredient dietary attributes
+// @Description  Replaces what one of the user's ingredients contains and relabels the recipes using it with the diets they fit
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        ingredientId  path      string                                 true  "Ingredient ID (UUID)"
+// @Param        request       body      SetIngredientDietaryAttributesRequest  true  "Dietary attributes"
+// @Success      200  {object}  IngredientDietaryAttributesJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/ingredients/{ingredientId}/dietary [put]
+func (h *RecipeHandler) SetIngredientDietaryAttributes(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ingredientID := chi.URLParam(r, "ingredientId")
+	if ingredientID == "" {
+		writeError(w, http.StatusBadRequest, "ingredient id is required")
+		return
+	}
+
+	var req SetIngredientDietaryAttributesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.SetIngredientDietaryAttributes(r.Context(), userID.String(), ingredientID, sanitizeStrings(req.Attributes))
+	if err != nil {
+		h.logger.Error("failed to set ingredient dietary attributes", "ingredientId", ingredientID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to set ingredient dietary attributes"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toIngredientDietaryAttributesJSON(resp))
+}
+
+func toIngredientDietaryAttributesJSON(attributes *recipepb.IngredientDietaryAttributes) IngredientDietaryAttributesJSON {
+	resp := IngredientDietaryAttributesJSON{
+		Ingredient: IngredientRefJSON{
+			ID:   attributes.GetIngredient().GetId(),
+			Name: attributes.GetIngredient().GetName(),
+		},
+		Classified:          attributes.GetClassified(),
+		Attributes:          attributes.GetAttributes(),
+		UpdatedAt:           attributes.GetUpdatedAt(),
+		ReclassifiedRecipes: attributes.GetReclassifiedRecipes(),
+	}
+	if resp.Attributes == nil {
+		resp.Attributes = []string{}
+	}
+	return resp
+}
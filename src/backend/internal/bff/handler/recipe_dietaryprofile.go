@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// DietaryProfileJSON is the JSON representation of a user's dietary profile.
+type DietaryProfileJSON struct {
+	Allergies []AllergyJSON `json:"allergies"`
+	// Diets are vegetarian, vegan or pescatarian.
+	Diets []string `json:"diets"`
+	// DislikedIngredients are ingredient names, matched case-insensitively.
+	DislikedIngredients []string `json:"dislikedIngredients"`
+	UpdatedAt           string   `json:"updatedAt,omitempty"`
+}
+
+// UpdateDietaryProfileRequest is the request body for replacing a dietary profile.
+type UpdateDietaryProfileRequest struct {
+	AllergyIDs          []string `json:"allergyIds"`
+	Diets               []string `json:"diets"`
+	DislikedIngredients []string `json:"dislikedIngredients"`
+}
+
+// GetDietaryProfile handles GET /v1/profile/dietary
+// @Summary      Get dietary profile
+// @Description  Retrieves the current user's allergies, diets and disliked ingredients. Users who never saved a profile get an empty one.
+// @Tags         profile
+// @Produce      json
+// @Success      200  {object}  DietaryProfileJSON
+// @Failure      401  {object}  ErrorResponse
+// @Router       /profile/dietary [get]
+func (h *RecipeHandler) GetDietaryProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	profile, err := h.client.GetDietaryProfile(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to get dietary profile", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch dietary profile"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toDietaryProfileJSON(profile))
+}
+
+// UpdateDietaryProfile handles PUT /v1/profile/dietary
+// @Summary      Update dietary profile
+// @Description  Replaces the current user's dietary profile. Recipe listings, similar recipes and meal plan suggestions leave out conflicting recipes unless ignoreDietaryProfile is set.
+// @Tags         profile
+// @Accept       json
+// @Produce      json
+// @Param        request  body      UpdateDietaryProfileRequest  true  "Dietary profile"
+// @Success      200  {object}  DietaryProfileJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /profile/dietary [put]
+func (h *RecipeHandler) UpdateDietaryProfile(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req UpdateDietaryProfileRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	profile, err := h.client.UpdateDietaryProfile(r.Context(), &recipepb.UpdateDietaryProfileRequest{
+		UserId:              userID.String(),
+		AllergyIds:          sanitizeStrings(req.AllergyIDs),
+		Diets:               sanitizeStrings(req.Diets),
+		DislikedIngredients: sanitizeStrings(req.DislikedIngredients),
+	})
+	if err != nil {
+		h.logger.Error("failed to update dietary profile", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to update dietary profile"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toDietaryProfileJSON(profile))
+}
+
+// ListAllergies handles GET /v1/recipe/allergies
+// @Summary      List allergies
+// @Description  Lists the allergies a dietary profile can declare
+// @Tags         recipes
+// @Produce      json
+// @Success      200  {array}   AllergyJSON
+// @Failure      401  {object}  ErrorResponse
+// @Router       /recipe/allergies [get]
+func (h *RecipeHandler) ListAllergies(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	allergies, err := h.client.ListAllergies(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to list allergies", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch allergies"))
+		return
+	}
+
+	items := toAllergiesJSON(allergies)
+	if items == nil {
+		items = []AllergyJSON{}
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func toDietaryProfileJSON(profile *recipepb.DietaryProfile) DietaryProfileJSON {
+	resp := DietaryProfileJSON{
+		Allergies:           toAllergiesJSON(profile.GetAllergies()),
+		Diets:               profile.GetDiets(),
+		DislikedIngredients: profile.GetDislikedIngredients(),
+		UpdatedAt:           profile.GetUpdatedAt(),
+	}
+	if resp.Allergies == nil {
+		resp.Allergies = []AllergyJSON{}
+	}
+	if resp.Diets == nil {
+		resp.Diets = []string{}
+	}
+	if resp.DislikedIngredients == nil {
+		resp.DislikedIngredients = []string{}
+	}
+	return resp
+}
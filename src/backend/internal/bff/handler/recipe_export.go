@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Export handles GET /v1/recipe/{id}/export
+// @Summary      Export a recipe
+// @Description  Renders a recipe as schema.org JSON-LD, Markdown or plain text
+// @Tags         recipes
+// @Produce      application/ld+json
+// @Produce      text/markdown
+// @Produce      text/plain
+// @Param        id      path      string  true   "Recipe ID (UUID)"
+// @Param        format  query     string  false  "Export format: jsonld, markdown or text"  default(jsonld)
+// @Success      200  {file}    file
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/export [get]
+func (h *RecipeHandler) Export(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	resp, err := h.client.ExportRecipe(r.Context(), userID.String(), id, r.URL.Query().Get("format"))
+	if err != nil {
+		h.logger.Error("failed to export recipe", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to export recipe"))
+		return
+	}
+
+	w.Header().Set("Content-Type", resp.GetContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.GetFilename()))
+	w.WriteHeader(http.StatusOK)
+	w.Write(resp.GetContent())
+}
+
+// ExportAll handles GET /v1/recipe/export
+// @Summary      Export all recipes
+// @Description  Downloads a zip archive with one file per recipe owned by the user
+// @Tags         recipes
+// @Produce      application/zip
+// @Param        format  query     string  false  "Format of each file: jsonld, markdown or text"  default(jsonld)
+// @Success      200  {file}    file
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/export [get]
+func (h *RecipeHandler) ExportAll(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	stream, err := h.client.ExportRecipeArchive(r.Context(), userID.String(), r.URL.Query().Get("format"))
+	if err != nil {
+		h.logger.Error("failed to export recipes", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to export recipes"))
+		return
+	}
+
+	// Receive the first chunk before committing to a response so that
+	// validation errors can still be reported as JSON.
+	chunk, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Error("failed to export recipes", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to export recipes"))
+		return
+	}
+
+	filename := fmt.Sprintf("platepilot-recipes-%s.zip", time.Now().UTC().Format("2006-01-02"))
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	for err == nil {
+		if _, writeErr := w.Write(chunk.GetData()); writeErr != nil {
+			h.logger.Error("failed to write export archive", "error", writeErr)
+			return
+		}
+		chunk, err = stream.Recv()
+	}
+	if !errors.Is(err, io.EOF) {
+		h.logger.Error("export archive stream failed", "error", err)
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// DuplicateRecipeRequest is the optional request body for duplicating a recipe.
+type DuplicateRecipeRequest struct {
+	// Name defaults to the original recipe's name.
+	Name string `json:"name,omitempty"`
+}
+
+// RecipeForkJSON links a duplicated recipe to the recipe it was copied from.
+type RecipeForkJSON struct {
+	RecipeID           string `json:"recipeId"`
+	RecipeName         string `json:"recipeName"`
+	Revision           int32  `json:"revision"`
+	LatestRevision     int32  `json:"latestRevision"`
+	HasUpstreamChanges bool   `json:"hasUpstreamChanges"`
+}
+
+// Duplicate handles POST /v1/recipe/{id}/duplicate
+// @Summary      Duplicate a recipe
+// @Description  Copies a recipe the current user can read, including shared ones, into their own library. The copy links back to the original.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                  true   "Recipe ID (UUID)"
+// @Param        request  body      DuplicateRecipeRequest  false  "Name of the copy"
+// @Success      201  {object}  RecipeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/duplicate [post]
+func (h *RecipeHandler) Duplicate(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	var req DuplicateRecipeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	recipe, err := h.client.Duplicate(r.Context(), userID.String(), id, strings.TrimSpace(req.Name))
+	if err != nil {
+		h.logger.Error("failed to duplicate recipe", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to duplicate recipe"))
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toRecipeJSON(recipe))
+}
+
+// PullUpstream handles POST /v1/recipe/{id}/pull-upstream
+// @Summary      Pull upstream changes
+// @Description  Replaces a duplicated recipe's content with the current version of the recipe it was copied from. The name is kept and the previous version stays available as a revision.
+// @Tags         recipes
+// @Produce      json
+// @Param        id   path      string  true  "Recipe ID (UUID) of the duplicate"
+// @Success      200  {object}  RecipeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      422  {object}  ErrorResponse
+// @Router       /recipe/{id}/pull-upstream [post]
+func (h *RecipeHandler) PullUpstream(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	recipe, err := h.client.PullUpstream(r.Context(), userID.String(), id)
+	if err != nil {
+		h.logger.Error("failed to pull upstream recipe", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to pull upstream recipe"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeJSON(recipe))
+}
+
+func toRecipeForkJSON(fork *recipepb.RecipeFork) *RecipeForkJSON {
+	if fork == nil {
+		return nil
+	}
+	return &RecipeForkJSON{
+		RecipeID:           fork.GetRecipeId(),
+		RecipeName:         fork.GetRecipeName(),
+		Revision:           fork.GetRevision(),
+		LatestRevision:     fork.GetLatestRevision(),
+		HasUpstreamChanges: fork.GetHasUpstreamChanges(),
+	}
+}
@@ -0,0 +1,186 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/platepilot/backend/internal/common/media"
+)
+
+// multipartOverhead is the room left for multipart headers and boundaries on
+// top of the image size limit.
+const multipartOverhead = 64 << 10
+
+// ImageJSON is the JSON representation of an uploaded image.
+type ImageJSON struct {
+	ID string `json:"id"`
+	// URL is the "large" variant, which is what recipes and steps display.
+	URL         string            `json:"url"`
+	OriginalURL string            `json:"originalUrl"`
+	Variants    map[string]string `json:"variants"` // large, medium and thumb
+	Width       int               `json:"width"`
+	Height      int               `json:"height"`
+}
+
+// WithImageUploader enables the image upload endpoints.
+func (h *RecipeHandler) WithImageUploader(uploader *media.Uploader) *RecipeHandler {
+	h.images = uploader
+	return h
+}
+
+// UploadImage handles POST /v1/recipe/images
+// @Summary      Upload an image
+// @Description  Stores a JPEG or PNG image with its large, medium and thumbnail JPEG variants and strips EXIF metadata. Use the returned URL as a recipe imageUrl or step mediaUrl.
+// @Tags         recipes
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        image  formData  file  true  "JPEG or PNG image"
+// @Success      201  {object}  ImageJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      413  {object}  ErrorResponse
+// @Failure      415  {object}  ErrorResponse
+// @Router       /recipe/images [post]
+func (h *RecipeHandler) UploadImage(w http.ResponseWriter, r *http.Request) {
+	if _, ok := requireUserID(r); !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	img, ok := h.uploadImage(w, r)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toImageJSON(img))
+}
+
+// SetImage handles POST /v1/recipe/{id}/image
+// @Summary      Upload a recipe image
+// @Description  Uploads a JPEG or PNG image and sets it as the recipe's image
+// @Tags         recipes
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id     path      string  true  "Recipe ID (UUID)"
+// @Param        image  formData  file    true  "JPEG or PNG image"
+// @Success      200  {object}  RecipeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      413  {object}  ErrorResponse
+// @Failure      415  {object}  ErrorResponse
+// @Router       /recipe/{id}/image [post]
+func (h *RecipeHandler) SetImage(w http.ResponseWriter, r *http.Request) {
+	h.setImage(w, r, 0)
+}
+
+// SetStepMedia handles POST /v1/recipe/{id}/steps/{stepIndex}/media
+// @Summary      Upload a step image
+// @Description  Uploads a JPEG or PNG image and sets it as the media of a recipe step
+// @Tags         recipes
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        id         path      string  true  "Recipe ID (UUID)"
+// @Param        stepIndex  path      int     true  "Step index (1-based)"
+// @Param        image      formData  file    true  "JPEG or PNG image"
+// @Success      200  {object}  RecipeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      403  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      413  {object}  ErrorResponse
+// @Failure      415  {object}  ErrorResponse
+// @Router       /recipe/{id}/steps/{stepIndex}/media [post]
+func (h *RecipeHandler) SetStepMedia(w http.ResponseWriter, r *http.Request) {
+	stepIndex, err := strconv.Atoi(chi.URLParam(r, "stepIndex"))
+	if err != nil || stepIndex < 1 {
+		writeError(w, http.StatusBadRequest, "step index must be a positive integer")
+		return
+	}
+
+	h.setImage(w, r, int32(stepIndex))
+}
+
+func (h *RecipeHandler) setImage(w http.ResponseWriter, r *http.Request, stepIndex int32) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	img, ok := h.uploadImage(w, r)
+	if !ok {
+		return
+	}
+
+	recipe, err := h.client.SetImage(r.Context(), userID.String(), id, stepIndex, img.URL)
+	if err != nil {
+		h.logger.Error("failed to set recipe image", "id", id, "stepIndex", stepIndex, "error", err)
+		// Nothing references the upload yet.
+		if err := h.images.Delete(r.Context(), img); err != nil {
+			h.logger.Error("failed to delete unused image", "imageId", img.ID, "error", err)
+		}
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to set recipe image"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeJSON(recipe))
+}
+
+// uploadImage stores the "image" file of a multipart request, writing the
+// error response when the upload is rejected.
+func (h *RecipeHandler) uploadImage(w http.ResponseWriter, r *http.Request) (*media.Image, bool) {
+	if h.images == nil {
+		writeError(w, http.StatusNotImplemented, "image uploads are not configured")
+		return nil, false
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.images.MaxBytes()+multipartOverhead)
+	file, _, err := r.FormFile("image")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeError(w, http.StatusRequestEntityTooLarge, media.ErrTooLarge.Error())
+			return nil, false
+		}
+		writeError(w, http.StatusBadRequest, "multipart field \"image\" is required")
+		return nil, false
+	}
+	defer file.Close()
+
+	img, err := h.images.Upload(r.Context(), file)
+	if err != nil {
+		switch {
+		case errors.Is(err, media.ErrTooLarge):
+			writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+		case errors.Is(err, media.ErrUnsupportedType):
+			writeError(w, http.StatusUnsupportedMediaType, err.Error())
+		case errors.Is(err, media.ErrInvalidImage):
+			writeError(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("failed to store image", "error", err)
+			writeError(w, http.StatusInternalServerError, "failed to store image")
+		}
+		return nil, false
+	}
+
+	return img, true
+}
+
+func toImageJSON(img *media.Image) ImageJSON {
+	return ImageJSON{
+		ID:          img.ID.String(),
+		URL:         img.URL,
+		OriginalURL: img.OriginalURL,
+		Variants:    img.Variants,
+		Width:       img.Width,
+		Height:      img.Height,
+	}
+}
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// maxImportBodyBytes bounds the size of documents posted for import.
+const maxImportBodyBytes = 5 << 20
+
+// ImportRecipeRequest is the request body for importing a recipe.
+type ImportRecipeRequest struct {
+	Document string `json:"document,omitempty"`
+	URL      string `json:"url,omitempty"`
+	Create   bool   `json:"create,omitempty"`
+}
+
+// ImportRecipeResponse is the response for a recipe import.
+type ImportRecipeResponse struct {
+	Draft  RecipeInputJSON `json:"draft"`
+	Recipe *RecipeJSON     `json:"recipe,omitempty"`
+}
+
+// Import handles POST /v1/recipe/import
+// @Summary      Import a recipe
+// @Description  Extracts a schema.org Recipe from an HTML page or JSON-LD blob (inline or by URL). Returns a draft for review, or creates the recipe when create is true.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        request  body      ImportRecipeRequest  true  "Document or URL to import"
+// @Success      200  {object}  ImportRecipeResponse  "Draft only"
+// @Success      201  {object}  ImportRecipeResponse  "Recipe created"
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      502  {object}  ErrorResponse
+// @Router       /recipe/import [post]
+func (h *RecipeHandler) Import(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req ImportRecipeRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxImportBodyBytes)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if strings.TrimSpace(req.Document) == "" && strings.TrimSpace(req.URL) == "" {
+		writeError(w, http.StatusBadRequest, "document or url is required")
+		return
+	}
+
+	resp, err := h.client.ImportRecipe(r.Context(), &recipepb.ImportRecipeRequest{
+		UserId:   userID.String(),
+		Document: req.Document,
+		Url:      strings.TrimSpace(req.URL),
+		Create:   req.Create,
+	})
+	if err != nil {
+		h.logger.Error("failed to import recipe", "url", req.URL, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to import recipe"))
+		return
+	}
+
+	result := ImportRecipeResponse{Draft: toRecipeInputJSON(resp.GetDraft())}
+	if resp.GetRecipe() != nil {
+		recipe := toRecipeJSON(resp.GetRecipe())
+		result.Recipe = &recipe
+		writeJSON(w, http.StatusCreated, result)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func toRecipeInputJSON(input *recipepb.RecipeInput) RecipeInputJSON {
+	lines := make([]IngredientLineInputJSON, len(input.GetIngredientLines()))
+	for i, line := range input.GetIngredientLines() {
+		var quantityValue *float64
+		if line.GetQuantityValue() != nil {
+			value := line.GetQuantityValue().GetValue()
+			quantityValue = &value
+		}
+		lines[i] = IngredientLineInputJSON{
+			IngredientID:   line.GetIngredientId(),
+			IngredientName: line.GetIngredientName(),
+			QuantityValue:  quantityValue,
+			QuantityText:   line.GetQuantityText(),
+			Unit:           line.GetUnit(),
+			IsOptional:     line.GetIsOptional(),
+			Note:           line.GetNote(),
+			SortOrder:      int(line.GetSortOrder()),
+		}
+	}
+
+	steps := make([]RecipeStepJSON, len(input.GetSteps()))
+	for i, step := range input.GetSteps() {
+		var duration *int
+		if step.GetDurationSeconds() != nil {
+			value := int(step.GetDurationSeconds().GetValue())
+			duration = &value
+		}
+		var temperature *float64
+		if step.GetTemperatureValue() != nil {
+			value := step.GetTemperatureValue().GetValue()
+			temperature = &value
+		}
+		steps[i] = RecipeStepJSON{
+			StepIndex:        int(step.GetStepIndex()),
+			Instruction:      step.GetInstruction(),
+			DurationSeconds:  duration,
+			TemperatureValue: temperature,
+			TemperatureUnit:  step.GetTemperatureUnit(),
+			MediaURL:         step.GetMediaUrl(),
+		}
+	}
+
+	var yieldQuantity *float64
+	if input.GetYieldQuantity() != nil {
+		value := input.GetYieldQuantity().GetValue()
+		yieldQuantity = &value
+	}
+
+	var nutrition *RecipeNutritionJSON
+	if n := input.GetNutrition(); n != nil {
+		nutrition = &RecipeNutritionJSON{
+			CaloriesTotal:      int(n.GetCaloriesTotal()),
+			CaloriesPerServing: int(n.GetCaloriesPerServing()),
+			ProteinG:           n.GetProteinG(),
+			CarbsG:             n.GetCarbsG(),
+			FatG:               n.GetFatG(),
+			FiberG:             n.GetFiberG(),
+			SugarG:             n.GetSugarG(),
+			SodiumMg:           n.GetSodiumMg(),
+			IsOverride:         n.GetIsOverride(),
+		}
+	}
+
+	return RecipeInputJSON{
+		Name:               input.GetName(),
+		Description:        input.GetDescription(),
+		PrepTimeMinutes:    int(input.GetPrepTimeMinutes()),
+		CookTimeMinutes:    int(input.GetCookTimeMinutes()),
+		Servings:           int(input.GetServings()),
+		YieldQuantity:      yieldQuantity,
+		YieldUnit:          input.GetYieldUnit(),
+		MainIngredientID:   input.GetMainIngredientId(),
+		MainIngredientName: input.GetMainIngredientName(),
+		CuisineID:          input.GetCuisineId(),
+		CuisineName:        input.GetCuisineName(),
+		IngredientLines:    lines,
+		Steps:              steps,
+		Tags:               input.GetTags(),
+		ImageURL:           input.GetImageUrl(),
+		Nutrition:          nutrition,
+	}
+}
@@ -0,0 +1,358 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// IngredientJSON is the JSON response for an ingredient in the user's catalog.
+type IngredientJSON struct {
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	Description string                  `json:"description,omitempty"`
+	Category    *IngredientCategoryJSON `json:"category,omitempty"`
+	Allergies   []AllergyJSON           `json:"allergies"`
+	// RecipeCount is the number of active recipes using the ingredient.
+	RecipeCount int32 `json:"recipeCount"`
+	// SearchScore is the name similarity to the search query, from 0 to 1.
+	SearchScore float64 `json:"searchScore,omitempty"`
+	CreatedAt   string  `json:"createdAt,omitempty"`
+	UpdatedAt   string  `json:"updatedAt,omitempty"`
+}
+
+// PaginatedIngredientsJSON is the paginated response for the ingredient catalog.
+type PaginatedIngredientsJSON struct {
+	Items      []IngredientJSON `json:"items"`
+	PageIndex  int32            `json:"pageIndex"`
+	PageSize   int32            `json:"pageSize"`
+	TotalCount int32            `json:"totalCount"`
+	TotalPages int32            `json:"totalPages"`
+}
+
+// RenameIngredientRequest is the request body for renaming an ingredient.
+type RenameIngredientRequest struct {
+	Name string `json:"name"`
+}
+
+// SetIngredientCategoryRequest is the request body for filing an ingredient
+// under a category.
+type SetIngredientCategoryRequest struct {
+	// CategoryID is the category to file the ingredient under; leave empty to
+	// clear its category.
+	CategoryID string `json:"categoryId,omitempty"`
+}
+
+// MergeIngredientsRequest is the request body for merging an ingredient into
+// another.
+type MergeIngredientsRequest struct {
+	// IntoIngredientID is the ingredient that replaces the merged one.
+	IntoIngredientID string `json:"intoIngredientId"`
+}
+
+// MergeIngredientsJSON is the JSON response for a merge.
+type MergeIngredientsJSON struct {
+	Ingredient IngredientJSON `json:"ingredient"`
+	// UpdatedRecipes is the number of recipes that used the merged ingredient.
+	UpdatedRecipes int32 `json:"updatedRecipes"`
+}
+
+// IngredientDuplicateJSON is a pair of ingredients that are likely the same food.
+type IngredientDuplicateJSON struct {
+	// Ingredient is used by more recipes and is usually the one to keep.
+	Ingredient IngredientJSON `json:"ingredient"`
+	Duplicate  IngredientJSON `json:"duplicate"`
+	Similarity float64        `json:"similarity"`
+}
+
+// IngredientDuplicateListResponse is the response for the duplicate report.
+type IngredientDuplicateListResponse struct {
+	Items []IngredientDuplicateJSON `json:"items"`
+}
+
+// ListIngredients handles GET /v1/recipe/ingredients
+// @Summary      List ingredients
+// @Description  Lists the current user's ingredients by name. With a query, ingredients are matched fuzzily so misspellings and plural forms are found, best matches first.
+// @Tags         recipes
+// @Produce      json
+// @Param        q          query     string  false  "Name search"
+// @Param        pageIndex  query     int     false  "Page number (1-indexed)"  default(1)
+// @Param        pageSize   query     int     false  "Items per page (max 100)" default(20)
+// @Success      200  {object}  PaginatedIngredientsJSON
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/ingredients [get]
+func (h *RecipeHandler) ListIngredients(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	pageIndex := parseIntParam(r, "pageIndex", 1)
+	pageSize := parseIntParam(r, "pageSize", 20)
+	if pageSize > 100 {
+		pageSize = 100
+	}
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+
+	resp, err := h.client.ListIngredients(r.Context(), userID.String(), query, int32(pageIndex), int32(pageSize))
+	if err != nil {
+		h.logger.Error("failed to list ingredients", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch ingredients"))
+		return
+	}
+
+	items := make([]IngredientJSON, len(resp.GetIngredients()))
+	for i, ingredient := range resp.GetIngredients() {
+		items[i] = toIngredientJSON(ingredient)
+	}
+
+	writeJSON(w, http.StatusOK, PaginatedIngredientsJSON{
+		Items:      items,
+		PageIndex:  resp.GetPageIndex(),
+		PageSize:   resp.GetPageSize(),
+		TotalCount: resp.GetTotalCount(),
+		TotalPages: resp.GetTotalPages(),
+	})
+}
+
+// RenameIngredient handles PUT /v1/recipe/ingredients/{ingredientId}/name
+// @Summary      Rename an ingredient
+// @Description  Renames one of the user's ingredients everywhere it is used. Renaming to the name of another ingredient is refused; merge the two instead.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        ingredientId  path      string                   true  "Ingredient ID (UUID)"
+// @Param        request       body      RenameIngredientRequest  true  "New name"
+// @Success      200  {object}  IngredientJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Router       /recipe/ingredients/{ingredientId}/name [put]
+func (h *RecipeHandler) RenameIngredient(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ingredientID := chi.URLParam(r, "ingredientId")
+	if ingredientID == "" {
+		writeError(w, http.StatusBadRequest, "ingredient id is required")
+		return
+	}
+
+	var req RenameIngredientRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.RenameIngredient(r.Context(), userID.String(), ingredientID, req.Name)
+	if err != nil {
+		h.logger.Error("failed to rename ingredient", "ingredientId", ingredientID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to rename ingredient"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toIngredientJSON(resp))
+}
+
+// SetIngredientCategory handles PUT /v1/recipe/ingredients/{ingredientId}/category
+// @Summary      Set an ingredient's category
+// @Description  Files one of the user's ingredients under a category, which groups it on shopping lists
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        ingredientId  path      string                        true  "Ingredient ID (UUID)"
+// @Param        request       body      SetIngredientCategoryRequest  true  "Category"
+// @Success      200  {object}  IngredientJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/ingredients/{ingredientId}/category [put]
+func (h *RecipeHandler) SetIngredientCategory(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ingredientID := chi.URLParam(r, "ingredientId")
+	if ingredientID == "" {
+		writeError(w, http.StatusBadRequest, "ingredient id is required")
+		return
+	}
+
+	var req SetIngredientCategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.SetIngredientCategory(r.Context(), userID.String(), ingredientID, req.CategoryID)
+	if err != nil {
+		h.logger.Error("failed to set ingredient category", "ingredientId", ingredientID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to set ingredient category"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toIngredientJSON(resp))
+}
+
+// ListIngredientCategories handles GET /v1/recipe/ingredient-categories
+// @Summary      List ingredient categories
+// @Description  Lists the categories ingredients can be filed under, in shopping list order
+// @Tags         recipes
+// @Produce      json
+// @Success      200  {array}   IngredientCategoryJSON
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/ingredient-categories [get]
+func (h *RecipeHandler) ListIngredientCategories(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	categories, err := h.client.ListIngredientCategories(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to list ingredient categories", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch ingredient categories"))
+		return
+	}
+
+	items := make([]IngredientCategoryJSON, len(categories))
+	for i, category := range categories {
+		items[i] = toIngredientCategoryJSON(category)
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+// MergeIngredient handles POST /v1/recipe/ingredients/{ingredientId}/merge
+// @Summary      Merge an ingredient into another
+// @Description  Replaces every use of the ingredient in recipes and shopping lists with another ingredient, combines their allergies, and deletes it. Nutrition and dietary attributes carry over where the other ingredient has none.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        ingredientId  path      string                   true  "ID of the ingredient to merge away (UUID)"
+// @Param        request       body      MergeIngredientsRequest  true  "Ingredient to keep"
+// @Success      200  {object}  MergeIngredientsJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/ingredients/{ingredientId}/merge [post]
+func (h *RecipeHandler) MergeIngredient(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ingredientID := chi.URLParam(r, "ingredientId")
+	if ingredientID == "" {
+		writeError(w, http.StatusBadRequest, "ingredient id is required")
+		return
+	}
+
+	var req MergeIngredientsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if strings.TrimSpace(req.IntoIngredientID) == "" {
+		writeError(w, http.StatusBadRequest, "intoIngredientId is required")
+		return
+	}
+
+	resp, err := h.client.MergeIngredients(r.Context(), userID.String(), ingredientID, strings.TrimSpace(req.IntoIngredientID))
+	if err != nil {
+		h.logger.Error("failed to merge ingredients", "ingredientId", ingredientID, "intoIngredientId", req.IntoIngredientID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to merge ingredients"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, MergeIngredientsJSON{
+		Ingredient:     toIngredientJSON(resp.GetIngredient()),
+		UpdatedRecipes: resp.GetUpdatedRecipes(),
+	})
+}
+
+// ListDuplicateIngredients handles GET /v1/recipe/ingredients/duplicates
+// @Summary      Suggest duplicate ingredients
+// @Description  Lists pairs of the user's ingredients whose names are so similar that they are likely the same food, most similar first, as candidates for merging
+// @Tags         recipes
+// @Produce      json
+// @Param        minSimilarity  query     number  false  "Minimum name similarity from 0 to 1"  default(0.5)
+// @Param        limit          query     int     false  "Maximum number of pairs (max 200)"    default(50)
+// @Success      200  {object}  IngredientDuplicateListResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/ingredients/duplicates [get]
+func (h *RecipeHandler) ListDuplicateIngredients(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var minSimilarity float64
+	if value := strings.TrimSpace(r.URL.Query().Get("minSimilarity")); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "minSimilarity must be a number")
+			return
+		}
+		minSimilarity = parsed
+	}
+
+	duplicates, err := h.client.ListDuplicateIngredients(r.Context(), userID.String(), minSimilarity, int32(parseIntParam(r, "limit", 0)))
+	if err != nil {
+		h.logger.Error("failed to list duplicate ingredients", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch duplicate ingredients"))
+		return
+	}
+
+	items := make([]IngredientDuplicateJSON, len(duplicates))
+	for i, duplicate := range duplicates {
+		items[i] = IngredientDuplicateJSON{
+			Ingredient: toIngredientJSON(duplicate.GetIngredient()),
+			Duplicate:  toIngredientJSON(duplicate.GetDuplicate()),
+			Similarity: duplicate.GetSimilarity(),
+		}
+	}
+
+	writeJSON(w, http.StatusOK, IngredientDuplicateListResponse{Items: items})
+}
+
+func toIngredientJSON(ingredient *recipepb.Ingredient) IngredientJSON {
+	resp := IngredientJSON{
+		ID:          ingredient.GetId(),
+		Name:        ingredient.GetName(),
+		Description: ingredient.GetDescription(),
+		Allergies:   toAllergiesJSON(ingredient.GetAllergies()),
+		RecipeCount: ingredient.GetRecipeCount(),
+		SearchScore: ingredient.GetSearchScore(),
+		CreatedAt:   ingredient.GetCreatedAt(),
+		UpdatedAt:   ingredient.GetUpdatedAt(),
+	}
+	if ingredient.GetCategory() != nil {
+		category := toIngredientCategoryJSON(ingredient.GetCategory())
+		resp.Category = &category
+	}
+	if resp.Allergies == nil {
+		resp.Allergies = []AllergyJSON{}
+	}
+	return resp
+}
+
+func toIngredientCategoryJSON(category *recipepb.IngredientCategory) IngredientCategoryJSON {
+	return IngredientCategoryJSON{
+		ID:           category.GetId(),
+		Name:         category.GetName(),
+		DisplayOrder: int(category.GetDisplayOrder()),
+	}
+}
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// IngredientMatchJSON is the JSON response for an ingredient linked to the
+// nutrition reference.
+type IngredientMatchJSON struct {
+	Ingredient IngredientRefJSON   `json:"ingredient"`
+	Status     string              `json:"status"`
+	Food       *NutritionFoodJSON  `json:"food,omitempty"`
+	Candidates []NutritionFoodJSON `json:"candidates,omitempty"`
+}
+
+// NutritionFoodJSON is the JSON response for a reference food, per 100 g.
+type NutritionFoodJSON struct {
+	ID       string  `json:"id"`
+	Source   string  `json:"source"`
+	SourceID string  `json:"sourceId"`
+	Name     string  `json:"name"`
+	Calories float64 `json:"calories"`
+	ProteinG float64 `json:"proteinG"`
+	CarbsG   float64 `json:"carbsG"`
+	FatG     float64 `json:"fatG"`
+	FiberG   float64 `json:"fiberG"`
+	SugarG   float64 `json:"sugarG"`
+	SodiumMg float64 `json:"sodiumMg"`
+}
+
+// IngredientMatchListResponse is the response for listing ingredient matches.
+type IngredientMatchListResponse struct {
+	Items []IngredientMatchJSON `json:"items"`
+}
+
+// ResolveIngredientMatchRequest is the request body for resolving a match.
+type ResolveIngredientMatchRequest struct {
+	// FoodID is the picked reference food; leave empty to reject all candidates.
+	FoodID string `json:"foodId,omitempty"`
+}
+
+// ListIngredientMatches handles GET /v1/recipe/ingredient-matches
+// @Summary      List ingredient nutrition matches
+// @Description  Lists the user's ingredients linked to the nutrition reference database by status. By default returns ambiguous matches awaiting review.
+// @Tags         recipes
+// @Produce      json
+// @Param        status  query     string  false  "Match status: matched, ambiguous, unmatched, confirmed or rejected"  default(ambiguous)
+// @Success      200  {object}  IngredientMatchListResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/ingredient-matches [get]
+func (h *RecipeHandler) ListIngredientMatches(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	resp, err := h.client.ListIngredientMatches(r.Context(), userID.String(), r.URL.Query().Get("status"))
+	if err != nil {
+		h.logger.Error("failed to list ingredient matches", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list ingredient matches"))
+		return
+	}
+
+	items := make([]IngredientMatchJSON, len(resp.GetMatches()))
+	for i, match := range resp.GetMatches() {
+		items[i] = toIngredientMatchJSON(match)
+	}
+
+	writeJSON(w, http.StatusOK, IngredientMatchListResponse{Items: items})
+}
+
+// ResolveIngredientMatch handles PUT /v1/recipe/ingredient-matches/{ingredientId}
+// @Summary      Resolve an ingredient nutrition match
+// @Description  Links an ingredient to the picked reference food and copies its nutrition, or rejects all candidates when no food is given
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        ingredientId  path      string                         true  "Ingredient ID (UUID)"
+// @Param        request       body      ResolveIngredientMatchRequest  true  "Picked food"
+// @Success      200  {object}  IngredientMatchJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/ingredient-matches/{ingredientId} [put]
+func (h *RecipeHandler) ResolveIngredientMatch(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	ingredientID := chi.URLParam(r, "ingredientId")
+	if ingredientID == "" {
+		writeError(w, http.StatusBadRequest, "ingredient id is required")
+		return
+	}
+
+	var req ResolveIngredientMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.ResolveIngredientMatch(r.Context(), userID.String(), ingredientID, req.FoodID)
+	if err != nil {
+		h.logger.Error("failed to resolve ingredient match", "ingredientId", ingredientID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to resolve ingredient match"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toIngredientMatchJSON(resp))
+}
+
+func toIngredientMatchJSON(match *recipepb.IngredientMatch) IngredientMatchJSON {
+	result := IngredientMatchJSON{
+		Ingredient: IngredientRefJSON{
+			ID:   match.GetIngredient().GetId(),
+			Name: match.GetIngredient().GetName(),
+		},
+		Status: match.GetStatus(),
+	}
+
+	if match.GetFood() != nil {
+		food := toNutritionFoodJSON(match.GetFood())
+		result.Food = &food
+	}
+	for _, candidate := range match.GetCandidates() {
+		result.Candidates = append(result.Candidates, toNutritionFoodJSON(candidate))
+	}
+
+	return result
+}
+
+func toNutritionFoodJSON(food *recipepb.NutritionFood) NutritionFoodJSON {
+	return NutritionFoodJSON{
+		ID:       food.GetId(),
+		Source:   food.GetSource(),
+		SourceID: food.GetSourceId(),
+		Name:     food.GetName(),
+		Calories: food.GetCalories(),
+		ProteinG: food.GetProteinG(),
+		CarbsG:   food.GetCarbsG(),
+		FatG:     food.GetFatG(),
+		FiberG:   food.GetFiberG(),
+		SugarG:   food.GetSugarG(),
+		SodiumMg: food.GetSodiumMg(),
+	}
+}
@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// RecipeRevisionSummaryJSON is the JSON response for a revision in a list.
+type RecipeRevisionSummaryJSON struct {
+	Revision  int32  `json:"revision"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// RecipeRevisionsResponse is the response for listing recipe revisions.
+type RecipeRevisionsResponse struct {
+	Items []RecipeRevisionSummaryJSON `json:"items"`
+}
+
+// RecipeRevisionJSON is the JSON response for a single revision.
+type RecipeRevisionJSON struct {
+	Revision  int32      `json:"revision"`
+	CreatedAt string     `json:"createdAt"`
+	Recipe    RecipeJSON `json:"recipe"`
+}
+
+// RecipeDiffJSON is the JSON response for the changes between two revisions.
+type RecipeDiffJSON struct {
+	FromRevision    int32                      `json:"fromRevision"`
+	ToRevision      int32                      `json:"toRevision"`
+	Fields          []FieldChangeJSON          `json:"fields"`
+	IngredientLines []IngredientLineChangeJSON `json:"ingredientLines"`
+	Steps           []StepChangeJSON           `json:"steps"`
+}
+
+// FieldChangeJSON is a recipe field whose value changed.
+type FieldChangeJSON struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// IngredientLineChangeJSON is an added, removed or modified ingredient line.
+type IngredientLineChangeJSON struct {
+	Change string              `json:"change"`
+	From   *IngredientLineJSON `json:"from,omitempty"`
+	To     *IngredientLineJSON `json:"to,omitempty"`
+}
+
+// StepChangeJSON is an added, removed or modified step.
+type StepChangeJSON struct {
+	Change string          `json:"change"`
+	From   *RecipeStepJSON `json:"from,omitempty"`
+	To     *RecipeStepJSON `json:"to,omitempty"`
+}
+
+// ListRevisions handles GET /v1/recipe/{id}/revisions
+// @Summary      List recipe revisions
+// @Description  Lists the saved versions of a recipe, newest first. A revision is recorded every time the recipe is created, updated or restored.
+// @Tags         recipes
+// @Produce      json
+// @Param        id   path      string  true  "Recipe ID (UUID)"
+// @Success      200  {object}  RecipeRevisionsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/revisions [get]
+func (h *RecipeHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	resp, err := h.client.ListRecipeRevisions(r.Context(), userID.String(), id)
+	if err != nil {
+		h.logger.Error("failed to list recipe revisions", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list recipe revisions"))
+		return
+	}
+
+	items := make([]RecipeRevisionSummaryJSON, len(resp.GetRevisions()))
+	for i, revision := range resp.GetRevisions() {
+		items[i] = RecipeRevisionSummaryJSON{
+			Revision:  revision.GetRevision(),
+			Name:      revision.GetName(),
+			CreatedAt: revision.GetCreatedAt(),
+		}
+	}
+
+	writeJSON(w, http.StatusOK, RecipeRevisionsResponse{Items: items})
+}
+
+// GetRevision handles GET /v1/recipe/{id}/revisions/{revision}
+// @Summary      Get a recipe revision
+// @Description  Retrieves a recipe as it was saved at a revision
+// @Tags         recipes
+// @Produce      json
+// @Param        id        path      string  true  "Recipe ID (UUID)"
+// @Param        revision  path      int     true  "Revision number"
+// @Success      200  {object}  RecipeRevisionJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/revisions/{revision} [get]
+func (h *RecipeHandler) GetRevision(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	revision, ok := parseRevision(chi.URLParam(r, "revision"))
+	if id == "" || !ok {
+		writeError(w, http.StatusBadRequest, "recipe id and a positive revision are required")
+		return
+	}
+
+	resp, err := h.client.GetRecipeRevision(r.Context(), userID.String(), id, revision)
+	if err != nil {
+		h.logger.Error("failed to get recipe revision", "id", id, "revision", revision, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to get recipe revision"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, RecipeRevisionJSON{
+		Revision:  resp.GetRevision(),
+		CreatedAt: resp.GetCreatedAt(),
+		Recipe:    toRecipeJSON(resp.GetRecipe()),
+	})
+}
+
+// DiffRevisions handles GET /v1/recipe/{id}/revisions/diff
+// @Summary      Compare recipe revisions
+// @Description  Lists the field, ingredient line and step changes between two revisions of a recipe
+// @Tags         recipes
+// @Produce      json
+// @Param        id    path      string  true  "Recipe ID (UUID)"
+// @Param        from  query     int     true  "Revision to compare from"
+// @Param        to    query     int     true  "Revision to compare to"
+// @Success      200  {object}  RecipeDiffJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/revisions/diff [get]
+func (h *RecipeHandler) DiffRevisions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	from, fromOK := parseRevision(r.URL.Query().Get("from"))
+	to, toOK := parseRevision(r.URL.Query().Get("to"))
+	if id == "" || !fromOK || !toOK {
+		writeError(w, http.StatusBadRequest, "recipe id and positive from and to revisions are required")
+		return
+	}
+
+	resp, err := h.client.DiffRecipeRevisions(r.Context(), userID.String(), id, from, to)
+	if err != nil {
+		h.logger.Error("failed to diff recipe revisions", "id", id, "from", from, "to", to, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to diff recipe revisions"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeDiffJSON(resp))
+}
+
+// RestoreRevision handles POST /v1/recipe/{id}/revisions/{revision}/restore
+// @Summary      Restore a recipe revision
+// @Description  Saves a past revision as the current version of the recipe. The restore is recorded as a new revision.
+// @Tags         recipes
+// @Produce      json
+// @Param        id        path      string  true  "Recipe ID (UUID)"
+// @Param        revision  path      int     true  "Revision number"
+// @Success      200  {object}  RecipeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/revisions/{revision}/restore [post]
+func (h *RecipeHandler) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	revision, ok := parseRevision(chi.URLParam(r, "revision"))
+	if id == "" || !ok {
+		writeError(w, http.StatusBadRequest, "recipe id and a positive revision are required")
+		return
+	}
+
+	resp, err := h.client.RestoreRecipeRevision(r.Context(), userID.String(), id, revision)
+	if err != nil {
+		h.logger.Error("failed to restore recipe revision", "id", id, "revision", revision, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to restore recipe revision"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeJSON(resp))
+}
+
+func parseRevision(value string) (int32, bool) {
+	revision, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || revision < 1 {
+		return 0, false
+	}
+	return int32(revision), true
+}
+
+func toRecipeDiffJSON(diff *recipepb.RecipeDiff) RecipeDiffJSON {
+	result := RecipeDiffJSON{
+		FromRevision:    diff.GetFromRevision(),
+		ToRevision:      diff.GetToRevision(),
+		Fields:          make([]FieldChangeJSON, len(diff.GetFields())),
+		IngredientLines: make([]IngredientLineChangeJSON, len(diff.GetIngredientLines())),
+		Steps:           make([]StepChangeJSON, len(diff.GetSteps())),
+	}
+
+	for i, change := range diff.GetFields() {
+		result.Fields[i] = FieldChangeJSON{
+			Field: change.GetField(),
+			From:  change.GetFrom(),
+			To:    change.GetTo(),
+		}
+	}
+
+	for i, change := range diff.GetIngredientLines() {
+		lineChange := IngredientLineChangeJSON{Change: change.GetChange()}
+		if change.GetFrom() != nil {
+			line := toIngredientLineJSON(change.GetFrom())
+			lineChange.From = &line
+		}
+		if change.GetTo() != nil {
+			line := toIngredientLineJSON(change.GetTo())
+			lineChange.To = &line
+		}
+		result.IngredientLines[i] = lineChange
+	}
+
+	for i, change := range diff.GetSteps() {
+		stepChange := StepChangeJSON{Change: change.GetChange()}
+		if change.GetFrom() != nil {
+			step := toRecipeStepJSON(change.GetFrom())
+			stepChange.From = &step
+		}
+		if change.GetTo() != nil {
+			step := toRecipeStepJSON(change.GetTo())
+			stepChange.To = &step
+		}
+		result.Steps[i] = stepChange
+	}
+
+	return result
+}
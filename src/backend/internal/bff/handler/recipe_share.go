@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// ShareRecipeRequest is the request body for sharing a recipe.
+type ShareRecipeRequest struct {
+	Email string `json:"email"`
+	// Permission is read (default) or edit.
+	Permission string `json:"permission,omitempty"`
+}
+
+// RecipeShareJSON is the JSON response for a recipe shared with a user.
+type RecipeShareJSON struct {
+	RecipeID         string `json:"recipeId"`
+	RecipeName       string `json:"recipeName"`
+	OwnerID          string `json:"ownerId"`
+	OwnerEmail       string `json:"ownerEmail"`
+	SharedWithUserID string `json:"sharedWithUserId"`
+	SharedWithEmail  string `json:"sharedWithEmail"`
+	Permission       string `json:"permission"`
+	CreatedAt        string `json:"createdAt"`
+}
+
+// RecipeShareListResponse is the response for listing recipe shares.
+type RecipeShareListResponse struct {
+	Items []RecipeShareJSON `json:"items"`
+}
+
+// Share handles POST /v1/recipe/{id}/shares
+// @Summary      Share a recipe
+// @Description  Shares a recipe with another user by email, read-only or with permission to edit. Sharing again with the same user changes the permission.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string              true  "Recipe ID (UUID)"
+// @Param        request  body      ShareRecipeRequest  true  "User to share with"
+// @Success      200  {object}  RecipeShareJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/shares [post]
+func (h *RecipeHandler) Share(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	var req ShareRecipeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.client.ShareRecipe(r.Context(), userID.String(), id, req.Email, req.Permission)
+	if err != nil {
+		h.logger.Error("failed to share recipe", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to share recipe"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeShareJSON(resp))
+}
+
+// RevokeShare handles DELETE /v1/recipe/{id}/shares/{userId}
+// @Summary      Revoke a recipe share
+// @Description  Removes another user's access to a recipe
+// @Tags         recipes
+// @Param        id      path      string  true  "Recipe ID (UUID)"
+// @Param        userId  path      string  true  "ID of the user the recipe is shared with (UUID)"
+// @Success      204
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/shares/{userId} [delete]
+func (h *RecipeHandler) RevokeShare(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	sharedWithUserID := chi.URLParam(r, "userId")
+	if id == "" || sharedWithUserID == "" {
+		writeError(w, http.StatusBadRequest, "recipe id and user id are required")
+		return
+	}
+
+	if err := h.client.RevokeRecipeShare(r.Context(), userID.String(), id, sharedWithUserID); err != nil {
+		h.logger.Error("failed to revoke recipe share", "id", id, "sharedWithUserId", sharedWithUserID, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to revoke recipe share"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListSharedWithMe handles GET /v1/recipe/shared-with-me
+// @Summary      List recipes shared with me
+// @Description  Lists the recipes other users shared with the current user and the permission granted
+// @Tags         recipes
+// @Produce      json
+// @Success      200  {object}  RecipeShareListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/shared-with-me [get]
+func (h *RecipeHandler) ListSharedWithMe(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	resp, err := h.client.ListSharedWithMe(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to list recipes shared with user", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list shared recipes"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeShareListResponse(resp))
+}
+
+// ListSharedByMe handles GET /v1/recipe/shared-by-me
+// @Summary      List recipes shared by me
+// @Description  Lists who the current user's recipes are shared with
+// @Tags         recipes
+// @Produce      json
+// @Success      200  {object}  RecipeShareListResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/shared-by-me [get]
+func (h *RecipeHandler) ListSharedByMe(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	resp, err := h.client.ListSharedByMe(r.Context(), userID.String())
+	if err != nil {
+		h.logger.Error("failed to list recipes shared by user", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list shared recipes"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeShareListResponse(resp))
+}
+
+func toRecipeShareListResponse(resp *recipepb.ListRecipeSharesResponse) RecipeShareListResponse {
+	items := make([]RecipeShareJSON, len(resp.GetShares()))
+	for i, share := range resp.GetShares() {
+		items[i] = toRecipeShareJSON(share)
+	}
+	return RecipeShareListResponse{Items: items}
+}
+
+func toRecipeShareJSON(share *recipepb.RecipeShare) RecipeShareJSON {
+	return RecipeShareJSON{
+		RecipeID:         share.GetRecipeId(),
+		RecipeName:       share.GetRecipeName(),
+		OwnerID:          share.GetOwnerId(),
+		OwnerEmail:       share.GetOwnerEmail(),
+		SharedWithUserID: share.GetSharedWithUserId(),
+		SharedWithEmail:  share.GetSharedWithEmail(),
+		Permission:       share.GetPermission(),
+		CreatedAt:        share.GetCreatedAt(),
+	}
+}
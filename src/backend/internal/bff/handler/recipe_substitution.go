@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// SubstitutionJSON is the JSON representation of an ingredient substitution.
+type SubstitutionJSON struct {
+	ID             string `json:"id"`
+	IngredientName string `json:"ingredientName"`
+	SubstituteName string `json:"substituteName"`
+	// Ratio is the amount of substitute per unit of the ingredient.
+	Ratio         float64      `json:"ratio"`
+	Notes         string       `json:"notes,omitempty"`
+	AvoidsAllergy *AllergyJSON `json:"avoidsAllergy,omitempty"`
+}
+
+// IngredientSubstitutionsJSON lists the substitutions for one ingredient of a recipe.
+type IngredientSubstitutionsJSON struct {
+	Ingredient    IngredientRefJSON  `json:"ingredient"`
+	Substitutions []SubstitutionJSON `json:"substitutions"`
+}
+
+// RecipeSubstitutionsResponse is the response for listing a recipe's substitutions.
+type RecipeSubstitutionsResponse struct {
+	Items []IngredientSubstitutionsJSON `json:"items"`
+}
+
+// SubstituteRecipeRequest is the request body for substituting ingredients.
+type SubstituteRecipeRequest struct {
+	SubstitutionIDs []string `json:"substitutionIds"`
+	// Name defaults to the recipe's name with "(with substitutions)" appended.
+	Name string `json:"name,omitempty"`
+}
+
+// ListSubstitutions handles GET /v1/recipe/{id}/substitutions
+// @Summary      List substitutions
+// @Description  Lists substitutions for the ingredients of a recipe, grouped by ingredient in recipe order
+// @Tags         recipes
+// @Produce      json
+// @Param        id         path      string  true   "Recipe ID (UUID)"
+// @Param        allergyId  query     string  false  "Only substitutions avoiding this allergy (UUID)"
+// @Success      200  {object}  RecipeSubstitutionsResponse
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/substitutions [get]
+func (h *RecipeHandler) ListSubstitutions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	allergyID := strings.TrimSpace(r.URL.Query().Get("allergyId"))
+	ingredients, err := h.client.ListRecipeSubstitutions(r.Context(), userID.String(), id, allergyID)
+	if err != nil {
+		h.logger.Error("failed to list substitutions", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list substitutions"))
+		return
+	}
+
+	items := make([]IngredientSubstitutionsJSON, len(ingredients))
+	for i, ingredient := range ingredients {
+		items[i] = toIngredientSubstitutionsJSON(ingredient)
+	}
+
+	writeJSON(w, http.StatusOK, RecipeSubstitutionsResponse{Items: items})
+}
+
+// Substitute handles POST /v1/recipe/{id}/substitute
+// @Summary      Substitute ingredients
+// @Description  Creates a copy of a recipe in the current user's library with the chosen substitutions applied. Quantities are adjusted by each substitution's ratio and allergies are recalculated from the new ingredients.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        id       path      string                   true  "Recipe ID (UUID)"
+// @Param        request  body      SubstituteRecipeRequest  true  "Substitutions to apply"
+// @Success      201  {object}  RecipeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/substitute [post]
+func (h *RecipeHandler) Substitute(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	var req SubstituteRecipeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if len(req.SubstitutionIDs) == 0 {
+		writeError(w, http.StatusBadRequest, "substitutionIds is required")
+		return
+	}
+
+	recipe, err := h.client.SubstituteRecipe(r.Context(), userID.String(), id, req.SubstitutionIDs, strings.TrimSpace(req.Name))
+	if err != nil {
+		h.logger.Error("failed to substitute recipe", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to substitute recipe"))
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, toRecipeJSON(recipe))
+}
+
+func toIngredientSubstitutionsJSON(ingredient *recipepb.IngredientSubstitutions) IngredientSubstitutionsJSON {
+	resp := IngredientSubstitutionsJSON{
+		Ingredient: IngredientRefJSON{
+			ID:   ingredient.GetIngredient().GetId(),
+			Name: ingredient.GetIngredient().GetName(),
+		},
+		Substitutions: make([]SubstitutionJSON, len(ingredient.GetSubstitutions())),
+	}
+	for i, sub := range ingredient.GetSubstitutions() {
+		resp.Substitutions[i] = SubstitutionJSON{
+			ID:             sub.GetId(),
+			IngredientName: sub.GetIngredientName(),
+			SubstituteName: sub.GetSubstituteName(),
+			Ratio:          sub.GetRatio(),
+			Notes:          sub.GetNotes(),
+		}
+		if sub.GetAvoidsAllergy() != nil {
+			allergy := toAllergyJSON(sub.GetAvoidsAllergy())
+			resp.Substitutions[i].AvoidsAllergy = &allergy
+		}
+	}
+	return resp
+}
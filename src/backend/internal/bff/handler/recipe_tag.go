@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	recipepb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// TagJSON is the JSON representation of a tag in use on the user's recipes.
+type TagJSON struct {
+	Name        string `json:"name"`
+	RecipeCount int32  `json:"recipeCount"`
+	// Group is course, occasion or technique; empty when ungrouped.
+	Group string `json:"group,omitempty"`
+}
+
+// TagsJSON is the JSON response for a tag listing.
+type TagsJSON struct {
+	Tags []TagJSON `json:"tags"`
+}
+
+// RenameTagRequest is the request body for renaming a tag.
+type RenameTagRequest struct {
+	Name string `json:"name"`
+}
+
+// MergeTagsRequest is the request body for merging tags into another.
+type MergeTagsRequest struct {
+	// Tags are the tags to merge away.
+	Tags []string `json:"tags"`
+	// IntoTag is the tag that replaces them.
+	IntoTag string `json:"intoTag"`
+}
+
+// SetTagGroupRequest is the request body for filing a tag under a group.
+type SetTagGroupRequest struct {
+	// Group is course, occasion or technique; empty to ungroup the tag.
+	Group string `json:"group"`
+}
+
+// TagChangeJSON is the JSON response for a tag rename, merge or deletion.
+type TagChangeJSON struct {
+	// UpdatedRecipes is the number of active recipes whose tags changed.
+	UpdatedRecipes int32 `json:"updatedRecipes"`
+}
+
+// ListTags handles GET /v1/recipe/tags
+// @Summary      List tags
+// @Description  Lists the tags on the user's recipes with the number of recipes carrying each, most used first
+// @Tags         recipes
+// @Produce      json
+// @Param        q      query     string  false  "Only tags starting with this prefix"
+// @Param        group  query     string  false  "Only tags in this group (course, occasion, technique)"
+// @Param        limit  query     int     false  "Maximum number of tags"  default(100)
+// @Success      200  {object}  TagsJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/tags [get]
+func (h *RecipeHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	h.listTags(w, r, 100)
+}
+
+// AutocompleteTags handles GET /v1/recipe/tags/autocomplete
+// @Summary      Autocomplete tags
+// @Description  Suggests the user's most used tags starting with a prefix
+// @Tags         recipes
+// @Produce      json
+// @Param        q      query     string  false  "Prefix typed so far; nothing is suggested while it is empty"
+// @Param        limit  query     int     false  "Maximum number of suggestions"                                default(10)
+// @Success      200  {object}  TagsJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/tags/autocomplete [get]
+func (h *RecipeHandler) AutocompleteTags(w http.ResponseWriter, r *http.Request) {
+	if strings.TrimSpace(r.URL.Query().Get("q")) == "" {
+		writeJSON(w, http.StatusOK, TagsJSON{Tags: []TagJSON{}})
+		return
+	}
+	h.listTags(w, r, 10)
+}
+
+func (h *RecipeHandler) listTags(w http.ResponseWriter, r *http.Request, defaultLimit int) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	query := r.URL.Query()
+	tags, err := h.client.ListTags(r.Context(), userID.String(),
+		strings.TrimSpace(query.Get("q")),
+		strings.TrimSpace(query.Get("group")),
+		int32(parseIntParam(r, "limit", defaultLimit)),
+	)
+	if err != nil {
+		h.logger.Error("failed to list tags", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to list tags"))
+		return
+	}
+
+	result := TagsJSON{Tags: make([]TagJSON, len(tags))}
+	for i, tag := range tags {
+		result.Tags[i] = toTagJSON(tag)
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+// RenameTag handles PUT /v1/recipe/tags/{tag}
+// @Summary      Rename a tag
+// @Description  Renames a tag on every recipe of the user. Renaming to a tag already in use is refused; merge the two instead.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        tag      path      string            true  "Tag"
+// @Param        request  body      RenameTagRequest  true  "New name"
+// @Success      200  {object}  TagChangeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Failure      409  {object}  ErrorResponse
+// @Router       /recipe/tags/{tag} [put]
+func (h *RecipeHandler) RenameTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	tag, ok := tagURLParam(w, r)
+	if !ok {
+		return
+	}
+
+	var req RenameTagRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+
+	updated, err := h.client.RenameTag(r.Context(), userID.String(), tag, name)
+	if err != nil {
+		h.logger.Error("failed to rename tag", "tag", tag, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to rename tag"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, TagChangeJSON{UpdatedRecipes: updated})
+}
+
+// MergeTags handles POST /v1/recipe/tags/merge
+// @Summary      Merge tags
+// @Description  Replaces the given tags with another on every recipe of the user. The kept tag takes the group of a merged one when it has none.
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        request  body      MergeTagsRequest  true  "Tags to merge and the tag to keep"
+// @Success      200  {object}  TagChangeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/tags/merge [post]
+func (h *RecipeHandler) MergeTags(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req MergeTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	into := strings.TrimSpace(req.IntoTag)
+	if into == "" {
+		writeError(w, http.StatusBadRequest, "intoTag is required")
+		return
+	}
+	tags := sanitizeStrings(req.Tags)
+	if len(tags) == 0 {
+		writeError(w, http.StatusBadRequest, "tags are required")
+		return
+	}
+
+	updated, err := h.client.MergeTags(r.Context(), userID.String(), tags, into)
+	if err != nil {
+		h.logger.Error("failed to merge tags", "tags", tags, "intoTag", into, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to merge tags"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, TagChangeJSON{UpdatedRecipes: updated})
+}
+
+// DeleteTag handles DELETE /v1/recipe/tags/{tag}
+// @Summary      Delete a tag
+// @Description  Removes a tag from every recipe of the user
+// @Tags         recipes
+// @Produce      json
+// @Param        tag  path      string  true  "Tag"
+// @Success      200  {object}  TagChangeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/tags/{tag} [delete]
+func (h *RecipeHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	tag, ok := tagURLParam(w, r)
+	if !ok {
+		return
+	}
+
+	updated, err := h.client.DeleteTag(r.Context(), userID.String(), tag)
+	if err != nil {
+		h.logger.Error("failed to delete tag", "tag", tag, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to delete tag"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, TagChangeJSON{UpdatedRecipes: updated})
+}
+
+// SetTagGroup handles PUT /v1/recipe/tags/{tag}/group
+// @Summary      Set the group of a tag
+// @Description  Files a tag under course, occasion or technique for faceted browsing, or ungroups it with an empty group
+// @Tags         recipes
+// @Accept       json
+// @Produce      json
+// @Param        tag      path      string              true  "Tag"
+// @Param        request  body      SetTagGroupRequest  true  "Group"
+// @Success      200  {object}  TagJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/tags/{tag}/group [put]
+func (h *RecipeHandler) SetTagGroup(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	tag, ok := tagURLParam(w, r)
+	if !ok {
+		return
+	}
+
+	var req SetTagGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	updated, err := h.client.SetTagGroup(r.Context(), userID.String(), tag, strings.TrimSpace(req.Group))
+	if err != nil {
+		h.logger.Error("failed to set tag group", "tag", tag, "group", req.Group, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to set tag group"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toTagJSON(updated))
+}
+
+// tagURLParam reads the tag from the path, writing a 400 when it is missing.
+// Tags are free text, so clients escape them.
+func tagURLParam(w http.ResponseWriter, r *http.Request) (string, bool) {
+	tag, err := url.PathUnescape(chi.URLParam(r, "tag"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid tag")
+		return "", false
+	}
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		writeError(w, http.StatusBadRequest, "tag is required")
+		return "", false
+	}
+	return tag, true
+}
+
+func toTagJSON(tag *recipepb.Tag) TagJSON {
+	return TagJSON{
+		Name:        tag.GetName(),
+		RecipeCount: tag.GetRecipeCount(),
+		Group:       tag.GetGroup(),
+	}
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ListTrash handles GET /v1/recipe/trash
+// @Summary      List deleted recipes
+// @Description  Lists the current user's deleted recipes, most recently deleted first. Deleted recipes are purged after the retention window.
+// @Tags         recipes
+// @Produce      json
+// @Param        pageIndex  query     int  false  "Page number (1-indexed)"  default(1)
+// @Param        pageSize   query     int  false  "Items per page (max 100)" default(20)
+// @Success      200  {object}  PaginatedRecipesJSON
+// @Failure      500  {object}  ErrorResponse
+// @Router       /recipe/trash [get]
+func (h *RecipeHandler) ListTrash(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	pageIndex := parseIntParam(r, "pageIndex", 1)
+	pageSize := parseIntParam(r, "pageSize", 20)
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	resp, err := h.client.ListDeleted(r.Context(), userID.String(), int32(pageIndex), int32(pageSize))
+	if err != nil {
+		h.logger.Error("failed to list deleted recipes", "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to fetch deleted recipes"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, PaginatedRecipesJSON{
+		Items:      toRecipesJSON(resp.Recipes),
+		PageIndex:  resp.PageIndex,
+		PageSize:   resp.PageSize,
+		TotalCount: resp.TotalCount,
+		TotalPages: resp.TotalPages,
+	})
+}
+
+// Restore handles POST /v1/recipe/{id}/restore
+// @Summary      Restore a deleted recipe
+// @Description  Moves a recipe out of the trash
+// @Tags         recipes
+// @Produce      json
+// @Param        id   path      string  true  "Recipe ID (UUID)"
+// @Success      200  {object}  RecipeJSON
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/{id}/restore [post]
+func (h *RecipeHandler) Restore(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	recipe, err := h.client.Restore(r.Context(), userID.String(), id)
+	if err != nil {
+		h.logger.Error("failed to restore recipe", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to restore recipe"))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRecipeJSON(recipe))
+}
+
+// Purge handles DELETE /v1/recipe/trash/{id}
+// @Summary      Permanently delete a recipe
+// @Description  Permanently deletes a recipe from the trash; only deleted recipes can be purged
+// @Tags         recipes
+// @Param        id   path      string  true  "Recipe ID (UUID)"
+// @Success      204
+// @Failure      400  {object}  ErrorResponse
+// @Failure      404  {object}  ErrorResponse
+// @Router       /recipe/trash/{id} [delete]
+func (h *RecipeHandler) Purge(w http.ResponseWriter, r *http.Request) {
+	userID, ok := requireUserID(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		writeError(w, http.StatusBadRequest, "recipe id is required")
+		return
+	}
+
+	if err := h.client.Purge(r.Context(), userID.String(), id); err != nil {
+		h.logger.Error("failed to purge recipe", "id", id, "error", err)
+		writeError(w, httpStatusFromError(err), errorMessage(err, "failed to purge recipe"))
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -64,7 +64,7 @@ type ShoppingListJSON struct {
 // ShoppingListItemJSON is the JSON response for a shopping list item
 type ShoppingListItemJSON struct {
 	ID           string                   `json:"id"`
-	Ingredient   *IngredientJSON          `json:"ingredient,omitempty"`
+	Ingredient   *IngredientRefJSON       `json:"ingredient,omitempty"`
 	Category     *IngredientCategoryJSON  `json:"category,omitempty"`
 	CustomName   *string                  `json:"customName,omitempty"`
 	Quantity     *float64                 `json:"quantity,omitempty"`
@@ -786,9 +786,9 @@ func toShoppingListJSON(list *domain.ShoppingList) ShoppingListJSON {
 }
 
 func toShoppingListItemJSON(item *domain.ShoppingListItem) ShoppingListItemJSON {
-	var ingredient *IngredientJSON
+	var ingredient *IngredientRefJSON
 	if item.Ingredient != nil {
-		ingredient = &IngredientJSON{
+		ingredient = &IngredientRefJSON{
 			ID:   item.Ingredient.ID.String(),
 			Name: item.Ingredient.Name,
 		}
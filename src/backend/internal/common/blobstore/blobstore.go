@@ -0,0 +1,34 @@
+// Package blobstore stores uploaded files such as recipe images behind a
+// pluggable interface. Keys are slash-separated paths ("images/<id>/thumb.jpg")
+// and every stored blob is publicly reachable under the store's base URL.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var (
+	// ErrNotFound is returned when no blob exists for a key.
+	ErrNotFound = errors.New("blob not found")
+	// ErrInvalidKey is returned for empty keys or keys that escape the store.
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// Store persists blobs and maps keys to the public URLs they are served from.
+// The local filesystem store is the only implementation today; an
+// S3-compatible store only needs to satisfy this interface.
+type Store interface {
+	// Put stores the content of r under key, replacing any existing blob.
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Open returns the blob stored under key and its content type.
+	Open(ctx context.Context, key string) (io.ReadCloser, string, error)
+	// DeletePrefix removes every blob whose key starts with prefix.
+	DeletePrefix(ctx context.Context, prefix string) error
+	// URL returns the public URL of key.
+	URL(key string) string
+	// Key returns the key a public URL points to, or false when the URL is
+	// not served by this store.
+	Key(url string) (string, bool)
+}
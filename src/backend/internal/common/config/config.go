@@ -19,6 +19,7 @@ type Config struct {
 	Database    Database       `mapstructure:"database"`
 	RabbitMQ    RabbitMQ       `mapstructure:"rabbitmq"`
 	LLM         LLM            `mapstructure:"llm"`
+	Media       Media          `mapstructure:"media"`
 }
 
 // RecipeAPI configuration
@@ -26,6 +27,13 @@ type RecipeAPI struct {
 	HTTPAddress string        `mapstructure:"http_address"`
 	GRPCAddress string        `mapstructure:"grpc_address"`
 	Timeout     time.Duration `mapstructure:"timeout"`
+	// TrashRetention is how long deleted recipes stay restorable before they
+	// are purged. Zero disables the scheduled purge.
+	TrashRetention     time.Duration `mapstructure:"trash_retention"`
+	TrashPurgeInterval time.Duration `mapstructure:"trash_purge_interval"`
+	// CookSessionTTL is how long a cook session stays available after its
+	// last change.
+	CookSessionTTL time.Duration `mapstructure:"cook_session_ttl"`
 }
 
 // MealPlannerAPI configuration
@@ -80,6 +88,15 @@ type LLM struct {
 	Temperature     float32       `mapstructure:"temperature"`      // Response temperature (0.0-2.0)
 }
 
+// Media configuration for uploaded images. The BFF stores uploads and the
+// recipe API deletes them when recipes are purged, so both must use the same
+// root.
+type Media struct {
+	Root           string `mapstructure:"root"`             // Directory of the local blob store
+	BaseURL        string `mapstructure:"base_url"`         // Public URL the root is served under
+	MaxUploadBytes int64  `mapstructure:"max_upload_bytes"` // Largest accepted upload
+}
+
 // IsConfigured returns true if LLM is properly configured
 func (l *LLM) IsConfigured() bool {
 	return l.BaseURL != "" && l.APIKey != ""
@@ -139,6 +156,9 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("recipe_api.http_address", ":8081")
 	v.SetDefault("recipe_api.grpc_address", ":9091")
 	v.SetDefault("recipe_api.timeout", "30s")
+	v.SetDefault("recipe_api.trash_retention", "720h")
+	v.SetDefault("recipe_api.trash_purge_interval", "1h")
+	v.SetDefault("recipe_api.cook_session_ttl", "12h")
 
 	// MealPlanner API
 	v.SetDefault("mealplanner_api.http_address", ":8082")
@@ -179,6 +199,11 @@ func setDefaults(v *viper.Viper) {
 	v.SetDefault("llm.timeout", "60s")
 	v.SetDefault("llm.max_tokens", 2000)
 	v.SetDefault("llm.temperature", 0.7)
+
+	// Media
+	v.SetDefault("media.root", "./media")
+	v.SetDefault("media.base_url", "http://localhost:8080/media")
+	v.SetDefault("media.max_upload_bytes", 10<<20)
 }
 
 // IsDevelopment returns true if running in development mode
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Collection is a user's named, ordered group of their own recipes, such as
+// "Weeknight" or "Christmas".
+type Collection struct {
+	ID            uuid.UUID
+	UserID        uuid.UUID
+	Name          string
+	Description   string
+	CoverImageURL string
+	RecipeCount   int
+	// Items are the recipes in the collection in order. They are only loaded
+	// for a single collection.
+	Items []CollectionItem
+	// Permission is what the requesting user may do with a collection shared
+	// with them; it is empty for the owner.
+	Permission SharePermission
+	// Public collections, like the seeded recipe library, can be read by
+	// every user.
+	Public    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CollectionItem is a recipe in a collection.
+type CollectionItem struct {
+	RecipeID   uuid.UUID
+	RecipeName string
+	ImageURL   string
+	Position   int
+	AddedAt    time.Time
+}
+
+// CollectionShare grants another user access to a collection and the recipes
+// in it.
+type CollectionShare struct {
+	CollectionID     uuid.UUID
+	CollectionName   string
+	OwnerID          uuid.UUID
+	OwnerEmail       string
+	SharedWithUserID uuid.UUID
+	SharedWithEmail  string
+	Permission       SharePermission
+	CreatedAt        time.Time
+}
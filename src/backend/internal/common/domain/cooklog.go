@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Cook ratings range from one to five stars.
+const (
+	MinCookRating = 1
+	MaxCookRating = 5
+)
+
+// CookLogEntry records that a user cooked a recipe.
+type CookLogEntry struct {
+	ID         uuid.UUID
+	RecipeID   uuid.UUID
+	RecipeName string
+	UserID     uuid.UUID
+	CookedOn   time.Time
+	Servings   int
+	// Rating is 1 to 5 stars, or nil when the user did not rate the dish.
+	Rating    *int
+	Notes     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// RecipeCookStats aggregates a user's cook log for one recipe.
+type RecipeCookStats struct {
+	TimesCooked   int
+	LastCookedOn  *time.Time
+	AverageRating *float64
+}
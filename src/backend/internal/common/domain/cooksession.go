@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// maxCookTimerNameLength bounds the part of a step's instruction used to name
+// its timer.
+const maxCookTimerNameLength = 40
+
+// CookSession tracks a user cooking a recipe step by step. Sessions are kept
+// server-side so every device of the user shows the same step and timers.
+type CookSession struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	RecipeID   uuid.UUID
+	RecipeName string
+	// Servings the recipe is scaled to; 0 cooks it as written.
+	Servings int
+	// CurrentStep is the StepIndex of the step being cooked.
+	CurrentStep int
+	Timers      []CookTimer
+	// Version increases with every change and guards against concurrent
+	// updates.
+	Version        int
+	CookLogEntryID *uuid.UUID
+	StartedAt      time.Time
+	UpdatedAt      time.Time
+	CompletedAt    *time.Time
+	ExpiresAt      time.Time
+}
+
+// IsCompleted reports whether the session was completed.
+func (s *CookSession) IsCompleted() bool {
+	return s.CompletedAt != nil
+}
+
+// Timer returns the timer of a step, or nil when the step is not timed.
+func (s *CookSession) Timer(stepIndex int) *CookTimer {
+	for i := range s.Timers {
+		if s.Timers[i].StepIndex == stepIndex {
+			return &s.Timers[i]
+		}
+	}
+	return nil
+}
+
+// CookTimer counts down the duration of a timed step. Timers are paused when
+// created and keep their remaining time when paused again.
+type CookTimer struct {
+	StepIndex       int
+	Name            string
+	DurationSeconds int
+	// RemainingSeconds is the time left when the timer was last started or
+	// paused.
+	RemainingSeconds int
+	// StartedAt is set while the timer runs.
+	StartedAt *time.Time
+}
+
+// NewCookTimers creates a paused timer for every step with a duration.
+func NewCookTimers(steps []RecipeStep) []CookTimer {
+	var timers []CookTimer
+	for _, step := range steps {
+		if step.DurationSeconds == nil || *step.DurationSeconds <= 0 {
+			continue
+		}
+		timers = append(timers, CookTimer{
+			StepIndex:        step.StepIndex,
+			Name:             cookTimerName(step),
+			DurationSeconds:  *step.DurationSeconds,
+			RemainingSeconds: *step.DurationSeconds,
+		})
+	}
+	return timers
+}
+
+// cookTimerName names a timer after its step, e.g. "Step 2: Simmer the sauce".
+func cookTimerName(step RecipeStep) string {
+	name := fmt.Sprintf("Step %d", step.StepIndex)
+
+	instruction := strings.TrimSpace(step.Instruction)
+	if end := strings.IndexAny(instruction, ".!?\n"); end >= 0 {
+		instruction = strings.TrimSpace(instruction[:end])
+	}
+	if instruction == "" {
+		return name
+	}
+	if utf8.RuneCountInString(instruction) > maxCookTimerNameLength {
+		instruction = strings.TrimSpace(string([]rune(instruction)[:maxCookTimerNameLength-1])) + "…"
+	}
+	return name + ": " + instruction
+}
+
+// IsRunning reports whether the timer is counting down.
+func (t *CookTimer) IsRunning() bool {
+	return t.StartedAt != nil
+}
+
+// Remaining returns the seconds left at the given time.
+func (t *CookTimer) Remaining(now time.Time) int {
+	if t.StartedAt == nil {
+		return t.RemainingSeconds
+	}
+	elapsed := int(now.Sub(*t.StartedAt) / time.Second)
+	return max(0, t.RemainingSeconds-elapsed)
+}
+
+// EndsAt returns when a running timer reaches zero.
+func (t *CookTimer) EndsAt() *time.Time {
+	if t.StartedAt == nil {
+		return nil
+	}
+	endsAt := t.StartedAt.Add(time.Duration(t.RemainingSeconds) * time.Second)
+	return &endsAt
+}
+
+// Start starts or resumes the timer. A timer that already ran out starts
+// over from its full duration.
+func (t *CookTimer) Start(now time.Time) {
+	if t.IsRunning() {
+		if t.Remaining(now) > 0 {
+			return
+		}
+		t.StartedAt = nil
+		t.RemainingSeconds = 0
+	}
+	if t.RemainingSeconds <= 0 {
+		t.RemainingSeconds = t.DurationSeconds
+	}
+	t.StartedAt = &now
+}
+
+// Pause stops the timer, keeping the time left.
+func (t *CookTimer) Pause(now time.Time) {
+	t.RemainingSeconds = t.Remaining(now)
+	t.StartedAt = nil
+}
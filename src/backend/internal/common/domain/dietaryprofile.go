@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Diet is a diet a user follows.
+type Diet string
+
+const (
+	DietVegetarian  Diet = "vegetarian"
+	DietVegan       Diet = "vegan"
+	DietPescatarian Diet = "pescatarian"
+)
+
+// IsValid reports whether d is a known diet.
+func (d Diet) IsValid() bool {
+	switch d {
+	case DietVegetarian, DietVegan, DietPescatarian:
+		return true
+	}
+	return false
+}
+
+// Label returns the diet label a recipe needs to fit the diet. Vegan recipes
+// are labeled vegetarian and pescatarian as well, so stricter recipes fit the
+// looser diets.
+func (d Diet) Label() DietLabel {
+	return DietLabel(d)
+}
+
+// DietaryProfile holds a user's allergies, diets and disliked ingredients.
+// Recipe listings, similar recipes and meal suggestions leave out recipes that
+// conflict with it unless the caller asks to ignore it.
+type DietaryProfile struct {
+	UserID    uuid.UUID
+	Allergies []Allergy
+	Diets     []Diet
+	// DislikedIngredients are ingredient names, matched case-insensitively
+	// against the main ingredient and required ingredient lines.
+	DislikedIngredients []string
+	UpdatedAt           time.Time
+}
+
+// IsEmpty reports whether the profile restricts nothing.
+func (p *DietaryProfile) IsEmpty() bool {
+	return p == nil || (len(p.Allergies) == 0 && len(p.Diets) == 0 && len(p.DislikedIngredients) == 0)
+}
+
+// AllergyIDs returns the IDs of the profile's allergies.
+func (p *DietaryProfile) AllergyIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, len(p.Allergies))
+	for i, allergy := range p.Allergies {
+		ids[i] = allergy.ID
+	}
+	return ids
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DietLabel is a diet a recipe fits, derived from the dietary attributes of
+// its ingredients.
+type DietLabel string
+
+const (
+	DietLabelVegan       DietLabel = "vegan"
+	DietLabelVegetarian  DietLabel = "vegetarian"
+	DietLabelPescatarian DietLabel = "pescatarian"
+	DietLabelGlutenFree  DietLabel = "gluten-free"
+	DietLabelDairyFree   DietLabel = "dairy-free"
+	DietLabelNutFree     DietLabel = "nut-free"
+)
+
+// DietLabels lists every diet label in display order.
+var DietLabels = []DietLabel{
+	DietLabelVegan,
+	DietLabelVegetarian,
+	DietLabelPescatarian,
+	DietLabelGlutenFree,
+	DietLabelDairyFree,
+	DietLabelNutFree,
+}
+
+// IsValid reports whether l is a known diet label.
+func (l DietLabel) IsValid() bool {
+	for _, label := range DietLabels {
+		if l == label {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludedBy returns the ingredient attributes that rule the label out.
+func (l DietLabel) ExcludedBy() []IngredientAttribute {
+	switch l {
+	case DietLabelVegan:
+		return []IngredientAttribute{
+			IngredientAttributeMeat, IngredientAttributeFish, IngredientAttributeDairy,
+			IngredientAttributeEgg, IngredientAttributeAnimalProduct,
+		}
+	case DietLabelVegetarian:
+		return []IngredientAttribute{IngredientAttributeMeat, IngredientAttributeFish}
+	case DietLabelPescatarian:
+		return []IngredientAttribute{IngredientAttributeMeat}
+	case DietLabelGlutenFree:
+		return []IngredientAttribute{IngredientAttributeGluten}
+	case DietLabelDairyFree:
+		return []IngredientAttribute{IngredientAttributeDairy}
+	case DietLabelNutFree:
+		return []IngredientAttribute{IngredientAttributeNuts}
+	}
+	return nil
+}
+
+// IngredientAttribute is something an ingredient contains that rules out
+// diet labels.
+type IngredientAttribute string
+
+const (
+	IngredientAttributeMeat IngredientAttribute = "meat"
+	// IngredientAttributeFish covers fish and seafood.
+	IngredientAttributeFish  IngredientAttribute = "fish"
+	IngredientAttributeDairy IngredientAttribute = "dairy"
+	IngredientAttributeEgg   IngredientAttribute = "egg"
+	// IngredientAttributeAnimalProduct covers other animal products that
+	// vegetarians eat, such as honey.
+	IngredientAttributeAnimalProduct IngredientAttribute = "animal_product"
+	IngredientAttributeGluten        IngredientAttribute = "gluten"
+	IngredientAttributeNuts          IngredientAttribute = "nuts"
+)
+
+// IsValid reports whether a is a known ingredient attribute.
+func (a IngredientAttribute) IsValid() bool {
+	switch a {
+	case IngredientAttributeMeat, IngredientAttributeFish, IngredientAttributeDairy,
+		IngredientAttributeEgg, IngredientAttributeAnimalProduct,
+		IngredientAttributeGluten, IngredientAttributeNuts:
+		return true
+	}
+	return false
+}
+
+// IngredientDietaryAttributes records what an ingredient contains. An
+// ingredient without a record is unclassified, which is different from one
+// recorded with no attributes: only the latter fits every diet.
+type IngredientDietaryAttributes struct {
+	IngredientID uuid.UUID
+	Attributes   []IngredientAttribute
+	UpdatedAt    time.Time
+}
+
+// Has reports whether the ingredient has the attribute.
+func (a IngredientDietaryAttributes) Has(attribute IngredientAttribute) bool {
+	for _, own := range a.Attributes {
+		if own == attribute {
+			return true
+		}
+	}
+	return false
+}
@@ -1,11 +1,179 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+)
 
 // RecipeFilter defines optional filters for listing recipes.
 type RecipeFilter struct {
 	CuisineID    *uuid.UUID
 	IngredientID *uuid.UUID
 	AllergyID    *uuid.UUID
+	CollectionID *uuid.UUID
 	Tags         []string
+	DietLabels   []DietLabel // recipes must carry every label
+
+	// Cook log filters apply to the listing user's own cook history.
+	MinRating      *float64
+	CookedSince    *time.Time
+	NotCookedSince *time.Time // also matches recipes never cooked
+
+	// DietaryProfile leaves out recipes that conflict with the listing user's
+	// allergies, diets or disliked ingredients.
+	DietaryProfile *DietaryProfile
+
+	Sort RecipeSort
+	// Order is the direction of a column sort; empty for its default order.
+	Order SortOrder
+	// After continues a keyset-paginated listing after the recipe the cursor
+	// points at. It requires a column sort or the default newest-first order.
+	After *RecipeCursor
+
+	// Query is a free-text search query. When set, results are matched and
+	// ranked by a blend of full-text relevance and QueryVector similarity.
+	Query       string
+	QueryVector *pgvector.Vector
+}
+
+// RecipeSort orders a recipe listing. Without an explicit sort, searches are
+// ordered by relevance, collections by their own order and everything else by
+// newest first. Cook log sorts use the listing user's own cook history.
+type RecipeSort string
+
+const (
+	RecipeSortNewest      RecipeSort = ""
+	RecipeSortLastCooked  RecipeSort = "last_cooked"
+	RecipeSortMostCooked  RecipeSort = "most_cooked"
+	RecipeSortTopRated    RecipeSort = "top_rated"
+	RecipeSortLeastRecent RecipeSort = "least_recent"
+
+	// Column sorts order by a single recipe attribute in either direction.
+	RecipeSortName      RecipeSort = "name"
+	RecipeSortCreatedAt RecipeSort = "created_at"
+	RecipeSortUpdatedAt RecipeSort = "updated_at"
+	RecipeSortTotalTime RecipeSort = "total_time"
+	RecipeSortCalories  RecipeSort = "calories" // per serving
+	RecipeSortRating    RecipeSort = "rating"   // unrated recipes sort last
+)
+
+// IsValid reports whether s is a known recipe sort.
+func (s RecipeSort) IsValid() bool {
+	switch s {
+	case RecipeSortNewest, RecipeSortLastCooked, RecipeSortMostCooked, RecipeSortTopRated, RecipeSortLeastRecent:
+		return true
+	}
+	return s.IsColumn()
+}
+
+// IsColumn reports whether s orders by a single recipe attribute. Column
+// sorts take a sort order and support keyset pagination.
+func (s RecipeSort) IsColumn() bool {
+	switch s {
+	case RecipeSortName, RecipeSortCreatedAt, RecipeSortUpdatedAt, RecipeSortTotalTime, RecipeSortCalories, RecipeSortRating:
+		return true
+	}
+	return false
+}
+
+// DefaultOrder returns the direction a column sort uses when none is given:
+// names A to Z, quickest and lightest first, newest and best rated first.
+func (s RecipeSort) DefaultOrder() SortOrder {
+	switch s {
+	case RecipeSortName, RecipeSortTotalTime, RecipeSortCalories:
+		return SortAscending
+	}
+	return SortDescending
+}
+
+// SortOrder is the direction of a column sort.
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+// IsValid reports whether o is a known sort order.
+func (o SortOrder) IsValid() bool {
+	return o == SortAscending || o == SortDescending
+}
+
+// KeysetSort returns the column sort and direction the filter lists recipes
+// in, with ok false when its order cannot be paginated with a cursor: cook log
+// sorts, search relevance and collection order. The default listing is
+// newest first.
+func (f RecipeFilter) KeysetSort() (sort RecipeSort, order SortOrder, ok bool) {
+	switch {
+	case f.Sort.IsColumn():
+		order = f.Order
+		if order == "" {
+			order = f.Sort.DefaultOrder()
+		}
+		return f.Sort, order, true
+	case f.Sort == RecipeSortNewest && f.Query == "" && f.CollectionID == nil:
+		return RecipeSortCreatedAt, SortDescending, true
+	}
+	return "", "", false
+}
+
+// RecipeCursor marks the last recipe of a keyset-paginated page; the next page
+// starts with the recipe ordered after it. Recipe IDs break ties between equal
+// sort keys.
+type RecipeCursor struct {
+	Sort  RecipeSort
+	Order SortOrder
+	// Key is the sort attribute of the recipe in text form. KeyNull marks a
+	// recipe without one, such as an unrated recipe.
+	Key     string
+	KeyNull bool
+	ID      uuid.UUID
+}
+
+// NewRecipeCursor returns the cursor after recipe in a listing sorted by the
+// column sort.
+func NewRecipeCursor(recipe *Recipe, sort RecipeSort, order SortOrder) RecipeCursor {
+	cursor := RecipeCursor{Sort: sort, Order: order, ID: recipe.ID}
+	switch sort {
+	case RecipeSortName:
+		cursor.Key = recipe.Name
+	case RecipeSortCreatedAt:
+		cursor.Key = recipe.CreatedAt.Format(time.RFC3339Nano)
+	case RecipeSortUpdatedAt:
+		cursor.Key = recipe.UpdatedAt.Format(time.RFC3339Nano)
+	case RecipeSortTotalTime:
+		cursor.Key = strconv.Itoa(recipe.TotalTimeMinutes)
+	case RecipeSortCalories:
+		cursor.Key = strconv.Itoa(recipe.Nutrition.CaloriesPerServing)
+	case RecipeSortRating:
+		if recipe.CookStats.AverageRating == nil {
+			cursor.KeyNull = true
+		} else {
+			cursor.Key = strconv.FormatFloat(*recipe.CookStats.AverageRating, 'g', -1, 64)
+		}
+	}
+	return cursor
+}
+
+// IsValid reports whether the key of the cursor parses as its sort attribute.
+func (c RecipeCursor) IsValid() bool {
+	if !c.Sort.IsColumn() || !c.Order.IsValid() || c.ID == uuid.Nil {
+		return false
+	}
+	if c.KeyNull {
+		return c.Sort == RecipeSortRating && c.Key == ""
+	}
+	var err error
+	switch c.Sort {
+	case RecipeSortCreatedAt, RecipeSortUpdatedAt:
+		_, err = time.Parse(time.RFC3339Nano, c.Key)
+	case RecipeSortTotalTime, RecipeSortCalories:
+		_, err = strconv.Atoi(c.Key)
+	case RecipeSortRating:
+		_, err = strconv.ParseFloat(c.Key, 64)
+	}
+	return err == nil
 }
@@ -0,0 +1,19 @@
+package domain
+
+import "github.com/google/uuid"
+
+// RecipeFork links a duplicated recipe to the recipe it was copied from.
+type RecipeFork struct {
+	RecipeID   uuid.UUID
+	RecipeName string
+	// Revision is the upstream revision the recipe was copied or last pulled
+	// at; LatestRevision is the upstream's current revision.
+	Revision       int
+	LatestRevision int
+}
+
+// HasUpstreamChanges reports whether the upstream recipe was edited since it
+// was copied or last pulled.
+func (f RecipeFork) HasUpstreamChanges() bool {
+	return f.LatestRevision > f.Revision
+}
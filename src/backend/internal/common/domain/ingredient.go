@@ -12,7 +12,41 @@ type Ingredient struct {
 	UserID      uuid.UUID
 	Name        string
 	Description string
+	Category    *IngredientCategory
 	Allergies   []Allergy
+	// RecipeCount is the number of active recipes using the ingredient; only
+	// set when listing the catalog.
+	RecipeCount int
+	// SearchScore is the trigram similarity to the search query; only set
+	// when searching the catalog.
+	SearchScore float64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
+
+// IngredientDuplicate is a pair of ingredients whose names are similar enough
+// that they are likely the same food.
+type IngredientDuplicate struct {
+	Ingredient Ingredient
+	Duplicate  Ingredient
+	// Similarity is the trigram similarity of the names, from 0 to 1.
+	Similarity float64
+}
+
+// IngredientNutrition holds the nutrition facts of one serving of an
+// ingredient, e.g. 100 g of flour or 1 egg.
+type IngredientNutrition struct {
+	IngredientID     uuid.UUID
+	ServingSizeValue float64
+	ServingUnit      string
+	Calories         int
+	ProteinG         float64
+	CarbsG           float64
+	FatG             float64
+	FiberG           float64
+	SugarG           float64
+	SodiumMg         float64
+	// GramsPerML is the density of the ingredient, used to convert between
+	// volume and mass; zero when unknown.
+	GramsPerML float64
+}
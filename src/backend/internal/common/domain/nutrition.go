@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NutritionFood is an entry of a nutrition reference database such as USDA
+// FoodData Central or CIQUAL. Values are per 100 g.
+type NutritionFood struct {
+	ID       uuid.UUID
+	Source   string
+	SourceID string
+	Name     string
+	Synonyms []string
+	Calories float64
+	ProteinG float64
+	CarbsG   float64
+	FatG     float64
+	FiberG   float64
+	SugarG   float64
+	SodiumMg float64
+}
+
+// FoodMatchStatus describes how an ingredient is linked to a reference food.
+type FoodMatchStatus string
+
+const (
+	// FoodMatchMatched means exactly one reference food matched the name.
+	FoodMatchMatched FoodMatchStatus = "matched"
+	// FoodMatchAmbiguous means several foods matched and a user has to pick one.
+	FoodMatchAmbiguous FoodMatchStatus = "ambiguous"
+	// FoodMatchUnmatched means no reference food matched the name.
+	FoodMatchUnmatched FoodMatchStatus = "unmatched"
+	// FoodMatchConfirmed means a user picked the food.
+	FoodMatchConfirmed FoodMatchStatus = "confirmed"
+	// FoodMatchRejected means a user decided none of the candidates fit.
+	FoodMatchRejected FoodMatchStatus = "rejected"
+)
+
+// IsValid reports whether s is a known match status.
+func (s FoodMatchStatus) IsValid() bool {
+	switch s {
+	case FoodMatchMatched, FoodMatchAmbiguous, FoodMatchUnmatched, FoodMatchConfirmed, FoodMatchRejected:
+		return true
+	}
+	return false
+}
+
+// IngredientFoodMatch links a user's ingredient to a reference food.
+type IngredientFoodMatch struct {
+	Ingredient Ingredient
+	Status     FoodMatchStatus
+	Food       *NutritionFood
+	Candidates []NutritionFood
+	UpdatedAt  time.Time
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,9 +25,15 @@ type Recipe struct {
 	IngredientLines  []RecipeIngredientLine
 	Steps            []RecipeStep
 	Tags             []string
+	DietLabels       []DietLabel // derived from the ingredients' dietary attributes
+	DietClassified   bool        // set when every counted ingredient is classified; the labels are unknown otherwise
 	ImageURL         string
 	Nutrition        RecipeNutrition
+	CookStats        RecipeCookStats // of the user the recipe was loaded for
+	ForkedFrom       *RecipeFork     // set when the recipe was duplicated from another
+	ExternalID       string          // ID in the library the recipe was bulk imported from; only stored on create
 	SearchVector     pgvector.Vector
+	SearchScore      float64
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	DeletedAt        *time.Time
@@ -42,6 +49,33 @@ type RecipeIngredientLine struct {
 	IsOptional    bool
 	Note          string
 	SortOrder     int
+	// NutritionUnresolved is set when the line could not be counted in the
+	// computed nutrition, e.g. because the ingredient has no nutrition facts.
+	NutritionUnresolved bool
+}
+
+// DisplayText renders the line as a single human-readable string, e.g.
+// "2 cups flour, sifted (optional)".
+func (l RecipeIngredientLine) DisplayText() string {
+	var parts []string
+	if l.QuantityValue != nil {
+		parts = append(parts, formatQuantity(*l.QuantityValue))
+	} else if l.QuantityText != "" {
+		parts = append(parts, l.QuantityText)
+	}
+	if l.Unit != "" {
+		parts = append(parts, l.Unit)
+	}
+	parts = append(parts, l.Ingredient.Name)
+
+	text := strings.Join(parts, " ")
+	if l.Note != "" {
+		text += ", " + l.Note
+	}
+	if l.IsOptional {
+		text += " (optional)"
+	}
+	return text
 }
 
 // RecipeStep represents a structured instruction step.
@@ -65,6 +99,9 @@ type RecipeNutrition struct {
 	FiberG             float64
 	SugarG             float64
 	SodiumMg           float64
+	// IsOverride is set when the values were typed in by the user instead of
+	// computed from the ingredient lines.
+	IsOverride bool
 }
 
 // Allergies returns all unique allergies from the recipe's ingredients.
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecipeRevision is an immutable snapshot of a recipe, saved every time the
+// recipe is created or updated. Revisions are numbered from 1.
+type RecipeRevision struct {
+	RecipeID  uuid.UUID
+	Revision  int
+	Recipe    Recipe
+	CreatedAt time.Time
+}
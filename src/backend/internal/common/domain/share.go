@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SharePermission is what a user a recipe is shared with may do with it.
+type SharePermission string
+
+const (
+	// SharePermissionRead lets the user view, scale and plan with the recipe.
+	SharePermissionRead SharePermission = "read"
+	// SharePermissionEdit also lets the user update the recipe.
+	SharePermissionEdit SharePermission = "edit"
+)
+
+// IsValid reports whether p is a known share permission.
+func (p SharePermission) IsValid() bool {
+	switch p {
+	case SharePermissionRead, SharePermissionEdit:
+		return true
+	}
+	return false
+}
+
+// RecipeShare grants another user access to a recipe.
+type RecipeShare struct {
+	RecipeID         uuid.UUID
+	RecipeName       string
+	OwnerID          uuid.UUID
+	OwnerEmail       string
+	SharedWithUserID uuid.UUID
+	SharedWithEmail  string
+	Permission       SharePermission
+	CreatedAt        time.Time
+}
@@ -1,10 +1,11 @@
 package domain
 
 import (
-	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/units"
 )
 
 // IngredientCategory represents a category for grouping ingredients
@@ -132,10 +133,7 @@ func (i *ShoppingListItem) DisplayQuantity() string {
 
 // formatQuantity formats a float quantity for display
 func formatQuantity(q float64) string {
-	if q == float64(int(q)) {
-		return strconv.Itoa(int(q))
-	}
-	return strconv.FormatFloat(q, 'f', -1, 64)
+	return units.Format(q)
 }
 
 // RecipeIngredientWithQuantity extends Ingredient with quantity info from a recipe
@@ -0,0 +1,22 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Substitution replaces an ingredient with another one, e.g. buttermilk with
+// milk soured with lemon juice. Substitutions form a global catalog and are
+// matched to ingredients by normalized name.
+type Substitution struct {
+	ID             uuid.UUID
+	IngredientName string
+	SubstituteName string
+	// Ratio is the amount of substitute per unit of the ingredient, in the
+	// same unit.
+	Ratio float64
+	Notes string
+	// AvoidsAllergy is the allergen the ingredient has and the substitute is
+	// free of; nil when the substitution is not about an allergy.
+	AvoidsAllergy *Allergy
+	// ContainsAllergies are the allergens the substitute has itself, which a
+	// recipe using it takes on.
+	ContainsAllergies []Allergy
+}
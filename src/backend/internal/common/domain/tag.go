@@ -0,0 +1,31 @@
+package domain
+
+// TagGroup is a facet a tag can be filed under for browsing.
+type TagGroup string
+
+const (
+	// TagGroupCourse holds tags such as "dessert" or "main".
+	TagGroupCourse TagGroup = "course"
+	// TagGroupOccasion holds tags such as "weeknight" or "christmas".
+	TagGroupOccasion TagGroup = "occasion"
+	// TagGroupTechnique holds tags such as "grilling" or "one-pot".
+	TagGroupTechnique TagGroup = "technique"
+)
+
+// IsValid reports whether g is a known tag group.
+func (g TagGroup) IsValid() bool {
+	switch g {
+	case TagGroupCourse, TagGroupOccasion, TagGroupTechnique:
+		return true
+	}
+	return false
+}
+
+// Tag is a tag in use on a user's recipes.
+type Tag struct {
+	Name string
+	// Group is the facet the user filed the tag under; empty when ungrouped.
+	Group TagGroup
+	// RecipeCount is the number of active recipes carrying the tag.
+	RecipeCount int
+}
@@ -23,6 +23,8 @@ type RecipeDTO struct {
 	IngredientLines  []IngredientLineDTO  `json:"ingredientLines"`
 	Allergies        []AllergyDTO         `json:"allergies"`
 	Tags             []string             `json:"tags"`
+	DietLabels       []string             `json:"dietLabels"`
+	DietClassified   bool                 `json:"dietClassified"`
 	ImageURL         string               `json:"imageUrl"`
 	Nutrition        RecipeNutritionDTO   `json:"nutrition"`
 	SearchVector     []float32            `json:"searchVector"`
@@ -76,6 +78,11 @@ func FromRecipe(r *domain.Recipe) RecipeDTO {
 		tags = []string{}
 	}
 
+	dietLabels := make([]string, len(r.DietLabels))
+	for i, label := range r.DietLabels {
+		dietLabels[i] = string(label)
+	}
+
 	lines := make([]IngredientLineDTO, len(r.IngredientLines))
 	for i, line := range r.IngredientLines {
 		lines[i] = IngredientLineDTO{
@@ -121,6 +128,8 @@ func FromRecipe(r *domain.Recipe) RecipeDTO {
 		IngredientLines:  lines,
 		Allergies:        allergyDTOs,
 		Tags:             tags,
+		DietLabels:       dietLabels,
+		DietClassified:   r.DietClassified,
 		ImageURL:         r.ImageURL,
 		Nutrition:        FromNutrition(&r.Nutrition),
 		SearchVector:     r.SearchVector.Slice(),
@@ -137,6 +146,11 @@ func (d *RecipeDTO) ToRecipe() *domain.Recipe {
 	mainIngredient := d.MainIngredient.ToIngredient()
 	cuisine := d.Cuisine.ToCuisine()
 
+	var dietLabels []domain.DietLabel
+	for _, label := range d.DietLabels {
+		dietLabels = append(dietLabels, domain.DietLabel(label))
+	}
+
 	return &domain.Recipe{
 		ID:               d.ID,
 		UserID:           d.UserID,
@@ -152,6 +166,8 @@ func (d *RecipeDTO) ToRecipe() *domain.Recipe {
 		Cuisine:          cuisine,
 		IngredientLines:  lines,
 		Tags:             d.Tags,
+		DietLabels:       dietLabels,
+		DietClassified:   d.DietClassified,
 		ImageURL:         d.ImageURL,
 		Nutrition:        *d.Nutrition.ToNutrition(),
 		SearchVector:     pgvectorFromSlice(d.SearchVector),
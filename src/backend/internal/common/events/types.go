@@ -73,3 +73,265 @@ func NewRecipeDeletedEvent(recipeID, userID uuid.UUID) RecipeDeletedEvent {
 		DeletedAt: time.Now().UTC(),
 	}
 }
+
+// RecipeSharedEvent is published when a recipe is shared with another user,
+// or when the permission of an existing share changes.
+type RecipeSharedEvent struct {
+	BaseEvent
+	UserID           uuid.UUID `json:"userId"`
+	SharedWithUserID uuid.UUID `json:"sharedWithUserId"`
+	Permission       string    `json:"permission"`
+}
+
+// NewRecipeSharedEvent creates a new RecipeSharedEvent.
+func NewRecipeSharedEvent(recipeID, userID, sharedWithUserID uuid.UUID, permission string) RecipeSharedEvent {
+	return RecipeSharedEvent{
+		BaseEvent: BaseEvent{
+			ID:              uuid.New(),
+			Type:            "RecipeSharedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      recipeID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:           userID,
+		SharedWithUserID: sharedWithUserID,
+		Permission:       permission,
+	}
+}
+
+// RecipeShareRevokedEvent is published when a user loses access to a shared
+// recipe.
+type RecipeShareRevokedEvent struct {
+	BaseEvent
+	UserID           uuid.UUID `json:"userId"`
+	SharedWithUserID uuid.UUID `json:"sharedWithUserId"`
+}
+
+// NewRecipeShareRevokedEvent creates a new RecipeShareRevokedEvent.
+func NewRecipeShareRevokedEvent(recipeID, userID, sharedWithUserID uuid.UUID) RecipeShareRevokedEvent {
+	return RecipeShareRevokedEvent{
+		BaseEvent: BaseEvent{
+			ID:              uuid.New(),
+			Type:            "RecipeShareRevokedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      recipeID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:           userID,
+		SharedWithUserID: sharedWithUserID,
+	}
+}
+
+// RecipeCookStatsUpdatedEvent is published when a user's cook log for a
+// recipe changes. It carries the recomputed aggregates so consumers do not
+// need the individual entries.
+type RecipeCookStatsUpdatedEvent struct {
+	BaseEvent
+	UserID        uuid.UUID `json:"userId"`
+	TimesCooked   int       `json:"timesCooked"`
+	LastCookedOn  *string   `json:"lastCookedOn"` // YYYY-MM-DD
+	AverageRating *float64  `json:"averageRating"`
+}
+
+// NewRecipeCookStatsUpdatedEvent creates a new RecipeCookStatsUpdatedEvent.
+func NewRecipeCookStatsUpdatedEvent(recipeID, userID uuid.UUID, timesCooked int, lastCookedOn *time.Time, averageRating *float64) RecipeCookStatsUpdatedEvent {
+	var lastCooked *string
+	if lastCookedOn != nil {
+		formatted := lastCookedOn.Format(time.DateOnly)
+		lastCooked = &formatted
+	}
+
+	return RecipeCookStatsUpdatedEvent{
+		BaseEvent: BaseEvent{
+			ID:              uuid.New(),
+			Type:            "RecipeCookStatsUpdatedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      recipeID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:        userID,
+		TimesCooked:   timesCooked,
+		LastCookedOn:  lastCooked,
+		AverageRating: averageRating,
+	}
+}
+
+// DietaryProfileUpdatedEvent is published when a user saves their dietary
+// profile. It carries the whole profile; the aggregate is the user.
+type DietaryProfileUpdatedEvent struct {
+	BaseEvent
+	AllergyIDs          []uuid.UUID `json:"allergyIds"`
+	Diets               []string    `json:"diets"`
+	DislikedIngredients []string    `json:"dislikedIngredients"`
+}
+
+// NewDietaryProfileUpdatedEvent creates a new DietaryProfileUpdatedEvent.
+func NewDietaryProfileUpdatedEvent(userID uuid.UUID, allergyIDs []uuid.UUID, diets, dislikedIngredients []string) DietaryProfileUpdatedEvent {
+	return DietaryProfileUpdatedEvent{
+		BaseEvent: BaseEvent{
+			ID:               uuid.New(),
+			Type:             "DietaryProfileUpdatedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      userID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		AllergyIDs:          allergyIDs,
+		Diets:               diets,
+		DislikedIngredients: dislikedIngredients,
+	}
+}
+
+// CollectionSharedEvent is published when a collection is shared with
+// another user, or when the permission of an existing share changes. It
+// carries the recipes in the collection, which the share grants access to.
+type CollectionSharedEvent struct {
+	BaseEvent
+	UserID           uuid.UUID   `json:"userId"`
+	SharedWithUserID uuid.UUID   `json:"sharedWithUserId"`
+	Permission       string      `json:"permission"`
+	RecipeIDs        []uuid.UUID `json:"recipeIds"`
+}
+
+// NewCollectionSharedEvent creates a new CollectionSharedEvent.
+func NewCollectionSharedEvent(collectionID, userID, sharedWithUserID uuid.UUID, permission string, recipeIDs []uuid.UUID) CollectionSharedEvent {
+	return CollectionSharedEvent{
+		BaseEvent: BaseEvent{
+			ID:               uuid.New(),
+			Type:             "CollectionSharedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      collectionID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:           userID,
+		SharedWithUserID: sharedWithUserID,
+		Permission:       permission,
+		RecipeIDs:        recipeIDs,
+	}
+}
+
+// CollectionShareRevokedEvent is published when a user loses access to a
+// shared collection.
+type CollectionShareRevokedEvent struct {
+	BaseEvent
+	UserID           uuid.UUID `json:"userId"`
+	SharedWithUserID uuid.UUID `json:"sharedWithUserId"`
+}
+
+// NewCollectionShareRevokedEvent creates a new CollectionShareRevokedEvent.
+func NewCollectionShareRevokedEvent(collectionID, userID, sharedWithUserID uuid.UUID) CollectionShareRevokedEvent {
+	return CollectionShareRevokedEvent{
+		BaseEvent: BaseEvent{
+			ID:               uuid.New(),
+			Type:             "CollectionShareRevokedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      collectionID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:           userID,
+		SharedWithUserID: sharedWithUserID,
+	}
+}
+
+// CollectionRecipeAddedEvent is published when a recipe is added to a
+// collection.
+type CollectionRecipeAddedEvent struct {
+	BaseEvent
+	UserID   uuid.UUID `json:"userId"`
+	RecipeID uuid.UUID `json:"recipeId"`
+}
+
+// NewCollectionRecipeAddedEvent creates a new CollectionRecipeAddedEvent.
+func NewCollectionRecipeAddedEvent(collectionID, userID, recipeID uuid.UUID) CollectionRecipeAddedEvent {
+	return CollectionRecipeAddedEvent{
+		BaseEvent: BaseEvent{
+			ID:               uuid.New(),
+			Type:             "CollectionRecipeAddedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      collectionID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:   userID,
+		RecipeID: recipeID,
+	}
+}
+
+// CollectionRecipeRemovedEvent is published when a recipe is removed from a
+// collection.
+type CollectionRecipeRemovedEvent struct {
+	BaseEvent
+	UserID   uuid.UUID `json:"userId"`
+	RecipeID uuid.UUID `json:"recipeId"`
+}
+
+// NewCollectionRecipeRemovedEvent creates a new CollectionRecipeRemovedEvent.
+func NewCollectionRecipeRemovedEvent(collectionID, userID, recipeID uuid.UUID) CollectionRecipeRemovedEvent {
+	return CollectionRecipeRemovedEvent{
+		BaseEvent: BaseEvent{
+			ID:               uuid.New(),
+			Type:             "CollectionRecipeRemovedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      collectionID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:   userID,
+		RecipeID: recipeID,
+	}
+}
+
+// CollectionDeletedEvent is published when a collection is deleted, which
+// ends its shares.
+type CollectionDeletedEvent struct {
+	BaseEvent
+	UserID uuid.UUID `json:"userId"`
+}
+
+// NewCollectionDeletedEvent creates a new CollectionDeletedEvent.
+func NewCollectionDeletedEvent(collectionID, userID uuid.UUID) CollectionDeletedEvent {
+	return CollectionDeletedEvent{
+		BaseEvent: BaseEvent{
+			ID:               uuid.New(),
+			Type:             "CollectionDeletedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      collectionID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID: userID,
+	}
+}
+
+// CollectionVisibilityChangedEvent is published when a collection is made
+// public, so every user can read it and the recipes in it, or private again.
+// It carries the recipes in the collection.
+type CollectionVisibilityChangedEvent struct {
+	BaseEvent
+	UserID    uuid.UUID   `json:"userId"`
+	Public    bool        `json:"public"`
+	RecipeIDs []uuid.UUID `json:"recipeIds"`
+}
+
+// NewCollectionVisibilityChangedEvent creates a new
+// CollectionVisibilityChangedEvent.
+func NewCollectionVisibilityChangedEvent(collectionID, userID uuid.UUID, public bool, recipeIDs []uuid.UUID) CollectionVisibilityChangedEvent {
+	return CollectionVisibilityChangedEvent{
+		BaseEvent: BaseEvent{
+			ID:               uuid.New(),
+			Type:             "CollectionVisibilityChangedEvent",
+			OccurredOn:       time.Now().UTC(),
+			AggregateId:      collectionID,
+			SchemaVersion:    1,
+			AggregateVersion: 0,
+		},
+		UserID:    userID,
+		Public:    public,
+		RecipeIDs: recipeIDs,
+	}
+}
@@ -0,0 +1,234 @@
+// Package media validates uploaded recipe images and stores them with their
+// resized variants in a blob store.
+//
+// Every upload is kept under its own prefix, "images/<id>/", holding the
+// original with its metadata stripped and one JPEG per variant. Variants are
+// JPEG only: the standard library has no WebP encoder.
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	_ "image/png" // registers the PNG decoder
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/blobstore"
+)
+
+// MaxPixels bounds the decoded size of an upload so that a small, highly
+// compressed file cannot exhaust memory.
+const MaxPixels = 50_000_000
+
+var (
+	// ErrTooLarge is returned for uploads over the size limit.
+	ErrTooLarge = errors.New("image exceeds the maximum upload size")
+	// ErrUnsupportedType is returned for uploads that are not JPEG or PNG.
+	ErrUnsupportedType = errors.New("image must be a JPEG or PNG")
+	// ErrInvalidImage is returned for uploads that cannot be decoded or whose
+	// dimensions exceed MaxPixels.
+	ErrInvalidImage = errors.New("image could not be read")
+)
+
+// Variant is a resized copy of an upload that fits in a Size x Size box.
+type Variant struct {
+	Name string
+	Size int
+}
+
+// Variants are generated for every upload, largest first. Images smaller than
+// a variant are not upscaled.
+var Variants = []Variant{
+	{Name: "large", Size: 1600},
+	{Name: "medium", Size: 800},
+	{Name: "thumb", Size: 320},
+}
+
+// DefaultVariant is the variant whose URL is used as the recipe or step
+// image.
+const DefaultVariant = "large"
+
+const (
+	imagePrefix    = "images/"
+	variantQuality = 82
+	rotatedQuality = 92
+)
+
+// Image describes a stored upload.
+type Image struct {
+	ID          uuid.UUID
+	URL         string // URL of DefaultVariant
+	OriginalURL string
+	Variants    map[string]string // variant name to URL
+	Width       int               // width of the upright original
+	Height      int               // height of the upright original
+}
+
+// Uploader validates uploads and writes them to a blob store.
+type Uploader struct {
+	store    blobstore.Store
+	maxBytes int64
+}
+
+// NewUploader creates an uploader that accepts images of up to maxBytes.
+func NewUploader(store blobstore.Store, maxBytes int64) *Uploader {
+	return &Uploader{
+		store:    store,
+		maxBytes: maxBytes,
+	}
+}
+
+// MaxBytes returns the upload size limit.
+func (u *Uploader) MaxBytes() int64 {
+	return u.maxBytes
+}
+
+// Upload validates the image read from r and stores the original and its
+// variants. EXIF and other metadata are removed from everything stored; a
+// JPEG's EXIF orientation is applied to the pixels first.
+func (u *Uploader) Upload(ctx context.Context, r io.Reader) (*Image, error) {
+	data, err := io.ReadAll(io.LimitReader(r, u.maxBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read image: %w", err)
+	}
+	if int64(len(data)) > u.maxBytes {
+		return nil, ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	var ext string
+	switch contentType {
+	case "image/jpeg":
+		ext = "jpg"
+	case "image/png":
+		ext = "png"
+	default:
+		return nil, ErrUnsupportedType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width <= 0 || config.Height <= 0 {
+		return nil, ErrInvalidImage
+	}
+	if int64(config.Width)*int64(config.Height) > MaxPixels {
+		return nil, ErrInvalidImage
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidImage
+	}
+
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = jpegOrientation(data)
+	}
+	upright := orient(flatten(decoded), orientation)
+
+	// The original keeps its encoding unless it has to be rotated, in which
+	// case the upright pixels are re-encoded since the orientation tag is
+	// stripped with the rest of the metadata.
+	original, err := stripMetadata(data, contentType)
+	if err != nil || orientation != 1 {
+		ext, contentType = "jpg", "image/jpeg"
+		if original, err = encodeJPEG(upright, rotatedQuality); err != nil {
+			return nil, err
+		}
+	}
+
+	id := uuid.New()
+	img := &Image{
+		ID:       id,
+		Variants: make(map[string]string, len(Variants)),
+		Width:    upright.Bounds().Dx(),
+		Height:   upright.Bounds().Dy(),
+	}
+
+	originalKey := imageKey(id, "original."+ext)
+	if err := u.store.Put(ctx, originalKey, bytes.NewReader(original), contentType); err != nil {
+		return nil, fmt.Errorf("store original image: %w", err)
+	}
+	img.OriginalURL = u.store.URL(originalKey)
+
+	// Each variant is scaled down from the previous one.
+	src := upright
+	for _, variant := range Variants {
+		src = fit(src, variant.Size)
+		encoded, err := encodeJPEG(src, variantQuality)
+		if err != nil {
+			return nil, err
+		}
+		key := imageKey(id, variant.Name+".jpg")
+		if err := u.store.Put(ctx, key, bytes.NewReader(encoded), "image/jpeg"); err != nil {
+			return nil, fmt.Errorf("store %s image: %w", variant.Name, err)
+		}
+		img.Variants[variant.Name] = u.store.URL(key)
+	}
+	img.URL = img.Variants[DefaultVariant]
+
+	return img, nil
+}
+
+// Delete removes an upload with all its variants.
+func (u *Uploader) Delete(ctx context.Context, img *Image) error {
+	return u.store.DeletePrefix(ctx, imagePrefix+img.ID.String()+"/")
+}
+
+// DeleteImages removes the uploads the given URLs belong to, with all their
+// variants. URLs that were not uploaded to the store are ignored.
+func DeleteImages(ctx context.Context, store blobstore.Store, urls []string) error {
+	var errs []error
+	deleted := make(map[string]bool)
+	for _, url := range urls {
+		prefix, ok := uploadPrefix(store, url)
+		if !ok || deleted[prefix] {
+			continue
+		}
+		if err := store.DeletePrefix(ctx, prefix); err != nil {
+			errs = append(errs, fmt.Errorf("delete %s: %w", prefix, err))
+			continue
+		}
+		deleted[prefix] = true
+	}
+	return errors.Join(errs...)
+}
+
+// uploadPrefix returns the "images/<id>/" prefix of an uploaded image URL.
+func uploadPrefix(store blobstore.Store, url string) (string, bool) {
+	key, ok := store.Key(url)
+	if !ok {
+		return "", false
+	}
+	rest, ok := strings.CutPrefix(key, imagePrefix)
+	if !ok {
+		return "", false
+	}
+	id, _, ok := strings.Cut(rest, "/")
+	if !ok {
+		return "", false
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return "", false
+	}
+	return imagePrefix + id + "/", true
+}
+
+func imageKey(id uuid.UUID, name string) string {
+	return path.Join(imagePrefix, id.String(), name)
+}
+
+func encodeJPEG(img image.Image, quality int) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: quality}); err != nil {
+		return nil, fmt.Errorf("encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
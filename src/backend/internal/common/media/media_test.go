@@ -0,0 +1,220 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/platepilot/backend/internal/common/blobstore"
+)
+
+const testBaseURL = "http://localhost:8080/media"
+
+func newTestUploader(t *testing.T, maxBytes int64) (*Uploader, *blobstore.LocalStore, string) {
+	t.Helper()
+	dir := t.TempDir()
+	store, err := blobstore.NewLocalStore(dir, testBaseURL)
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	return NewUploader(store, maxBytes), store, dir
+}
+
+func encodeTestJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: 200, G: 80, B: 40, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("failed to encode jpeg: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withExifOrientation inserts an APP1 segment carrying only an orientation
+// tag after the SOI marker.
+func withExifOrientation(data []byte, orientation byte) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08, // header, IFD0 at offset 8
+		0x00, 0x01, // one entry
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, 0x00, orientation, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, // no next IFD
+	}
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+	length := len(payload) + 2
+	segment := append([]byte{0xFF, 0xE1, byte(length >> 8), byte(length)}, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+	return append(out, data[2:]...)
+}
+
+func readStored(t *testing.T, store *blobstore.LocalStore, url string) []byte {
+	t.Helper()
+	key, ok := store.Key(url)
+	if !ok {
+		t.Fatalf("url %q is not served by the store", url)
+	}
+	r, _, err := store.Open(context.Background(), key)
+	if err != nil {
+		t.Fatalf("failed to open %s: %v", key, err)
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", key, err)
+	}
+	return data
+}
+
+func TestUpload_StoresOriginalAndDownscaledVariants(t *testing.T) {
+	uploader, store, _ := newTestUploader(t, 10<<20)
+
+	img, err := uploader.Upload(context.Background(), bytes.NewReader(encodeTestJPEG(t, 2000, 1000)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if img.URL != img.Variants[DefaultVariant] {
+		t.Errorf("expected URL to be the %s variant, got %q", DefaultVariant, img.URL)
+	}
+
+	want := map[string][2]int{"large": {1600, 800}, "medium": {800, 400}, "thumb": {320, 160}}
+	for name, size := range want {
+		config, err := jpeg.DecodeConfig(bytes.NewReader(readStored(t, store, img.Variants[name])))
+		if err != nil {
+			t.Fatalf("failed to decode %s variant: %v", name, err)
+		}
+		if config.Width != size[0] || config.Height != size[1] {
+			t.Errorf("expected %s variant %dx%d, got %dx%d", name, size[0], size[1], config.Width, config.Height)
+		}
+	}
+
+	config, err := jpeg.DecodeConfig(bytes.NewReader(readStored(t, store, img.OriginalURL)))
+	if err != nil {
+		t.Fatalf("failed to decode original: %v", err)
+	}
+	if config.Width != 2000 || config.Height != 1000 {
+		t.Errorf("expected original 2000x1000, got %dx%d", config.Width, config.Height)
+	}
+}
+
+func TestUpload_AppliesOrientationAndStripsExif(t *testing.T) {
+	uploader, store, _ := newTestUploader(t, 10<<20)
+	data := withExifOrientation(encodeTestJPEG(t, 40, 20), 6)
+
+	img, err := uploader.Upload(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if img.Width != 20 || img.Height != 40 {
+		t.Errorf("expected upright image 20x40, got %dx%d", img.Width, img.Height)
+	}
+
+	for _, url := range []string{img.OriginalURL, img.Variants["thumb"]} {
+		stored := readStored(t, store, url)
+		if bytes.Contains(stored, []byte("Exif\x00\x00")) {
+			t.Errorf("expected EXIF to be stripped from %s", url)
+		}
+		config, err := jpeg.DecodeConfig(bytes.NewReader(stored))
+		if err != nil {
+			t.Fatalf("failed to decode %s: %v", url, err)
+		}
+		if config.Width != 20 || config.Height != 40 {
+			t.Errorf("expected %s to be 20x40, got %dx%d", url, config.Width, config.Height)
+		}
+	}
+}
+
+func TestUpload_KeepsUnrotatedOriginalBytesWithoutMetadata(t *testing.T) {
+	uploader, store, _ := newTestUploader(t, 10<<20)
+	plain := encodeTestJPEG(t, 40, 20)
+
+	img, err := uploader.Upload(context.Background(), bytes.NewReader(withExifOrientation(plain, 1)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !bytes.Equal(readStored(t, store, img.OriginalURL), plain) {
+		t.Error("expected the original to equal the upload without its EXIF segment")
+	}
+}
+
+func TestUpload_RejectsInvalidUploads(t *testing.T) {
+	var gif bytes.Buffer
+	gif.WriteString("GIF89a")
+	gif.Write(make([]byte, 32))
+
+	var huge bytes.Buffer
+	if err := png.Encode(&huge, image.NewGray(image.Rect(0, 0, 10000, 6000))); err != nil {
+		t.Fatalf("failed to encode png: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		data     []byte
+		maxBytes int64
+		want     error
+	}{
+		{name: "too large", data: encodeTestJPEG(t, 40, 20), maxBytes: 100, want: ErrTooLarge},
+		{name: "gif", data: gif.Bytes(), maxBytes: 10 << 20, want: ErrUnsupportedType},
+		{name: "text", data: []byte("not an image"), maxBytes: 10 << 20, want: ErrUnsupportedType},
+		{name: "truncated", data: encodeTestJPEG(t, 40, 20)[:20], maxBytes: 10 << 20, want: ErrInvalidImage},
+		{name: "too many pixels", data: huge.Bytes(), maxBytes: 10 << 20, want: ErrInvalidImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uploader, _, dir := newTestUploader(t, tt.maxBytes)
+
+			_, err := uploader.Upload(context.Background(), bytes.NewReader(tt.data))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+
+			entries, _ := os.ReadDir(dir)
+			if len(entries) != 0 {
+				t.Errorf("expected nothing stored, found %d entries", len(entries))
+			}
+		})
+	}
+}
+
+func TestDeleteImages_RemovesUploadsAndIgnoresForeignURLs(t *testing.T) {
+	uploader, store, dir := newTestUploader(t, 10<<20)
+	ctx := context.Background()
+
+	kept, err := uploader.Upload(ctx, bytes.NewReader(encodeTestJPEG(t, 40, 20)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deleted, err := uploader.Upload(ctx, bytes.NewReader(encodeTestJPEG(t, 40, 20)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = DeleteImages(ctx, store, []string{deleted.URL, "https://example.com/images/photo.jpg", testBaseURL + "/../etc/passwd"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "images", deleted.ID.String())); !os.IsNotExist(err) {
+		t.Errorf("expected deleted upload to be removed, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "images", kept.ID.String(), "thumb.jpg")); err != nil {
+		t.Errorf("expected other upload to be kept: %v", err)
+	}
+}
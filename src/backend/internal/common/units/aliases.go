@@ -0,0 +1,185 @@
+package units
+
+// aliases maps lower-cased English and Dutch spellings to canonical unit
+// names. The case-sensitive "t" and "T" are handled in Lookup.
+var aliases = map[string]string{
+	// Volume, spoons and cups
+	"tsp":         "tsp",
+	"tsps":        "tsp",
+	"teaspoon":    "tsp",
+	"teaspoons":   "tsp",
+	"tl":          "tsp",
+	"theelepel":   "tsp",
+	"theelepels":  "tsp",
+	"tbsp":        "tbsp",
+	"tbsps":       "tbsp",
+	"tbs":         "tbsp",
+	"tbl":         "tbsp",
+	"tablespoon":  "tbsp",
+	"tablespoons": "tbsp",
+	"el":          "tbsp",
+	"eetlepel":    "tbsp",
+	"eetlepels":   "tbsp",
+	"c":           "cup",
+	"cup":         "cup",
+	"cups":        "cup",
+	"kop":         "cup",
+	"koppen":      "cup",
+	"kopje":       "cup",
+	"kopjes":      "cup",
+	"pt":          "pint",
+	"pint":        "pint",
+	"pints":       "pint",
+	"qt":          "quart",
+	"quart":       "quart",
+	"quarts":      "quart",
+	"gal":         "gallon",
+	"gallon":      "gallon",
+	"gallons":     "gallon",
+
+	// Metric volume
+	"ml":          "ml",
+	"milliliter":  "ml",
+	"milliliters": "ml",
+	"millilitre":  "ml",
+	"millilitres": "ml",
+	"cl":          "cl",
+	"centiliter":  "cl",
+	"centiliters": "cl",
+	"dl":          "dl",
+	"deciliter":   "dl",
+	"deciliters":  "dl",
+	"l":           "l",
+	"liter":       "l",
+	"liters":      "l",
+	"litre":       "l",
+	"litres":      "l",
+
+	// Weight
+	"mg":         "mg",
+	"milligram":  "mg",
+	"milligrams": "mg",
+	"g":          "g",
+	"gr":         "g",
+	"gram":       "g",
+	"grams":      "g",
+	"gramme":     "g",
+	"grammes":    "g",
+	"kg":         "kg",
+	"kilo":       "kg",
+	"kilos":      "kg",
+	"kilogram":   "kg",
+	"kilograms":  "kg",
+	"oz":         "oz",
+	"ounce":      "oz",
+	"ounces":     "oz",
+	"lb":         "lb",
+	"lbs":        "lb",
+	"pound":      "lb",
+	"pounds":     "lb",
+	"ons":        "ons",
+	"pond":       "pond",
+
+	// Counts and kitchen measures
+	"pinch":      "pinch",
+	"pinches":    "pinch",
+	"snufje":     "pinch",
+	"snufjes":    "pinch",
+	"mespunt":    "pinch",
+	"mespuntje":  "pinch",
+	"dash":       "dash",
+	"dashes":     "dash",
+	"scheut":     "dash",
+	"scheutje":   "dash",
+	"scheutjes":  "dash",
+	"clove":      "clove",
+	"cloves":     "clove",
+	"teen":       "clove",
+	"teentje":    "clove",
+	"teentjes":   "clove",
+	"tenen":      "clove",
+	"can":        "can",
+	"cans":       "can",
+	"tin":        "can",
+	"tins":       "can",
+	"blik":       "can",
+	"blikje":     "can",
+	"blikjes":    "can",
+	"blikken":    "can",
+	"piece":      "piece",
+	"pieces":     "piece",
+	"pc":         "piece",
+	"pcs":        "piece",
+	"stuk":       "piece",
+	"stuks":      "piece",
+	"stukje":     "piece",
+	"stukjes":    "piece",
+	"slice":      "slice",
+	"slices":     "slice",
+	"plak":       "slice",
+	"plakje":     "slice",
+	"plakjes":    "slice",
+	"plakken":    "slice",
+	"bunch":      "bunch",
+	"bunches":    "bunch",
+	"bos":        "bunch",
+	"bosje":      "bunch",
+	"bosjes":     "bunch",
+	"head":       "head",
+	"heads":      "head",
+	"krop":       "head",
+	"kropje":     "head",
+	"sprig":      "sprig",
+	"sprigs":     "sprig",
+	"takje":      "sprig",
+	"takjes":     "sprig",
+	"handful":    "handful",
+	"handfuls":   "handful",
+	"handje":     "handful",
+	"handjes":    "handful",
+	"handvol":    "handful",
+	"package":    "package",
+	"packages":   "package",
+	"pack":       "package",
+	"packs":      "package",
+	"pkg":        "package",
+	"pak":        "package",
+	"pakje":      "package",
+	"pakjes":     "package",
+	"zak":        "package",
+	"zakje":      "package",
+	"zakjes":     "package",
+	"stick":      "stick",
+	"sticks":     "stick",
+	"jar":        "jar",
+	"jars":       "jar",
+	"pot":        "jar",
+	"potje":      "jar",
+	"potjes":     "jar",
+	"leaf":       "leaf",
+	"leaves":     "leaf",
+	"blad":       "leaf",
+	"blaadje":    "leaf",
+	"blaadjes":   "leaf",
+	"drop":       "drop",
+	"drops":      "drop",
+	"druppel":    "drop",
+	"druppels":   "drop",
+	"druppeltje": "drop",
+
+	// Multi-word spellings
+	"fl oz":        "fl oz",
+	"fl. oz":       "fl oz",
+	"fl.oz":        "fl oz",
+	"floz":         "fl oz",
+	"fluid ounce":  "fl oz",
+	"fluid ounces": "fl oz",
+
+	// Temperature
+	"°c":         "°C",
+	"ºc":         "°C",
+	"celsius":    "°C",
+	"°f":         "°F",
+	"ºf":         "°F",
+	"fahrenheit": "°F",
+}
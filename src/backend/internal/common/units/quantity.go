@@ -0,0 +1,149 @@
+package units
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Quantity is an amount expressed in a unit.
+type Quantity struct {
+	Value float64
+	Unit  string
+}
+
+// String renders the quantity as "1.5 kg", or just the value without a unit.
+func (q Quantity) String() string {
+	if q.Unit == "" {
+		return Format(q.Value)
+	}
+	return Format(q.Value) + " " + q.Unit
+}
+
+// Format renders a value with at most two decimals and no trailing zeros.
+func Format(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+// step is a rung on a display ladder: the unit is used from min base units up.
+type step struct {
+	unit string
+	min  float64
+}
+
+// ladders list the display units per dimension and system, smallest first.
+var ladders = map[Dimension]map[System][]step{
+	DimensionMass: {
+		SystemMetric:   {{"mg", 0}, {"g", 1}, {"kg", 1000}},
+		SystemImperial: {{"oz", 0}, {"lb", 453.59237}},
+	},
+	DimensionVolume: {
+		SystemMetric:   {{"ml", 0}, {"l", 1000}},
+		SystemImperial: {{"tsp", 0}, {"tbsp", 14.78676478125}, {"cup", 236.5882365 / 4}},
+	},
+}
+
+// Humanize re-expresses q in the most readable unit of its own system, so
+// "1500 g" becomes "1.5 kg" and "48 tsp" becomes "1 cup". Count, temperature
+// and unknown units are returned unchanged.
+func Humanize(q Quantity) Quantity {
+	unit, ok := Lookup(q.Unit)
+	if !ok {
+		return q
+	}
+	return ToSystem(q, unit.System)
+}
+
+// ToSystem converts q into the metric or imperial system and picks the most
+// readable unit there. Count, temperature and unknown units are returned
+// unchanged.
+func ToSystem(q Quantity, system System) Quantity {
+	unit, ok := Lookup(q.Unit)
+	if !ok {
+		return q
+	}
+	ladder := ladders[unit.Dimension][system]
+	if ladder == nil {
+		return q
+	}
+	return fromBase(q.Value*unit.toBase, ladder)
+}
+
+func fromBase(base float64, ladder []step) Quantity {
+	chosen := ladder[0]
+	for _, s := range ladder[1:] {
+		// Compare with a small tolerance so 3 tsp reads as 1 tbsp.
+		if math.Abs(base) >= s.min*(1-1e-9) {
+			chosen = s
+		}
+	}
+	return Quantity{Value: base / catalogue[chosen.unit].toBase, Unit: chosen.unit}
+}
+
+// Total sums the quantities of one ingredient across recipes. Mass and volume
+// are added up across units, and volume counts as mass when a density is
+// known; count units add up per unit; unknown units add up per spelling and
+// are passed through as written.
+type Total struct {
+	gramsPerML float64
+	entries    []*totalEntry
+}
+
+type totalEntry struct {
+	key    string
+	value  float64
+	unit   string
+	dim    Dimension
+	system System
+}
+
+// NewTotal starts a total for an ingredient with the given density in grams
+// per millilitre, or zero when it is unknown.
+func NewTotal(gramsPerML float64) *Total {
+	return &Total{gramsPerML: gramsPerML}
+}
+
+// Add adds value in unit to the total.
+func (t *Total) Add(value float64, unit string) {
+	u, known := Lookup(unit)
+	if !known || (u.Dimension != DimensionMass && u.Dimension != DimensionVolume) {
+		name := strings.TrimSpace(unit)
+		if known {
+			name = u.Name
+		}
+		t.entry("unit:"+strings.ToLower(name), name, "", SystemUniversal).value += value
+		return
+	}
+
+	dim, base := u.Dimension, value*u.toBase
+	if dim == DimensionVolume && t.gramsPerML > 0 {
+		dim, base = DimensionMass, base*t.gramsPerML
+	}
+	t.entry(string(dim), "", dim, u.System).value += base
+}
+
+func (t *Total) entry(key, unit string, dim Dimension, system System) *totalEntry {
+	for _, e := range t.entries {
+		if e.key == key {
+			return e
+		}
+	}
+	e := &totalEntry{key: key, unit: unit, dim: dim, system: system}
+	t.entries = append(t.entries, e)
+	return e
+}
+
+// Quantities returns the summed quantities in the order their units were
+// first added. Mass and volume are expressed in readable units of the system
+// of the first contribution.
+func (t *Total) Quantities() []Quantity {
+	quantities := make([]Quantity, 0, len(t.entries))
+	for _, e := range t.entries {
+		if e.dim == "" {
+			quantities = append(quantities, Quantity{Value: e.value, Unit: e.unit})
+			continue
+		}
+		quantities = append(quantities, fromBase(e.value, ladders[e.dim][e.system]))
+	}
+	return quantities
+}
@@ -0,0 +1,207 @@
+// Package units knows the dimensions of cooking units, converts between them
+// and picks kitchen-friendly units for display.
+//
+// Mass and volume convert freely within their dimension and across metric
+// and US customary systems; volume and mass convert into each other only
+// with an ingredient density. Count units such as "clove" or "can" only
+// match themselves. Unknown units are never dropped: callers keep them as
+// written.
+package units
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrUnknownUnit is returned for units not in the catalogue.
+	ErrUnknownUnit = errors.New("unknown unit")
+	// ErrIncompatibleUnits is returned when two units measure different
+	// dimensions and no density is available to bridge them.
+	ErrIncompatibleUnits = errors.New("incompatible units")
+)
+
+// Dimension is the physical quantity a unit measures.
+type Dimension string
+
+const (
+	DimensionMass        Dimension = "mass"
+	DimensionVolume      Dimension = "volume"
+	DimensionCount       Dimension = "count"
+	DimensionTemperature Dimension = "temperature"
+)
+
+// System is the measurement system a unit belongs to.
+type System string
+
+const (
+	SystemMetric    System = "metric"
+	SystemImperial  System = "imperial"
+	SystemUniversal System = ""
+)
+
+// Unit is a canonical unit with its conversion factor to the base unit of
+// its dimension: grams, millilitres or degrees Celsius.
+type Unit struct {
+	Name      string
+	Dimension Dimension
+	System    System
+	toBase    float64
+}
+
+// Base units of each convertible dimension.
+const (
+	Gram       = "g"
+	Millilitre = "ml"
+	Celsius    = "°C"
+	Fahrenheit = "°F"
+)
+
+var catalogue = map[string]Unit{
+	// Mass
+	"mg":   {Name: "mg", Dimension: DimensionMass, System: SystemMetric, toBase: 0.001},
+	"g":    {Name: "g", Dimension: DimensionMass, System: SystemMetric, toBase: 1},
+	"kg":   {Name: "kg", Dimension: DimensionMass, System: SystemMetric, toBase: 1000},
+	"ons":  {Name: "ons", Dimension: DimensionMass, System: SystemMetric, toBase: 100},
+	"pond": {Name: "pond", Dimension: DimensionMass, System: SystemMetric, toBase: 500},
+	"oz":   {Name: "oz", Dimension: DimensionMass, System: SystemImperial, toBase: 28.349523125},
+	"lb":   {Name: "lb", Dimension: DimensionMass, System: SystemImperial, toBase: 453.59237},
+
+	// Volume
+	"ml":     {Name: "ml", Dimension: DimensionVolume, System: SystemMetric, toBase: 1},
+	"cl":     {Name: "cl", Dimension: DimensionVolume, System: SystemMetric, toBase: 10},
+	"dl":     {Name: "dl", Dimension: DimensionVolume, System: SystemMetric, toBase: 100},
+	"l":      {Name: "l", Dimension: DimensionVolume, System: SystemMetric, toBase: 1000},
+	"tsp":    {Name: "tsp", Dimension: DimensionVolume, System: SystemImperial, toBase: 4.92892159375},
+	"tbsp":   {Name: "tbsp", Dimension: DimensionVolume, System: SystemImperial, toBase: 14.78676478125},
+	"fl oz":  {Name: "fl oz", Dimension: DimensionVolume, System: SystemImperial, toBase: 29.5735295625},
+	"cup":    {Name: "cup", Dimension: DimensionVolume, System: SystemImperial, toBase: 236.5882365},
+	"pint":   {Name: "pint", Dimension: DimensionVolume, System: SystemImperial, toBase: 473.176473},
+	"quart":  {Name: "quart", Dimension: DimensionVolume, System: SystemImperial, toBase: 946.352946},
+	"gallon": {Name: "gallon", Dimension: DimensionVolume, System: SystemImperial, toBase: 3785.411784},
+
+	// Temperature
+	"°C": {Name: "°C", Dimension: DimensionTemperature, System: SystemMetric, toBase: 1},
+	"°F": {Name: "°F", Dimension: DimensionTemperature, System: SystemImperial, toBase: 1},
+
+	// Count
+	"piece":   countUnit("piece"),
+	"clove":   countUnit("clove"),
+	"slice":   countUnit("slice"),
+	"can":     countUnit("can"),
+	"bunch":   countUnit("bunch"),
+	"head":    countUnit("head"),
+	"sprig":   countUnit("sprig"),
+	"handful": countUnit("handful"),
+	"package": countUnit("package"),
+	"stick":   countUnit("stick"),
+	"jar":     countUnit("jar"),
+	"leaf":    countUnit("leaf"),
+	"pinch":   countUnit("pinch"),
+	"dash":    countUnit("dash"),
+	"drop":    countUnit("drop"),
+}
+
+func countUnit(name string) Unit {
+	return Unit{Name: name, Dimension: DimensionCount, System: SystemUniversal, toBase: 1}
+}
+
+// Lookup resolves a unit name or alias in English or Dutch, e.g. "Tbsp.",
+// "eetlepels" or "fluid ounces", to its canonical unit.
+func Lookup(name string) (Unit, bool) {
+	name = strings.TrimSpace(name)
+	switch strings.TrimSuffix(name, ".") {
+	case "T", "Tb":
+		return catalogue["tbsp"], true
+	case "t":
+		return catalogue["tsp"], true
+	}
+
+	key := strings.ToLower(name)
+	if canonical, ok := aliases[key]; ok {
+		return catalogue[canonical], true
+	}
+	if canonical, ok := aliases[strings.TrimSuffix(key, ".")]; ok {
+		return catalogue[canonical], true
+	}
+	return Unit{}, false
+}
+
+// LookupTemperature resolves a temperature unit, also accepting the bare
+// "C" and "F" used on recipe steps.
+func LookupTemperature(name string) (Unit, bool) {
+	switch strings.ToUpper(strings.TrimSpace(name)) {
+	case "C":
+		return catalogue[Celsius], true
+	case "F":
+		return catalogue[Fahrenheit], true
+	}
+	unit, ok := Lookup(name)
+	if !ok || unit.Dimension != DimensionTemperature {
+		return Unit{}, false
+	}
+	return unit, true
+}
+
+// Canonical returns the canonical name of a unit, or the trimmed input when
+// the unit is unknown.
+func Canonical(name string) string {
+	if unit, ok := Lookup(name); ok {
+		return unit.Name
+	}
+	return strings.TrimSpace(name)
+}
+
+// Convert converts value from one unit to another of the same dimension.
+// Temperatures need the degree sign here; see ConvertTemperature.
+func Convert(value float64, from, to string) (float64, error) {
+	return ConvertWithDensity(value, from, to, 0)
+}
+
+// ConvertWithDensity converts value between units, bridging volume and mass
+// with gramsPerML when it is positive.
+func ConvertWithDensity(value float64, from, to string, gramsPerML float64) (float64, error) {
+	fromUnit, ok := Lookup(from)
+	if !ok {
+		return 0, ErrUnknownUnit
+	}
+	toUnit, ok := Lookup(to)
+	if !ok {
+		return 0, ErrUnknownUnit
+	}
+	if fromUnit.Name == toUnit.Name {
+		return value, nil
+	}
+
+	switch {
+	case fromUnit.Dimension == DimensionTemperature && toUnit.Dimension == DimensionTemperature:
+		if fromUnit.Name == Fahrenheit {
+			return (value - 32) * 5 / 9, nil
+		}
+		return value*9/5 + 32, nil
+	case fromUnit.Dimension == DimensionCount || toUnit.Dimension == DimensionCount:
+		return 0, ErrIncompatibleUnits
+	case fromUnit.Dimension == toUnit.Dimension:
+		return value * fromUnit.toBase / toUnit.toBase, nil
+	case gramsPerML > 0 && fromUnit.Dimension == DimensionVolume && toUnit.Dimension == DimensionMass:
+		return value * fromUnit.toBase * gramsPerML / toUnit.toBase, nil
+	case gramsPerML > 0 && fromUnit.Dimension == DimensionMass && toUnit.Dimension == DimensionVolume:
+		return value * fromUnit.toBase / gramsPerML / toUnit.toBase, nil
+	default:
+		return 0, ErrIncompatibleUnits
+	}
+}
+
+// ConvertTemperature converts between Celsius and Fahrenheit, accepting the
+// bare "C" and "F" spellings.
+func ConvertTemperature(value float64, from, to string) (float64, error) {
+	fromUnit, ok := LookupTemperature(from)
+	if !ok {
+		return 0, ErrUnknownUnit
+	}
+	toUnit, ok := LookupTemperature(to)
+	if !ok {
+		return 0, ErrUnknownUnit
+	}
+	return ConvertWithDensity(value, fromUnit.Name, toUnit.Name, 0)
+}
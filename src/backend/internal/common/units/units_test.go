@@ -0,0 +1,188 @@
+package units_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/platepilot/backend/internal/common/units"
+)
+
+func TestLookup_ResolvesAliases(t *testing.T) {
+	cases := map[string]string{
+		"Tbsp.":        "tbsp",
+		"T":            "tbsp",
+		"t":            "tsp",
+		"eetlepels":    "tbsp",
+		"theelepel":    "tsp",
+		"cups":         "cup",
+		"fluid ounces": "fl oz",
+		"gr.":          "g",
+		"Kilo":         "kg",
+		"teentjes":     "clove",
+		"°F":           "°F",
+	}
+	for alias, want := range cases {
+		unit, ok := units.Lookup(alias)
+		if !ok || unit.Name != want {
+			t.Fatalf("Lookup(%q): expected %q, got %q (found %v)", alias, want, unit.Name, ok)
+		}
+	}
+
+	if _, ok := units.Lookup("handvol zand"); ok {
+		t.Fatal("expected unknown unit to be reported")
+	}
+	if got := units.Canonical(" snuf "); got != "snuf" {
+		t.Fatalf("expected unknown unit to pass through trimmed, got %q", got)
+	}
+}
+
+func TestConvert_WithinAndAcrossSystems(t *testing.T) {
+	cases := []struct {
+		value    float64
+		from, to string
+		want     float64
+	}{
+		{1, "tbsp", "tsp", 3},
+		{1, "cup", "tbsp", 16},
+		{1, "cup", "ml", 236.5882365},
+		{1, "kg", "g", 1000},
+		{1, "lb", "oz", 16},
+		{500, "g", "lb", 1.10231},
+		{2, "ons", "g", 200},
+		{1, "l", "cup", 4.22675},
+	}
+	for _, tc := range cases {
+		got, err := units.Convert(tc.value, tc.from, tc.to)
+		thenNoError(t, err)
+		thenApprox(t, got, tc.want)
+	}
+}
+
+func TestConvert_IncompatibleAndUnknown(t *testing.T) {
+	if _, err := units.Convert(1, "cup", "g"); !errors.Is(err, units.ErrIncompatibleUnits) {
+		t.Fatalf("expected incompatible units without density, got %v", err)
+	}
+	if _, err := units.Convert(2, "clove", "g"); !errors.Is(err, units.ErrIncompatibleUnits) {
+		t.Fatalf("expected count units to be incompatible with mass, got %v", err)
+	}
+	if _, err := units.Convert(1, "snuf", "g"); !errors.Is(err, units.ErrUnknownUnit) {
+		t.Fatalf("expected unknown unit, got %v", err)
+	}
+}
+
+func TestConvertWithDensity_BridgesVolumeAndMass(t *testing.T) {
+	// All-purpose flour weighs about 0.53 g/ml.
+	grams, err := units.ConvertWithDensity(1, "cup", "g", 0.53)
+	thenNoError(t, err)
+	thenApprox(t, grams, 125.39)
+
+	cups, err := units.ConvertWithDensity(125.39, "g", "cup", 0.53)
+	thenNoError(t, err)
+	thenApprox(t, cups, 1)
+}
+
+func TestConvertTemperature(t *testing.T) {
+	celsius, err := units.ConvertTemperature(350, "F", "C")
+	thenNoError(t, err)
+	thenApprox(t, celsius, 176.67)
+
+	fahrenheit, err := units.ConvertTemperature(200, "°C", "fahrenheit")
+	thenNoError(t, err)
+	thenApprox(t, fahrenheit, 392)
+}
+
+func TestHumanize_PicksReadableUnit(t *testing.T) {
+	cases := []struct {
+		in   units.Quantity
+		want units.Quantity
+	}{
+		{units.Quantity{Value: 1500, Unit: "g"}, units.Quantity{Value: 1.5, Unit: "kg"}},
+		{units.Quantity{Value: 0.25, Unit: "kg"}, units.Quantity{Value: 250, Unit: "g"}},
+		{units.Quantity{Value: 48, Unit: "tsp"}, units.Quantity{Value: 1, Unit: "cup"}},
+		{units.Quantity{Value: 3, Unit: "tsp"}, units.Quantity{Value: 1, Unit: "tbsp"}},
+		{units.Quantity{Value: 2, Unit: "tbsp"}, units.Quantity{Value: 2, Unit: "tbsp"}},
+		{units.Quantity{Value: 24, Unit: "oz"}, units.Quantity{Value: 1.5, Unit: "lb"}},
+		{units.Quantity{Value: 15, Unit: "dl"}, units.Quantity{Value: 1.5, Unit: "l"}},
+		{units.Quantity{Value: 3, Unit: "clove"}, units.Quantity{Value: 3, Unit: "clove"}},
+		{units.Quantity{Value: 2, Unit: "snuf"}, units.Quantity{Value: 2, Unit: "snuf"}},
+	}
+	for _, tc := range cases {
+		got := units.Humanize(tc.in)
+		if got.Unit != tc.want.Unit {
+			t.Fatalf("Humanize(%v): expected unit %q, got %q", tc.in, tc.want.Unit, got.Unit)
+		}
+		thenApprox(t, got.Value, tc.want.Value)
+	}
+}
+
+func TestToSystem_ConvertsBetweenMetricAndImperial(t *testing.T) {
+	metric := units.ToSystem(units.Quantity{Value: 2, Unit: "cup"}, units.SystemMetric)
+	if metric.String() != "473.18 ml" {
+		t.Fatalf("expected 473.18 ml, got %s", metric)
+	}
+
+	imperial := units.ToSystem(units.Quantity{Value: 1, Unit: "kg"}, units.SystemImperial)
+	if imperial.String() != "2.2 lb" {
+		t.Fatalf("expected 2.2 lb, got %s", imperial)
+	}
+}
+
+func TestTotal_SumsCompatibleUnits(t *testing.T) {
+	total := units.NewTotal(0)
+	total.Add(200, "g")
+	total.Add(0.5, "kg")
+	total.Add(2, "tbsp")
+	total.Add(1, "teentje")
+	total.Add(2, "cloves")
+	total.Add(1, "Snuf")
+	total.Add(1, "snuf")
+
+	thenQuantities(t, total.Quantities(), "700 g", "2 tbsp", "3 clove", "2 Snuf")
+}
+
+func TestTotal_WithDensity_FoldsVolumeIntoMass(t *testing.T) {
+	// Butter weighs about 0.911 g/ml.
+	total := units.NewTotal(0.911)
+	total.Add(200, "g")
+	total.Add(0.5, "kg")
+	total.Add(2, "tbsp")
+
+	thenQuantities(t, total.Quantities(), "726.94 g")
+}
+
+func TestTotal_FirstSystemWins(t *testing.T) {
+	total := units.NewTotal(0)
+	total.Add(1, "cup")
+	total.Add(250, "ml")
+
+	thenQuantities(t, total.Quantities(), "2.06 cup")
+}
+
+// Helpers
+
+func thenNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func thenApprox(t *testing.T, got, want float64) {
+	t.Helper()
+	if math.Abs(got-want) > 0.01 {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+}
+
+func thenQuantities(t *testing.T, got []units.Quantity, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d quantities %v, got %v", len(want), want, got)
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Fatalf("quantity %d: expected %q, got %q", i, want[i], got[i].String())
+		}
+	}
+}
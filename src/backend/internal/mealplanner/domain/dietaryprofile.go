@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	commondomain "github.com/platepilot/backend/internal/common/domain"
+)
+
+// DietaryProfile is a user's dietary profile as kept in the read model.
+// Suggestions leave out recipes it does not allow unless the request ignores
+// it.
+type DietaryProfile struct {
+	UserID              uuid.UUID
+	AllergyIDs          []uuid.UUID
+	Diets               []string
+	DislikedIngredients []string
+}
+
+// Allows reports whether a recipe fits the profile: it has none of the
+// profile's allergies, is labeled with every diet unless it is unclassified,
+// and has no disliked main ingredient or required ingredient line.
+func (p *DietaryProfile) Allows(recipe Recipe) bool {
+	if p == nil {
+		return true
+	}
+
+	for _, allergyID := range recipe.AllergyIDs {
+		for _, avoided := range p.AllergyIDs {
+			if allergyID == avoided {
+				return false
+			}
+		}
+	}
+
+	dietLabels := make([]string, len(p.Diets))
+	for i, diet := range p.Diets {
+		dietLabels[i] = string(commondomain.Diet(diet).Label())
+	}
+	if recipe.DietClassified && !recipe.HasDietLabels(dietLabels) {
+		return false
+	}
+
+	for _, disliked := range p.DislikedIngredients {
+		if strings.EqualFold(recipe.MainIngredientName, disliked) {
+			return false
+		}
+		for _, name := range recipe.IngredientNames {
+			if strings.EqualFold(name, disliked) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -9,4 +9,6 @@ import (
 // RecipeRepository defines the repository operations needed by the planner
 type RecipeRepository interface {
 	GetAll(ctx context.Context, userID uuid.UUID, limit, offset int) ([]Recipe, error)
+	// GetDietaryProfile returns nil when the user has no profile.
+	GetDietaryProfile(ctx context.Context, userID uuid.UUID) (*DietaryProfile, error)
 }
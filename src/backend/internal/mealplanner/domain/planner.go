@@ -4,6 +4,7 @@ import (
 	"context"
 	"math"
 	"sort"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
@@ -15,6 +16,12 @@ type SuggestionRequest struct {
 	DailyConstraints       []DailyConstraints
 	AlreadySelectedRecipes []uuid.UUID
 	Amount                 int
+	// IgnoreDietaryProfile includes recipes the user's dietary profile
+	// does not allow.
+	IgnoreDietaryProfile bool
+	// DietLabels limits suggestions to recipes carrying every label, e.g.
+	// vegan or gluten-free.
+	DietLabels []string
 }
 
 // DailyConstraints represents constraints for a single day's meal
@@ -23,14 +30,31 @@ type DailyConstraints struct {
 	CuisineConstraints    []uuid.UUID
 }
 
-// Planner suggests recipes based on constraints and diversity
+// Suggestion scoring. Diversity dominates; the user's cook history nudges
+// well-rated recipes up and recently cooked ones down.
+const (
+	diversityWeight = 1.0
+	ratingWeight    = 0.3
+	freshnessWeight = 0.3
+
+	// A recipe cooked within this many days counts as recently cooked; its
+	// freshness recovers linearly over the window.
+	recentlyCookedDays = 14
+	// neutralRating is assumed for recipes the user has not rated.
+	neutralRating = 3.0
+	minRating     = 1.0
+	maxRating     = 5.0
+)
+
+// Planner suggests recipes based on constraints, diversity and cook history
 type Planner struct {
 	repo RecipeRepository
+	now  func() time.Time
 }
 
 // NewPlanner creates a new meal planner
 func NewPlanner(repo RecipeRepository) *Planner {
-	return &Planner{repo: repo}
+	return &Planner{repo: repo, now: time.Now}
 }
 
 // SuggestMeals suggests recipes based on the given request
@@ -43,6 +67,16 @@ func (p *Planner) SuggestMeals(ctx context.Context, req SuggestionRequest) ([]uu
 
 	// Filter by constraints
 	filtered := p.filterByConstraints(recipes, req.DailyConstraints)
+	filtered = p.filterByDietLabels(filtered, req.DietLabels)
+
+	// Remove recipes the user's dietary profile does not allow
+	if !req.IgnoreDietaryProfile {
+		profile, err := p.repo.GetDietaryProfile(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		filtered = p.filterByProfile(filtered, profile)
+	}
 
 	// Remove already selected recipes
 	filtered = p.removeSelected(filtered, req.AlreadySelectedRecipes)
@@ -51,8 +85,8 @@ func (p *Planner) SuggestMeals(ctx context.Context, req SuggestionRequest) ([]uu
 		return []uuid.UUID{}, nil
 	}
 
-	// Score recipes for diversity
-	scored := p.scoreForDiversity(filtered, req.AlreadySelectedRecipes, recipes)
+	// Score recipes for diversity and cook history
+	scored := p.scoreCandidates(filtered, req.AlreadySelectedRecipes, recipes)
 
 	// Sort by score (higher is better)
 	sort.Slice(scored, func(i, j int) bool {
@@ -148,6 +182,34 @@ func (p *Planner) matchesDailyConstraint(recipe Recipe, constraint DailyConstrai
 	return true
 }
 
+func (p *Planner) filterByDietLabels(recipes []Recipe, labels []string) []Recipe {
+	if len(labels) == 0 {
+		return recipes
+	}
+
+	var filtered []Recipe
+	for _, recipe := range recipes {
+		if recipe.HasDietLabels(labels) {
+			filtered = append(filtered, recipe)
+		}
+	}
+	return filtered
+}
+
+func (p *Planner) filterByProfile(recipes []Recipe, profile *DietaryProfile) []Recipe {
+	if profile == nil {
+		return recipes
+	}
+
+	var filtered []Recipe
+	for _, recipe := range recipes {
+		if profile.Allows(recipe) {
+			filtered = append(filtered, recipe)
+		}
+	}
+	return filtered
+}
+
 func (p *Planner) removeSelected(recipes []Recipe, selected []uuid.UUID) []Recipe {
 	if len(selected) == 0 {
 		return recipes
@@ -167,7 +229,7 @@ func (p *Planner) removeSelected(recipes []Recipe, selected []uuid.UUID) []Recip
 	return filtered
 }
 
-func (p *Planner) scoreForDiversity(candidates []Recipe, selected []uuid.UUID, allRecipes []Recipe) []scoredRecipe {
+func (p *Planner) scoreCandidates(candidates []Recipe, selected []uuid.UUID, allRecipes []Recipe) []scoredRecipe {
 	// Build a map of selected recipe vectors for diversity calculation
 	selectedVectors := make([]pgvector.Vector, 0, len(selected))
 	recipeMap := make(map[uuid.UUID]Recipe)
@@ -185,8 +247,10 @@ func (p *Planner) scoreForDiversity(candidates []Recipe, selected []uuid.UUID, a
 	for i, candidate := range candidates {
 		diversityScore := p.calculateDiversityScore(candidate.SearchVector, selectedVectors)
 		scored[i] = scoredRecipe{
-			id:    candidate.ID,
-			score: diversityScore,
+			id: candidate.ID,
+			score: diversityWeight*diversityScore +
+				ratingWeight*ratingScore(candidate.AverageRating) +
+				freshnessWeight*p.freshnessScore(candidate.LastCookedOn),
 		}
 	}
 
@@ -209,6 +273,26 @@ func (p *Planner) calculateDiversityScore(candidate pgvector.Vector, selected []
 	return 1.0 - avgSimilarity
 }
 
+// ratingScore maps an average rating to 0..1, treating unrated recipes as
+// neutral.
+func ratingScore(averageRating *float64) float64 {
+	rating := neutralRating
+	if averageRating != nil {
+		rating = math.Max(minRating, math.Min(maxRating, *averageRating))
+	}
+	return (rating - minRating) / (maxRating - minRating)
+}
+
+// freshnessScore is 0 for a recipe cooked today, rising to 1 once it has not
+// been cooked for recentlyCookedDays. Recipes never cooked score 1.
+func (p *Planner) freshnessScore(lastCookedOn *time.Time) float64 {
+	if lastCookedOn == nil {
+		return 1.0
+	}
+	days := p.now().Sub(*lastCookedOn).Hours() / 24
+	return math.Max(0, math.Min(1, days/recentlyCookedDays))
+}
+
 func cosineSimilarity(a, b []float32) float64 {
 	if len(a) == 0 || len(b) == 0 || len(a) != len(b) {
 		return 0
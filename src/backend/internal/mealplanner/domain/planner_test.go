@@ -2,6 +2,7 @@ package domain_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
@@ -384,6 +385,143 @@ func TestSuggestMeals_NoPreviousSelection_AllHaveMaxDiversity(t *testing.T) {
 	thenResultContains(t, result, recipe2.ID)
 }
 
+// =============================================================================
+// SuggestMeals Tests - Cook History
+// =============================================================================
+
+func TestSuggestMeals_RecentlyCooked_RanksBelowNotRecentlyCooked(t *testing.T) {
+	// Given
+	tc := givenPlanner()
+	cookedYesterday := givenRecipeWithCookHistory(tc, "Cooked Yesterday", time.Now().AddDate(0, 0, -1), 5)
+	neverCooked := givenRecipeExists(tc, "Never Cooked")
+
+	// When
+	result, err := whenSuggestingMeals(tc, domain.SuggestionRequest{Amount: 2})
+
+	// Then - a dish just cooked should not be suggested first, even if loved
+	thenNoError(t, err)
+	thenResultHasCount(t, result, 2)
+	thenResultHasFirst(t, result, neverCooked.ID)
+	thenResultContains(t, result, cookedYesterday.ID)
+}
+
+func TestSuggestMeals_HigherRated_RanksFirst(t *testing.T) {
+	// Given
+	tc := givenPlanner()
+	monthAgo := time.Now().AddDate(0, -1, 0)
+	disliked := givenRecipeWithCookHistory(tc, "Disliked", monthAgo, 1)
+	favourite := givenRecipeWithCookHistory(tc, "Favourite", monthAgo, 5)
+
+	// When
+	result, err := whenSuggestingMeals(tc, domain.SuggestionRequest{Amount: 2})
+
+	// Then
+	thenNoError(t, err)
+	thenResultHasFirst(t, result, favourite.ID)
+	thenResultContains(t, result, disliked.ID)
+}
+
+// =============================================================================
+// SuggestMeals Tests - Dietary Profile
+// =============================================================================
+
+func TestSuggestMeals_DietaryProfile_ExcludesConflictingRecipes(t *testing.T) {
+	// Given
+	tc := givenPlanner()
+	peanutAllergy := uuid.New()
+	givenDietaryProfile(tc, &domain.DietaryProfile{
+		AllergyIDs:          []uuid.UUID{peanutAllergy},
+		Diets:               []string{"vegetarian"},
+		DislikedIngredients: []string{"cilantro"},
+	})
+	satay := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Satay").
+		WithDietLabels([]string{"vegan", "vegetarian", "pescatarian"}).
+		WithAllergyIDs([]uuid.UUID{peanutAllergy}))
+	steak := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Steak").
+		WithDietLabels([]string{"gluten-free", "dairy-free", "nut-free"}))
+	salsa := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Salsa").
+		WithDietLabels([]string{"vegan", "vegetarian", "pescatarian"}).
+		WithIngredientNames([]string{"Tomato", "Cilantro"}))
+	risotto := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Risotto").
+		WithDietLabels([]string{"vegetarian", "pescatarian"}).
+		WithIngredientNames([]string{"Rice", "Parmesan"}))
+
+	// When
+	result, err := whenSuggestingMeals(tc, domain.SuggestionRequest{Amount: 5})
+
+	// Then
+	thenNoError(t, err)
+	thenResultHasCount(t, result, 1)
+	thenResultContains(t, result, risotto.ID)
+	thenResultDoesNotContain(t, result, satay.ID)
+	thenResultDoesNotContain(t, result, steak.ID)
+	thenResultDoesNotContain(t, result, salsa.ID)
+}
+
+func TestSuggestMeals_DietaryProfile_KeepsUnclassifiedRecipes(t *testing.T) {
+	// Given
+	tc := givenPlanner()
+	givenDietaryProfile(tc, &domain.DietaryProfile{Diets: []string{"vegan"}})
+	stew := givenRecipe(tc, testutil.NewRecipeBuilder().WithName("Stew"))
+	omelette := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Omelette").
+		WithDietLabels([]string{"vegetarian", "pescatarian", "gluten-free"}))
+
+	// When
+	result, err := whenSuggestingMeals(tc, domain.SuggestionRequest{Amount: 5})
+
+	// Then
+	thenNoError(t, err)
+	thenResultHasCount(t, result, 1)
+	thenResultContains(t, result, stew.ID)
+	thenResultDoesNotContain(t, result, omelette.ID)
+}
+
+func TestSuggestMeals_DietLabels_OnlyIncludesRecipesWithEveryLabel(t *testing.T) {
+	// Given
+	tc := givenPlanner()
+	curry := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Curry").
+		WithDietLabels([]string{"vegan", "vegetarian", "gluten-free"}))
+	pasta := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Pasta").
+		WithDietLabels([]string{"vegan", "vegetarian"}))
+	steak := givenRecipe(tc, testutil.NewRecipeBuilder().
+		WithName("Steak").
+		WithDietLabels([]string{"gluten-free"}))
+
+	// When
+	result, err := whenSuggestingMeals(tc, domain.SuggestionRequest{
+		Amount:     5,
+		DietLabels: []string{"vegan", "gluten-free"},
+	})
+
+	// Then
+	thenNoError(t, err)
+	thenResultHasCount(t, result, 1)
+	thenResultContains(t, result, curry.ID)
+	thenResultDoesNotContain(t, result, pasta.ID)
+	thenResultDoesNotContain(t, result, steak.ID)
+}
+
+func TestSuggestMeals_IgnoreDietaryProfile_IncludesConflictingRecipes(t *testing.T) {
+	// Given
+	tc := givenPlanner()
+	givenDietaryProfile(tc, &domain.DietaryProfile{Diets: []string{"vegan"}})
+	givenRecipeExists(tc, "Steak")
+
+	// When
+	result, err := whenSuggestingMeals(tc, domain.SuggestionRequest{Amount: 5, IgnoreDietaryProfile: true})
+
+	// Then
+	thenNoError(t, err)
+	thenResultHasCount(t, result, 1)
+}
+
 // =============================================================================
 // Given Helpers (Setup)
 // =============================================================================
@@ -461,6 +599,27 @@ func givenRecipeExistsWithVector(tc *testutil.PlannerTestContext, name string, v
 	return recipe
 }
 
+func givenRecipeWithCookHistory(tc *testutil.PlannerTestContext, name string, lastCookedOn time.Time, rating float64) repository.Recipe {
+	recipe := testutil.NewRecipeBuilder().
+		WithName(name).
+		WithUserID(tc.UserID).
+		WithCookHistory(3, lastCookedOn, rating).
+		Build()
+	tc.Repo.AddRecipe(recipe)
+	return recipe
+}
+
+func givenRecipe(tc *testutil.PlannerTestContext, builder *testutil.RecipeBuilder) repository.Recipe {
+	recipe := builder.WithUserID(tc.UserID).Build()
+	tc.Repo.AddRecipe(recipe)
+	return recipe
+}
+
+func givenDietaryProfile(tc *testutil.PlannerTestContext, profile *domain.DietaryProfile) {
+	profile.UserID = tc.UserID
+	tc.Repo.DietaryProfiles[tc.UserID] = profile
+}
+
 func givenRepositoryFails(tc *testutil.PlannerTestContext) {
 	tc.Repo.FailOnGetAll = true
 }
@@ -1,6 +1,9 @@
 package domain
 
 import (
+	"slices"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 )
@@ -23,8 +26,14 @@ type Recipe struct {
 	MainIngredientID   uuid.UUID
 	MainIngredientName string
 	IngredientIDs      []uuid.UUID
+	// IngredientNames are the names of the required ingredient lines.
+	IngredientNames    []string
 	AllergyIDs         []uuid.UUID
 	Tags               []string
+	DietLabels         []string
+	// DietClassified is unset when the recipe service could not classify
+	// every ingredient; the recipe may then fit a diet despite lacking labels.
+	DietClassified     bool
 	ImageURL           string
 	CaloriesTotal      int
 	CaloriesPerServing int
@@ -34,4 +43,19 @@ type Recipe struct {
 	FiberG             float64
 	SugarG             float64
 	SodiumMg           float64
+
+	// Cook history of the user the recipe was loaded for.
+	TimesCooked   int
+	LastCookedOn  *time.Time
+	AverageRating *float64
+}
+
+// HasDietLabels reports whether the recipe carries every one of the labels.
+func (r Recipe) HasDietLabels(labels []string) bool {
+	for _, label := range labels {
+		if !slices.Contains(r.DietLabels, label) {
+			return false
+		}
+	}
+	return true
 }
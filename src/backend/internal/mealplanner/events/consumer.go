@@ -5,11 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 	amqp "github.com/rabbitmq/amqp091-go"
 
+	"github.com/platepilot/backend/internal/mealplanner/domain"
 	"github.com/platepilot/backend/internal/mealplanner/repository"
 )
 
@@ -174,6 +176,26 @@ func (c *Consumer) handleMessage(ctx context.Context, msg amqp.Delivery) error {
 		return c.handleRecipeUpserted(ctx, msg.Body)
 	case "RecipeDeletedEvent":
 		return c.handleRecipeDeleted(ctx, msg.Body)
+	case "RecipeSharedEvent":
+		return c.handleRecipeShared(ctx, msg.Body)
+	case "RecipeShareRevokedEvent":
+		return c.handleRecipeShareRevoked(ctx, msg.Body)
+	case "RecipeCookStatsUpdatedEvent":
+		return c.handleRecipeCookStatsUpdated(ctx, msg.Body)
+	case "DietaryProfileUpdatedEvent":
+		return c.handleDietaryProfileUpdated(ctx, msg.Body)
+	case "CollectionSharedEvent":
+		return c.handleCollectionShared(ctx, msg.Body)
+	case "CollectionShareRevokedEvent":
+		return c.handleCollectionShareRevoked(ctx, msg.Body)
+	case "CollectionRecipeAddedEvent":
+		return c.handleCollectionRecipeAdded(ctx, msg.Body)
+	case "CollectionRecipeRemovedEvent":
+		return c.handleCollectionRecipeRemoved(ctx, msg.Body)
+	case "CollectionDeletedEvent":
+		return c.handleCollectionDeleted(ctx, msg.Body)
+	case "CollectionVisibilityChangedEvent":
+		return c.handleCollectionVisibilityChanged(ctx, msg.Body)
 	default:
 		c.logger.Warn("unknown event type", "type", envelope.Type)
 		return nil // Acknowledge unknown events to prevent redelivery
@@ -222,6 +244,219 @@ func (c *Consumer) handleRecipeDeleted(ctx context.Context, body []byte) error {
 	return nil
 }
 
+func (c *Consumer) handleRecipeShared(ctx context.Context, body []byte) error {
+	var event RecipeSharedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal recipe shared event: %w", err)
+	}
+
+	c.logger.Info("handling recipe shared event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"sharedWithUserId", event.SharedWithUserID,
+	)
+
+	if err := c.repo.AddShare(ctx, event.AggregateId, event.SharedWithUserID); err != nil {
+		return fmt.Errorf("add recipe share: %w", err)
+	}
+
+	c.logger.Info("recipe share added to read model", "recipeId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleRecipeShareRevoked(ctx context.Context, body []byte) error {
+	var event RecipeShareRevokedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal recipe share revoked event: %w", err)
+	}
+
+	c.logger.Info("handling recipe share revoked event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"sharedWithUserId", event.SharedWithUserID,
+	)
+
+	if err := c.repo.RemoveShare(ctx, event.AggregateId, event.SharedWithUserID); err != nil {
+		return fmt.Errorf("remove recipe share: %w", err)
+	}
+
+	c.logger.Info("recipe share removed from read model", "recipeId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleRecipeCookStatsUpdated(ctx context.Context, body []byte) error {
+	var event RecipeCookStatsUpdatedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal recipe cook stats updated event: %w", err)
+	}
+
+	c.logger.Info("handling recipe cook stats updated event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"userId", event.UserID,
+	)
+
+	var lastCookedOn *time.Time
+	if event.LastCookedOn != nil {
+		date, err := time.Parse(time.DateOnly, *event.LastCookedOn)
+		if err != nil {
+			return fmt.Errorf("parse last cooked date: %w", err)
+		}
+		lastCookedOn = &date
+	}
+
+	if err := c.repo.UpsertCookStats(ctx, event.AggregateId, event.UserID, event.TimesCooked, lastCookedOn, event.AverageRating); err != nil {
+		return fmt.Errorf("upsert cook stats: %w", err)
+	}
+
+	c.logger.Info("recipe cook stats updated in read model", "recipeId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleDietaryProfileUpdated(ctx context.Context, body []byte) error {
+	var event DietaryProfileUpdatedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal dietary profile updated event: %w", err)
+	}
+
+	c.logger.Info("handling dietary profile updated event",
+		"eventId", event.ID,
+		"userId", event.AggregateId,
+	)
+
+	profile := domain.DietaryProfile{
+		UserID:              event.AggregateId,
+		AllergyIDs:          event.AllergyIDs,
+		Diets:               event.Diets,
+		DislikedIngredients: event.DislikedIngredients,
+	}
+	if err := c.repo.UpsertDietaryProfile(ctx, profile); err != nil {
+		return fmt.Errorf("upsert dietary profile: %w", err)
+	}
+
+	c.logger.Info("dietary profile updated in read model", "userId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleCollectionShared(ctx context.Context, body []byte) error {
+	var event CollectionSharedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal collection shared event: %w", err)
+	}
+
+	c.logger.Info("handling collection shared event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"sharedWithUserId", event.SharedWithUserID,
+	)
+
+	if err := c.repo.AddCollectionShare(ctx, event.AggregateId, event.SharedWithUserID, event.RecipeIDs); err != nil {
+		return fmt.Errorf("add collection share: %w", err)
+	}
+
+	c.logger.Info("collection share added to read model", "collectionId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleCollectionShareRevoked(ctx context.Context, body []byte) error {
+	var event CollectionShareRevokedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal collection share revoked event: %w", err)
+	}
+
+	c.logger.Info("handling collection share revoked event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"sharedWithUserId", event.SharedWithUserID,
+	)
+
+	if err := c.repo.RemoveCollectionShare(ctx, event.AggregateId, event.SharedWithUserID); err != nil {
+		return fmt.Errorf("remove collection share: %w", err)
+	}
+
+	c.logger.Info("collection share removed from read model", "collectionId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleCollectionRecipeAdded(ctx context.Context, body []byte) error {
+	var event CollectionRecipeEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal collection recipe added event: %w", err)
+	}
+
+	c.logger.Info("handling collection recipe added event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"recipeId", event.RecipeID,
+	)
+
+	if err := c.repo.AddCollectionRecipe(ctx, event.AggregateId, event.RecipeID); err != nil {
+		return fmt.Errorf("add collection recipe: %w", err)
+	}
+
+	c.logger.Info("collection recipe added to read model", "collectionId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleCollectionRecipeRemoved(ctx context.Context, body []byte) error {
+	var event CollectionRecipeEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal collection recipe removed event: %w", err)
+	}
+
+	c.logger.Info("handling collection recipe removed event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"recipeId", event.RecipeID,
+	)
+
+	if err := c.repo.RemoveCollectionRecipe(ctx, event.AggregateId, event.RecipeID); err != nil {
+		return fmt.Errorf("remove collection recipe: %w", err)
+	}
+
+	c.logger.Info("collection recipe removed from read model", "collectionId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleCollectionDeleted(ctx context.Context, body []byte) error {
+	var event CollectionDeletedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal collection deleted event: %w", err)
+	}
+
+	c.logger.Info("handling collection deleted event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+	)
+
+	if err := c.repo.DeleteCollection(ctx, event.AggregateId); err != nil {
+		return fmt.Errorf("delete collection: %w", err)
+	}
+
+	c.logger.Info("collection deleted from read model", "collectionId", event.AggregateId)
+	return nil
+}
+
+func (c *Consumer) handleCollectionVisibilityChanged(ctx context.Context, body []byte) error {
+	var event CollectionVisibilityChangedEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("unmarshal collection visibility changed event: %w", err)
+	}
+
+	c.logger.Info("handling collection visibility changed event",
+		"eventId", event.ID,
+		"aggregateId", event.AggregateId,
+		"public", event.Public,
+	)
+
+	if err := c.repo.SetCollectionPublic(ctx, event.AggregateId, event.Public, event.RecipeIDs); err != nil {
+		return fmt.Errorf("set collection visibility: %w", err)
+	}
+
+	c.logger.Info("collection visibility changed in read model", "collectionId", event.AggregateId)
+	return nil
+}
+
 // EventEnvelope is the common structure for all events
 type EventEnvelope struct {
 	ID               uuid.UUID `json:"id"`
@@ -245,6 +480,80 @@ type RecipeDeletedEvent struct {
 	DeletedAt string    `json:"deletedAt"`
 }
 
+// RecipeSharedEvent represents a recipe share event
+type RecipeSharedEvent struct {
+	EventEnvelope
+	UserID           uuid.UUID `json:"userId"`
+	SharedWithUserID uuid.UUID `json:"sharedWithUserId"`
+	Permission       string    `json:"permission"`
+}
+
+// RecipeShareRevokedEvent represents a revoked recipe share event
+type RecipeShareRevokedEvent struct {
+	EventEnvelope
+	UserID           uuid.UUID `json:"userId"`
+	SharedWithUserID uuid.UUID `json:"sharedWithUserId"`
+}
+
+// RecipeCookStatsUpdatedEvent represents a change to a user's cook history
+// aggregates for a recipe
+type RecipeCookStatsUpdatedEvent struct {
+	EventEnvelope
+	UserID        uuid.UUID `json:"userId"`
+	TimesCooked   int       `json:"timesCooked"`
+	LastCookedOn  *string   `json:"lastCookedOn"`
+	AverageRating *float64  `json:"averageRating"`
+}
+
+// DietaryProfileUpdatedEvent represents a change to a user's dietary profile;
+// the aggregate is the user
+type DietaryProfileUpdatedEvent struct {
+	EventEnvelope
+	AllergyIDs          []uuid.UUID `json:"allergyIds"`
+	Diets               []string    `json:"diets"`
+	DislikedIngredients []string    `json:"dislikedIngredients"`
+}
+
+// CollectionSharedEvent represents a collection share event; it carries the
+// recipes in the collection
+type CollectionSharedEvent struct {
+	EventEnvelope
+	UserID           uuid.UUID   `json:"userId"`
+	SharedWithUserID uuid.UUID   `json:"sharedWithUserId"`
+	Permission       string      `json:"permission"`
+	RecipeIDs        []uuid.UUID `json:"recipeIds"`
+}
+
+// CollectionShareRevokedEvent represents a revoked collection share event
+type CollectionShareRevokedEvent struct {
+	EventEnvelope
+	UserID           uuid.UUID `json:"userId"`
+	SharedWithUserID uuid.UUID `json:"sharedWithUserId"`
+}
+
+// CollectionRecipeEvent represents a recipe added to or removed from a
+// collection
+type CollectionRecipeEvent struct {
+	EventEnvelope
+	UserID   uuid.UUID `json:"userId"`
+	RecipeID uuid.UUID `json:"recipeId"`
+}
+
+// CollectionDeletedEvent represents a deleted collection event
+type CollectionDeletedEvent struct {
+	EventEnvelope
+	UserID uuid.UUID `json:"userId"`
+}
+
+// CollectionVisibilityChangedEvent represents a collection made public or
+// private; it carries the recipes in the collection
+type CollectionVisibilityChangedEvent struct {
+	EventEnvelope
+	UserID    uuid.UUID   `json:"userId"`
+	Public    bool        `json:"public"`
+	RecipeIDs []uuid.UUID `json:"recipeIds"`
+}
+
 // RecipeDTO is the recipe data in events
 type RecipeDTO struct {
 	ID               uuid.UUID          `json:"id"`
@@ -262,6 +571,8 @@ type RecipeDTO struct {
 	IngredientLines  []IngredientLineDTO `json:"ingredientLines"`
 	Allergies        []AllergyDTO       `json:"allergies"`
 	Tags             []string           `json:"tags"`
+	DietLabels       []string           `json:"dietLabels"`
+	DietClassified   bool               `json:"dietClassified"`
 	ImageURL         string             `json:"imageUrl"`
 	Nutrition        RecipeNutritionDTO `json:"nutrition"`
 	SearchVector     []float32          `json:"searchVector"`
@@ -315,6 +626,11 @@ func (d *RecipeDTO) ToRepositoryModel() *repository.Recipe {
 		tags = []string{}
 	}
 
+	dietLabels := d.DietLabels
+	if dietLabels == nil {
+		dietLabels = []string{}
+	}
+
 	ingredientIDs := make([]uuid.UUID, len(d.IngredientLines))
 	for i, line := range d.IngredientLines {
 		ingredientIDs[i] = line.Ingredient.ID
@@ -345,6 +661,8 @@ func (d *RecipeDTO) ToRepositoryModel() *repository.Recipe {
 		AllergyIDs:         allergyIDs,
 		ImageURL:           d.ImageURL,
 		Tags:               tags,
+		DietLabels:         dietLabels,
+		DietClassified:     d.DietClassified,
 		CaloriesTotal:      d.Nutrition.CaloriesTotal,
 		CaloriesPerServing: d.Nutrition.CaloriesPerServing,
 		ProteinG:           d.Nutrition.ProteinG,
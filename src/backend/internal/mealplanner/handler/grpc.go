@@ -3,13 +3,16 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	commondomain "github.com/platepilot/backend/internal/common/domain"
 	"github.com/platepilot/backend/internal/mealplanner/domain"
 	pb "github.com/platepilot/backend/internal/mealplanner/pb"
 	"github.com/platepilot/backend/internal/mealplanner/repository"
@@ -214,11 +217,23 @@ func (h *GRPCHandler) toDomainRequest(req *pb.SuggestionsRequest) (domain.Sugges
 		return domain.SuggestionRequest{}, err
 	}
 
+	// Parse diet labels
+	dietLabels := make([]string, 0, len(req.GetDietLabels()))
+	for _, value := range req.GetDietLabels() {
+		label := commondomain.DietLabel(strings.ToLower(strings.TrimSpace(value)))
+		if !label.IsValid() {
+			return domain.SuggestionRequest{}, fmt.Errorf("unknown diet label %q", value)
+		}
+		dietLabels = append(dietLabels, string(label))
+	}
+
 	return domain.SuggestionRequest{
 		UserID:                 userID,
 		DailyConstraints:       dailyConstraints,
 		AlreadySelectedRecipes: alreadySelected,
 		Amount:                 amount,
+		IgnoreDietaryProfile:   req.GetIgnoreDietaryProfile(),
+		DietLabels:             dietLabels,
 	}, nil
 }
 
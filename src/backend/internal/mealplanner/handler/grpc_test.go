@@ -1,6 +1,7 @@
 package handler_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/google/uuid"
@@ -92,6 +93,51 @@ func TestSuggestRecipes_PassesUserIDToPlanner(t *testing.T) {
 	thenPlannerReceivedUserID(t, tc, userID)
 }
 
+func TestSuggestRecipes_IgnoreDietaryProfile_PassesToPlanner(t *testing.T) {
+	// Given
+	tc := givenMealPlannerAPI()
+
+	// When
+	_, err := whenRequestingSuggestions(tc, &pb.SuggestionsRequest{
+		Amount:               5,
+		IgnoreDietaryProfile: true,
+	})
+
+	// Then
+	thenNoError(t, err)
+	thenPlannerIgnoredDietaryProfile(t, tc)
+}
+
+func TestSuggestRecipes_DietLabels_NormalizedAndPassedToPlanner(t *testing.T) {
+	// Given
+	tc := givenMealPlannerAPI()
+
+	// When
+	_, err := whenRequestingSuggestions(tc, &pb.SuggestionsRequest{
+		Amount:     5,
+		DietLabels: []string{" Vegan ", "gluten-free"},
+	})
+
+	// Then
+	thenNoError(t, err)
+	thenPlannerReceivedDietLabels(t, tc, "vegan", "gluten-free")
+}
+
+func TestSuggestRecipes_InvalidDietLabel_ReturnsInvalidArgument(t *testing.T) {
+	// Given
+	tc := givenMealPlannerAPI()
+
+	// When
+	_, err := whenRequestingSuggestions(tc, &pb.SuggestionsRequest{
+		Amount:     5,
+		DietLabels: []string{"carnivore"},
+	})
+
+	// Then
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+	thenPlannerWasNotCalled(t, tc)
+}
+
 func TestSuggestRecipes_InvalidSelectedRecipeID_ReturnsInvalidArgument(t *testing.T) {
 	// Given
 	tc := givenMealPlannerAPI()
@@ -395,3 +441,25 @@ func thenPlannerReceivedUserID(t *testing.T, tc *testutil.HandlerTestContext, ex
 		t.Fatalf("expected user ID %s, got %s", expectedUserID, lastCall.UserID)
 	}
 }
+
+func thenPlannerReceivedDietLabels(t *testing.T, tc *testutil.HandlerTestContext, labels ...string) {
+	t.Helper()
+	if len(tc.Planner.SuggestMealsCalls) == 0 {
+		t.Fatal("expected planner to be called")
+	}
+	lastCall := tc.Planner.SuggestMealsCalls[len(tc.Planner.SuggestMealsCalls)-1]
+	if !slices.Equal(lastCall.DietLabels, labels) {
+		t.Fatalf("expected diet labels %v, got %v", labels, lastCall.DietLabels)
+	}
+}
+
+func thenPlannerIgnoredDietaryProfile(t *testing.T, tc *testutil.HandlerTestContext) {
+	t.Helper()
+	if len(tc.Planner.SuggestMealsCalls) == 0 {
+		t.Fatal("expected planner to be called")
+	}
+	lastCall := tc.Planner.SuggestMealsCalls[len(tc.Planner.SuggestMealsCalls)-1]
+	if !lastCall.IgnoreDietaryProfile {
+		t.Fatal("expected the dietary profile to be ignored")
+	}
+}
@@ -27,7 +27,9 @@ type SuggestionsRequest struct {
 	DailyConstraints         []*DailyConstraints    `protobuf:"bytes,1,rep,name=daily_constraints,json=dailyConstraints,proto3" json:"daily_constraints,omitempty"`
 	AlreadySelectedRecipeIds []string               `protobuf:"bytes,2,rep,name=already_selected_recipe_ids,json=alreadySelectedRecipeIds,proto3" json:"already_selected_recipe_ids,omitempty"` // UUID strings
 	Amount                   int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	UserId                   string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	UserId                   string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                              // UUID string
+	IgnoreDietaryProfile     bool                   `protobuf:"varint,5,opt,name=ignore_dietary_profile,json=ignoreDietaryProfile,proto3" json:"ignore_dietary_profile,omitempty"` // include recipes conflicting with the user's dietary profile
+	DietLabels               []string               `protobuf:"bytes,6,rep,name=diet_labels,json=dietLabels,proto3" json:"diet_labels,omitempty"`                                  // only recipes carrying every label, e.g. vegan or gluten-free
 	unknownFields            protoimpl.UnknownFields
 	sizeCache                protoimpl.SizeCache
 }
@@ -90,6 +92,20 @@ func (x *SuggestionsRequest) GetUserId() string {
 	return ""
 }
 
+func (x *SuggestionsRequest) GetIgnoreDietaryProfile() bool {
+	if x != nil {
+		return x.IgnoreDietaryProfile
+	}
+	return false
+}
+
+func (x *SuggestionsRequest) GetDietLabels() []string {
+	if x != nil {
+		return x.DietLabels
+	}
+	return nil
+}
+
 // Response message containing suggested recipe IDs
 type SuggestionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -783,12 +799,15 @@ var File_mealplanner_v1_mealplanner_proto protoreflect.FileDescriptor
 
 const file_mealplanner_v1_mealplanner_proto_rawDesc = "" +
 	"\n" +
-	" mealplanner/v1/mealplanner.proto\x12\x0emealplanner.v1\"\xd3\x01\n" +
+	" mealplanner/v1/mealplanner.proto\x12\x0emealplanner.v1\"\xaa\x02\n" +
 	"\x12SuggestionsRequest\x12M\n" +
 	"\x11daily_constraints\x18\x01 \x03(\v2 .mealplanner.v1.DailyConstraintsR\x10dailyConstraints\x12=\n" +
 	"\x1balready_selected_recipe_ids\x18\x02 \x03(\tR\x18alreadySelectedRecipeIds\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\tR\x06userId\"4\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x124\n" +
+	"\x16ignore_dietary_profile\x18\x05 \x01(\bR\x14ignoreDietaryProfile\x12\x1f\n" +
+	"\vdiet_labels\x18\x06 \x03(\tR\n" +
+	"dietLabels\"4\n" +
 	"\x13SuggestionsResponse\x12\x1d\n" +
 	"\n" +
 	"recipe_ids\x18\x01 \x03(\tR\trecipeIds\"L\n" +
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// AddCollectionShare gives a user access to a collection and the given
+// recipes in it in the read model.
+func (r *Repository) AddCollectionShare(ctx context.Context, collectionID, userID uuid.UUID, recipeIDs []uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO collection_shares (collection_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (collection_id, user_id) DO NOTHING
+	`, collectionID, userID)
+	if err != nil {
+		return fmt.Errorf("insert collection share: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO collection_recipes (collection_id, recipe_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT (collection_id, recipe_id) DO NOTHING
+	`, collectionID, recipeIDs)
+	if err != nil {
+		return fmt.Errorf("insert collection recipes: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveCollectionShare removes a user's access to a collection from the read
+// model.
+func (r *Repository) RemoveCollectionShare(ctx context.Context, collectionID, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM collection_shares WHERE collection_id = $1 AND user_id = $2`, collectionID, userID)
+	if err != nil {
+		return fmt.Errorf("delete collection share: %w", err)
+	}
+	return nil
+}
+
+// AddCollectionRecipe records a recipe in a collection in the read model.
+func (r *Repository) AddCollectionRecipe(ctx context.Context, collectionID, recipeID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO collection_recipes (collection_id, recipe_id)
+		VALUES ($1, $2)
+		ON CONFLICT (collection_id, recipe_id) DO NOTHING
+	`, collectionID, recipeID)
+	if err != nil {
+		return fmt.Errorf("insert collection recipe: %w", err)
+	}
+	return nil
+}
+
+// RemoveCollectionRecipe removes a recipe from a collection in the read model.
+func (r *Repository) RemoveCollectionRecipe(ctx context.Context, collectionID, recipeID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM collection_recipes WHERE collection_id = $1 AND recipe_id = $2`, collectionID, recipeID)
+	if err != nil {
+		return fmt.Errorf("delete collection recipe: %w", err)
+	}
+	return nil
+}
+
+// SetCollectionPublic makes a collection and the given recipes in it readable
+// by every user in the read model, or takes that back.
+func (r *Repository) SetCollectionPublic(ctx context.Context, collectionID uuid.UUID, public bool, recipeIDs []uuid.UUID) error {
+	if !public {
+		_, err := r.pool.Exec(ctx, `DELETE FROM public_collections WHERE collection_id = $1`, collectionID)
+		if err != nil {
+			return fmt.Errorf("delete public collection: %w", err)
+		}
+		return nil
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO public_collections (collection_id)
+		VALUES ($1)
+		ON CONFLICT (collection_id) DO NOTHING
+	`, collectionID)
+	if err != nil {
+		return fmt.Errorf("insert public collection: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO collection_recipes (collection_id, recipe_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT (collection_id, recipe_id) DO NOTHING
+	`, collectionID, recipeIDs)
+	if err != nil {
+		return fmt.Errorf("insert collection recipes: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteCollection removes a collection with its shares from the read model.
+func (r *Repository) DeleteCollection(ctx context.Context, collectionID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM collection_shares WHERE collection_id = $1`, collectionID)
+	if err != nil {
+		return fmt.Errorf("delete collection shares: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `DELETE FROM public_collections WHERE collection_id = $1`, collectionID)
+	if err != nil {
+		return fmt.Errorf("delete public collection: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `DELETE FROM collection_recipes WHERE collection_id = $1`, collectionID)
+	if err != nil {
+		return fmt.Errorf("delete collection recipes: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/platepilot/backend/internal/mealplanner/repository"
+)
+
+// These tests run against a migrated meal planner database named by
+// MEALPLANNER_DB_URL, as `make migrate-up test-integration` sets up.
+
+func TestCollectionShare_GrantsAccessToItsRecipesUntilRevoked(t *testing.T) {
+	ctx, repo := givenRepository(t)
+	ownerID, friendID, collectionID := uuid.New(), uuid.New(), uuid.New()
+	soup := givenRecipe(t, ctx, repo, ownerID, "Soup")
+	stew := givenRecipe(t, ctx, repo, ownerID, "Stew")
+	t.Cleanup(func() { repo.DeleteCollection(context.Background(), collectionID) })
+
+	if err := repo.AddCollectionShare(ctx, collectionID, friendID, []uuid.UUID{soup.ID}); err != nil {
+		t.Fatalf("add collection share: %v", err)
+	}
+	if err := repo.AddCollectionRecipe(ctx, collectionID, stew.ID); err != nil {
+		t.Fatalf("add collection recipe: %v", err)
+	}
+
+	for _, recipe := range []*repository.Recipe{soup, stew} {
+		if _, err := repo.GetByID(ctx, friendID, recipe.ID); err != nil {
+			t.Fatalf("expected %s through the collection share, got %v", recipe.Name, err)
+		}
+	}
+
+	if err := repo.RemoveCollectionShare(ctx, collectionID, friendID); err != nil {
+		t.Fatalf("remove collection share: %v", err)
+	}
+
+	if _, err := repo.GetByID(ctx, friendID, soup.ID); err == nil {
+		t.Fatalf("expected no access after the share is revoked, got %v", err)
+	}
+}
+
+func TestPublicCollection_GrantsEveryUserAccessToItsRecipesUntilPrivate(t *testing.T) {
+	ctx, repo := givenRepository(t)
+	ownerID, strangerID, collectionID := uuid.New(), uuid.New(), uuid.New()
+	soup := givenRecipe(t, ctx, repo, ownerID, "Soup")
+	t.Cleanup(func() { repo.DeleteCollection(context.Background(), collectionID) })
+
+	if err := repo.SetCollectionPublic(ctx, collectionID, true, []uuid.UUID{soup.ID}); err != nil {
+		t.Fatalf("make collection public: %v", err)
+	}
+
+	if _, err := repo.GetByID(ctx, strangerID, soup.ID); err != nil {
+		t.Fatalf("expected the soup through the public collection, got %v", err)
+	}
+
+	if err := repo.SetCollectionPublic(ctx, collectionID, false, nil); err != nil {
+		t.Fatalf("make collection private: %v", err)
+	}
+
+	if _, err := repo.GetByID(ctx, strangerID, soup.ID); err == nil {
+		t.Fatal("expected no access once the collection is private")
+	}
+}
+
+func givenRepository(t *testing.T) (context.Context, *repository.Repository) {
+	t.Helper()
+
+	url := os.Getenv("MEALPLANNER_DB_URL")
+	if url == "" {
+		t.Skip("MEALPLANNER_DB_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("connect to meal planner database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	return ctx, repository.NewRepository(pool)
+}
+
+func givenRecipe(t *testing.T, ctx context.Context, repo *repository.Repository, userID uuid.UUID, name string) *repository.Recipe {
+	t.Helper()
+
+	recipe := &repository.Recipe{
+		ID:                 uuid.New(),
+		UserID:             userID,
+		Name:               name,
+		Servings:           4,
+		SearchVector:       pgvector.NewVector(make([]float32, 1536)),
+		CuisineID:          uuid.New(),
+		CuisineName:        "General",
+		MainIngredientID:   uuid.New(),
+		MainIngredientName: "Water",
+		IngredientIDs:      []uuid.UUID{},
+		AllergyIDs:         []uuid.UUID{},
+	}
+	if err := repo.Upsert(ctx, recipe, nil); err != nil {
+		t.Fatalf("upsert recipe %s: %v", name, err)
+	}
+	t.Cleanup(func() { repo.Delete(context.Background(), recipe.ID) })
+
+	return recipe
+}
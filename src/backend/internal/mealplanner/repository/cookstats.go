@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpsertCookStats stores a user's cook history aggregates for a recipe in the
+// read model. Stats with no cooks left are removed.
+func (r *Repository) UpsertCookStats(ctx context.Context, recipeID, userID uuid.UUID, timesCooked int, lastCookedOn *time.Time, averageRating *float64) error {
+	if timesCooked == 0 {
+		_, err := r.pool.Exec(ctx, `DELETE FROM recipe_cook_stats WHERE recipe_id = $1 AND user_id = $2`, recipeID, userID)
+		if err != nil {
+			return fmt.Errorf("delete cook stats: %w", err)
+		}
+		return nil
+	}
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO recipe_cook_stats (recipe_id, user_id, times_cooked, last_cooked_on, average_rating, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (recipe_id, user_id) DO UPDATE SET
+			times_cooked = EXCLUDED.times_cooked,
+			last_cooked_on = EXCLUDED.last_cooked_on,
+			average_rating = EXCLUDED.average_rating,
+			updated_at = NOW()
+	`, recipeID, userID, timesCooked, lastCookedOn, averageRating)
+	if err != nil {
+		return fmt.Errorf("upsert cook stats: %w", err)
+	}
+	return nil
+}
+
+// attachCookStats fills in the user's cook history on the given recipes.
+func (r *Repository) attachCookStats(ctx context.Context, userID uuid.UUID, recipes []Recipe) error {
+	if len(recipes) == 0 {
+		return nil
+	}
+
+	index := make(map[uuid.UUID]int, len(recipes))
+	ids := make([]uuid.UUID, len(recipes))
+	for i, recipe := range recipes {
+		index[recipe.ID] = i
+		ids[i] = recipe.ID
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT recipe_id, times_cooked, last_cooked_on, average_rating
+		FROM recipe_cook_stats
+		WHERE user_id = $1 AND recipe_id = ANY($2)
+	`, userID, ids)
+	if err != nil {
+		return fmt.Errorf("query cook stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var recipeID uuid.UUID
+		var timesCooked int
+		var lastCookedOn *time.Time
+		var averageRating *float64
+		if err := rows.Scan(&recipeID, &timesCooked, &lastCookedOn, &averageRating); err != nil {
+			return fmt.Errorf("scan cook stats: %w", err)
+		}
+		recipe := &recipes[index[recipeID]]
+		recipe.TimesCooked = timesCooked
+		recipe.LastCookedOn = lastCookedOn
+		recipe.AverageRating = averageRating
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate cook stats: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/mealplanner/domain"
+)
+
+// UpsertDietaryProfile stores a user's dietary profile in the read model.
+func (r *Repository) UpsertDietaryProfile(ctx context.Context, profile domain.DietaryProfile) error {
+	allergyIDs := profile.AllergyIDs
+	if allergyIDs == nil {
+		allergyIDs = []uuid.UUID{}
+	}
+	diets := profile.Diets
+	if diets == nil {
+		diets = []string{}
+	}
+	disliked := profile.DislikedIngredients
+	if disliked == nil {
+		disliked = []string{}
+	}
+
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO dietary_profiles (user_id, allergy_ids, diets, disliked_ingredients, updated_at)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			allergy_ids = EXCLUDED.allergy_ids,
+			diets = EXCLUDED.diets,
+			disliked_ingredients = EXCLUDED.disliked_ingredients,
+			updated_at = NOW()
+	`, profile.UserID, allergyIDs, diets, disliked)
+	if err != nil {
+		return fmt.Errorf("upsert dietary profile: %w", err)
+	}
+	return nil
+}
+
+// GetDietaryProfile retrieves a user's dietary profile, or nil when the user
+// has none.
+func (r *Repository) GetDietaryProfile(ctx context.Context, userID uuid.UUID) (*domain.DietaryProfile, error) {
+	profile := domain.DietaryProfile{UserID: userID}
+	err := r.pool.QueryRow(ctx, `
+		SELECT allergy_ids, diets, disliked_ingredients
+		FROM dietary_profiles
+		WHERE user_id = $1
+	`, userID).Scan(&profile.AllergyIDs, &profile.Diets, &profile.DislikedIngredients)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query dietary profile: %w", err)
+	}
+	return &profile, nil
+}
+
+// attachIngredientNames fills in the names of the required ingredient lines
+// on the given recipes, so dietary profiles can match disliked ingredients.
+func (r *Repository) attachIngredientNames(ctx context.Context, recipes []Recipe) error {
+	if len(recipes) == 0 {
+		return nil
+	}
+
+	index := make(map[uuid.UUID]int, len(recipes))
+	ids := make([]uuid.UUID, len(recipes))
+	for i, recipe := range recipes {
+		index[recipe.ID] = i
+		ids[i] = recipe.ID
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT recipe_id, ingredient_name
+		FROM recipe_ingredient_lines
+		WHERE recipe_id = ANY($1) AND NOT is_optional
+		ORDER BY recipe_id, sort_order
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("query ingredient names: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var recipeID uuid.UUID
+		var name string
+		if err := rows.Scan(&recipeID, &name); err != nil {
+			return fmt.Errorf("scan ingredient name: %w", err)
+		}
+		recipe := &recipes[index[recipeID]]
+		recipe.IngredientNames = append(recipe.IngredientNames, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterate ingredient names: %w", err)
+	}
+	return nil
+}
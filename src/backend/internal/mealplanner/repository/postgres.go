@@ -34,6 +34,22 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 	return &Repository{pool: pool}
 }
 
+// accessClause matches recipes the user owns, that were shared with them, or
+// that are in a collection shared with them or made public.
+func accessClause(userParam int) string {
+	return fmt.Sprintf(`(user_id = $%[1]d
+		OR id IN (SELECT recipe_id FROM recipe_shares WHERE user_id = $%[1]d)
+		OR id IN (
+			SELECT cr.recipe_id FROM collection_recipes cr
+			JOIN collection_shares cs ON cs.collection_id = cr.collection_id
+			WHERE cs.user_id = $%[1]d
+		)
+		OR id IN (
+			SELECT cr.recipe_id FROM collection_recipes cr
+			JOIN public_collections pc ON pc.collection_id = cr.collection_id
+		))`, userParam)
+}
+
 // GetByID retrieves a recipe by its ID
 func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*Recipe, error) {
 	query := `
@@ -43,11 +59,11 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*Recipe
 			servings, yield_quantity, yield_unit,
 			search_vector, cuisine_id, cuisine_name,
 			main_ingredient_id, main_ingredient_name,
-			ingredient_ids, allergy_ids, tags, image_url,
+			ingredient_ids, allergy_ids, tags, diet_labels, diet_classified, image_url,
 			calories_total, calories_per_serving,
 			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
 		FROM recipes
-		WHERE id = $1 AND user_id = $2
+		WHERE id = $1 AND ` + accessClause(2) + `
 	`
 
 	var recipe Recipe
@@ -65,7 +81,7 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*Recipe
 		&recipe.Servings, &yieldQuantity, &yieldUnit,
 		&recipe.SearchVector, &recipe.CuisineID, &recipe.CuisineName,
 		&recipe.MainIngredientID, &recipe.MainIngredientName,
-		&recipe.IngredientIDs, &recipe.AllergyIDs, &recipe.Tags, &recipe.ImageURL,
+		&recipe.IngredientIDs, &recipe.AllergyIDs, &recipe.Tags, &recipe.DietLabels, &recipe.DietClassified, &recipe.ImageURL,
 		&recipe.CaloriesTotal, &recipe.CaloriesPerServing,
 		&protein, &carbs, &fat, &fiber, &sugar, &sodium,
 	)
@@ -94,7 +110,8 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*Recipe
 	return &recipe, nil
 }
 
-// GetAll retrieves all recipes with pagination
+// GetAll retrieves all recipes with pagination, including the user's cook
+// history and the required ingredient names for each recipe
 func (r *Repository) GetAll(ctx context.Context, userID uuid.UUID, limit, offset int) ([]Recipe, error) {
 	query := `
 		SELECT
@@ -103,11 +120,11 @@ func (r *Repository) GetAll(ctx context.Context, userID uuid.UUID, limit, offset
 			servings, yield_quantity, yield_unit,
 			search_vector, cuisine_id, cuisine_name,
 			main_ingredient_id, main_ingredient_name,
-			ingredient_ids, allergy_ids, tags, image_url,
+			ingredient_ids, allergy_ids, tags, diet_labels, diet_classified, image_url,
 			calories_total, calories_per_serving,
 			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
 		FROM recipes
-		WHERE user_id = $1
+		WHERE ` + accessClause(1) + `
 		ORDER BY created_at DESC
 		LIMIT $2 OFFSET $3
 	`
@@ -118,7 +135,18 @@ func (r *Repository) GetAll(ctx context.Context, userID uuid.UUID, limit, offset
 	}
 	defer rows.Close()
 
-	return scanRecipes(rows)
+	recipes, err := scanRecipes(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.attachCookStats(ctx, userID, recipes); err != nil {
+		return nil, err
+	}
+	if err := r.attachIngredientNames(ctx, recipes); err != nil {
+		return nil, err
+	}
+	return recipes, nil
 }
 
 // GetByCuisine retrieves recipes by cuisine ID
@@ -130,11 +158,11 @@ func (r *Repository) GetByCuisine(ctx context.Context, userID, cuisineID uuid.UU
 			servings, yield_quantity, yield_unit,
 			search_vector, cuisine_id, cuisine_name,
 			main_ingredient_id, main_ingredient_name,
-			ingredient_ids, allergy_ids, tags, image_url,
+			ingredient_ids, allergy_ids, tags, diet_labels, diet_classified, image_url,
 			calories_total, calories_per_serving,
 			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
 		FROM recipes
-		WHERE cuisine_id = $1 AND user_id = $2
+		WHERE cuisine_id = $1 AND ` + accessClause(2) + `
 		ORDER BY created_at DESC
 		LIMIT $3 OFFSET $4
 	`
@@ -157,12 +185,12 @@ func (r *Repository) GetByIngredient(ctx context.Context, userID, ingredientID u
 			servings, yield_quantity, yield_unit,
 			search_vector, cuisine_id, cuisine_name,
 			main_ingredient_id, main_ingredient_name,
-			ingredient_ids, allergy_ids, tags, image_url,
+			ingredient_ids, allergy_ids, tags, diet_labels, diet_classified, image_url,
 			calories_total, calories_per_serving,
 			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
 		FROM recipes
 		WHERE (main_ingredient_id = $1 OR $1 = ANY(ingredient_ids))
-		  AND user_id = $2
+		  AND ` + accessClause(2) + `
 		ORDER BY created_at DESC
 		LIMIT $3 OFFSET $4
 	`
@@ -185,12 +213,12 @@ func (r *Repository) GetExcludingAllergy(ctx context.Context, userID, allergyID
 			servings, yield_quantity, yield_unit,
 			search_vector, cuisine_id, cuisine_name,
 			main_ingredient_id, main_ingredient_name,
-			ingredient_ids, allergy_ids, tags, image_url,
+			ingredient_ids, allergy_ids, tags, diet_labels, diet_classified, image_url,
 			calories_total, calories_per_serving,
 			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
 		FROM recipes
 		WHERE NOT ($1 = ANY(allergy_ids))
-		  AND user_id = $2
+		  AND ` + accessClause(2) + `
 		ORDER BY created_at DESC
 		LIMIT $3 OFFSET $4
 	`
@@ -209,7 +237,7 @@ func (r *Repository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID,
 	// First get the vector for the target recipe
 	var targetVector pgvector.Vector
 	err := r.pool.QueryRow(ctx,
-		`SELECT search_vector FROM recipes WHERE id = $1 AND user_id = $2`,
+		`SELECT search_vector FROM recipes WHERE id = $1 AND `+accessClause(2),
 		recipeID, userID,
 	).Scan(&targetVector)
 	if err != nil {
@@ -223,11 +251,11 @@ func (r *Repository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID,
 			servings, yield_quantity, yield_unit,
 			search_vector, cuisine_id, cuisine_name,
 			main_ingredient_id, main_ingredient_name,
-			ingredient_ids, allergy_ids, tags, image_url,
+			ingredient_ids, allergy_ids, tags, diet_labels, diet_classified, image_url,
 			calories_total, calories_per_serving,
 			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
 		FROM recipes
-		WHERE id != $1 AND user_id = $2
+		WHERE id != $1 AND ` + accessClause(2) + `
 		ORDER BY search_vector <=> $3
 		LIMIT $4
 	`
@@ -245,7 +273,7 @@ func (r *Repository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID,
 func (r *Repository) GetVectorByID(ctx context.Context, userID, id uuid.UUID) (pgvector.Vector, error) {
 	var vector pgvector.Vector
 	err := r.pool.QueryRow(ctx,
-		`SELECT search_vector FROM recipes WHERE id = $1 AND user_id = $2`,
+		`SELECT search_vector FROM recipes WHERE id = $1 AND `+accessClause(2),
 		id, userID,
 	).Scan(&vector)
 	if err != nil {
@@ -260,6 +288,10 @@ func (r *Repository) Upsert(ctx context.Context, recipe *Recipe, lines []Ingredi
 	if tags == nil {
 		tags = []string{}
 	}
+	dietLabels := recipe.DietLabels
+	if dietLabels == nil {
+		dietLabels = []string{}
+	}
 
 	query := `
 		INSERT INTO recipes (
@@ -268,7 +300,7 @@ func (r *Repository) Upsert(ctx context.Context, recipe *Recipe, lines []Ingredi
 			servings, yield_quantity, yield_unit,
 			search_vector, cuisine_id, cuisine_name,
 			main_ingredient_id, main_ingredient_name,
-			ingredient_ids, allergy_ids, tags, image_url,
+			ingredient_ids, allergy_ids, tags, diet_labels, diet_classified, image_url,
 			calories_total, calories_per_serving,
 			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
 		) VALUES (
@@ -278,8 +310,8 @@ func (r *Repository) Upsert(ctx context.Context, recipe *Recipe, lines []Ingredi
 			$11, $12, $13,
 			$14, $15,
 			$16, $17,
-			$18, $19,
-			$20, $21, $22, $23, $24, $25, $26, $27
+			$18, $19, $20, $21,
+			$22, $23, $24, $25, $26, $27, $28, $29
 		)
 		ON CONFLICT (id) DO UPDATE SET
 			user_id = EXCLUDED.user_id,
@@ -299,6 +331,8 @@ func (r *Repository) Upsert(ctx context.Context, recipe *Recipe, lines []Ingredi
 			ingredient_ids = EXCLUDED.ingredient_ids,
 			allergy_ids = EXCLUDED.allergy_ids,
 			tags = EXCLUDED.tags,
+			diet_labels = EXCLUDED.diet_labels,
+			diet_classified = EXCLUDED.diet_classified,
 			image_url = EXCLUDED.image_url,
 			calories_total = EXCLUDED.calories_total,
 			calories_per_serving = EXCLUDED.calories_per_serving,
@@ -317,7 +351,7 @@ func (r *Repository) Upsert(ctx context.Context, recipe *Recipe, lines []Ingredi
 		recipe.Servings, recipe.YieldQuantity, recipe.YieldUnit,
 		recipe.SearchVector, recipe.CuisineID, recipe.CuisineName,
 		recipe.MainIngredientID, recipe.MainIngredientName,
-		recipe.IngredientIDs, recipe.AllergyIDs, tags, recipe.ImageURL,
+		recipe.IngredientIDs, recipe.AllergyIDs, tags, dietLabels, recipe.DietClassified, recipe.ImageURL,
 		recipe.CaloriesTotal, recipe.CaloriesPerServing,
 		recipe.ProteinG, recipe.CarbsG, recipe.FatG, recipe.FiberG, recipe.SugarG, recipe.SodiumMg,
 	)
@@ -353,12 +387,28 @@ func (r *Repository) Upsert(ctx context.Context, recipe *Recipe, lines []Ingredi
 	return nil
 }
 
-// Delete removes a recipe from the read model
+// Delete removes a recipe with its shares, collection entries and cook stats
+// from the read model
 func (r *Repository) Delete(ctx context.Context, id uuid.UUID) error {
 	_, err := r.pool.Exec(ctx, `DELETE FROM recipes WHERE id = $1`, id)
 	if err != nil {
 		return fmt.Errorf("delete recipe: %w", err)
 	}
+
+	_, err = r.pool.Exec(ctx, `DELETE FROM recipe_shares WHERE recipe_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete recipe shares: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `DELETE FROM collection_recipes WHERE recipe_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete collection recipes: %w", err)
+	}
+
+	_, err = r.pool.Exec(ctx, `DELETE FROM recipe_cook_stats WHERE recipe_id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("delete recipe cook stats: %w", err)
+	}
 	return nil
 }
 
@@ -380,7 +430,7 @@ func scanRecipes(rows pgx.Rows) ([]Recipe, error) {
 			&recipe.Servings, &yieldQuantity, &yieldUnit,
 			&recipe.SearchVector, &recipe.CuisineID, &recipe.CuisineName,
 			&recipe.MainIngredientID, &recipe.MainIngredientName,
-			&recipe.IngredientIDs, &recipe.AllergyIDs, &recipe.Tags, &recipe.ImageURL,
+			&recipe.IngredientIDs, &recipe.AllergyIDs, &recipe.Tags, &recipe.DietLabels, &recipe.DietClassified, &recipe.ImageURL,
 			&recipe.CaloriesTotal, &recipe.CaloriesPerServing,
 			&protein, &carbs, &fat, &fiber, &sugar, &sodium,
 		)
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// AddShare gives a user access to a recipe in the read model.
+func (r *Repository) AddShare(ctx context.Context, recipeID, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO recipe_shares (recipe_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (recipe_id, user_id) DO NOTHING
+	`, recipeID, userID)
+	if err != nil {
+		return fmt.Errorf("insert recipe share: %w", err)
+	}
+	return nil
+}
+
+// RemoveShare removes a user's access to a recipe from the read model.
+func (r *Repository) RemoveShare(ctx context.Context, recipeID, userID uuid.UUID) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM recipe_shares WHERE recipe_id = $1 AND user_id = $2`, recipeID, userID)
+	if err != nil {
+		return fmt.Errorf("delete recipe share: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package testutil
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 
@@ -102,12 +104,33 @@ func (b *RecipeBuilder) WithAllergyIDs(ids []uuid.UUID) *RecipeBuilder {
 	return b
 }
 
+// WithIngredientNames sets the names of the required ingredient lines
+func (b *RecipeBuilder) WithIngredientNames(names []string) *RecipeBuilder {
+	b.recipe.IngredientNames = names
+	return b
+}
+
+// WithDietLabels sets the recipe diet labels and marks the recipe classified
+func (b *RecipeBuilder) WithDietLabels(labels []string) *RecipeBuilder {
+	b.recipe.DietLabels = labels
+	b.recipe.DietClassified = true
+	return b
+}
+
 // WithSearchVector sets the search vector
 func (b *RecipeBuilder) WithSearchVector(vector pgvector.Vector) *RecipeBuilder {
 	b.recipe.SearchVector = vector
 	return b
 }
 
+// WithCookHistory sets the user's cook history aggregates
+func (b *RecipeBuilder) WithCookHistory(timesCooked int, lastCookedOn time.Time, averageRating float64) *RecipeBuilder {
+	b.recipe.TimesCooked = timesCooked
+	b.recipe.LastCookedOn = &lastCookedOn
+	b.recipe.AverageRating = &averageRating
+	return b
+}
+
 // Build returns the constructed Recipe
 func (b *RecipeBuilder) Build() repository.Recipe {
 	return b.recipe
@@ -14,7 +14,8 @@ import (
 
 // FakeRecipeRepository is an in-memory implementation of RecipeRepository for testing
 type FakeRecipeRepository struct {
-	Recipes []repository.Recipe
+	Recipes         []repository.Recipe
+	DietaryProfiles map[uuid.UUID]*domain.DietaryProfile
 
 	// Failure modes for testing error paths
 	FailOnGetAll bool
@@ -33,8 +34,9 @@ type GetAllCall struct {
 // NewFakeRecipeRepository creates a new fake repository
 func NewFakeRecipeRepository() *FakeRecipeRepository {
 	return &FakeRecipeRepository{
-		Recipes:     []repository.Recipe{},
-		GetAllCalls: []GetAllCall{},
+		Recipes:         []repository.Recipe{},
+		DietaryProfiles: make(map[uuid.UUID]*domain.DietaryProfile),
+		GetAllCalls:     []GetAllCall{},
 	}
 }
 
@@ -64,6 +66,11 @@ func (r *FakeRecipeRepository) GetAll(ctx context.Context, userID uuid.UUID, lim
 	return filtered[offset:end], nil
 }
 
+// GetDietaryProfile retrieves a user's dietary profile, or nil when none was set
+func (r *FakeRecipeRepository) GetDietaryProfile(ctx context.Context, userID uuid.UUID) (*domain.DietaryProfile, error) {
+	return r.DietaryProfiles[userID], nil
+}
+
 // AddRecipe adds a recipe to the fake repository for test setup
 func (r *FakeRecipeRepository) AddRecipe(recipe repository.Recipe) {
 	r.Recipes = append(r.Recipes, recipe)
@@ -0,0 +1,85 @@
+package dietary
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// backfillBatchSize is the number of ingredients read per query.
+const backfillBatchSize = 500
+
+// BackfillStore reads unclassified ingredients and saves what they contain.
+type BackfillStore interface {
+	// ListUnclassifiedIngredients returns ingredients of all users, ordered
+	// by ID and after the given ID, that have no dietary attributes.
+	ListUnclassifiedIngredients(ctx context.Context, after uuid.UUID, limit int) ([]domain.Ingredient, error)
+	SaveIngredientDietaryAttributes(ctx context.Context, record *domain.IngredientDietaryAttributes) error
+}
+
+// Reclassifier relabels the recipes using an ingredient and publishes those
+// whose labels changed.
+type Reclassifier interface {
+	ReclassifyRecipesUsing(ctx context.Context, ingredient *domain.Ingredient) (int, error)
+}
+
+// BackfillStats summarizes a backfill run.
+type BackfillStats struct {
+	Classified   int
+	Reclassified int
+}
+
+// Backfill classifies the unclassified ingredients the bundled catalog lists
+// and relabels the recipes using them. It is idempotent: classified
+// ingredients are never touched again, so attributes users set are kept, and
+// ingredients the catalog does not list are retried once it does.
+func Backfill(ctx context.Context, store BackfillStore, reclassifier Reclassifier, logger *slog.Logger) (BackfillStats, error) {
+	var stats BackfillStats
+
+	catalog, err := Bundled()
+	if err != nil {
+		return stats, err
+	}
+
+	after := uuid.Nil
+	for {
+		ingredients, err := store.ListUnclassifiedIngredients(ctx, after, backfillBatchSize)
+		if err != nil {
+			return stats, fmt.Errorf("list unclassified ingredients: %w", err)
+		}
+		if len(ingredients) == 0 {
+			break
+		}
+
+		for i := range ingredients {
+			ingredient := &ingredients[i]
+			attributes, ok := catalog.Lookup(ingredient.Name)
+			if !ok {
+				continue
+			}
+
+			record := &domain.IngredientDietaryAttributes{IngredientID: ingredient.ID, Attributes: attributes}
+			if err := store.SaveIngredientDietaryAttributes(ctx, record); err != nil {
+				return stats, fmt.Errorf("save dietary attributes of ingredient %s: %w", ingredient.ID, err)
+			}
+			stats.Classified++
+
+			reclassified, err := reclassifier.ReclassifyRecipesUsing(ctx, ingredient)
+			if err != nil {
+				return stats, fmt.Errorf("reclassify recipes using ingredient %s: %w", ingredient.ID, err)
+			}
+			stats.Reclassified += reclassified
+		}
+		after = ingredients[len(ingredients)-1].ID
+	}
+
+	logger.Info("ingredient dietary backfill complete",
+		"classified", stats.Classified,
+		"reclassifiedRecipes", stats.Reclassified,
+	)
+	return stats, nil
+}
@@ -0,0 +1,84 @@
+package dietary
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/fooddata"
+)
+
+//go:embed attributes.json
+var bundledCatalog []byte
+
+// Catalog holds what common ingredients contain, keyed by normalized name.
+type Catalog map[string][]domain.IngredientAttribute
+
+type catalogFile struct {
+	Ingredients []catalogEntry `json:"ingredients"`
+}
+
+type catalogEntry struct {
+	Name     string   `json:"name"`
+	Contains []string `json:"contains"`
+}
+
+// Load reads an attribute catalog in the format of the bundled
+// attributes.json. An ingredient listed with nothing it contains is
+// classified as fitting every diet.
+func Load(r io.Reader) (Catalog, error) {
+	var file catalogFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("decode dietary attribute catalog: %w", err)
+	}
+
+	catalog := make(Catalog, len(file.Ingredients))
+	for i, entry := range file.Ingredients {
+		key := Key(entry.Name)
+		if key == "" {
+			return nil, fmt.Errorf("ingredient %d: name is required", i+1)
+		}
+		if _, ok := catalog[key]; ok {
+			return nil, fmt.Errorf("ingredient %d: %s is listed twice", i+1, strings.TrimSpace(entry.Name))
+		}
+
+		attributes := []domain.IngredientAttribute{}
+		for _, value := range entry.Contains {
+			attribute := domain.IngredientAttribute(value)
+			if !attribute.IsValid() {
+				return nil, fmt.Errorf("ingredient %d: invalid dietary attribute %q", i+1, value)
+			}
+			attributes = append(attributes, attribute)
+		}
+		catalog[key] = attributes
+	}
+
+	return catalog, nil
+}
+
+var loadBundled = sync.OnceValues(func() (Catalog, error) {
+	return Load(bytes.NewReader(bundledCatalog))
+})
+
+// Bundled returns the catalog bundled with the recipe API.
+func Bundled() (Catalog, error) {
+	return loadBundled()
+}
+
+// Lookup returns what an ingredient contains, matched by normalized name so
+// "Eggs" finds "egg". It reports false for ingredients the catalog does not
+// list, which stay unclassified.
+func (c Catalog) Lookup(name string) ([]domain.IngredientAttribute, bool) {
+	attributes, ok := c[Key(name)]
+	return attributes, ok
+}
+
+// Key returns the normalized ingredient name the catalog is keyed by.
+func Key(name string) string {
+	return fooddata.Normalize(name)
+}
@@ -0,0 +1,111 @@
+package dietary_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/dietary"
+)
+
+type fakeBackfillStore struct {
+	ingredients []domain.Ingredient
+	saved       map[uuid.UUID]domain.IngredientDietaryAttributes
+}
+
+func (s *fakeBackfillStore) ListUnclassifiedIngredients(ctx context.Context, after uuid.UUID, limit int) ([]domain.Ingredient, error) {
+	var result []domain.Ingredient
+	for _, ingredient := range s.ingredients {
+		if _, ok := s.saved[ingredient.ID]; ok || ingredient.ID.String() <= after.String() {
+			continue
+		}
+		result = append(result, ingredient)
+	}
+	slices.SortFunc(result, func(a, b domain.Ingredient) int { return strings.Compare(a.ID.String(), b.ID.String()) })
+	if len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (s *fakeBackfillStore) SaveIngredientDietaryAttributes(ctx context.Context, record *domain.IngredientDietaryAttributes) error {
+	s.saved[record.IngredientID] = *record
+	return nil
+}
+
+type fakeReclassifier struct {
+	ingredients []string
+}
+
+func (r *fakeReclassifier) ReclassifyRecipesUsing(ctx context.Context, ingredient *domain.Ingredient) (int, error) {
+	r.ingredients = append(r.ingredients, ingredient.Name)
+	return 1, nil
+}
+
+func TestBundled_ListsCommonIngredients(t *testing.T) {
+	catalog, err := dietary.Bundled()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attributes, ok := catalog.Lookup("Eggs"); !ok || !slices.Equal(attributes, []domain.IngredientAttribute{domain.IngredientAttributeEgg}) {
+		t.Errorf("expected eggs to contain egg, got %v", attributes)
+	}
+	if attributes, ok := catalog.Lookup("Bell Peppers"); !ok || len(attributes) != 0 {
+		t.Errorf("expected bell peppers to be classified as containing nothing, got %v", attributes)
+	}
+	if _, ok := catalog.Lookup("mystery sauce"); ok {
+		t.Error("expected an unlisted ingredient to stay unclassified")
+	}
+}
+
+func TestLoad_RejectsInvalidIngredients(t *testing.T) {
+	cases := map[string]string{
+		"missing name":      `{"ingredients": [{"contains": ["meat"]}]}`,
+		"invalid attribute": `{"ingredients": [{"name": "Tofu", "contains": ["soy"]}]}`,
+		"duplicate":         `{"ingredients": [{"name": "Eggs", "contains": ["egg"]}, {"name": "egg", "contains": ["egg"]}]}`,
+		"malformed":         `{"ingredients": {}}`,
+	}
+
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := dietary.Load(strings.NewReader(input)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestBackfill_ClassifiesListedIngredientsAndReclassifiesRecipes(t *testing.T) {
+	chicken := domain.Ingredient{ID: uuid.New(), Name: "Chicken"}
+	mystery := domain.Ingredient{ID: uuid.New(), Name: "Mystery sauce"}
+	store := &fakeBackfillStore{
+		ingredients: []domain.Ingredient{chicken, mystery},
+		saved:       make(map[uuid.UUID]domain.IngredientDietaryAttributes),
+	}
+	reclassifier := &fakeReclassifier{}
+
+	stats, err := dietary.Backfill(context.Background(), store, reclassifier, slog.New(slog.NewTextHandler(io.Discard, nil)))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stats.Classified != 1 || stats.Reclassified != 1 {
+		t.Fatalf("expected 1 ingredient classified and 1 recipe reclassified, got %+v", stats)
+	}
+	if !store.saved[chicken.ID].Has(domain.IngredientAttributeMeat) {
+		t.Error("expected chicken to be saved as containing meat")
+	}
+	if _, ok := store.saved[mystery.ID]; ok {
+		t.Error("expected the unlisted ingredient to stay unclassified")
+	}
+	if !slices.Equal(reclassifier.ingredients, []string{"Chicken"}) {
+		t.Errorf("expected the recipes using chicken to be reclassified, got %v", reclassifier.ingredients)
+	}
+}
@@ -0,0 +1,76 @@
+// Package dietary derives recipe diet labels from the dietary attributes of
+// its ingredients.
+package dietary
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// Classify sets the diet labels of recipe from the dietary attributes per
+// ingredient ID. The main ingredient and every required line count; optional
+// lines are left out since they can be skipped. A label is only given when
+// every counted ingredient is classified and none of them rules it out, so a
+// single unclassified ingredient leaves the recipe unclassified: without
+// labels, but not known to break any diet either.
+func Classify(recipe *domain.Recipe, attributes map[uuid.UUID]domain.IngredientDietaryAttributes) {
+	recipe.DietLabels = nil
+	recipe.DietClassified = false
+
+	ingredientIDs := IngredientIDs(recipe)
+	if len(ingredientIDs) == 0 {
+		return
+	}
+
+	excluded := make(map[domain.IngredientAttribute]bool)
+	for _, id := range ingredientIDs {
+		attrs, ok := attributes[id]
+		if !ok {
+			return
+		}
+		for _, attribute := range attrs.Attributes {
+			excluded[attribute] = true
+		}
+	}
+
+	recipe.DietClassified = true
+	for _, label := range domain.DietLabels {
+		if fits(label, excluded) {
+			recipe.DietLabels = append(recipe.DietLabels, label)
+		}
+	}
+}
+
+// IngredientIDs returns the distinct IDs of the ingredients Classify counts:
+// the main ingredient and those of the required lines.
+func IngredientIDs(recipe *domain.Recipe) []uuid.UUID {
+	var ids []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	add := func(id uuid.UUID) {
+		if id == uuid.Nil || seen[id] {
+			return
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	if recipe.MainIngredient != nil {
+		add(recipe.MainIngredient.ID)
+	}
+	for _, line := range recipe.IngredientLines {
+		if !line.IsOptional {
+			add(line.Ingredient.ID)
+		}
+	}
+	return ids
+}
+
+func fits(label domain.DietLabel, excluded map[domain.IngredientAttribute]bool) bool {
+	for _, attribute := range label.ExcludedBy() {
+		if excluded[attribute] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,132 @@
+package dietary_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/dietary"
+)
+
+func TestClassify_PlantIngredients_FitEveryDiet(t *testing.T) {
+	rice := ingredient("rice")
+	beans := ingredient("beans")
+	recipe := givenRecipe(rice, required(beans))
+	attributes := givenAttributes(
+		classified(rice),
+		classified(beans),
+	)
+
+	dietary.Classify(recipe, attributes)
+
+	thenLabels(t, recipe, domain.DietLabels...)
+}
+
+func TestClassify_AnimalProducts_RuleOutStricterDiets(t *testing.T) {
+	pasta := ingredient("pasta")
+	parmesan := ingredient("parmesan")
+	salmon := ingredient("salmon")
+	recipe := givenRecipe(salmon, required(pasta), required(parmesan))
+	attributes := givenAttributes(
+		classified(pasta, domain.IngredientAttributeGluten),
+		classified(parmesan, domain.IngredientAttributeDairy),
+		classified(salmon, domain.IngredientAttributeFish),
+	)
+
+	dietary.Classify(recipe, attributes)
+
+	thenLabels(t, recipe, domain.DietLabelPescatarian, domain.DietLabelNutFree)
+}
+
+func TestClassify_OptionalLines_AreLeftOut(t *testing.T) {
+	lentils := ingredient("lentils")
+	walnuts := ingredient("walnuts")
+	bacon := ingredient("bacon")
+	recipe := givenRecipe(lentils,
+		optional(walnuts),
+		optional(bacon),
+	)
+	attributes := givenAttributes(
+		classified(lentils),
+		classified(walnuts, domain.IngredientAttributeNuts),
+	)
+
+	dietary.Classify(recipe, attributes)
+
+	thenLabels(t, recipe, domain.DietLabels...)
+}
+
+func TestClassify_UnclassifiedIngredient_GivesNoLabels(t *testing.T) {
+	tofu := ingredient("tofu")
+	sauce := ingredient("mystery sauce")
+	recipe := givenRecipe(tofu, required(sauce))
+	recipe.DietLabels = []domain.DietLabel{domain.DietLabelVegan}
+	attributes := givenAttributes(classified(tofu))
+
+	dietary.Classify(recipe, attributes)
+
+	thenLabels(t, recipe)
+	if recipe.DietClassified {
+		t.Fatal("expected the recipe to be unclassified")
+	}
+}
+
+func TestClassify_NoFittingDiet_IsStillClassified(t *testing.T) {
+	bread := ingredient("bread")
+	butter := ingredient("butter")
+	ham := ingredient("ham")
+	almonds := ingredient("almonds")
+	recipe := givenRecipe(ham, required(bread), required(butter), required(almonds))
+	attributes := givenAttributes(
+		classified(bread, domain.IngredientAttributeGluten),
+		classified(butter, domain.IngredientAttributeDairy),
+		classified(ham, domain.IngredientAttributeMeat),
+		classified(almonds, domain.IngredientAttributeNuts),
+	)
+
+	dietary.Classify(recipe, attributes)
+
+	thenLabels(t, recipe)
+	if !recipe.DietClassified {
+		t.Fatal("expected the recipe to be classified")
+	}
+}
+
+// Helpers
+
+func givenRecipe(main domain.Ingredient, lines ...domain.RecipeIngredientLine) *domain.Recipe {
+	return &domain.Recipe{Name: "Test", MainIngredient: &main, IngredientLines: lines}
+}
+
+func givenAttributes(records ...domain.IngredientDietaryAttributes) map[uuid.UUID]domain.IngredientDietaryAttributes {
+	attributes := make(map[uuid.UUID]domain.IngredientDietaryAttributes, len(records))
+	for _, record := range records {
+		attributes[record.IngredientID] = record
+	}
+	return attributes
+}
+
+func ingredient(name string) domain.Ingredient {
+	return domain.Ingredient{ID: uuid.New(), Name: name}
+}
+
+func classified(ingredient domain.Ingredient, attributes ...domain.IngredientAttribute) domain.IngredientDietaryAttributes {
+	return domain.IngredientDietaryAttributes{IngredientID: ingredient.ID, Attributes: attributes}
+}
+
+func required(ingredient domain.Ingredient) domain.RecipeIngredientLine {
+	return domain.RecipeIngredientLine{Ingredient: ingredient}
+}
+
+func optional(ingredient domain.Ingredient) domain.RecipeIngredientLine {
+	return domain.RecipeIngredientLine{Ingredient: ingredient, IsOptional: true}
+}
+
+func thenLabels(t *testing.T, recipe *domain.Recipe, want ...domain.DietLabel) {
+	t.Helper()
+	if !slices.Equal(recipe.DietLabels, want) {
+		t.Fatalf("expected labels %v, got %v", want, recipe.DietLabels)
+	}
+}
@@ -135,6 +135,130 @@ func (p *Publisher) PublishRecipeDeleted(ctx context.Context, recipeID, userID u
 	return p.Publish(ctx, event)
 }
 
+// PublishRecipeShared publishes a RecipeSharedEvent.
+func (p *Publisher) PublishRecipeShared(ctx context.Context, share *domain.RecipeShare) error {
+	event := events.NewRecipeSharedEvent(share.RecipeID, share.OwnerID, share.SharedWithUserID, string(share.Permission))
+
+	p.logger.Info("publishing recipe shared event",
+		"recipeId", share.RecipeID,
+		"sharedWithUserId", share.SharedWithUserID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishRecipeShareRevoked publishes a RecipeShareRevokedEvent.
+func (p *Publisher) PublishRecipeShareRevoked(ctx context.Context, recipeID, userID, sharedWithUserID uuid.UUID) error {
+	event := events.NewRecipeShareRevokedEvent(recipeID, userID, sharedWithUserID)
+
+	p.logger.Info("publishing recipe share revoked event",
+		"recipeId", recipeID,
+		"sharedWithUserId", sharedWithUserID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishRecipeCookStatsUpdated publishes a RecipeCookStatsUpdatedEvent.
+func (p *Publisher) PublishRecipeCookStatsUpdated(ctx context.Context, recipeID, userID uuid.UUID, stats *domain.RecipeCookStats) error {
+	event := events.NewRecipeCookStatsUpdatedEvent(recipeID, userID, stats.TimesCooked, stats.LastCookedOn, stats.AverageRating)
+
+	p.logger.Info("publishing recipe cook stats updated event",
+		"recipeId", recipeID,
+		"userId", userID,
+		"timesCooked", stats.TimesCooked,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishDietaryProfileUpdated publishes a DietaryProfileUpdatedEvent.
+func (p *Publisher) PublishDietaryProfileUpdated(ctx context.Context, profile *domain.DietaryProfile) error {
+	diets := make([]string, len(profile.Diets))
+	for i, diet := range profile.Diets {
+		diets[i] = string(diet)
+	}
+	event := events.NewDietaryProfileUpdatedEvent(profile.UserID, profile.AllergyIDs(), diets, profile.DislikedIngredients)
+
+	p.logger.Info("publishing dietary profile updated event",
+		"userId", profile.UserID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishCollectionShared publishes a CollectionSharedEvent.
+func (p *Publisher) PublishCollectionShared(ctx context.Context, share *domain.CollectionShare, recipeIDs []uuid.UUID) error {
+	event := events.NewCollectionSharedEvent(share.CollectionID, share.OwnerID, share.SharedWithUserID, string(share.Permission), recipeIDs)
+
+	p.logger.Info("publishing collection shared event",
+		"collectionId", share.CollectionID,
+		"sharedWithUserId", share.SharedWithUserID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishCollectionShareRevoked publishes a CollectionShareRevokedEvent.
+func (p *Publisher) PublishCollectionShareRevoked(ctx context.Context, collectionID, userID, sharedWithUserID uuid.UUID) error {
+	event := events.NewCollectionShareRevokedEvent(collectionID, userID, sharedWithUserID)
+
+	p.logger.Info("publishing collection share revoked event",
+		"collectionId", collectionID,
+		"sharedWithUserId", sharedWithUserID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishCollectionRecipeAdded publishes a CollectionRecipeAddedEvent.
+func (p *Publisher) PublishCollectionRecipeAdded(ctx context.Context, collectionID, userID, recipeID uuid.UUID) error {
+	event := events.NewCollectionRecipeAddedEvent(collectionID, userID, recipeID)
+
+	p.logger.Info("publishing collection recipe added event",
+		"collectionId", collectionID,
+		"recipeId", recipeID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishCollectionRecipeRemoved publishes a CollectionRecipeRemovedEvent.
+func (p *Publisher) PublishCollectionRecipeRemoved(ctx context.Context, collectionID, userID, recipeID uuid.UUID) error {
+	event := events.NewCollectionRecipeRemovedEvent(collectionID, userID, recipeID)
+
+	p.logger.Info("publishing collection recipe removed event",
+		"collectionId", collectionID,
+		"recipeId", recipeID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishCollectionDeleted publishes a CollectionDeletedEvent.
+func (p *Publisher) PublishCollectionDeleted(ctx context.Context, collectionID, userID uuid.UUID) error {
+	event := events.NewCollectionDeletedEvent(collectionID, userID)
+
+	p.logger.Info("publishing collection deleted event",
+		"collectionId", collectionID,
+	)
+
+	return p.Publish(ctx, event)
+}
+
+// PublishCollectionVisibilityChanged publishes a
+// CollectionVisibilityChangedEvent.
+func (p *Publisher) PublishCollectionVisibilityChanged(ctx context.Context, collectionID, userID uuid.UUID, public bool, recipeIDs []uuid.UUID) error {
+	event := events.NewCollectionVisibilityChangedEvent(collectionID, userID, public, recipeIDs)
+
+	p.logger.Info("publishing collection visibility changed event",
+		"collectionId", collectionID,
+		"public", public,
+	)
+
+	return p.Publish(ctx, event)
+}
+
 // routingKeyForEvent returns the routing key for a given event
 func routingKeyForEvent(event events.Event) string {
 	switch event.EventType() {
@@ -142,6 +266,26 @@ func routingKeyForEvent(event events.Event) string {
 		return "recipe.upserted"
 	case "RecipeDeletedEvent":
 		return "recipe.deleted"
+	case "RecipeSharedEvent":
+		return "recipe.shared"
+	case "RecipeShareRevokedEvent":
+		return "recipe.share_revoked"
+	case "RecipeCookStatsUpdatedEvent":
+		return "recipe.cook_stats_updated"
+	case "DietaryProfileUpdatedEvent":
+		return "recipe.dietary_profile_updated"
+	case "CollectionSharedEvent":
+		return "recipe.collection_shared"
+	case "CollectionShareRevokedEvent":
+		return "recipe.collection_share_revoked"
+	case "CollectionRecipeAddedEvent":
+		return "recipe.collection_recipe_added"
+	case "CollectionRecipeRemovedEvent":
+		return "recipe.collection_recipe_removed"
+	case "CollectionDeletedEvent":
+		return "recipe.collection_deleted"
+	case "CollectionVisibilityChangedEvent":
+		return "recipe.collection_visibility_changed"
 	default:
 		return "recipe.unknown"
 	}
@@ -0,0 +1,374 @@
+// Package exporter renders recipes as schema.org JSON-LD, Markdown or plain
+// text, individually or bundled in a zip archive.
+package exporter
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// Format identifies an export format.
+type Format string
+
+const (
+	FormatJSONLD   Format = "jsonld"
+	FormatMarkdown Format = "markdown"
+	FormatText     Format = "text"
+)
+
+// ErrUnsupportedFormat is returned for unknown export formats.
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ParseFormat parses a format name, defaulting to JSON-LD when empty.
+func ParseFormat(value string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "jsonld", "json-ld", "json":
+		return FormatJSONLD, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	case "text", "txt":
+		return FormatText, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatMarkdown:
+		return "text/markdown; charset=utf-8"
+	case FormatText:
+		return "text/plain; charset=utf-8"
+	default:
+		return "application/ld+json"
+	}
+}
+
+// Extension returns the file extension of the format, without the dot.
+func (f Format) Extension() string {
+	switch f {
+	case FormatMarkdown:
+		return "md"
+	case FormatText:
+		return "txt"
+	default:
+		return "jsonld"
+	}
+}
+
+// Filename returns a filesystem-safe file name for a recipe export.
+func Filename(recipe *domain.Recipe, format Format) string {
+	slug := strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(recipe.Name), "-"), "-")
+	if slug == "" {
+		slug = recipe.ID.String()
+	}
+	return slug + "." + format.Extension()
+}
+
+// Render renders a recipe in the given format.
+func Render(recipe *domain.Recipe, format Format) ([]byte, error) {
+	switch format {
+	case FormatJSONLD:
+		return renderJSONLD(recipe)
+	case FormatMarkdown:
+		return []byte(renderMarkdown(recipe)), nil
+	case FormatText:
+		return []byte(renderText(recipe)), nil
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// Archive writes recipes into a zip archive, one file per recipe.
+type Archive struct {
+	zw     *zip.Writer
+	format Format
+	names  map[string]int
+}
+
+// NewArchive starts a zip archive of recipes rendered in format.
+func NewArchive(w io.Writer, format Format) *Archive {
+	return &Archive{
+		zw:     zip.NewWriter(w),
+		format: format,
+		names:  make(map[string]int),
+	}
+}
+
+// Add renders a recipe and adds it to the archive.
+func (a *Archive) Add(recipe *domain.Recipe) error {
+	content, err := Render(recipe, a.format)
+	if err != nil {
+		return err
+	}
+
+	name := Filename(recipe, a.format)
+	a.names[name]++
+	if count := a.names[name]; count > 1 {
+		ext := "." + a.format.Extension()
+		name = strings.TrimSuffix(name, ext) + "-" + strconv.Itoa(count) + ext
+	}
+
+	f, err := a.zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: recipe.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("create archive entry: %w", err)
+	}
+	if _, err := f.Write(content); err != nil {
+		return fmt.Errorf("write archive entry: %w", err)
+	}
+	return nil
+}
+
+// Close finishes the archive.
+func (a *Archive) Close() error {
+	return a.zw.Close()
+}
+
+// schema.org JSON-LD
+
+type jsonLDRecipe struct {
+	Context            string           `json:"@context"`
+	Type               string           `json:"@type"`
+	Name               string           `json:"name"`
+	Description        string           `json:"description,omitempty"`
+	Image              string           `json:"image,omitempty"`
+	PrepTime           string           `json:"prepTime,omitempty"`
+	CookTime           string           `json:"cookTime,omitempty"`
+	TotalTime          string           `json:"totalTime,omitempty"`
+	RecipeYield        []string         `json:"recipeYield,omitempty"`
+	RecipeCuisine      string           `json:"recipeCuisine,omitempty"`
+	Keywords           string           `json:"keywords,omitempty"`
+	RecipeIngredient   []string         `json:"recipeIngredient"`
+	RecipeInstructions []jsonLDStep     `json:"recipeInstructions"`
+	Nutrition          *jsonLDNutrition `json:"nutrition,omitempty"`
+	DateCreated        string           `json:"dateCreated,omitempty"`
+	DateModified       string           `json:"dateModified,omitempty"`
+	Identifier         string           `json:"identifier,omitempty"`
+}
+
+type jsonLDStep struct {
+	Type string `json:"@type"`
+	Text string `json:"text"`
+}
+
+// jsonLDNutrition is per serving, as defined by schema.org.
+type jsonLDNutrition struct {
+	Type                string `json:"@type"`
+	Calories            string `json:"calories,omitempty"`
+	ProteinContent      string `json:"proteinContent,omitempty"`
+	CarbohydrateContent string `json:"carbohydrateContent,omitempty"`
+	FatContent          string `json:"fatContent,omitempty"`
+	FiberContent        string `json:"fiberContent,omitempty"`
+	SugarContent        string `json:"sugarContent,omitempty"`
+	SodiumContent       string `json:"sodiumContent,omitempty"`
+}
+
+func renderJSONLD(recipe *domain.Recipe) ([]byte, error) {
+	doc := jsonLDRecipe{
+		Context:            "https://schema.org",
+		Type:               "Recipe",
+		Name:               recipe.Name,
+		Description:        recipe.Description,
+		Image:              recipe.ImageURL,
+		PrepTime:           isoDuration(recipe.PrepTimeMinutes),
+		CookTime:           isoDuration(recipe.CookTimeMinutes),
+		TotalTime:          isoDuration(recipe.TotalTimeMinutes),
+		Keywords:           strings.Join(recipe.Tags, ", "),
+		RecipeIngredient:   make([]string, 0, len(recipe.IngredientLines)),
+		RecipeInstructions: make([]jsonLDStep, 0, len(recipe.Steps)),
+		Identifier:         recipe.ID.String(),
+	}
+
+	if recipe.Cuisine != nil {
+		doc.RecipeCuisine = recipe.Cuisine.Name
+	}
+	if recipe.Servings > 0 {
+		doc.RecipeYield = append(doc.RecipeYield, fmt.Sprintf("%d servings", recipe.Servings))
+	}
+	if recipe.YieldQuantity != nil {
+		doc.RecipeYield = append(doc.RecipeYield, strings.TrimSpace(formatNumber(*recipe.YieldQuantity)+" "+recipe.YieldUnit))
+	}
+	if !recipe.CreatedAt.IsZero() {
+		doc.DateCreated = recipe.CreatedAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	if !recipe.UpdatedAt.IsZero() {
+		doc.DateModified = recipe.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+
+	for _, line := range recipe.IngredientLines {
+		doc.RecipeIngredient = append(doc.RecipeIngredient, line.DisplayText())
+	}
+	for _, step := range recipe.Steps {
+		doc.RecipeInstructions = append(doc.RecipeInstructions, jsonLDStep{Type: "HowToStep", Text: step.Instruction})
+	}
+
+	if n := recipe.Nutrition; n != (domain.RecipeNutrition{}) {
+		doc.Nutrition = &jsonLDNutrition{
+			Type:                "NutritionInformation",
+			Calories:            amount(float64(n.CaloriesPerServing), "kcal"),
+			ProteinContent:      amount(n.ProteinG, "g"),
+			CarbohydrateContent: amount(n.CarbsG, "g"),
+			FatContent:          amount(n.FatG, "g"),
+			FiberContent:        amount(n.FiberG, "g"),
+			SugarContent:        amount(n.SugarG, "g"),
+			SodiumContent:       amount(n.SodiumMg, "mg"),
+		}
+	}
+
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// Markdown and plain text
+
+func renderMarkdown(recipe *domain.Recipe) string {
+	var sb strings.Builder
+
+	sb.WriteString("# " + recipe.Name + "\n\n")
+	if recipe.Description != "" {
+		sb.WriteString(recipe.Description + "\n\n")
+	}
+	for _, fact := range facts(recipe) {
+		sb.WriteString("- **" + fact[0] + ":** " + fact[1] + "\n")
+	}
+	sb.WriteString("\n")
+
+	if recipe.ImageURL != "" {
+		sb.WriteString("![" + recipe.Name + "](" + recipe.ImageURL + ")\n\n")
+	}
+
+	sb.WriteString("## Ingredients\n\n")
+	for _, line := range recipe.IngredientLines {
+		sb.WriteString("- " + line.DisplayText() + "\n")
+	}
+
+	sb.WriteString("\n## Instructions\n\n")
+	for i, step := range recipe.Steps {
+		sb.WriteString(strconv.Itoa(i+1) + ". " + step.Instruction + "\n")
+	}
+
+	if nutrition := nutritionFacts(recipe.Nutrition); len(nutrition) > 0 {
+		sb.WriteString("\n## Nutrition (per serving)\n\n")
+		for _, fact := range nutrition {
+			sb.WriteString("- " + fact[0] + ": " + fact[1] + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func renderText(recipe *domain.Recipe) string {
+	var sb strings.Builder
+
+	sb.WriteString(strings.ToUpper(recipe.Name) + "\n\n")
+	if recipe.Description != "" {
+		sb.WriteString(recipe.Description + "\n\n")
+	}
+	for _, fact := range facts(recipe) {
+		sb.WriteString(fact[0] + ": " + fact[1] + "\n")
+	}
+
+	sb.WriteString("\nINGREDIENTS\n")
+	for _, line := range recipe.IngredientLines {
+		sb.WriteString("* " + line.DisplayText() + "\n")
+	}
+
+	sb.WriteString("\nINSTRUCTIONS\n")
+	for i, step := range recipe.Steps {
+		sb.WriteString(strconv.Itoa(i+1) + ". " + step.Instruction + "\n")
+	}
+
+	if nutrition := nutritionFacts(recipe.Nutrition); len(nutrition) > 0 {
+		sb.WriteString("\nNUTRITION (PER SERVING)\n")
+		for _, fact := range nutrition {
+			sb.WriteString(fact[0] + ": " + fact[1] + "\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// facts lists the summary fields shared by the Markdown and text renderers.
+func facts(recipe *domain.Recipe) [][2]string {
+	var result [][2]string
+	if recipe.Cuisine != nil && recipe.Cuisine.Name != "" {
+		result = append(result, [2]string{"Cuisine", recipe.Cuisine.Name})
+	}
+	if recipe.Servings > 0 {
+		result = append(result, [2]string{"Servings", strconv.Itoa(recipe.Servings)})
+	}
+	if recipe.YieldQuantity != nil {
+		result = append(result, [2]string{"Yield", strings.TrimSpace(formatNumber(*recipe.YieldQuantity) + " " + recipe.YieldUnit)})
+	}
+	if recipe.PrepTimeMinutes > 0 {
+		result = append(result, [2]string{"Prep time", fmt.Sprintf("%d min", recipe.PrepTimeMinutes)})
+	}
+	if recipe.CookTimeMinutes > 0 {
+		result = append(result, [2]string{"Cook time", fmt.Sprintf("%d min", recipe.CookTimeMinutes)})
+	}
+	if recipe.TotalTimeMinutes > 0 {
+		result = append(result, [2]string{"Total time", fmt.Sprintf("%d min", recipe.TotalTimeMinutes)})
+	}
+	if len(recipe.Tags) > 0 {
+		result = append(result, [2]string{"Tags", strings.Join(recipe.Tags, ", ")})
+	}
+	return result
+}
+
+func nutritionFacts(n domain.RecipeNutrition) [][2]string {
+	var result [][2]string
+	add := func(label string, value float64, unit string) {
+		if value > 0 {
+			result = append(result, [2]string{label, amount(value, unit)})
+		}
+	}
+	add("Calories", float64(n.CaloriesPerServing), "kcal")
+	add("Protein", n.ProteinG, "g")
+	add("Carbohydrates", n.CarbsG, "g")
+	add("Fat", n.FatG, "g")
+	add("Fiber", n.FiberG, "g")
+	add("Sugar", n.SugarG, "g")
+	add("Sodium", n.SodiumMg, "mg")
+	return result
+}
+
+// isoDuration formats minutes as an ISO-8601 duration (e.g. PT1H30M).
+func isoDuration(minutes int) string {
+	if minutes <= 0 {
+		return ""
+	}
+	hours, mins := minutes/60, minutes%60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("PT%dM", mins)
+	case mins == 0:
+		return fmt.Sprintf("PT%dH", hours)
+	default:
+		return fmt.Sprintf("PT%dH%dM", hours, mins)
+	}
+}
+
+func amount(value float64, unit string) string {
+	if value <= 0 {
+		return ""
+	}
+	return formatNumber(value) + " " + unit
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
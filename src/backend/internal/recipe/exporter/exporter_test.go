@@ -0,0 +1,166 @@
+package exporter_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/exporter"
+	"github.com/platepilot/backend/internal/recipe/importer"
+)
+
+func TestRender_JSONLD_RoundTripsThroughImporter(t *testing.T) {
+	recipe := givenRecipe("Lemon Risotto")
+
+	content, err := exporter.Render(recipe, exporter.FormatJSONLD)
+	thenNoError(t, err)
+
+	input, err := importer.ExtractRecipe(content)
+	thenNoError(t, err)
+
+	if input.GetName() != recipe.Name || input.GetDescription() != recipe.Description {
+		t.Fatalf("expected name/description to round-trip, got %q / %q", input.GetName(), input.GetDescription())
+	}
+	if input.GetPrepTimeMinutes() != 10 || input.GetCookTimeMinutes() != 95 {
+		t.Fatalf("expected prep 10 / cook 95, got %d / %d", input.GetPrepTimeMinutes(), input.GetCookTimeMinutes())
+	}
+	if input.GetServings() != 4 {
+		t.Fatalf("expected 4 servings, got %d", input.GetServings())
+	}
+	if input.GetCuisineName() != "Italian" {
+		t.Fatalf("expected cuisine Italian, got %q", input.GetCuisineName())
+	}
+	if input.GetImageUrl() != recipe.ImageURL {
+		t.Fatalf("expected image %q, got %q", recipe.ImageURL, input.GetImageUrl())
+	}
+
+	lines := input.GetIngredientLines()
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 ingredient lines, got %d", len(lines))
+	}
+	for i, want := range []string{"300 g arborio rice", "1.5 l stock, warm", "1 lemon (optional)"} {
+		if lines[i].GetIngredientName() != want {
+			t.Fatalf("line %d: expected %q, got %q", i, want, lines[i].GetIngredientName())
+		}
+	}
+
+	steps := input.GetSteps()
+	if len(steps) != 2 || steps[1].GetInstruction() != "Add the stock ladle by ladle." {
+		t.Fatalf("unexpected steps %+v", steps)
+	}
+
+	tags := input.GetTags()
+	if len(tags) != 2 || tags[0] != "comfort" || tags[1] != "vegetarian" {
+		t.Fatalf("unexpected tags %v", tags)
+	}
+
+	nutrition := input.GetNutrition()
+	if nutrition.GetCaloriesPerServing() != 450 || nutrition.GetCaloriesTotal() != 1800 {
+		t.Fatalf("unexpected calories %d / %d", nutrition.GetCaloriesPerServing(), nutrition.GetCaloriesTotal())
+	}
+	if nutrition.GetProteinG() != 12.5 {
+		t.Fatalf("expected protein 12.5, got %v", nutrition.GetProteinG())
+	}
+}
+
+func TestRender_Markdown_IncludesSections(t *testing.T) {
+	recipe := givenRecipe("Lemon Risotto")
+
+	content, err := exporter.Render(recipe, exporter.FormatMarkdown)
+	thenNoError(t, err)
+
+	text := string(content)
+	for _, want := range []string{"# Lemon Risotto", "## Ingredients", "- 300 g arborio rice", "## Instructions", "1. Toast the rice."} {
+		if !strings.Contains(text, want) {
+			t.Fatalf("expected markdown to contain %q, got:\n%s", want, text)
+		}
+	}
+}
+
+func TestParseFormat_Unknown_ReturnsError(t *testing.T) {
+	if _, err := exporter.ParseFormat("pdf"); err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+
+	format, err := exporter.ParseFormat("")
+	thenNoError(t, err)
+	if format != exporter.FormatJSONLD {
+		t.Fatalf("expected default format jsonld, got %q", format)
+	}
+}
+
+func TestArchive_DuplicateNames_AreDisambiguated(t *testing.T) {
+	var buf bytes.Buffer
+	archive := exporter.NewArchive(&buf, exporter.FormatText)
+
+	thenNoError(t, archive.Add(givenRecipe("Soup")))
+	thenNoError(t, archive.Add(givenRecipe("Soup")))
+	thenNoError(t, archive.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	thenNoError(t, err)
+	if len(zr.File) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(zr.File))
+	}
+	if zr.File[0].Name != "soup.txt" || zr.File[1].Name != "soup-2.txt" {
+		t.Fatalf("unexpected entry names %q, %q", zr.File[0].Name, zr.File[1].Name)
+	}
+
+	f, err := zr.File[0].Open()
+	thenNoError(t, err)
+	defer f.Close()
+	body, err := io.ReadAll(f)
+	thenNoError(t, err)
+	if !strings.Contains(string(body), "SOUP") {
+		t.Fatalf("expected entry to contain recipe, got %q", body)
+	}
+}
+
+// Helpers
+
+func givenRecipe(name string) *domain.Recipe {
+	rice, stock, lemon := 300.0, 1.5, 1.0
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	return &domain.Recipe{
+		ID:               uuid.New(),
+		UserID:           uuid.New(),
+		Name:             name,
+		Description:      "Creamy and bright.",
+		PrepTimeMinutes:  10,
+		CookTimeMinutes:  95,
+		TotalTimeMinutes: 105,
+		Servings:         4,
+		Cuisine:          &domain.Cuisine{ID: uuid.New(), Name: "Italian"},
+		IngredientLines: []domain.RecipeIngredientLine{
+			{Ingredient: domain.Ingredient{Name: "arborio rice"}, QuantityValue: &rice, Unit: "g"},
+			{Ingredient: domain.Ingredient{Name: "stock"}, QuantityValue: &stock, Unit: "l", Note: "warm"},
+			{Ingredient: domain.Ingredient{Name: "lemon"}, QuantityValue: &lemon, IsOptional: true},
+		},
+		Steps: []domain.RecipeStep{
+			{StepIndex: 1, Instruction: "Toast the rice."},
+			{StepIndex: 2, Instruction: "Add the stock ladle by ladle."},
+		},
+		Tags:     []string{"comfort", "vegetarian"},
+		ImageURL: "https://example.com/risotto.jpg",
+		Nutrition: domain.RecipeNutrition{
+			CaloriesTotal:      1800,
+			CaloriesPerServing: 450,
+			ProteinG:           12.5,
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func thenNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
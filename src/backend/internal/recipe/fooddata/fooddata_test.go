@@ -0,0 +1,263 @@
+package fooddata_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/fooddata"
+)
+
+const fdcCSV = `"fdc_id","description","Energy (kcal)","Protein (g)","Carbohydrate, by difference (g)","Total lipid (fat) (g)","Fiber, total dietary (g)","Sugars, total (g)","Sodium, Na (mg)"
+"170457","Tomatoes, red, ripe, raw","18","0.88","3.89","0.2","1.2","2.63","5"
+"","","","","","","","",""
+"169145","Onions, raw","40","1.1","9.34","0.1","1.7","4.24","4"
+`
+
+const ciqualCSV = "\ufeffalim_code;alim_nom_eng;alim_nom_fr;Energy, Regulation EU No 1169/2011 (kcal/100g);Protein (g/100g);Carbohydrate (g/100g);Fat (g/100g);Sugars (g/100g);Fibres (g/100g);Salt (g/100g);Sodium (g/100g)\n" +
+	"20047;Tomato, raw;Tomate, crue;19,3;0,86;2,26;0,26;2,25;1,2;0,01;0,005\n" +
+	"11017;Sea salt;Sel de mer;0;0;0;0;0;0;97,7;< 39,1\n"
+
+func TestReader_FoodDataCentral_ReadsNutrientsPer100g(t *testing.T) {
+	reader := givenReader(t, fdcCSV)
+
+	tomato := thenNextFood(t, reader)
+	if tomato.SourceID != "170457" || tomato.Name != "Tomatoes, red, ripe, raw" {
+		t.Fatalf("unexpected food %+v", tomato)
+	}
+	if tomato.Calories != 18 || tomato.ProteinG != 0.88 || tomato.CarbsG != 3.89 || tomato.FatG != 0.2 ||
+		tomato.FiberG != 1.2 || tomato.SugarG != 2.63 || tomato.SodiumMg != 5 {
+		t.Fatalf("unexpected nutrients %+v", tomato)
+	}
+
+	blank := thenNextFood(t, reader)
+	if blank.SourceID != "" {
+		t.Fatalf("expected blank row to have no source ID, got %q", blank.SourceID)
+	}
+	if reader.Row() != 2 {
+		t.Fatalf("expected blank row to be counted, got row %d", reader.Row())
+	}
+}
+
+func TestReader_Ciqual_HandlesSemicolonsDecimalCommasAndGrams(t *testing.T) {
+	reader := givenReader(t, ciqualCSV)
+
+	tomato := thenNextFood(t, reader)
+	if tomato.SourceID != "20047" || tomato.Calories != 19.3 || tomato.ProteinG != 0.86 || tomato.FiberG != 1.2 {
+		t.Fatalf("unexpected food %+v", tomato)
+	}
+	if tomato.SodiumMg != 5 {
+		t.Fatalf("expected sodium converted to 5 mg, got %v", tomato.SodiumMg)
+	}
+	if len(tomato.Synonyms) != 1 || tomato.Synonyms[0] != "Tomate, crue" {
+		t.Fatalf("expected French name as synonym, got %v", tomato.Synonyms)
+	}
+
+	salt := thenNextFood(t, reader)
+	if salt.SodiumMg != 39100 {
+		t.Fatalf("expected upper bound sodium of 39100 mg, got %v", salt.SodiumMg)
+	}
+
+	if _, err := reader.Next(); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestReader_MissingNameColumn_ReturnsError(t *testing.T) {
+	_, err := fooddata.NewReader(strings.NewReader("fdc_id,Protein (g)\n1,2\n"))
+
+	if !errors.Is(err, fooddata.ErrMissingColumns) {
+		t.Fatalf("expected ErrMissingColumns, got %v", err)
+	}
+}
+
+func TestNormalize_FoldsAccentsPunctuationAndPlurals(t *testing.T) {
+	cases := map[string]string{
+		"Tomatoes":         "tomato",
+		"Crème fraîche":    "creme fraiche",
+		"Peaches, canned":  "peach canned",
+		"Cherries":         "cherry",
+		"  Couscous  ":     "couscous",
+		"Sun-dried tomato": "sun dried tomato",
+	}
+
+	for name, want := range cases {
+		if got := fooddata.Normalize(name); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestSynonyms_IncludesHeadsButNotFullName(t *testing.T) {
+	synonyms := fooddata.Synonyms("Tomatoes, red, ripe, raw", []string{"Tomate, crue"})
+
+	want := []string{"tomato", "tomate crue", "tomate"}
+	if strings.Join(synonyms, "|") != strings.Join(want, "|") {
+		t.Fatalf("expected %v, got %v", want, synonyms)
+	}
+}
+
+func TestImport_Interrupted_ResumesAfterSavedRows(t *testing.T) {
+	store := newFakeImportStore()
+	key := fooddata.ImportKey{Source: "fdc", FileName: "foods.csv", FileSize: int64(len(fdcCSV))}
+	store.failAfter = 1
+	importer := fooddata.NewImporter(store, discardLogger()).WithBatchSize(1)
+
+	if _, err := importer.Import(context.Background(), strings.NewReader(fdcCSV), key); err == nil {
+		t.Fatal("expected first import to fail")
+	}
+	if store.progress[key].RowsDone != 1 {
+		t.Fatalf("expected one row saved before the failure, got %d", store.progress[key].RowsDone)
+	}
+
+	store.failAfter = 0
+	stats, err := importer.Import(context.Background(), strings.NewReader(fdcCSV), key)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stats.Resumed != 1 || stats.Rows != 2 || stats.Skipped != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	if len(store.foods) != 2 || store.foods["169145"].Source != "fdc" {
+		t.Fatalf("expected both foods stored once, got %+v", store.foods)
+	}
+	if !store.progress[key].Completed {
+		t.Fatal("expected import to be completed")
+	}
+}
+
+func TestImport_Completed_SkipsFile(t *testing.T) {
+	store := newFakeImportStore()
+	key := fooddata.ImportKey{Source: "fdc", FileName: "foods.csv", FileSize: int64(len(fdcCSV))}
+	store.progress[key] = fooddata.ImportProgress{RowsDone: 3, Completed: true}
+
+	stats, err := fooddata.NewImporter(store, discardLogger()).Import(context.Background(), strings.NewReader(fdcCSV), key)
+
+	if err != nil || stats.Rows != 0 || store.saves != 0 {
+		t.Fatalf("expected completed import to be skipped, got %+v, %v", stats, err)
+	}
+}
+
+func TestMatchIngredients_ClassifiesByCandidates(t *testing.T) {
+	store := &fakeMatchStore{
+		ingredients: []domain.Ingredient{
+			{ID: uuid.New(), Name: "Tomatoes"},
+			{ID: uuid.New(), Name: "Onion"},
+			{ID: uuid.New(), Name: "Unicorn dust"},
+			{ID: uuid.New(), Name: "Milk"},
+		},
+		candidates: map[string][]fooddata.FoodCandidate{
+			"tomato": {{FoodID: uuid.New(), Exact: false}},
+			"onion":  {{FoodID: uuid.New()}, {FoodID: uuid.New()}},
+			"milk":   {{FoodID: uuid.New(), Exact: true}, {FoodID: uuid.New()}},
+		},
+		saved: make(map[uuid.UUID]domain.FoodMatchStatus),
+	}
+
+	stats, err := fooddata.NewMatcher(store, discardLogger()).MatchIngredients(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stats.Matched != 2 || stats.Ambiguous != 1 || stats.Unmatched != 1 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+	want := []domain.FoodMatchStatus{
+		domain.FoodMatchMatched, domain.FoodMatchAmbiguous, domain.FoodMatchUnmatched, domain.FoodMatchMatched,
+	}
+	for i, ingredient := range store.ingredients {
+		if store.saved[ingredient.ID] != want[i] {
+			t.Errorf("%s: expected %s, got %s", ingredient.Name, want[i], store.saved[ingredient.ID])
+		}
+	}
+}
+
+// Helpers
+
+func givenReader(t *testing.T, csv string) *fooddata.Reader {
+	t.Helper()
+	reader, err := fooddata.NewReader(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return reader
+}
+
+func thenNextFood(t *testing.T, reader *fooddata.Reader) domain.NutritionFood {
+	t.Helper()
+	food, err := reader.Next()
+	if err != nil {
+		t.Fatalf("expected a food, got %v", err)
+	}
+	return food
+}
+
+func discardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, nil))
+}
+
+type fakeImportStore struct {
+	foods     map[string]domain.NutritionFood
+	progress  map[fooddata.ImportKey]fooddata.ImportProgress
+	saves     int
+	failAfter int
+}
+
+func newFakeImportStore() *fakeImportStore {
+	return &fakeImportStore{
+		foods:    make(map[string]domain.NutritionFood),
+		progress: make(map[fooddata.ImportKey]fooddata.ImportProgress),
+	}
+}
+
+func (s *fakeImportStore) GetImportProgress(ctx context.Context, key fooddata.ImportKey) (fooddata.ImportProgress, error) {
+	return s.progress[key], nil
+}
+
+func (s *fakeImportStore) SaveFoods(ctx context.Context, key fooddata.ImportKey, foods []domain.NutritionFood, rowsDone int64) error {
+	if s.failAfter > 0 && s.saves >= s.failAfter {
+		return errors.New("connection lost")
+	}
+	s.saves++
+	for _, food := range foods {
+		s.foods[food.SourceID] = food
+	}
+	s.progress[key] = fooddata.ImportProgress{RowsDone: rowsDone}
+	return nil
+}
+
+func (s *fakeImportStore) CompleteImport(ctx context.Context, key fooddata.ImportKey) error {
+	progress := s.progress[key]
+	progress.Completed = true
+	s.progress[key] = progress
+	return nil
+}
+
+type fakeMatchStore struct {
+	ingredients []domain.Ingredient
+	candidates  map[string][]fooddata.FoodCandidate
+	saved       map[uuid.UUID]domain.FoodMatchStatus
+}
+
+func (s *fakeMatchStore) ListIngredientsToMatch(ctx context.Context, after uuid.UUID, limit int) ([]domain.Ingredient, error) {
+	if after != uuid.Nil {
+		return nil, nil
+	}
+	return s.ingredients, nil
+}
+
+func (s *fakeMatchStore) FindFoodCandidates(ctx context.Context, normalizedName string, limit int) ([]fooddata.FoodCandidate, error) {
+	return s.candidates[normalizedName], nil
+}
+
+func (s *fakeMatchStore) SaveMatch(ctx context.Context, ingredientID uuid.UUID, status domain.FoodMatchStatus, foodID *uuid.UUID, candidates []uuid.UUID) error {
+	s.saved[ingredientID] = status
+	return nil
+}
@@ -0,0 +1,173 @@
+package fooddata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// DefaultBatchSize is the number of rows written per transaction.
+const DefaultBatchSize = 500
+
+// ImportKey identifies an import of one file so it can be resumed.
+type ImportKey struct {
+	Source   string
+	FileName string
+	FileSize int64
+}
+
+// ImportProgress records how far an import got.
+type ImportProgress struct {
+	RowsDone  int64
+	Completed bool
+}
+
+// ImportStore persists reference foods and import progress.
+type ImportStore interface {
+	GetImportProgress(ctx context.Context, key ImportKey) (ImportProgress, error)
+	// SaveFoods upserts a batch of foods by source and source ID and records
+	// rowsDone in the same transaction.
+	SaveFoods(ctx context.Context, key ImportKey, foods []domain.NutritionFood, rowsDone int64) error
+	CompleteImport(ctx context.Context, key ImportKey) error
+}
+
+// ImportStats summarizes an import run.
+type ImportStats struct {
+	Rows    int64
+	Skipped int64
+	Resumed int64
+}
+
+// Importer loads reference food CSV files. Imports are idempotent, since
+// foods are upserted by their source ID, and resumable, since progress is
+// saved with every batch and already imported rows are skipped on the next
+// run.
+type Importer struct {
+	store     ImportStore
+	logger    *slog.Logger
+	batchSize int
+}
+
+// NewImporter creates a new importer.
+func NewImporter(store ImportStore, logger *slog.Logger) *Importer {
+	return &Importer{
+		store:     store,
+		logger:    logger,
+		batchSize: DefaultBatchSize,
+	}
+}
+
+// WithBatchSize sets the number of rows written per transaction.
+func (i *Importer) WithBatchSize(size int) *Importer {
+	if size > 0 {
+		i.batchSize = size
+	}
+	return i
+}
+
+// ImportFile imports the CSV file at path as the given source, e.g. "fdc" or
+// "ciqual".
+func (i *Importer) ImportFile(ctx context.Context, path, source string) (ImportStats, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return ImportStats{}, fmt.Errorf("open nutrition file: %w", err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return ImportStats{}, fmt.Errorf("stat nutrition file: %w", err)
+	}
+
+	key := ImportKey{
+		Source:   strings.ToLower(strings.TrimSpace(source)),
+		FileName: filepath.Base(path),
+		FileSize: info.Size(),
+	}
+	return i.Import(ctx, file, key)
+}
+
+// Import reads foods from r and stores them under key.
+func (i *Importer) Import(ctx context.Context, r io.Reader, key ImportKey) (ImportStats, error) {
+	var stats ImportStats
+	if key.Source == "" {
+		return stats, errors.New("nutrition source is required")
+	}
+
+	progress, err := i.store.GetImportProgress(ctx, key)
+	if err != nil {
+		return stats, fmt.Errorf("get import progress: %w", err)
+	}
+	if progress.Completed {
+		i.logger.Info("nutrition file already imported", "source", key.Source, "file", key.FileName)
+		return stats, nil
+	}
+	if progress.RowsDone > 0 {
+		i.logger.Info("resuming nutrition import", "source", key.Source, "file", key.FileName, "rowsDone", progress.RowsDone)
+		stats.Resumed = progress.RowsDone
+	}
+
+	reader, err := NewReader(r)
+	if err != nil {
+		return stats, err
+	}
+
+	batch := make([]domain.NutritionFood, 0, i.batchSize)
+	flush := func() error {
+		if err := i.store.SaveFoods(ctx, key, batch, reader.Row()); err != nil {
+			return fmt.Errorf("save foods at row %d: %w", reader.Row(), err)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		food, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return stats, fmt.Errorf("read row %d: %w", reader.Row()+1, err)
+		}
+		if reader.Row() <= progress.RowsDone {
+			continue
+		}
+
+		stats.Rows++
+		if food.SourceID == "" {
+			stats.Skipped++
+			continue
+		}
+		food.Source = key.Source
+		batch = append(batch, food)
+
+		if len(batch) >= i.batchSize {
+			if err := flush(); err != nil {
+				return stats, err
+			}
+			i.logger.Debug("imported nutrition rows", "source", key.Source, "rows", reader.Row())
+		}
+	}
+
+	if err := flush(); err != nil {
+		return stats, err
+	}
+	if err := i.store.CompleteImport(ctx, key); err != nil {
+		return stats, fmt.Errorf("complete import: %w", err)
+	}
+
+	i.logger.Info("nutrition import complete",
+		"source", key.Source,
+		"file", key.FileName,
+		"rows", stats.Rows,
+		"skipped", stats.Skipped,
+	)
+	return stats, nil
+}
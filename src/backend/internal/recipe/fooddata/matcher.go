@@ -0,0 +1,130 @@
+package fooddata
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// maxCandidates bounds the foods kept for review of an ambiguous match.
+const maxCandidates = 10
+
+// FoodCandidate is a reference food found for a normalized name.
+type FoodCandidate struct {
+	FoodID uuid.UUID
+	// Exact is set when the food's full name matched, rather than a synonym.
+	Exact bool
+}
+
+// MatchStore reads ingredients to match and saves the results.
+type MatchStore interface {
+	// ListIngredientsToMatch returns ingredients, ordered by ID and after the
+	// given ID, that have no match yet or were unmatched before.
+	ListIngredientsToMatch(ctx context.Context, after uuid.UUID, limit int) ([]domain.Ingredient, error)
+	FindFoodCandidates(ctx context.Context, normalizedName string, limit int) ([]FoodCandidate, error)
+	// SaveMatch stores an automatic match result. Matched ingredients get the
+	// food's nutrition; decisions made by users are never overwritten.
+	SaveMatch(ctx context.Context, ingredientID uuid.UUID, status domain.FoodMatchStatus, foodID *uuid.UUID, candidates []uuid.UUID) error
+}
+
+// MatchStats summarizes a matching run.
+type MatchStats struct {
+	Matched   int
+	Ambiguous int
+	Unmatched int
+}
+
+// Matcher links user ingredients to reference foods by normalized name and
+// synonyms. Ingredients matching a single food are linked directly; those
+// matching several are left for review.
+type Matcher struct {
+	store     MatchStore
+	logger    *slog.Logger
+	batchSize int
+}
+
+// NewMatcher creates a new matcher.
+func NewMatcher(store MatchStore, logger *slog.Logger) *Matcher {
+	return &Matcher{store: store, logger: logger, batchSize: DefaultBatchSize}
+}
+
+// MatchIngredients matches every ingredient that has no match yet. It can be
+// run repeatedly: earlier results are kept, and ingredients that matched
+// nothing are retried against newly imported foods.
+func (m *Matcher) MatchIngredients(ctx context.Context) (MatchStats, error) {
+	var stats MatchStats
+	after := uuid.Nil
+
+	for {
+		ingredients, err := m.store.ListIngredientsToMatch(ctx, after, m.batchSize)
+		if err != nil {
+			return stats, fmt.Errorf("list ingredients to match: %w", err)
+		}
+		if len(ingredients) == 0 {
+			break
+		}
+
+		for _, ingredient := range ingredients {
+			status, foodID, candidates, err := m.match(ctx, ingredient.Name)
+			if err != nil {
+				return stats, fmt.Errorf("match ingredient %s: %w", ingredient.ID, err)
+			}
+			if err := m.store.SaveMatch(ctx, ingredient.ID, status, foodID, candidates); err != nil {
+				return stats, fmt.Errorf("save match for ingredient %s: %w", ingredient.ID, err)
+			}
+
+			switch status {
+			case domain.FoodMatchMatched:
+				stats.Matched++
+			case domain.FoodMatchAmbiguous:
+				stats.Ambiguous++
+			default:
+				stats.Unmatched++
+			}
+		}
+		after = ingredients[len(ingredients)-1].ID
+	}
+
+	m.logger.Info("ingredient matching complete",
+		"matched", stats.Matched,
+		"ambiguous", stats.Ambiguous,
+		"unmatched", stats.Unmatched,
+	)
+	return stats, nil
+}
+
+func (m *Matcher) match(ctx context.Context, name string) (domain.FoodMatchStatus, *uuid.UUID, []uuid.UUID, error) {
+	normalized := Normalize(name)
+	if normalized == "" {
+		return domain.FoodMatchUnmatched, nil, nil, nil
+	}
+
+	candidates, err := m.store.FindFoodCandidates(ctx, normalized, maxCandidates)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	var exact []uuid.UUID
+	ids := make([]uuid.UUID, len(candidates))
+	for i, candidate := range candidates {
+		ids[i] = candidate.FoodID
+		if candidate.Exact {
+			exact = append(exact, candidate.FoodID)
+		}
+	}
+
+	switch {
+	case len(exact) == 1:
+		return domain.FoodMatchMatched, &exact[0], nil, nil
+	case len(ids) == 1:
+		return domain.FoodMatchMatched, &ids[0], nil, nil
+	case len(ids) > 1:
+		return domain.FoodMatchAmbiguous, nil, ids, nil
+	default:
+		return domain.FoodMatchUnmatched, nil, nil, nil
+	}
+}
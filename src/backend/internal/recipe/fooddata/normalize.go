@@ -0,0 +1,81 @@
+package fooddata
+
+import (
+	"strings"
+	"unicode"
+)
+
+var accentFolds = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ä': "a", 'ã': "a", 'å': "a",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i",
+	'ò': "o", 'ó': "o", 'ô': "o", 'ö': "o", 'õ': "o",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u",
+	'ç': "c", 'ñ': "n", 'ÿ': "y", 'œ': "oe", 'æ': "ae", 'ß': "ss",
+}
+
+// Normalize reduces a food or ingredient name to the form used for matching:
+// lowercase, without accents or punctuation, and with plural words made
+// singular, so "Tomatoes" and "tomato" compare equal.
+func Normalize(name string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(name) {
+		if folded, ok := accentFolds[c]; ok {
+			sb.WriteString(folded)
+		} else if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			sb.WriteRune(c)
+		} else {
+			sb.WriteByte(' ')
+		}
+	}
+
+	words := strings.Fields(sb.String())
+	for i, word := range words {
+		words[i] = singular(word)
+	}
+	return strings.Join(words, " ")
+}
+
+func singular(word string) string {
+	switch {
+	case len(word) <= 3 || strings.HasSuffix(word, "ss") || strings.HasSuffix(word, "us"):
+		return word
+	case strings.HasSuffix(word, "ies"):
+		return strings.TrimSuffix(word, "ies") + "y"
+	case strings.HasSuffix(word, "oes"), strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "shes"):
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s"):
+		return strings.TrimSuffix(word, "s")
+	}
+	return word
+}
+
+// Synonyms returns the normalized names a food can be matched by besides its
+// full name: the head of descriptive names such as "Tomatoes, red, ripe, raw"
+// and the synonyms listed in the source.
+func Synonyms(food string, listed []string) []string {
+	full := Normalize(food)
+	seen := map[string]bool{full: true, "": true}
+	var synonyms []string
+
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			synonyms = append(synonyms, name)
+		}
+	}
+
+	add(Normalize(head(food)))
+	for _, synonym := range listed {
+		add(Normalize(synonym))
+		add(Normalize(head(synonym)))
+	}
+	return synonyms
+}
+
+func head(name string) string {
+	if i := strings.IndexByte(name, ','); i >= 0 {
+		return name[:i]
+	}
+	return name
+}
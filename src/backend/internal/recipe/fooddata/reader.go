@@ -0,0 +1,214 @@
+// Package fooddata loads nutrition reference databases, such as USDA FoodData
+// Central or CIQUAL CSV dumps, and links user ingredients to their foods.
+package fooddata
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// ErrMissingColumns is returned when a CSV header has no ID or name column.
+var ErrMissingColumns = errors.New("csv header needs an id and a name column")
+
+type field int
+
+const (
+	fieldID field = iota
+	fieldName
+	fieldSynonyms
+	fieldCalories
+	fieldProtein
+	fieldCarbs
+	fieldFat
+	fieldFiber
+	fieldSugar
+	fieldSodium
+)
+
+// Reader reads foods from a flat CSV export with one food per row and
+// nutrient values per 100 g. Columns are recognized by their header, so both
+// FoodData Central exports ("fdc_id", "description", "Protein (g)") and
+// CIQUAL tables ("alim_code", "alim_nom_eng", "Protein (g/100 g)") work. The
+// delimiter is detected from the header and decimal commas are accepted.
+type Reader struct {
+	csv         *csv.Reader
+	columns     map[field]int
+	sodiumGrams bool
+	row         int64
+}
+
+// NewReader reads the header from r and returns a reader for its rows.
+func NewReader(r io.Reader) (*Reader, error) {
+	buffered := bufio.NewReader(r)
+	header, err := buffered.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	header = strings.TrimPrefix(header, "\ufeff")
+
+	reader := csv.NewReader(io.MultiReader(strings.NewReader(header), buffered))
+	reader.Comma = detectDelimiter(header)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	names, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("parse header: %w", err)
+	}
+
+	fr := &Reader{csv: reader, columns: make(map[field]int)}
+	for i, name := range names {
+		key := headerKey(name)
+		f, ok := classifyColumn(key)
+		if !ok {
+			continue
+		}
+		if _, taken := fr.columns[f]; taken {
+			continue
+		}
+		fr.columns[f] = i
+		if f == fieldSodium {
+			unit := strings.TrimPrefix(key, "sodium")
+			fr.sodiumGrams = !strings.Contains(unit, "mg") && strings.Contains(unit, "g")
+		}
+	}
+
+	if _, ok := fr.columns[fieldID]; !ok {
+		return nil, ErrMissingColumns
+	}
+	if _, ok := fr.columns[fieldName]; !ok {
+		return nil, ErrMissingColumns
+	}
+
+	return fr, nil
+}
+
+// Next returns the next food, or io.EOF after the last row. Rows without an
+// ID or name are returned with an empty SourceID so callers can count them
+// without losing their place in the file.
+func (r *Reader) Next() (domain.NutritionFood, error) {
+	record, err := r.csv.Read()
+	if err != nil {
+		return domain.NutritionFood{}, err
+	}
+	r.row++
+
+	food := domain.NutritionFood{
+		SourceID: r.value(record, fieldID),
+		Name:     r.value(record, fieldName),
+		Calories: r.number(record, fieldCalories),
+		ProteinG: r.number(record, fieldProtein),
+		CarbsG:   r.number(record, fieldCarbs),
+		FatG:     r.number(record, fieldFat),
+		FiberG:   r.number(record, fieldFiber),
+		SugarG:   r.number(record, fieldSugar),
+		SodiumMg: r.number(record, fieldSodium),
+	}
+	if r.sodiumGrams {
+		food.SodiumMg *= 1000
+	}
+	if food.Name == "" {
+		food.SourceID = ""
+	}
+	if synonyms := r.value(record, fieldSynonyms); synonyms != "" {
+		food.Synonyms = strings.FieldsFunc(synonyms, func(c rune) bool { return c == '|' || c == ';' })
+	}
+
+	return food, nil
+}
+
+// Row returns the number of data rows read so far.
+func (r *Reader) Row() int64 {
+	return r.row
+}
+
+func (r *Reader) value(record []string, f field) string {
+	i, ok := r.columns[f]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// number parses nutrient values, which CIQUAL writes as "12,5", "< 0,5",
+// "traces" or "-".
+func (r *Reader) number(record []string, f field) float64 {
+	value := strings.TrimSpace(strings.TrimLeft(r.value(record, f), "<>~ "))
+	if value == "" {
+		return 0
+	}
+	if !strings.Contains(value, ".") {
+		value = strings.Replace(value, ",", ".", 1)
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		return 0
+	}
+	return number
+}
+
+func detectDelimiter(header string) rune {
+	best, bestCount := ',', strings.Count(header, ",")
+	for _, candidate := range []rune{';', '\t'} {
+		if count := strings.Count(header, string(candidate)); count > bestCount {
+			best, bestCount = candidate, count
+		}
+	}
+	return best
+}
+
+// headerKey lowercases a column name and drops everything but letters and
+// digits, so "Protein (g/100 g)" becomes "proteing100g".
+func headerKey(name string) string {
+	var sb strings.Builder
+	for _, c := range strings.ToLower(name) {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
+
+func classifyColumn(key string) (field, bool) {
+	switch key {
+	case "fdcid", "alimcode", "foodcode", "code", "id":
+		return fieldID, true
+	case "description", "alimnomeng", "foodname", "name":
+		return fieldName, true
+	case "synonyms", "alimnomfr", "commonnames":
+		return fieldSynonyms, true
+	case "calories", "energy", "kcal":
+		return fieldCalories, true
+	case "fat", "lipids", "totalfat":
+		return fieldFat, true
+	case "carbs":
+		return fieldCarbs, true
+	}
+
+	switch {
+	case strings.Contains(key, "kcal"):
+		return fieldCalories, true
+	case strings.HasPrefix(key, "protein"):
+		return fieldProtein, true
+	case strings.HasPrefix(key, "carbohydrate"):
+		return fieldCarbs, true
+	case strings.HasPrefix(key, "fatg"), strings.HasPrefix(key, "totallipid"):
+		return fieldFat, true
+	case strings.Contains(key, "fiber"), strings.Contains(key, "fibre"):
+		return fieldFiber, true
+	case strings.HasPrefix(key, "sugar"):
+		return fieldSugar, true
+	case strings.HasPrefix(key, "sodium"):
+		return fieldSodium, true
+	}
+	return 0, false
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// Bulk import result statuses.
+const (
+	ImportStatusCreated = "created"
+	ImportStatusUpdated = "updated"
+	ImportStatusSkipped = "skipped"
+	ImportStatusError   = "error"
+)
+
+// ImportOptions controls how a bulk import treats its items.
+type ImportOptions struct {
+	// DryRun reports what the import would do without writing anything.
+	DryRun bool
+	// UpdateExisting updates the recipe an item matches instead of skipping
+	// the item.
+	UpdateExisting bool
+}
+
+// BulkImportRecipes imports a stream of recipes into the user's library. The
+// first message carries the options; every following one carries a recipe.
+// Each recipe is created, updated or skipped on its own, so one bad item does
+// not stop the import, and the response reports the outcome of every item.
+func (h *GRPCHandler) BulkImportRecipes(stream grpc.ClientStreamingServer[pb.BulkImportRecipesRequest, pb.BulkImportRecipesResponse]) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Errorf(codes.InvalidArgument, "import options are required")
+	}
+	if err != nil {
+		return err
+	}
+
+	options := first.GetOptions()
+	if options == nil {
+		return status.Errorf(codes.InvalidArgument, "the first message must carry the import options")
+	}
+
+	userID, err := uuid.Parse(options.GetUserId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	imp := h.NewRecipeImport(userID, ImportOptions{
+		DryRun:         options.GetDryRun(),
+		UpdateExisting: options.GetUpdateExisting(),
+	})
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		item := req.GetRecipe()
+		if item == nil {
+			return status.Errorf(codes.InvalidArgument, "import options may only be sent once")
+		}
+		imp.Import(ctx, item)
+	}
+
+	resp := imp.Response()
+	h.logger.Info("recipes imported",
+		"userId", userID,
+		"dryRun", resp.GetDryRun(),
+		"created", resp.GetCreated(),
+		"updated", resp.GetUpdated(),
+		"skipped", resp.GetSkipped(),
+		"failed", resp.GetFailed(),
+	)
+
+	return stream.SendAndClose(resp)
+}
+
+// RecipeImport imports recipes into one user's library one item at a time and
+// keeps the results. Items are matched against the library by external ID,
+// then by name.
+type RecipeImport struct {
+	h        *GRPCHandler
+	userID   uuid.UUID
+	options  ImportOptions
+	seen     map[string]struct{}
+	response *pb.BulkImportRecipesResponse
+}
+
+// NewRecipeImport starts a bulk import into the user's library.
+func (h *GRPCHandler) NewRecipeImport(userID uuid.UUID, options ImportOptions) *RecipeImport {
+	return &RecipeImport{
+		h:        h,
+		userID:   userID,
+		options:  options,
+		seen:     make(map[string]struct{}),
+		response: &pb.BulkImportRecipesResponse{DryRun: options.DryRun},
+	}
+}
+
+// Import imports one item and returns its result.
+func (i *RecipeImport) Import(ctx context.Context, item *pb.PortableRecipe) *pb.BulkImportResult {
+	result := &pb.BulkImportResult{
+		Index:      int32(len(i.response.Results)),
+		ExternalId: strings.TrimSpace(item.GetExternalId()),
+		Name:       strings.TrimSpace(item.GetRecipe().GetName()),
+	}
+
+	if err := i.importItem(ctx, result, item.GetRecipe()); err != nil {
+		result.Status = ImportStatusError
+		result.Message = status.Convert(err).Message()
+	}
+
+	switch result.Status {
+	case ImportStatusCreated:
+		i.response.Created++
+	case ImportStatusUpdated:
+		i.response.Updated++
+	case ImportStatusSkipped:
+		i.response.Skipped++
+	default:
+		i.response.Failed++
+	}
+	i.response.Results = append(i.response.Results, result)
+	return result
+}
+
+// Response returns the results of every item imported so far.
+func (i *RecipeImport) Response() *pb.BulkImportRecipesResponse {
+	return i.response
+}
+
+// importItem creates, updates or skips the recipe of an item and records the
+// outcome in result.
+func (i *RecipeImport) importItem(ctx context.Context, result *pb.BulkImportResult, input *pb.RecipeInput) error {
+	if input == nil {
+		return status.Errorf(codes.InvalidArgument, "recipe is required")
+	}
+	if result.Name == "" {
+		return status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	key := "name:" + strings.ToLower(result.Name)
+	if result.ExternalId != "" {
+		key = "external:" + result.ExternalId
+	}
+	if _, ok := i.seen[key]; ok {
+		result.Status = ImportStatusSkipped
+		result.Message = "duplicate of an earlier item"
+		return nil
+	}
+	i.seen[key] = struct{}{}
+
+	existingID, trashed, err := i.h.repo.FindImportedRecipe(ctx, i.userID, result.ExternalId, result.Name)
+	if err != nil && !errors.Is(err, repository.ErrRecipeNotFound) {
+		i.h.logger.Error("failed to match imported recipe", "error", err, "externalId", result.ExternalId)
+		return status.Errorf(codes.Internal, "failed to match recipe")
+	}
+	exists := err == nil
+	if exists {
+		result.RecipeId = existingID.String()
+	}
+
+	switch {
+	case exists && trashed:
+		result.Status = ImportStatusSkipped
+		result.Message = "matches a recipe in the trash"
+		return nil
+	case exists && !i.options.UpdateExisting:
+		result.Status = ImportStatusSkipped
+		result.Message = "recipe already exists"
+		return nil
+	}
+
+	if i.options.DryRun {
+		if err := i.h.validateRecipeInput(ctx, i.userID, input); err != nil {
+			return err
+		}
+	} else if exists {
+		if _, err := i.h.updateRecipe(ctx, i.userID, existingID, input); err != nil {
+			return err
+		}
+	} else {
+		recipe, err := i.h.buildRecipeFromInput(ctx, i.userID, input)
+		if err != nil {
+			return err
+		}
+		recipe.ExternalID = result.ExternalId
+		if _, err := i.h.insertRecipe(ctx, recipe); err != nil {
+			return err
+		}
+		result.RecipeId = recipe.ID.String()
+	}
+
+	result.Status = ImportStatusCreated
+	if exists {
+		result.Status = ImportStatusUpdated
+	}
+	return nil
+}
@@ -0,0 +1,450 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// ListCollections lists the user's collections and those shared with them.
+func (h *GRPCHandler) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	collections, err := h.repo.ListCollections(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to list collections", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list collections")
+	}
+
+	resp := &pb.ListCollectionsResponse{
+		Collections: make([]*pb.Collection, len(collections)),
+	}
+	for i := range collections {
+		resp.Collections[i] = toCollectionResponse(&collections[i])
+	}
+
+	return resp, nil
+}
+
+// GetCollection returns a collection with its recipes in order.
+func (h *GRPCHandler) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.Collection, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return h.getCollection(ctx, userID, collectionID)
+}
+
+// CreateCollection creates an empty collection.
+func (h *GRPCHandler) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.Collection, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	collection, err := collectionFromInput(req.GetCollection())
+	if err != nil {
+		return nil, err
+	}
+	collection.UserID = userID
+
+	if err := h.repo.CreateCollection(ctx, collection); err != nil {
+		h.logger.Error("failed to create collection", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create collection")
+	}
+
+	h.logger.Info("collection created", "collectionId", collection.ID, "name", collection.Name)
+
+	return toCollectionResponse(collection), nil
+}
+
+// UpdateCollection changes the name, description and cover image of a
+// collection.
+func (h *GRPCHandler) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.Collection, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := collectionFromInput(req.GetCollection())
+	if err != nil {
+		return nil, err
+	}
+	collection.ID = collectionID
+
+	if err := h.repo.UpdateCollection(ctx, userID, collection); err != nil {
+		return nil, h.collectionError(err, "update collection", collectionID)
+	}
+
+	return h.getCollection(ctx, userID, collectionID)
+}
+
+// DeleteCollection deletes a collection. The recipes in it are kept.
+func (h *GRPCHandler) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*emptypb.Empty, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.DeleteCollection(ctx, userID, collectionID); err != nil {
+		return nil, h.collectionError(err, "delete collection", collectionID)
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishCollectionDeleted(ctx, collectionID, userID); err != nil {
+			h.logger.Error("failed to publish collection deleted event",
+				"error", err,
+				"collectionId", collectionID,
+			)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SetCollectionPublic makes a collection the user owns readable by every
+// user, or private again, and publishes the change with the recipes in it.
+// It is not exposed over gRPC; the seeder publishes the recipe library with
+// it.
+func (h *GRPCHandler) SetCollectionPublic(ctx context.Context, userID, collectionID uuid.UUID, public bool) error {
+	if err := h.repo.SetCollectionPublic(ctx, userID, collectionID, public); err != nil {
+		return h.collectionError(err, "set collection visibility", collectionID)
+	}
+
+	collection, err := h.repo.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		return h.collectionError(err, "get collection", collectionID)
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishCollectionVisibilityChanged(ctx, collectionID, userID, public, collectionRecipeIDs(collection)); err != nil {
+			h.logger.Error("failed to publish collection visibility changed event",
+				"error", err,
+				"collectionId", collectionID,
+			)
+		}
+	}
+
+	h.logger.Info("collection visibility changed", "collectionId", collectionID, "public", public)
+
+	return nil
+}
+
+// AddRecipeToCollection appends one of the collection owner's recipes to the
+// collection.
+func (h *GRPCHandler) AddRecipeToCollection(ctx context.Context, req *pb.CollectionRecipeRequest) (*pb.Collection, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	if err := h.repo.AddCollectionRecipe(ctx, userID, collectionID, recipeID); err != nil {
+		return nil, h.collectionError(err, "add recipe to collection", collectionID)
+	}
+
+	collection, err := h.repo.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, h.collectionError(err, "get collection", collectionID)
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishCollectionRecipeAdded(ctx, collectionID, collection.UserID, recipeID); err != nil {
+			h.logger.Error("failed to publish collection recipe added event",
+				"error", err,
+				"collectionId", collectionID,
+				"recipeId", recipeID,
+			)
+		}
+	}
+
+	return toCollectionResponse(collection), nil
+}
+
+// RemoveRecipeFromCollection removes a recipe from a collection.
+func (h *GRPCHandler) RemoveRecipeFromCollection(ctx context.Context, req *pb.CollectionRecipeRequest) (*pb.Collection, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	if err := h.repo.RemoveCollectionRecipe(ctx, userID, collectionID, recipeID); err != nil {
+		return nil, h.collectionError(err, "remove recipe from collection", collectionID)
+	}
+
+	collection, err := h.repo.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, h.collectionError(err, "get collection", collectionID)
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishCollectionRecipeRemoved(ctx, collectionID, collection.UserID, recipeID); err != nil {
+			h.logger.Error("failed to publish collection recipe removed event",
+				"error", err,
+				"collectionId", collectionID,
+				"recipeId", recipeID,
+			)
+		}
+	}
+
+	return toCollectionResponse(collection), nil
+}
+
+// ReorderCollection puts the recipes of a collection in the given order.
+func (h *GRPCHandler) ReorderCollection(ctx context.Context, req *pb.ReorderCollectionRequest) (*pb.Collection, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	recipeIDs := make([]uuid.UUID, len(req.GetRecipeIds()))
+	for i, value := range req.GetRecipeIds() {
+		recipeIDs[i], err = uuid.Parse(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+		}
+	}
+
+	if err := h.repo.ReorderCollection(ctx, userID, collectionID, recipeIDs); err != nil {
+		return nil, h.collectionError(err, "reorder collection", collectionID)
+	}
+
+	return h.getCollection(ctx, userID, collectionID)
+}
+
+// ShareCollection shares a collection the user owns, and the recipes in it,
+// with another user found by email.
+func (h *GRPCHandler) ShareCollection(ctx context.Context, req *pb.ShareCollectionRequest) (*pb.CollectionShare, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.TrimSpace(req.GetEmail())
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	permission := domain.SharePermissionRead
+	if req.GetPermission() != "" {
+		permission = domain.SharePermission(req.GetPermission())
+		if !permission.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid permission: %s", req.GetPermission())
+		}
+	}
+
+	share, err := h.repo.ShareCollection(ctx, userID, collectionID, email, permission)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "no user with email %s", email)
+		case errors.Is(err, repository.ErrShareWithOwner):
+			return nil, status.Errorf(codes.InvalidArgument, "collection cannot be shared with its owner")
+		}
+		return nil, h.collectionError(err, "share collection", collectionID)
+	}
+
+	if h.publisher != nil {
+		h.publishCollectionShared(ctx, share)
+	}
+
+	h.logger.Info("collection shared", "collectionId", collectionID, "sharedWithUserId", share.SharedWithUserID, "permission", share.Permission)
+
+	return toCollectionShareResponse(share), nil
+}
+
+// ListCollectionShares lists who a collection the user owns is shared with.
+func (h *GRPCHandler) ListCollectionShares(ctx context.Context, req *pb.ListCollectionSharesRequest) (*pb.ListCollectionSharesResponse, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := h.repo.ListCollectionShares(ctx, userID, collectionID)
+	if err != nil {
+		return nil, h.collectionError(err, "list collection shares", collectionID)
+	}
+
+	resp := &pb.ListCollectionSharesResponse{
+		Shares: make([]*pb.CollectionShare, len(shares)),
+	}
+	for i := range shares {
+		resp.Shares[i] = toCollectionShareResponse(&shares[i])
+	}
+
+	return resp, nil
+}
+
+// RevokeCollectionShare removes another user's access to a collection.
+func (h *GRPCHandler) RevokeCollectionShare(ctx context.Context, req *pb.RevokeCollectionShareRequest) (*emptypb.Empty, error) {
+	userID, collectionID, err := parseCollectionIDs(req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	sharedWithUserID, err := uuid.Parse(req.GetSharedWithUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shared with user ID: %v", err)
+	}
+
+	if err := h.repo.RevokeCollectionShare(ctx, userID, collectionID, sharedWithUserID); err != nil {
+		if errors.Is(err, repository.ErrShareNotFound) {
+			return nil, status.Errorf(codes.NotFound, "collection share not found")
+		}
+		return nil, h.collectionError(err, "revoke collection share", collectionID)
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishCollectionShareRevoked(ctx, collectionID, userID, sharedWithUserID); err != nil {
+			h.logger.Error("failed to publish collection share revoked event",
+				"error", err,
+				"collectionId", collectionID,
+			)
+		}
+	}
+
+	h.logger.Info("collection share revoked", "collectionId", collectionID, "sharedWithUserId", sharedWithUserID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// publishCollectionShared publishes a share with the recipes it grants access
+// to. Failures are logged; the share already happened.
+func (h *GRPCHandler) publishCollectionShared(ctx context.Context, share *domain.CollectionShare) {
+	collection, err := h.repo.GetCollection(ctx, share.OwnerID, share.CollectionID)
+	if err != nil {
+		h.logger.Error("failed to get collection", "error", err, "collectionId", share.CollectionID)
+		return
+	}
+
+	if err := h.publisher.PublishCollectionShared(ctx, share, collectionRecipeIDs(collection)); err != nil {
+		h.logger.Error("failed to publish collection shared event",
+			"error", err,
+			"collectionId", share.CollectionID,
+		)
+	}
+}
+
+func collectionRecipeIDs(collection *domain.Collection) []uuid.UUID {
+	ids := make([]uuid.UUID, len(collection.Items))
+	for i, item := range collection.Items {
+		ids[i] = item.RecipeID
+	}
+	return ids
+}
+
+func (h *GRPCHandler) getCollection(ctx context.Context, userID, collectionID uuid.UUID) (*pb.Collection, error) {
+	collection, err := h.repo.GetCollection(ctx, userID, collectionID)
+	if err != nil {
+		return nil, h.collectionError(err, "get collection", collectionID)
+	}
+	return toCollectionResponse(collection), nil
+}
+
+// collectionError maps a repository error from a collection operation to a
+// gRPC status.
+func (h *GRPCHandler) collectionError(err error, action string, collectionID uuid.UUID) error {
+	switch {
+	case errors.Is(err, repository.ErrCollectionNotFound):
+		return status.Errorf(codes.NotFound, "collection not found")
+	case errors.Is(err, repository.ErrCollectionNotEditable):
+		return status.Errorf(codes.PermissionDenied, "collection is shared read-only")
+	case errors.Is(err, repository.ErrCollectionItemNotFound):
+		return status.Errorf(codes.NotFound, "recipe is not in the collection")
+	case errors.Is(err, repository.ErrCollectionOrderMismatch):
+		return status.Errorf(codes.InvalidArgument, "order must list every recipe in the collection once")
+	case errors.Is(err, repository.ErrRecipeNotFound):
+		return status.Errorf(codes.NotFound, "recipe not found")
+	}
+	h.logger.Error("failed to "+action, "error", err, "collectionId", collectionID)
+	return status.Errorf(codes.Internal, "failed to %s", action)
+}
+
+func parseCollectionIDs(userIDStr, collectionIDStr string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	collectionID, err := uuid.Parse(collectionIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid collection ID: %v", err)
+	}
+
+	return userID, collectionID, nil
+}
+
+func collectionFromInput(input *pb.CollectionInput) (*domain.Collection, error) {
+	name := strings.TrimSpace(input.GetName())
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "collection name is required")
+	}
+
+	return &domain.Collection{
+		Name:          name,
+		Description:   strings.TrimSpace(input.GetDescription()),
+		CoverImageURL: strings.TrimSpace(input.GetCoverImageUrl()),
+	}, nil
+}
+
+func toCollectionResponse(c *domain.Collection) *pb.Collection {
+	resp := &pb.Collection{
+		Id:            c.ID.String(),
+		UserId:        c.UserID.String(),
+		Name:          c.Name,
+		Description:   c.Description,
+		CoverImageUrl: c.CoverImageURL,
+		RecipeCount:   int32(c.RecipeCount),
+		Permission:    string(c.Permission),
+		CreatedAt:     c.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:     c.UpdatedAt.UTC().Format(time.RFC3339),
+		Public:        c.Public,
+	}
+
+	for _, item := range c.Items {
+		resp.Items = append(resp.Items, &pb.CollectionItem{
+			RecipeId:   item.RecipeID.String(),
+			RecipeName: item.RecipeName,
+			ImageUrl:   item.ImageURL,
+			Position:   int32(item.Position),
+		})
+	}
+
+	return resp
+}
+
+func toCollectionShareResponse(share *domain.CollectionShare) *pb.CollectionShare {
+	return &pb.CollectionShare{
+		CollectionId:     share.CollectionID.String(),
+		CollectionName:   share.CollectionName,
+		OwnerId:          share.OwnerID.String(),
+		OwnerEmail:       share.OwnerEmail,
+		SharedWithUserId: share.SharedWithUserID.String(),
+		SharedWithEmail:  share.SharedWithEmail,
+		Permission:       string(share.Permission),
+		CreatedAt:        share.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
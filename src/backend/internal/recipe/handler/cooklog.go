@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+const (
+	defaultCookLogLimit = 50
+	maxCookLogLimit     = 200
+)
+
+// LogCook records that the user cooked a recipe they have access to.
+func (h *GRPCHandler) LogCook(ctx context.Context, req *pb.LogCookRequest) (*pb.CookLogEntry, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	entry, err := cookLogEntryFromInput(req.GetEntry())
+	if err != nil {
+		return nil, err
+	}
+	entry.RecipeID = recipeID
+	entry.UserID = userID
+
+	if err := h.repo.LogCook(ctx, entry); err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to log cook", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to log cook")
+	}
+
+	h.publishCookStats(ctx, userID, recipeID)
+
+	h.logger.Info("cook logged", "recipeId", recipeID, "entryId", entry.ID)
+
+	return toCookLogEntryResponse(entry), nil
+}
+
+// ListCookLog lists the user's cook log, most recent first. When a recipe is
+// given, the response also carries its aggregates.
+func (h *GRPCHandler) ListCookLog(ctx context.Context, req *pb.ListCookLogRequest) (*pb.ListCookLogResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	var recipeID *uuid.UUID
+	if value := strings.TrimSpace(req.GetRecipeId()); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+		}
+		recipeID = &id
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultCookLogLimit
+	}
+	if limit > maxCookLogLimit {
+		limit = maxCookLogLimit
+	}
+
+	entries, err := h.repo.ListCookLog(ctx, userID, recipeID, limit)
+	if err != nil {
+		h.logger.Error("failed to list cook log", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list cook log")
+	}
+
+	resp := &pb.ListCookLogResponse{
+		Entries: make([]*pb.CookLogEntry, len(entries)),
+	}
+	for i := range entries {
+		resp.Entries[i] = toCookLogEntryResponse(&entries[i])
+	}
+
+	if recipeID != nil {
+		stats, err := h.repo.GetCookStats(ctx, userID, *recipeID)
+		if err != nil {
+			h.logger.Error("failed to get cook stats", "error", err, "recipeId", *recipeID)
+			return nil, status.Errorf(codes.Internal, "failed to list cook log")
+		}
+		resp.Stats = toCookStatsResponse(stats)
+	}
+
+	return resp, nil
+}
+
+// UpdateCookLogEntry changes one of the user's cook log entries.
+func (h *GRPCHandler) UpdateCookLogEntry(ctx context.Context, req *pb.UpdateCookLogEntryRequest) (*pb.CookLogEntry, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	entryID, err := uuid.Parse(req.GetEntryId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
+	}
+
+	entry, err := cookLogEntryFromInput(req.GetEntry())
+	if err != nil {
+		return nil, err
+	}
+	entry.ID = entryID
+	entry.UserID = userID
+
+	if err := h.repo.UpdateCookLogEntry(ctx, entry); err != nil {
+		if errors.Is(err, repository.ErrCookLogEntryNotFound) {
+			return nil, status.Errorf(codes.NotFound, "cook log entry not found")
+		}
+		h.logger.Error("failed to update cook log entry", "error", err, "entryId", entryID)
+		return nil, status.Errorf(codes.Internal, "failed to update cook log entry")
+	}
+
+	h.publishCookStats(ctx, userID, entry.RecipeID)
+
+	return toCookLogEntryResponse(entry), nil
+}
+
+// DeleteCookLogEntry deletes one of the user's cook log entries.
+func (h *GRPCHandler) DeleteCookLogEntry(ctx context.Context, req *pb.DeleteCookLogEntryRequest) (*emptypb.Empty, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	entryID, err := uuid.Parse(req.GetEntryId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid entry ID: %v", err)
+	}
+
+	recipeID, err := h.repo.DeleteCookLogEntry(ctx, userID, entryID)
+	if err != nil {
+		if errors.Is(err, repository.ErrCookLogEntryNotFound) {
+			return nil, status.Errorf(codes.NotFound, "cook log entry not found")
+		}
+		h.logger.Error("failed to delete cook log entry", "error", err, "entryId", entryID)
+		return nil, status.Errorf(codes.Internal, "failed to delete cook log entry")
+	}
+
+	h.publishCookStats(ctx, userID, recipeID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// publishCookStats recomputes the user's aggregates for a recipe and publishes
+// them. Failures are logged; the cook log itself was already saved.
+func (h *GRPCHandler) publishCookStats(ctx context.Context, userID, recipeID uuid.UUID) {
+	if h.publisher == nil {
+		return
+	}
+
+	stats, err := h.repo.GetCookStats(ctx, userID, recipeID)
+	if err != nil {
+		h.logger.Error("failed to get cook stats", "error", err, "recipeId", recipeID)
+		return
+	}
+
+	if err := h.publisher.PublishRecipeCookStatsUpdated(ctx, recipeID, userID, stats); err != nil {
+		h.logger.Error("failed to publish recipe cook stats updated event",
+			"error", err,
+			"recipeId", recipeID,
+		)
+	}
+}
+
+func cookLogEntryFromInput(input *pb.CookLogEntryInput) (*domain.CookLogEntry, error) {
+	if input == nil {
+		input = &pb.CookLogEntryInput{}
+	}
+
+	entry := &domain.CookLogEntry{
+		Servings: int(input.GetServings()),
+		Notes:    strings.TrimSpace(input.GetNotes()),
+	}
+
+	if value := strings.TrimSpace(input.GetCookedOn()); value != "" {
+		cookedOn, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid cooked on date: %v", err)
+		}
+		entry.CookedOn = cookedOn
+	} else {
+		entry.CookedOn = time.Now().UTC().Truncate(24 * time.Hour)
+	}
+
+	if entry.Servings < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "servings must not be negative")
+	}
+
+	if rating := int(input.GetRating()); rating != 0 {
+		if rating < domain.MinCookRating || rating > domain.MaxCookRating {
+			return nil, status.Errorf(codes.InvalidArgument, "rating must be between %d and %d", domain.MinCookRating, domain.MaxCookRating)
+		}
+		entry.Rating = &rating
+	}
+
+	return entry, nil
+}
+
+func toCookLogEntryResponse(entry *domain.CookLogEntry) *pb.CookLogEntry {
+	resp := &pb.CookLogEntry{
+		Id:         entry.ID.String(),
+		RecipeId:   entry.RecipeID.String(),
+		RecipeName: entry.RecipeName,
+		UserId:     entry.UserID.String(),
+		CookedOn:   entry.CookedOn.Format(time.DateOnly),
+		Servings:   int32(entry.Servings),
+		Notes:      entry.Notes,
+		CreatedAt:  entry.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  entry.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if entry.Rating != nil {
+		resp.Rating = int32(*entry.Rating)
+	}
+	return resp
+}
+
+func toCookStatsResponse(stats *domain.RecipeCookStats) *pb.RecipeCookStats {
+	resp := &pb.RecipeCookStats{
+		TimesCooked: int32(stats.TimesCooked),
+	}
+	if stats.LastCookedOn != nil {
+		resp.LastCookedOn = stats.LastCookedOn.Format(time.DateOnly)
+	}
+	if stats.AverageRating != nil {
+		resp.AverageRating = wrapperspb.Double(*stats.AverageRating)
+	}
+	return resp
+}
@@ -0,0 +1,436 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+	"github.com/platepilot/backend/internal/recipe/scaler"
+)
+
+const (
+	defaultCookSessionTTL = 12 * time.Hour
+	// cookSessionAttempts bounds how often a change is retried when another
+	// device changed the session at the same time.
+	cookSessionAttempts = 3
+
+	cookSessionActive    = "active"
+	cookSessionCompleted = "completed"
+)
+
+// StartCookSession starts cooking a recipe step by step, optionally scaled to
+// a number of servings. If the user is already cooking the recipe, the
+// running session is returned instead so a second device joins it.
+func (h *GRPCHandler) StartCookSession(ctx context.Context, req *pb.StartCookSessionRequest) (*pb.CookSession, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	servings := int(req.GetServings())
+	if servings < 0 || servings > maxScaleServings {
+		return nil, status.Errorf(codes.InvalidArgument, "servings must be between 0 (the recipe's servings) and %d", maxScaleServings)
+	}
+
+	recipe, err := h.repo.GetByID(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to start cook session")
+	}
+	if len(recipe.Steps) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "recipe has no steps to cook")
+	}
+
+	if session, err := h.activeCookSession(ctx, userID, recipeID); err != nil || session != nil {
+		if err != nil {
+			return nil, err
+		}
+		return h.toCookSessionResponse(session, recipe, time.Now().UTC())
+	}
+
+	now := time.Now().UTC()
+	session := &domain.CookSession{
+		UserID:      userID,
+		RecipeID:    recipeID,
+		RecipeName:  recipe.Name,
+		Servings:    servings,
+		CurrentStep: recipe.Steps[0].StepIndex,
+		Timers:      domain.NewCookTimers(recipe.Steps),
+		ExpiresAt:   now.Add(h.cookSessionTTL),
+	}
+
+	// Validate the scaling before the session is stored.
+	if _, err := h.toCookSessionResponse(session, recipe, now); err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.CreateCookSession(ctx, session); err != nil {
+		if errors.Is(err, repository.ErrCookSessionExists) {
+			// Another device started the session first.
+			existing, err := h.activeCookSession(ctx, userID, recipeID)
+			if err != nil {
+				return nil, err
+			}
+			if existing == nil {
+				// It finished or expired again before it could be joined.
+				return nil, status.Errorf(codes.Aborted, "cook session was changed on another device")
+			}
+			return h.toCookSessionResponse(existing, recipe, time.Now().UTC())
+		}
+		h.logger.Error("failed to create cook session", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to start cook session")
+	}
+
+	h.logger.Info("cook session started", "sessionId", session.ID, "recipeId", recipeID)
+
+	return h.toCookSessionResponse(session, recipe, now)
+}
+
+// GetCookSession returns the current state of a cook session, with timers
+// computed at the server's time.
+func (h *GRPCHandler) GetCookSession(ctx context.Context, req *pb.CookSessionRequest) (*pb.CookSession, error) {
+	userID, sessionID, err := parseCookSessionIDs(req.GetUserId(), req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	session, recipe, err := h.getCookSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.toCookSessionResponse(session, recipe, time.Now().UTC())
+}
+
+// ListCookSessions lists the user's active cook sessions so another device
+// can pick one up.
+func (h *GRPCHandler) ListCookSessions(ctx context.Context, req *pb.ListCookSessionsRequest) (*pb.ListCookSessionsResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	sessions, err := h.repo.ListCookSessions(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to list cook sessions", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list cook sessions")
+	}
+
+	now := time.Now().UTC()
+	resp := &pb.ListCookSessionsResponse{
+		Sessions: make([]*pb.CookSession, len(sessions)),
+	}
+	for i := range sessions {
+		resp.Sessions[i] = toCookSessionStateResponse(&sessions[i], now)
+	}
+
+	return resp, nil
+}
+
+// MoveCookSessionStep advances, rewinds or jumps to a step. Relative moves
+// stop at the first and last step.
+func (h *GRPCHandler) MoveCookSessionStep(ctx context.Context, req *pb.MoveCookSessionStepRequest) (*pb.CookSession, error) {
+	delta := int(req.GetDelta())
+	stepIndex := int(req.GetStepIndex())
+	if delta == 0 && stepIndex == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "delta or step index is required")
+	}
+
+	return h.changeCookSession(ctx, req.GetUserId(), req.GetSessionId(), func(session *domain.CookSession, recipe *domain.Recipe, now time.Time) error {
+		position := 0
+		for i, step := range recipe.Steps {
+			if step.StepIndex == session.CurrentStep {
+				position = i
+			}
+		}
+
+		if stepIndex != 0 {
+			position = -1
+			for i, step := range recipe.Steps {
+				if step.StepIndex == stepIndex {
+					position = i
+				}
+			}
+			if position < 0 {
+				return status.Errorf(codes.NotFound, "step %d not found", stepIndex)
+			}
+		} else {
+			position = min(max(position+delta, 0), len(recipe.Steps)-1)
+		}
+
+		session.CurrentStep = recipe.Steps[position].StepIndex
+		return nil
+	})
+}
+
+// StartCookTimer starts or resumes the timer of a step. A timer that ran out
+// starts over.
+func (h *GRPCHandler) StartCookTimer(ctx context.Context, req *pb.CookTimerRequest) (*pb.CookSession, error) {
+	return h.changeCookTimer(ctx, req, (*domain.CookTimer).Start)
+}
+
+// PauseCookTimer pauses the timer of a step, keeping the time left.
+func (h *GRPCHandler) PauseCookTimer(ctx context.Context, req *pb.CookTimerRequest) (*pb.CookSession, error) {
+	return h.changeCookTimer(ctx, req, (*domain.CookTimer).Pause)
+}
+
+// CompleteCookSession finishes a session and records it in the cook log.
+func (h *GRPCHandler) CompleteCookSession(ctx context.Context, req *pb.CompleteCookSessionRequest) (*pb.CookSession, error) {
+	userID, sessionID, err := parseCookSessionIDs(req.GetUserId(), req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		session, recipe, err := h.getActiveCookSession(ctx, userID, sessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		entry, err := cookLogEntryFromInput(req.GetEntry())
+		if err != nil {
+			return nil, err
+		}
+		entry.RecipeID = session.RecipeID
+		entry.UserID = userID
+		if entry.Servings == 0 {
+			entry.Servings = session.Servings
+		}
+
+		now := time.Now().UTC()
+		for i := range session.Timers {
+			session.Timers[i].Pause(now)
+		}
+		session.ExpiresAt = now.Add(h.cookSessionTTL)
+
+		err = h.repo.CompleteCookSession(ctx, session, entry)
+		if errors.Is(err, repository.ErrCookSessionConflict) && attempt < cookSessionAttempts {
+			continue
+		}
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrCookSessionConflict):
+				return nil, status.Errorf(codes.Aborted, "cook session was changed on another device")
+			case errors.Is(err, repository.ErrRecipeNotFound):
+				return nil, status.Errorf(codes.NotFound, "recipe not found")
+			}
+			h.logger.Error("failed to complete cook session", "error", err, "sessionId", sessionID)
+			return nil, status.Errorf(codes.Internal, "failed to complete cook session")
+		}
+
+		h.publishCookStats(ctx, userID, session.RecipeID)
+
+		h.logger.Info("cook session completed", "sessionId", sessionID, "entryId", entry.ID)
+
+		return h.toCookSessionResponse(session, recipe, now)
+	}
+}
+
+// DeleteCookSession abandons a cook session without logging it.
+func (h *GRPCHandler) DeleteCookSession(ctx context.Context, req *pb.CookSessionRequest) (*emptypb.Empty, error) {
+	userID, sessionID, err := parseCookSessionIDs(req.GetUserId(), req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.DeleteCookSession(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, repository.ErrCookSessionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "cook session not found")
+		}
+		h.logger.Error("failed to delete cook session", "error", err, "sessionId", sessionID)
+		return nil, status.Errorf(codes.Internal, "failed to delete cook session")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *GRPCHandler) changeCookTimer(ctx context.Context, req *pb.CookTimerRequest, change func(*domain.CookTimer, time.Time)) (*pb.CookSession, error) {
+	stepIndex := int(req.GetStepIndex())
+	return h.changeCookSession(ctx, req.GetUserId(), req.GetSessionId(), func(session *domain.CookSession, recipe *domain.Recipe, now time.Time) error {
+		timer := session.Timer(stepIndex)
+		if timer == nil {
+			return status.Errorf(codes.NotFound, "step %d has no timer", stepIndex)
+		}
+		change(timer, now)
+		return nil
+	})
+}
+
+// changeCookSession applies a change to an active session and saves it. The
+// change is re-applied to a fresh copy when another device saved the session
+// in between.
+func (h *GRPCHandler) changeCookSession(ctx context.Context, userIDStr, sessionIDStr string, change func(*domain.CookSession, *domain.Recipe, time.Time) error) (*pb.CookSession, error) {
+	userID, sessionID, err := parseCookSessionIDs(userIDStr, sessionIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		session, recipe, err := h.getActiveCookSession(ctx, userID, sessionID)
+		if err != nil {
+			return nil, err
+		}
+
+		now := time.Now().UTC()
+		if err := change(session, recipe, now); err != nil {
+			return nil, err
+		}
+		session.ExpiresAt = now.Add(h.cookSessionTTL)
+
+		err = h.repo.UpdateCookSession(ctx, session)
+		if errors.Is(err, repository.ErrCookSessionConflict) && attempt < cookSessionAttempts {
+			continue
+		}
+		if err != nil {
+			if errors.Is(err, repository.ErrCookSessionConflict) {
+				return nil, status.Errorf(codes.Aborted, "cook session was changed on another device")
+			}
+			h.logger.Error("failed to update cook session", "error", err, "sessionId", sessionID)
+			return nil, status.Errorf(codes.Internal, "failed to update cook session")
+		}
+
+		return h.toCookSessionResponse(session, recipe, now)
+	}
+}
+
+// getActiveCookSession loads a session that can still be changed.
+func (h *GRPCHandler) getActiveCookSession(ctx context.Context, userID, sessionID uuid.UUID) (*domain.CookSession, *domain.Recipe, error) {
+	session, recipe, err := h.getCookSession(ctx, userID, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.IsCompleted() {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "cook session is already completed")
+	}
+	return session, recipe, nil
+}
+
+// getCookSession loads a session and the recipe being cooked.
+func (h *GRPCHandler) getCookSession(ctx context.Context, userID, sessionID uuid.UUID) (*domain.CookSession, *domain.Recipe, error) {
+	session, err := h.repo.GetCookSession(ctx, userID, sessionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrCookSessionNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "cook session not found")
+		}
+		h.logger.Error("failed to get cook session", "error", err, "sessionId", sessionID)
+		return nil, nil, status.Errorf(codes.Internal, "failed to get cook session")
+	}
+
+	recipe, err := h.repo.GetByID(ctx, userID, session.RecipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", session.RecipeID)
+		return nil, nil, status.Errorf(codes.Internal, "failed to get cook session")
+	}
+
+	return session, recipe, nil
+}
+
+// activeCookSession returns the user's running session for a recipe, or nil.
+func (h *GRPCHandler) activeCookSession(ctx context.Context, userID, recipeID uuid.UUID) (*domain.CookSession, error) {
+	session, err := h.repo.GetActiveCookSession(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrCookSessionNotFound) {
+			return nil, nil
+		}
+		h.logger.Error("failed to get active cook session", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to start cook session")
+	}
+	return session, nil
+}
+
+func parseCookSessionIDs(userIDStr, sessionIDStr string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	sessionID, err := uuid.Parse(sessionIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	return userID, sessionID, nil
+}
+
+// toCookSessionResponse returns the session with its recipe scaled to the
+// session's servings.
+func (h *GRPCHandler) toCookSessionResponse(session *domain.CookSession, recipe *domain.Recipe, now time.Time) (*pb.CookSession, error) {
+	resp := toCookSessionStateResponse(session, now)
+
+	if session.Servings == 0 || session.Servings == recipe.Servings {
+		resp.Recipe = toRecipeResponse(recipe)
+		return resp, nil
+	}
+
+	result, err := scaler.Scale(recipe, session.Servings)
+	if err != nil {
+		if errors.Is(err, scaler.ErrNoServings) {
+			return nil, status.Errorf(codes.FailedPrecondition, "recipe has no servings to scale from")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	resp.Recipe = toScaledRecipeResponse(result)
+
+	return resp, nil
+}
+
+// toCookSessionStateResponse converts a session without its recipe.
+func toCookSessionStateResponse(session *domain.CookSession, now time.Time) *pb.CookSession {
+	resp := &pb.CookSession{
+		Id:          session.ID.String(),
+		RecipeId:    session.RecipeID.String(),
+		RecipeName:  session.RecipeName,
+		UserId:      session.UserID.String(),
+		Servings:    int32(session.Servings),
+		CurrentStep: int32(session.CurrentStep),
+		Status:      cookSessionActive,
+		Version:     int32(session.Version),
+		StartedAt:   session.StartedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:   session.UpdatedAt.UTC().Format(time.RFC3339),
+		ExpiresAt:   session.ExpiresAt.UTC().Format(time.RFC3339),
+		ServerTime:  now.UTC().Format(time.RFC3339),
+	}
+	if session.IsCompleted() {
+		resp.Status = cookSessionCompleted
+		resp.CompletedAt = session.CompletedAt.UTC().Format(time.RFC3339)
+	}
+	if session.CookLogEntryID != nil {
+		resp.CookLogEntryId = session.CookLogEntryID.String()
+	}
+
+	for i := range session.Timers {
+		timer := &session.Timers[i]
+		timerResp := &pb.CookTimer{
+			StepIndex:        int32(timer.StepIndex),
+			Name:             timer.Name,
+			DurationSeconds:  int32(timer.DurationSeconds),
+			RemainingSeconds: int32(timer.Remaining(now)),
+			Running:          timer.IsRunning() && timer.Remaining(now) > 0,
+		}
+		if endsAt := timer.EndsAt(); endsAt != nil {
+			timerResp.EndsAt = endsAt.UTC().Format(time.RFC3339)
+		}
+		resp.Timers = append(resp.Timers, timerResp)
+	}
+
+	return resp
+}
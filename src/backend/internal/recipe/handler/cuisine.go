@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// UpdateCuisine renames one of the user's cuisines and republishes the recipes
+// using it. Renaming to the name of another cuisine is refused; those two
+// should be merged instead.
+func (h *GRPCHandler) UpdateCuisine(ctx context.Context, req *pb.UpdateCuisineRequest) (*pb.Cuisine, error) {
+	cuisine, err := h.ownCuisine(ctx, req.GetUserId(), req.GetCuisineId())
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	if name == cuisine.Name {
+		return toCuisineResponse(cuisine), nil
+	}
+
+	recipeIDs, err := h.repo.RenameCuisine(ctx, cuisine.UserID, cuisine.ID, name)
+	if err != nil {
+		return nil, h.cuisineError(err, "failed to update cuisine", cuisine.ID)
+	}
+
+	h.logger.Info("cuisine renamed", "cuisineId", cuisine.ID, "name", name, "recipes", len(recipeIDs))
+
+	if err := h.republishRecipes(ctx, cuisine.UserID, recipeIDs); err != nil {
+		return nil, err
+	}
+
+	return &pb.Cuisine{Id: cuisine.ID.String(), Name: name}, nil
+}
+
+// DeleteCuisine deletes one of the user's cuisines. Recipes are required to
+// have a cuisine, so while any recipe uses it a cuisine to reassign them to
+// must be given.
+func (h *GRPCHandler) DeleteCuisine(ctx context.Context, req *pb.DeleteCuisineRequest) (*pb.DeleteCuisineResponse, error) {
+	cuisine, err := h.ownCuisine(ctx, req.GetUserId(), req.GetCuisineId())
+	if err != nil {
+		return nil, err
+	}
+
+	var reassignTo *uuid.UUID
+	if idStr := strings.TrimSpace(req.GetReassignToCuisineId()); idStr != "" {
+		target, err := h.ownCuisine(ctx, req.GetUserId(), idStr)
+		if err != nil {
+			return nil, err
+		}
+		reassignTo = &target.ID
+	}
+
+	updated, err := h.deleteCuisine(ctx, cuisine, reassignTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCuisineResponse{UpdatedRecipes: int32(updated)}, nil
+}
+
+// MergeCuisines moves every recipe of the source cuisine to the target and
+// deletes the source.
+func (h *GRPCHandler) MergeCuisines(ctx context.Context, req *pb.MergeCuisinesRequest) (*pb.MergeCuisinesResponse, error) {
+	source, err := h.ownCuisine(ctx, req.GetUserId(), req.GetSourceCuisineId())
+	if err != nil {
+		return nil, err
+	}
+	target, err := h.ownCuisine(ctx, req.GetUserId(), req.GetTargetCuisineId())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.deleteCuisine(ctx, source, &target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MergeCuisinesResponse{
+		Cuisine:        toCuisineResponse(target),
+		UpdatedRecipes: int32(updated),
+	}, nil
+}
+
+// deleteCuisine deletes a cuisine, moving its recipes to reassignTo, and
+// republishes the moved recipes.
+func (h *GRPCHandler) deleteCuisine(ctx context.Context, cuisine *domain.Cuisine, reassignTo *uuid.UUID) (int, error) {
+	if reassignTo != nil && *reassignTo == cuisine.ID {
+		return 0, status.Errorf(codes.InvalidArgument, "cuisine cannot be merged into itself")
+	}
+
+	recipeIDs, err := h.repo.DeleteCuisine(ctx, cuisine.UserID, cuisine.ID, reassignTo)
+	if err != nil {
+		return 0, h.cuisineError(err, "failed to delete cuisine", cuisine.ID)
+	}
+
+	h.logger.Info("cuisine deleted", "cuisineId", cuisine.ID, "reassignedTo", reassignTo, "recipes", len(recipeIDs))
+
+	if err := h.republishRecipes(ctx, cuisine.UserID, recipeIDs); err != nil {
+		return 0, err
+	}
+	return len(recipeIDs), nil
+}
+
+// ownCuisine parses the IDs of a request and loads the user's cuisine.
+func (h *GRPCHandler) ownCuisine(ctx context.Context, userIDStr, cuisineIDStr string) (*domain.Cuisine, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	cuisineID, err := uuid.Parse(cuisineIDStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cuisine ID: %v", err)
+	}
+
+	cuisine, err := h.repo.GetCuisineByID(ctx, userID, cuisineID)
+	if err != nil {
+		if errors.Is(err, repository.ErrCuisineNotFound) {
+			return nil, status.Errorf(codes.NotFound, "cuisine not found")
+		}
+		h.logger.Error("failed to get cuisine", "error", err, "cuisineId", cuisineID)
+		return nil, status.Errorf(codes.Internal, "failed to get cuisine")
+	}
+	return cuisine, nil
+}
+
+// cuisineError maps repository errors of cuisine changes to gRPC errors.
+func (h *GRPCHandler) cuisineError(err error, message string, cuisineID uuid.UUID) error {
+	switch {
+	case errors.Is(err, repository.ErrCuisineNotFound):
+		return status.Errorf(codes.NotFound, "cuisine not found")
+	case errors.Is(err, repository.ErrCuisineNameTaken):
+		return status.Errorf(codes.AlreadyExists, "another cuisine has that name; merge them instead")
+	case errors.Is(err, repository.ErrCuisineInUse):
+		return status.Errorf(codes.FailedPrecondition, "cuisine is used by recipes; choose a cuisine to reassign them to")
+	case errors.Is(err, repository.ErrCuisineMergeSelf):
+		return status.Errorf(codes.InvalidArgument, "cuisine cannot be merged into itself")
+	}
+	h.logger.Error(message, "error", err, "cuisineId", cuisineID)
+	return status.Errorf(codes.Internal, "%s", message)
+}
+
+func toCuisineResponse(cuisine *domain.Cuisine) *pb.Cuisine {
+	return &pb.Cuisine{
+		Id:   cuisine.ID.String(),
+		Name: cuisine.Name,
+	}
+}
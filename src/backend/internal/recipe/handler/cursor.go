@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// recipeCursorToken is the wire form of a recipe cursor. Clients treat the
+// encoded token as opaque, so the field names are kept short.
+type recipeCursorToken struct {
+	Sort    domain.RecipeSort `json:"s"`
+	Order   domain.SortOrder  `json:"o"`
+	Key     string            `json:"k,omitempty"`
+	KeyNull bool              `json:"n,omitempty"`
+	ID      uuid.UUID         `json:"i"`
+}
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+// encodeRecipeCursor renders a cursor as an opaque URL-safe token.
+func encodeRecipeCursor(cursor domain.RecipeCursor) string {
+	// Every field is a string, bool or UUID, so marshaling cannot fail.
+	data, _ := json.Marshal(recipeCursorToken(cursor))
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeRecipeCursor parses a token made by encodeRecipeCursor.
+func decodeRecipeCursor(token string) (domain.RecipeCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return domain.RecipeCursor{}, errInvalidCursor
+	}
+
+	var decoded recipeCursorToken
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return domain.RecipeCursor{}, errInvalidCursor
+	}
+
+	cursor := domain.RecipeCursor(decoded)
+	if !cursor.IsValid() {
+		return domain.RecipeCursor{}, errInvalidCursor
+	}
+	return cursor, nil
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// GetDietaryProfile returns the user's dietary profile. Users who never saved
+// one get an empty profile.
+func (h *GRPCHandler) GetDietaryProfile(ctx context.Context, req *pb.GetDietaryProfileRequest) (*pb.DietaryProfile, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	profile, err := h.repo.GetDietaryProfile(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to get dietary profile", "error", err, "userId", userID)
+		return nil, status.Errorf(codes.Internal, "failed to get dietary profile")
+	}
+
+	return toDietaryProfileResponse(profile), nil
+}
+
+// UpdateDietaryProfile replaces the user's dietary profile and publishes it so
+// the meal planner applies it to suggestions.
+func (h *GRPCHandler) UpdateDietaryProfile(ctx context.Context, req *pb.UpdateDietaryProfileRequest) (*pb.DietaryProfile, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	profile := &domain.DietaryProfile{UserID: userID}
+
+	seenAllergies := make(map[uuid.UUID]bool)
+	for _, value := range req.GetAllergyIds() {
+		id, err := uuid.Parse(strings.TrimSpace(value))
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid allergy ID: %v", err)
+		}
+		if seenAllergies[id] {
+			continue
+		}
+		seenAllergies[id] = true
+
+		allergy, err := h.repo.GetAllergyByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, repository.ErrAllergyNotFound) {
+				return nil, status.Errorf(codes.NotFound, "allergy %s not found", id)
+			}
+			h.logger.Error("failed to get allergy", "error", err, "allergyId", id)
+			return nil, status.Errorf(codes.Internal, "failed to update dietary profile")
+		}
+		profile.Allergies = append(profile.Allergies, *allergy)
+	}
+
+	seenDiets := make(map[domain.Diet]bool)
+	for _, value := range req.GetDiets() {
+		diet := domain.Diet(strings.ToLower(strings.TrimSpace(value)))
+		if !diet.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid diet %q: must be vegetarian, vegan or pescatarian", value)
+		}
+		if seenDiets[diet] {
+			continue
+		}
+		seenDiets[diet] = true
+		profile.Diets = append(profile.Diets, diet)
+	}
+
+	seenIngredients := make(map[string]bool)
+	for _, value := range req.GetDislikedIngredients() {
+		name := strings.TrimSpace(value)
+		key := strings.ToLower(name)
+		if name == "" || seenIngredients[key] {
+			continue
+		}
+		seenIngredients[key] = true
+		profile.DislikedIngredients = append(profile.DislikedIngredients, name)
+	}
+
+	if err := h.repo.SaveDietaryProfile(ctx, profile); err != nil {
+		h.logger.Error("failed to save dietary profile", "error", err, "userId", userID)
+		return nil, status.Errorf(codes.Internal, "failed to update dietary profile")
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishDietaryProfileUpdated(ctx, profile); err != nil {
+			h.logger.Error("failed to publish dietary profile updated event",
+				"error", err,
+				"userId", userID,
+			)
+		}
+	}
+
+	h.logger.Info("dietary profile updated",
+		"userId", userID,
+		"allergies", len(profile.Allergies),
+		"diets", len(profile.Diets),
+		"dislikedIngredients", len(profile.DislikedIngredients),
+	)
+
+	return toDietaryProfileResponse(profile), nil
+}
+
+// ListAllergies returns the allergies a dietary profile can declare.
+func (h *GRPCHandler) ListAllergies(ctx context.Context, req *pb.ListAllergiesRequest) (*pb.ListAllergiesResponse, error) {
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	allergies, err := h.repo.ListAllergies(ctx)
+	if err != nil {
+		h.logger.Error("failed to list allergies", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list allergies")
+	}
+
+	resp := &pb.ListAllergiesResponse{Allergies: make([]*pb.Allergy, len(allergies))}
+	for i := range allergies {
+		resp.Allergies[i] = toAllergyResponse(&allergies[i])
+	}
+	return resp, nil
+}
+
+// dietaryProfileFilter returns the user's dietary profile for filtering
+// recipes, or nil when the caller asked to ignore it.
+func (h *GRPCHandler) dietaryProfileFilter(ctx context.Context, userID uuid.UUID, ignore bool) (*domain.DietaryProfile, error) {
+	if ignore {
+		return nil, nil
+	}
+
+	profile, err := h.repo.GetDietaryProfile(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to get dietary profile", "error", err, "userId", userID)
+		return nil, status.Errorf(codes.Internal, "failed to get dietary profile")
+	}
+	return profile, nil
+}
+
+func toDietaryProfileResponse(profile *domain.DietaryProfile) *pb.DietaryProfile {
+	resp := &pb.DietaryProfile{
+		Allergies:           make([]*pb.Allergy, len(profile.Allergies)),
+		Diets:               make([]string, len(profile.Diets)),
+		DislikedIngredients: profile.DislikedIngredients,
+	}
+	for i := range profile.Allergies {
+		resp.Allergies[i] = toAllergyResponse(&profile.Allergies[i])
+	}
+	for i, diet := range profile.Diets {
+		resp.Diets[i] = string(diet)
+	}
+	if !profile.UpdatedAt.IsZero() {
+		resp.UpdatedAt = profile.UpdatedAt.Format(time.RFC3339)
+	}
+	return resp
+}
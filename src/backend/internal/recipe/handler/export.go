@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/exporter"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+const (
+	exportPageSize  = 100
+	exportChunkSize = 64 << 10
+)
+
+// ExportRecipe renders a single recipe as JSON-LD, Markdown or plain text.
+func (h *GRPCHandler) ExportRecipe(ctx context.Context, req *pb.ExportRecipeRequest) (*pb.ExportRecipeResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	format, err := exporter.ParseFormat(req.GetFormat())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", req.GetFormat())
+	}
+
+	recipe, err := h.repo.GetByID(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to get recipe")
+	}
+
+	content, err := exporter.Render(recipe, format)
+	if err != nil {
+		h.logger.Error("failed to render recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to export recipe")
+	}
+
+	return &pb.ExportRecipeResponse{
+		Content:     content,
+		ContentType: format.ContentType(),
+		Filename:    exporter.Filename(recipe, format),
+	}, nil
+}
+
+// ExportRecipeArchive streams a zip archive of all recipes owned by the user.
+func (h *GRPCHandler) ExportRecipeArchive(req *pb.ExportRecipeArchiveRequest, stream grpc.ServerStreamingServer[pb.ExportChunk]) error {
+	ctx := stream.Context()
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	format, err := exporter.ParseFormat(req.GetFormat())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "unsupported export format: %s", req.GetFormat())
+	}
+
+	out := bufio.NewWriterSize(chunkWriter{stream: stream}, exportChunkSize)
+	archive := exporter.NewArchive(out, format)
+
+	for offset := 0; ; offset += exportPageSize {
+		recipes, err := h.repo.List(ctx, userID, domain.RecipeFilter{}, exportPageSize, offset)
+		if err != nil {
+			h.logger.Error("failed to list recipes for export", "error", err)
+			return status.Errorf(codes.Internal, "failed to export recipes")
+		}
+
+		for i := range recipes {
+			if recipes[i].UserID != userID {
+				continue
+			}
+			if err := archive.Add(&recipes[i]); err != nil {
+				h.logger.Error("failed to add recipe to archive", "error", err, "recipeId", recipes[i].ID)
+				return status.Errorf(codes.Internal, "failed to export recipes")
+			}
+		}
+
+		if len(recipes) < exportPageSize {
+			break
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		h.logger.Error("failed to finish export archive", "error", err)
+		return status.Errorf(codes.Internal, "failed to export recipes")
+	}
+	if err := out.Flush(); err != nil {
+		h.logger.Error("failed to send export archive", "error", err)
+		return status.Errorf(codes.Internal, "failed to export recipes")
+	}
+
+	return nil
+}
+
+// ExportRecipes streams every recipe the user owns in the portable form
+// BulkImportRecipes accepts. Each recipe's ID becomes its external ID, so
+// importing the export again updates or skips the recipes it already brought
+// over.
+func (h *GRPCHandler) ExportRecipes(req *pb.ExportRecipesRequest, stream grpc.ServerStreamingServer[pb.PortableRecipe]) error {
+	ctx := stream.Context()
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	for offset := 0; ; offset += exportPageSize {
+		recipes, err := h.repo.List(ctx, userID, domain.RecipeFilter{}, exportPageSize, offset)
+		if err != nil {
+			h.logger.Error("failed to list recipes for export", "error", err)
+			return status.Errorf(codes.Internal, "failed to export recipes")
+		}
+
+		for i := range recipes {
+			if recipes[i].UserID != userID {
+				continue
+			}
+			if err := stream.Send(toPortableRecipe(&recipes[i])); err != nil {
+				return err
+			}
+		}
+
+		if len(recipes) < exportPageSize {
+			break
+		}
+	}
+
+	return nil
+}
+
+// toPortableRecipe turns a stored recipe into import input that references
+// ingredients and the cuisine by name, so it can be imported into any library.
+func toPortableRecipe(r *domain.Recipe) *pb.PortableRecipe {
+	input := toRecipeInput(r)
+	input.MainIngredientId = ""
+	for _, line := range input.IngredientLines {
+		line.IngredientId = ""
+	}
+
+	return &pb.PortableRecipe{
+		ExternalId: r.ID.String(),
+		Recipe:     input,
+	}
+}
+
+// chunkWriter adapts an export stream to io.Writer.
+type chunkWriter struct {
+	stream grpc.ServerStreamingServer[pb.ExportChunk]
+}
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.stream.Send(&pb.ExportChunk{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
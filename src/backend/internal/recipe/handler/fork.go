@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// DuplicateRecipe copies a recipe the user can read into their own library.
+// The copy links back to the original so upstream changes can be pulled in
+// later.
+func (h *GRPCHandler) DuplicateRecipe(ctx context.Context, req *pb.DuplicateRecipeRequest) (*pb.Recipe, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	sourceID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	source, err := h.repo.GetByID(ctx, userID, sourceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", sourceID)
+		return nil, status.Errorf(codes.Internal, "failed to duplicate recipe")
+	}
+
+	recipe, err := h.copyRecipe(ctx, userID, source)
+	if err != nil {
+		return nil, err
+	}
+	if name := strings.TrimSpace(req.GetName()); name != "" {
+		recipe.Name = name
+	}
+	recipe.ForkedFrom = &domain.RecipeFork{
+		RecipeID:   source.ID,
+		RecipeName: source.Name,
+	}
+
+	if err := h.computeNutrition(ctx, recipe); err != nil {
+		return nil, err
+	}
+
+	if err := h.classifyDiet(ctx, recipe); err != nil {
+		return nil, err
+	}
+
+	recipe, err = h.insertRecipe(ctx, recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("recipe duplicated", "recipeId", recipe.ID, "forkedFrom", source.ID)
+
+	return toRecipeResponse(recipe), nil
+}
+
+// PullUpstreamRecipe replaces the content of a duplicated recipe with the
+// current version of the recipe it was copied from. The recipe keeps its own
+// name, and the replaced version stays available as a revision.
+func (h *GRPCHandler) PullUpstreamRecipe(ctx context.Context, req *pb.PullUpstreamRecipeRequest) (*pb.Recipe, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	ownerID, err := h.editableRecipeOwner(ctx, userID, recipeID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := h.repo.GetByID(ctx, ownerID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to pull upstream recipe")
+	}
+	if current.ForkedFrom == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "recipe was not duplicated from a recipe that is still available")
+	}
+	fork := *current.ForkedFrom
+
+	upstream, err := h.repo.GetByID(ctx, ownerID, fork.RecipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "upstream recipe is no longer available")
+		}
+		h.logger.Error("failed to get upstream recipe", "error", err, "recipeId", fork.RecipeID)
+		return nil, status.Errorf(codes.Internal, "failed to pull upstream recipe")
+	}
+
+	recipe, err := h.copyRecipe(ctx, ownerID, upstream)
+	if err != nil {
+		return nil, err
+	}
+	recipe.ID = recipeID
+	recipe.Name = current.Name
+
+	if err := h.computeNutrition(ctx, recipe); err != nil {
+		return nil, err
+	}
+
+	if err := h.classifyDiet(ctx, recipe); err != nil {
+		return nil, err
+	}
+
+	recipe, err = h.saveRecipe(ctx, recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.SyncForkRevision(ctx, recipeID, fork.LatestRevision); err != nil {
+		h.logger.Error("failed to sync fork revision", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to pull upstream recipe")
+	}
+	fork.Revision = fork.LatestRevision
+	fork.RecipeName = upstream.Name
+	recipe.ForkedFrom = &fork
+
+	h.logger.Info("upstream recipe pulled", "recipeId", recipeID, "forkedFrom", fork.RecipeID, "revision", fork.Revision)
+
+	return toRecipeResponse(recipe), nil
+}
+
+// copyRecipe deep-copies a recipe for ownerID. Cuisine and ingredients are
+// re-resolved by name against the owner's catalog; ingredients the owner does
+// not have yet are created with the allergens, nutrition facts and dietary
+// attributes of the source's. Nutrition is copied as is; callers recompute it
+// and the diet labels once they are done changing the copy.
+func (h *GRPCHandler) copyRecipe(ctx context.Context, ownerID uuid.UUID, source *domain.Recipe) (*domain.Recipe, error) {
+	ingredients := make(map[string]*domain.Ingredient)
+	resolveIngredient := func(original *domain.Ingredient) (*domain.Ingredient, error) {
+		if ingredient, ok := ingredients[original.Name]; ok {
+			return ingredient, nil
+		}
+		ingredient, err := h.repo.CopyIngredient(ctx, ownerID, original)
+		if err != nil {
+			h.logger.Error("failed to copy ingredient", "error", err, "ingredientName", original.Name)
+			return nil, status.Errorf(codes.Internal, "failed to create ingredient")
+		}
+		ingredients[original.Name] = ingredient
+		return ingredient, nil
+	}
+
+	recipe := &domain.Recipe{
+		UserID:           ownerID,
+		Name:             source.Name,
+		Description:      source.Description,
+		PrepTimeMinutes:  source.PrepTimeMinutes,
+		CookTimeMinutes:  source.CookTimeMinutes,
+		TotalTimeMinutes: source.TotalTimeMinutes,
+		Servings:         source.Servings,
+		YieldUnit:        source.YieldUnit,
+		Tags:             append([]string(nil), source.Tags...),
+		ImageURL:         source.ImageURL,
+		Nutrition:        source.Nutrition,
+	}
+	if source.YieldQuantity != nil {
+		quantity := *source.YieldQuantity
+		recipe.YieldQuantity = &quantity
+	}
+
+	cuisineName := defaultCuisineName
+	if source.Cuisine != nil {
+		cuisineName = source.Cuisine.Name
+	}
+	cuisine, err := h.repo.GetOrCreateCuisine(ctx, ownerID, cuisineName)
+	if err != nil {
+		h.logger.Error("failed to get or create cuisine", "error", err, "cuisineName", cuisineName)
+		return nil, status.Errorf(codes.Internal, "failed to create cuisine")
+	}
+	recipe.Cuisine = cuisine
+
+	recipe.IngredientLines = make([]domain.RecipeIngredientLine, len(source.IngredientLines))
+	for i, line := range source.IngredientLines {
+		ingredient, err := resolveIngredient(&line.Ingredient)
+		if err != nil {
+			return nil, err
+		}
+		line.ID = uuid.Nil
+		line.Ingredient = *ingredient
+		recipe.IngredientLines[i] = line
+	}
+
+	if source.MainIngredient != nil {
+		recipe.MainIngredient, err = resolveIngredient(source.MainIngredient)
+		if err != nil {
+			return nil, err
+		}
+	} else if len(recipe.IngredientLines) > 0 {
+		recipe.MainIngredient = &recipe.IngredientLines[0].Ingredient
+	}
+
+	recipe.Steps = make([]domain.RecipeStep, len(source.Steps))
+	for i, step := range source.Steps {
+		step.ID = uuid.Nil
+		recipe.Steps[i] = step
+	}
+
+	return recipe, nil
+}
+
+func toRecipeForkResponse(fork *domain.RecipeFork) *pb.RecipeFork {
+	if fork == nil {
+		return nil
+	}
+	return &pb.RecipeFork{
+		RecipeId:           fork.RecipeID.String(),
+		RecipeName:         fork.RecipeName,
+		Revision:           int32(fork.Revision),
+		LatestRevision:     int32(fork.LatestRevision),
+		HasUpstreamChanges: fork.HasUpstreamChanges(),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -12,8 +13,13 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/platepilot/backend/internal/common/blobstore"
 	"github.com/platepilot/backend/internal/common/domain"
 	"github.com/platepilot/backend/internal/common/vector"
+	"github.com/platepilot/backend/internal/recipe/dietary"
+	"github.com/platepilot/backend/internal/recipe/importer"
+	"github.com/platepilot/backend/internal/recipe/ingredientparser"
+	"github.com/platepilot/backend/internal/recipe/nutrition"
 	pb "github.com/platepilot/backend/internal/recipe/pb"
 	"github.com/platepilot/backend/internal/recipe/repository"
 )
@@ -21,10 +27,13 @@ import (
 // GRPCHandler implements the RecipeService gRPC interface.
 type GRPCHandler struct {
 	pb.UnimplementedRecipeServiceServer
-	repo      RecipeRepository
-	vectorGen vector.Generator
-	publisher EventPublisher
-	logger    *slog.Logger
+	repo           RecipeRepository
+	vectorGen      vector.Generator
+	publisher      EventPublisher
+	fetcher        DocumentFetcher
+	media          blobstore.Store
+	cookSessionTTL time.Duration
+	logger         *slog.Logger
 }
 
 const (
@@ -34,13 +43,35 @@ const (
 // NewGRPCHandler creates a new gRPC handler.
 func NewGRPCHandler(repo RecipeRepository, vectorGen vector.Generator, publisher EventPublisher, logger *slog.Logger) *GRPCHandler {
 	return &GRPCHandler{
-		repo:      repo,
-		vectorGen: vectorGen,
-		publisher: publisher,
-		logger:    logger,
+		repo:           repo,
+		vectorGen:      vectorGen,
+		publisher:      publisher,
+		fetcher:        importer.NewHTTPFetcher(),
+		cookSessionTTL: defaultCookSessionTTL,
+		logger:         logger,
 	}
 }
 
+// WithDocumentFetcher replaces the fetcher used to download recipes for import.
+func (h *GRPCHandler) WithDocumentFetcher(fetcher DocumentFetcher) *GRPCHandler {
+	h.fetcher = fetcher
+	return h
+}
+
+// WithMediaStore deletes the uploaded images that purged recipes leave
+// unreferenced from store.
+func (h *GRPCHandler) WithMediaStore(store blobstore.Store) *GRPCHandler {
+	h.media = store
+	return h
+}
+
+// WithCookSessionTTL changes how long cook sessions live after their last
+// change.
+func (h *GRPCHandler) WithCookSessionTTL(ttl time.Duration) *GRPCHandler {
+	h.cookSessionTTL = ttl
+	return h
+}
+
 // GetRecipe retrieves a recipe by ID.
 func (h *GRPCHandler) GetRecipe(ctx context.Context, req *pb.GetRecipeRequest) (*pb.Recipe, error) {
 	h.logger.Debug("get recipe", "recipeId", req.GetRecipeId(), "userId", req.GetUserId())
@@ -67,7 +98,10 @@ func (h *GRPCHandler) GetRecipe(ctx context.Context, req *pb.GetRecipeRequest) (
 	return toRecipeResponse(recipe), nil
 }
 
-// ListRecipes retrieves recipes with pagination and optional filters.
+// ListRecipes retrieves recipes with pagination and optional filters. Recipes
+// conflicting with the user's dietary profile are left out unless the request
+// asks to ignore it. A cursor from a previous page switches to keyset
+// pagination, which skips the count.
 func (h *GRPCHandler) ListRecipes(ctx context.Context, req *pb.ListRecipesRequest) (*pb.ListRecipesResponse, error) {
 	userID, err := uuid.Parse(req.GetUserId())
 	if err != nil {
@@ -89,6 +123,35 @@ func (h *GRPCHandler) ListRecipes(ctx context.Context, req *pb.ListRecipesReques
 	if err != nil {
 		return nil, err
 	}
+	filter.DietaryProfile, err = h.dietaryProfileFilter(ctx, userID, req.GetIgnoreDietaryProfile())
+	if err != nil {
+		return nil, err
+	}
+	if filter.Query != "" {
+		queryVector := h.vectorGen.Generate(filter.Query)
+		filter.QueryVector = &queryVector
+	}
+
+	if filter.After != nil {
+		// Fetch one recipe more than the page to learn whether another page
+		// follows, without counting.
+		recipes, err := h.repo.List(ctx, userID, filter, pageSize+1, 0)
+		if err != nil {
+			h.logger.Error("failed to list recipes", "error", err)
+			return nil, status.Errorf(codes.Internal, "failed to list recipes")
+		}
+
+		hasMore := len(recipes) > pageSize
+		if hasMore {
+			recipes = recipes[:pageSize]
+		}
+		resp := toRecipesResponse(recipes)
+		resp.PageSize = int32(pageSize)
+		if hasMore {
+			resp.NextCursor = nextRecipeCursor(filter, recipes)
+		}
+		return resp, nil
+	}
 
 	recipes, err := h.repo.List(ctx, userID, filter, pageSize, offset)
 	if err != nil {
@@ -103,7 +166,21 @@ func (h *GRPCHandler) ListRecipes(ctx context.Context, req *pb.ListRecipesReques
 	}
 
 	totalPages := int32((totalCount + int64(pageSize) - 1) / int64(pageSize))
-	return toRecipesResponseWithPagination(recipes, int32(pageIndex), int32(pageSize), int32(totalCount), totalPages), nil
+	resp := toRecipesResponseWithPagination(recipes, int32(pageIndex), int32(pageSize), int32(totalCount), totalPages)
+	if int64(offset+len(recipes)) < totalCount {
+		resp.NextCursor = nextRecipeCursor(filter, recipes)
+	}
+	return resp, nil
+}
+
+// nextRecipeCursor returns the token continuing a listing after the last of
+// recipes, or an empty token when the listing order has no cursor.
+func nextRecipeCursor(filter domain.RecipeFilter, recipes []domain.Recipe) string {
+	sort, order, ok := filter.KeysetSort()
+	if !ok || len(recipes) == 0 {
+		return ""
+	}
+	return encodeRecipeCursor(domain.NewRecipeCursor(&recipes[len(recipes)-1], sort, order))
 }
 
 // CreateRecipe creates a new recipe.
@@ -113,11 +190,26 @@ func (h *GRPCHandler) CreateRecipe(ctx context.Context, req *pb.CreateRecipeRequ
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
 	}
 
-	recipe, err := h.buildRecipeFromInput(ctx, userID, req.GetRecipe())
+	recipe, err := h.createRecipe(ctx, userID, req.GetRecipe())
 	if err != nil {
 		return nil, err
 	}
 
+	return toRecipeResponse(recipe), nil
+}
+
+// createRecipe builds, persists and publishes a new recipe from input.
+func (h *GRPCHandler) createRecipe(ctx context.Context, userID uuid.UUID, input *pb.RecipeInput) (*domain.Recipe, error) {
+	recipe, err := h.buildRecipeFromInput(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.insertRecipe(ctx, recipe)
+}
+
+// insertRecipe persists and publishes a new recipe.
+func (h *GRPCHandler) insertRecipe(ctx context.Context, recipe *domain.Recipe) (*domain.Recipe, error) {
 	recipe.ID = uuid.New()
 	recipe.SearchVector = h.vectorGen.GenerateForRecipe(recipe)
 
@@ -137,7 +229,7 @@ func (h *GRPCHandler) CreateRecipe(ctx context.Context, req *pb.CreateRecipeRequ
 		}
 	}
 
-	return toRecipeResponse(recipe), nil
+	return recipe, nil
 }
 
 // UpdateRecipe updates an existing recipe.
@@ -152,19 +244,58 @@ func (h *GRPCHandler) UpdateRecipe(ctx context.Context, req *pb.UpdateRecipeRequ
 		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
 	}
 
-	recipe, err := h.buildRecipeFromInput(ctx, userID, req.GetRecipe())
+	recipe, err := h.updateRecipe(ctx, userID, recipeID, req.GetRecipe())
+	if err != nil {
+		return nil, err
+	}
+
+	return toRecipeResponse(recipe), nil
+}
+
+// updateRecipe builds, persists and publishes a new version of a recipe from
+// input. Users the recipe is shared with for editing update it on behalf of
+// the owner, so ingredients and cuisines resolve against the owner's catalog.
+func (h *GRPCHandler) updateRecipe(ctx context.Context, userID, recipeID uuid.UUID, input *pb.RecipeInput) (*domain.Recipe, error) {
+	ownerID, err := h.editableRecipeOwner(ctx, userID, recipeID)
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, err := h.buildRecipeFromInput(ctx, ownerID, input)
 	if err != nil {
 		return nil, err
 	}
 	recipe.ID = recipeID
-	recipe.UserID = userID
+	recipe.UserID = ownerID
+
+	return h.saveRecipe(ctx, recipe)
+}
+
+// editableRecipeOwner returns the owner of a recipe the user may edit.
+func (h *GRPCHandler) editableRecipeOwner(ctx context.Context, userID, recipeID uuid.UUID) (uuid.UUID, error) {
+	ownerID, err := h.repo.GetEditableRecipeOwner(ctx, userID, recipeID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecipeNotFound):
+			return uuid.Nil, status.Errorf(codes.NotFound, "recipe not found")
+		case errors.Is(err, repository.ErrRecipeNotEditable):
+			return uuid.Nil, status.Errorf(codes.PermissionDenied, "recipe is shared read-only")
+		}
+		h.logger.Error("failed to check recipe access", "error", err, "recipeId", recipeID)
+		return uuid.Nil, status.Errorf(codes.Internal, "failed to update recipe")
+	}
+	return ownerID, nil
+}
+
+// saveRecipe persists and publishes a new version of an existing recipe.
+func (h *GRPCHandler) saveRecipe(ctx context.Context, recipe *domain.Recipe) (*domain.Recipe, error) {
 	recipe.SearchVector = h.vectorGen.GenerateForRecipe(recipe)
 
 	if err := h.repo.Update(ctx, recipe); err != nil {
 		if errors.Is(err, repository.ErrRecipeNotFound) {
 			return nil, status.Errorf(codes.NotFound, "recipe not found")
 		}
-		h.logger.Error("failed to update recipe", "error", err, "recipeId", recipeID)
+		h.logger.Error("failed to update recipe", "error", err, "recipeId", recipe.ID)
 		return nil, status.Errorf(codes.Internal, "failed to update recipe")
 	}
 
@@ -177,7 +308,7 @@ func (h *GRPCHandler) UpdateRecipe(ctx context.Context, req *pb.UpdateRecipeRequ
 		}
 	}
 
-	return toRecipeResponse(recipe), nil
+	return recipe, nil
 }
 
 // DeleteRecipe deletes a recipe.
@@ -234,7 +365,12 @@ func (h *GRPCHandler) GetSimilarRecipes(ctx context.Context, req *pb.GetSimilarR
 		amount = 50
 	}
 
-	recipes, err := h.repo.GetSimilar(ctx, userID, recipeID, amount)
+	profile, err := h.dietaryProfileFilter(ctx, userID, req.GetIgnoreDietaryProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	recipes, err := h.repo.GetSimilar(ctx, userID, recipeID, profile, amount)
 	if err != nil {
 		if errors.Is(err, repository.ErrRecipeNotFound) {
 			return nil, status.Errorf(codes.NotFound, "recipe not found")
@@ -295,6 +431,20 @@ func (h *GRPCHandler) CreateCuisine(ctx context.Context, req *pb.CreateCuisineRe
 }
 
 func (h *GRPCHandler) buildRecipeFromInput(ctx context.Context, userID uuid.UUID, input *pb.RecipeInput) (*domain.Recipe, error) {
+	return h.resolveRecipeInput(ctx, userID, input, false)
+}
+
+// validateRecipeInput runs the checks buildRecipeFromInput makes without
+// creating the ingredients and cuisine the input names.
+func (h *GRPCHandler) validateRecipeInput(ctx context.Context, userID uuid.UUID, input *pb.RecipeInput) error {
+	_, err := h.resolveRecipeInput(ctx, userID, input, true)
+	return err
+}
+
+// resolveRecipeInput turns input into a recipe of userID. With dryRun set,
+// ingredients and cuisines the user does not have yet are left unsaved and the
+// recipe is neither costed nor classified.
+func (h *GRPCHandler) resolveRecipeInput(ctx context.Context, userID uuid.UUID, input *pb.RecipeInput, dryRun bool) (*domain.Recipe, error) {
 	if input == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "recipe is required")
 	}
@@ -304,17 +454,17 @@ func (h *GRPCHandler) buildRecipeFromInput(ctx context.Context, userID uuid.UUID
 		return nil, status.Errorf(codes.InvalidArgument, "name is required")
 	}
 
-	ingredientLines, err := h.resolveIngredientLines(ctx, userID, input.GetIngredientLines())
+	ingredientLines, err := h.resolveIngredientLines(ctx, userID, input.GetIngredientLines(), dryRun)
 	if err != nil {
 		return nil, err
 	}
 
-	mainIngredient, err := h.resolveMainIngredient(ctx, userID, input, ingredientLines)
+	mainIngredient, err := h.resolveMainIngredient(ctx, userID, input, ingredientLines, dryRun)
 	if err != nil {
 		return nil, err
 	}
 
-	cuisine, err := h.resolveCuisine(ctx, userID, input)
+	cuisine, err := h.resolveCuisine(ctx, userID, input, dryRun)
 	if err != nil {
 		return nil, err
 	}
@@ -363,11 +513,56 @@ func (h *GRPCHandler) buildRecipeFromInput(ctx context.Context, userID uuid.UUID
 		ImageURL:         strings.TrimSpace(input.GetImageUrl()),
 		Nutrition:        nutritionFromProto(input.GetNutrition()),
 	}
+	if dryRun {
+		return recipe, nil
+	}
+
+	if err := h.computeNutrition(ctx, recipe); err != nil {
+		return nil, err
+	}
+
+	if err := h.classifyDiet(ctx, recipe); err != nil {
+		return nil, err
+	}
 
 	return recipe, nil
 }
 
-func (h *GRPCHandler) resolveIngredientLines(ctx context.Context, userID uuid.UUID, inputs []*pb.IngredientLineInput) ([]domain.RecipeIngredientLine, error) {
+// computeNutrition calculates the recipe nutrition from the nutrition facts of
+// its ingredients, unless the client overrides it.
+func (h *GRPCHandler) computeNutrition(ctx context.Context, recipe *domain.Recipe) error {
+	if recipe.Nutrition.IsOverride {
+		return nil
+	}
+
+	ingredientIDs := make([]uuid.UUID, 0, len(recipe.IngredientLines))
+	for _, line := range recipe.IngredientLines {
+		ingredientIDs = append(ingredientIDs, line.Ingredient.ID)
+	}
+
+	facts, err := h.repo.GetIngredientNutrition(ctx, ingredientIDs)
+	if err != nil {
+		h.logger.Error("failed to get ingredient nutrition", "error", err)
+		return status.Errorf(codes.Internal, "failed to compute nutrition")
+	}
+
+	nutrition.Calculate(recipe, facts)
+	return nil
+}
+
+// classifyDiet labels the recipe with the diets its required ingredients fit.
+func (h *GRPCHandler) classifyDiet(ctx context.Context, recipe *domain.Recipe) error {
+	attributes, err := h.repo.GetIngredientDietaryAttributes(ctx, dietary.IngredientIDs(recipe))
+	if err != nil {
+		h.logger.Error("failed to get ingredient dietary attributes", "error", err)
+		return status.Errorf(codes.Internal, "failed to classify recipe diet")
+	}
+
+	dietary.Classify(recipe, attributes)
+	return nil
+}
+
+func (h *GRPCHandler) resolveIngredientLines(ctx context.Context, userID uuid.UUID, inputs []*pb.IngredientLineInput, dryRun bool) ([]domain.RecipeIngredientLine, error) {
 	lines := make([]domain.RecipeIngredientLine, 0, len(inputs))
 
 	for index, input := range inputs {
@@ -375,6 +570,20 @@ func (h *GRPCHandler) resolveIngredientLines(ctx context.Context, userID uuid.UU
 			continue
 		}
 
+		line := domain.RecipeIngredientLine{
+			QuantityText: strings.TrimSpace(input.GetQuantityText()),
+			Unit:         strings.TrimSpace(input.GetUnit()),
+			IsOptional:   input.GetIsOptional(),
+			Note:         strings.TrimSpace(input.GetNote()),
+		}
+		if input.GetQuantityValue() != nil {
+			value := input.GetQuantityValue().GetValue()
+			line.QuantityValue = &value
+		}
+		name := fillFromFreeText(&line, strings.TrimSpace(input.GetIngredientName()))
+
+		// A name sent along with an ID is the fallback for an ingredient that
+		// no longer exists, such as one merged away since a revision was saved.
 		var ingredient *domain.Ingredient
 		if idStr := strings.TrimSpace(input.GetIngredientId()); idStr != "" {
 			ingredientID, err := uuid.Parse(idStr)
@@ -382,47 +591,73 @@ func (h *GRPCHandler) resolveIngredientLines(ctx context.Context, userID uuid.UU
 				return nil, status.Errorf(codes.InvalidArgument, "invalid ingredient ID: %v", err)
 			}
 			ingredient, err = h.repo.GetIngredientByID(ctx, userID, ingredientID)
-			if err != nil {
-				if errors.Is(err, repository.ErrIngredientNotFound) {
-					return nil, status.Errorf(codes.NotFound, "ingredient not found: %s", idStr)
-				}
+			switch {
+			case errors.Is(err, repository.ErrIngredientNotFound) && name != "":
+				ingredient = nil
+			case errors.Is(err, repository.ErrIngredientNotFound):
+				return nil, status.Errorf(codes.NotFound, "ingredient not found: %s", idStr)
+			case err != nil:
 				h.logger.Error("failed to get ingredient", "error", err, "ingredientId", idStr)
 				return nil, status.Errorf(codes.Internal, "failed to get ingredient")
 			}
-		} else if name := strings.TrimSpace(input.GetIngredientName()); name != "" {
+		}
+		if ingredient == nil {
+			if name == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "ingredient line is missing id or name")
+			}
 			var err error
-			ingredient, err = h.repo.GetOrCreateIngredient(ctx, userID, name)
+			ingredient, err = h.namedIngredient(ctx, userID, name, dryRun)
 			if err != nil {
 				h.logger.Error("failed to get or create ingredient", "error", err, "ingredientName", name)
 				return nil, status.Errorf(codes.Internal, "failed to create ingredient")
 			}
-		} else {
-			return nil, status.Errorf(codes.InvalidArgument, "ingredient line is missing id or name")
 		}
 
-		var quantityValue *float64
-		if input.GetQuantityValue() != nil {
-			value := input.GetQuantityValue().GetValue()
-			quantityValue = &value
+		line.Ingredient = *ingredient
+		line.SortOrder = int(input.GetSortOrder())
+		if line.SortOrder == 0 {
+			line.SortOrder = index + 1
 		}
 
-		sortOrder := int(input.GetSortOrder())
-		if sortOrder == 0 {
-			sortOrder = index + 1
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// fillFromFreeText completes a line whose structured fields are missing by
+// parsing the free text the client sent instead: a whole line such as
+// "2 cups flour, sifted" in the ingredient name, or an amount such as
+// "1/2 cup, chopped" in the quantity text. It returns the ingredient name to
+// resolve.
+func fillFromFreeText(line *domain.RecipeIngredientLine, name string) string {
+	if line.QuantityValue != nil || line.Unit != "" {
+		return name
+	}
+
+	if line.QuantityText != "" {
+		if parsed := ingredientparser.ParseQuantity(line.QuantityText); parsed.Quantity != nil {
+			applyParsedLine(line, parsed)
 		}
+		return name
+	}
 
-		lines = append(lines, domain.RecipeIngredientLine{
-			Ingredient:    *ingredient,
-			QuantityValue: quantityValue,
-			QuantityText:  strings.TrimSpace(input.GetQuantityText()),
-			Unit:          strings.TrimSpace(input.GetUnit()),
-			IsOptional:    input.GetIsOptional(),
-			Note:          strings.TrimSpace(input.GetNote()),
-			SortOrder:     sortOrder,
-		})
+	if name == "" {
+		return name
 	}
+	parsed := ingredientparser.Parse(name)
+	if parsed.Name == "" {
+		return name
+	}
+	applyParsedLine(line, parsed)
+	return parsed.Name
+}
 
-	return lines, nil
+func applyParsedLine(line *domain.RecipeIngredientLine, parsed ingredientparser.Line) {
+	line.QuantityValue, line.QuantityText = parsed.Amount()
+	line.Unit = parsed.Unit
+	line.IsOptional = line.IsOptional || parsed.Optional
+	line.Note = ingredientparser.JoinNotes(parsed.Note, line.Note)
 }
 
 func (h *GRPCHandler) resolveMainIngredient(
@@ -430,25 +665,30 @@ func (h *GRPCHandler) resolveMainIngredient(
 	userID uuid.UUID,
 	input *pb.RecipeInput,
 	lines []domain.RecipeIngredientLine,
+	dryRun bool,
 ) (*domain.Ingredient, error) {
+	name := strings.TrimSpace(input.GetMainIngredientName())
 	if idStr := strings.TrimSpace(input.GetMainIngredientId()); idStr != "" {
 		ingredientID, err := uuid.Parse(idStr)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid main ingredient ID: %v", err)
 		}
 		ingredient, err := h.repo.GetIngredientByID(ctx, userID, ingredientID)
-		if err != nil {
-			if errors.Is(err, repository.ErrIngredientNotFound) {
-				return nil, status.Errorf(codes.NotFound, "main ingredient not found")
-			}
+		switch {
+		case err == nil:
+			return ingredient, nil
+		case errors.Is(err, repository.ErrIngredientNotFound) && name != "":
+			// Fall back to the name, as resolveIngredientLines does.
+		case errors.Is(err, repository.ErrIngredientNotFound):
+			return nil, status.Errorf(codes.NotFound, "main ingredient not found")
+		default:
 			h.logger.Error("failed to get main ingredient", "error", err)
 			return nil, status.Errorf(codes.Internal, "failed to get main ingredient")
 		}
-		return ingredient, nil
 	}
 
-	if name := strings.TrimSpace(input.GetMainIngredientName()); name != "" {
-		ingredient, err := h.repo.GetOrCreateIngredient(ctx, userID, name)
+	if name != "" {
+		ingredient, err := h.namedIngredient(ctx, userID, name, dryRun)
 		if err != nil {
 			h.logger.Error("failed to get or create main ingredient", "error", err, "ingredientName", name)
 			return nil, status.Errorf(codes.Internal, "failed to create main ingredient")
@@ -463,7 +703,16 @@ func (h *GRPCHandler) resolveMainIngredient(
 	return &lines[0].Ingredient, nil
 }
 
-func (h *GRPCHandler) resolveCuisine(ctx context.Context, userID uuid.UUID, input *pb.RecipeInput) (*domain.Cuisine, error) {
+// namedIngredient returns the user's ingredient called name, creating it
+// unless dryRun is set.
+func (h *GRPCHandler) namedIngredient(ctx context.Context, userID uuid.UUID, name string, dryRun bool) (*domain.Ingredient, error) {
+	if dryRun {
+		return &domain.Ingredient{UserID: userID, Name: name}, nil
+	}
+	return h.repo.GetOrCreateIngredient(ctx, userID, name)
+}
+
+func (h *GRPCHandler) resolveCuisine(ctx context.Context, userID uuid.UUID, input *pb.RecipeInput, dryRun bool) (*domain.Cuisine, error) {
 	cuisineID := strings.TrimSpace(input.GetCuisineId())
 	if cuisineID != "" {
 		parsedID, err := uuid.Parse(cuisineID)
@@ -485,6 +734,9 @@ func (h *GRPCHandler) resolveCuisine(ctx context.Context, userID uuid.UUID, inpu
 	if cuisineName == "" {
 		cuisineName = defaultCuisineName
 	}
+	if dryRun {
+		return &domain.Cuisine{UserID: userID, Name: cuisineName}, nil
+	}
 	cuisine, err := h.repo.GetOrCreateCuisine(ctx, userID, cuisineName)
 	if err != nil {
 		h.logger.Error("failed to get or create cuisine", "error", err, "cuisineName", cuisineName)
@@ -533,7 +785,8 @@ func (h *GRPCHandler) resolveSteps(inputs []*pb.RecipeStepInput) ([]domain.Recip
 
 func buildRecipeFilter(req *pb.ListRecipesRequest) (domain.RecipeFilter, error) {
 	filter := domain.RecipeFilter{
-		Tags: normalizeTags(req.GetTags()),
+		Tags:  normalizeTags(req.GetTags()),
+		Query: strings.TrimSpace(req.GetQuery()),
 	}
 
 	if value := strings.TrimSpace(req.GetCuisineId()); value != "" {
@@ -560,6 +813,76 @@ func buildRecipeFilter(req *pb.ListRecipesRequest) (domain.RecipeFilter, error)
 		filter.AllergyID = &id
 	}
 
+	if value := strings.TrimSpace(req.GetCollectionId()); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid collection ID: %v", err)
+		}
+		filter.CollectionID = &id
+	}
+
+	if req.GetMinRating() != nil {
+		rating := req.GetMinRating().GetValue()
+		if rating < domain.MinCookRating || rating > domain.MaxCookRating {
+			return filter, status.Errorf(codes.InvalidArgument, "min rating must be between %d and %d", domain.MinCookRating, domain.MaxCookRating)
+		}
+		filter.MinRating = &rating
+	}
+
+	if value := strings.TrimSpace(req.GetCookedSince()); value != "" {
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid cooked since date: %v", err)
+		}
+		filter.CookedSince = &date
+	}
+
+	if value := strings.TrimSpace(req.GetNotCookedSince()); value != "" {
+		date, err := time.Parse(time.DateOnly, value)
+		if err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid not cooked since date: %v", err)
+		}
+		filter.NotCookedSince = &date
+	}
+
+	for _, value := range req.GetDietLabels() {
+		label := domain.DietLabel(strings.ToLower(strings.TrimSpace(value)))
+		if !label.IsValid() {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid diet label: %s", value)
+		}
+		filter.DietLabels = append(filter.DietLabels, label)
+	}
+
+	filter.Sort = domain.RecipeSort(strings.TrimSpace(req.GetSort()))
+	if !filter.Sort.IsValid() {
+		return filter, status.Errorf(codes.InvalidArgument, "invalid sort: %s", req.GetSort())
+	}
+
+	if value := strings.TrimSpace(req.GetSortOrder()); value != "" {
+		filter.Order = domain.SortOrder(strings.ToLower(value))
+		if !filter.Order.IsValid() {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid sort order: %s", value)
+		}
+		if !filter.Sort.IsColumn() {
+			return filter, status.Errorf(codes.InvalidArgument, "sort order does not apply to sort %q", filter.Sort)
+		}
+	}
+
+	if token := strings.TrimSpace(req.GetCursor()); token != "" {
+		cursor, err := decodeRecipeCursor(token)
+		if err != nil {
+			return filter, status.Errorf(codes.InvalidArgument, "invalid cursor")
+		}
+		sort, order, ok := filter.KeysetSort()
+		if !ok {
+			return filter, status.Errorf(codes.InvalidArgument, "cursor pagination needs a column sort or the default order without a search query or collection")
+		}
+		if cursor.Sort != sort || cursor.Order != order {
+			return filter, status.Errorf(codes.InvalidArgument, "cursor belongs to a listing with another sort")
+		}
+		filter.After = &cursor
+	}
+
 	return filter, nil
 }
 
@@ -576,6 +899,7 @@ func nutritionFromProto(input *pb.RecipeNutrition) domain.RecipeNutrition {
 		FiberG:             input.GetFiberG(),
 		SugarG:             input.GetSugarG(),
 		SodiumMg:           input.GetSodiumMg(),
+		IsOverride:         input.GetIsOverride(),
 	}
 }
 
@@ -626,6 +950,7 @@ func toRecipeResponse(r *domain.Recipe) *pb.Recipe {
 		YieldUnit:        r.YieldUnit,
 		Tags:             r.Tags,
 		ImageUrl:         r.ImageURL,
+		SearchScore:      r.SearchScore,
 		Nutrition: &pb.RecipeNutrition{
 			CaloriesTotal:      int32(r.Nutrition.CaloriesTotal),
 			CaloriesPerServing: int32(r.Nutrition.CaloriesPerServing),
@@ -635,13 +960,20 @@ func toRecipeResponse(r *domain.Recipe) *pb.Recipe {
 			FiberG:             r.Nutrition.FiberG,
 			SugarG:             r.Nutrition.SugarG,
 			SodiumMg:           r.Nutrition.SodiumMg,
+			IsOverride:         r.Nutrition.IsOverride,
 		},
+		CookStats:  toCookStatsResponse(&r.CookStats),
+		ForkedFrom: toRecipeForkResponse(r.ForkedFrom),
 	}
 
 	if r.YieldQuantity != nil {
 		resp.YieldQuantity = wrapperspb.Double(*r.YieldQuantity)
 	}
 
+	if r.DeletedAt != nil {
+		resp.DeletedAt = r.DeletedAt.UTC().Format(time.RFC3339)
+	}
+
 	if r.MainIngredient != nil {
 		resp.MainIngredient = &pb.IngredientRef{
 			Id:   r.MainIngredient.ID.String(),
@@ -657,43 +989,67 @@ func toRecipeResponse(r *domain.Recipe) *pb.Recipe {
 	}
 
 	lines := make([]*pb.IngredientLine, len(r.IngredientLines))
-	for i, line := range r.IngredientLines {
-		lineResp := &pb.IngredientLine{
-			Ingredient: &pb.IngredientRef{
-				Id:   line.Ingredient.ID.String(),
-				Name: line.Ingredient.Name,
-			},
-			QuantityText: line.QuantityText,
-			Unit:         line.Unit,
-			IsOptional:   line.IsOptional,
-			Note:         line.Note,
-			SortOrder:    int32(line.SortOrder),
-		}
-		if line.QuantityValue != nil {
-			lineResp.QuantityValue = wrapperspb.Double(*line.QuantityValue)
-		}
-		lines[i] = lineResp
+	for i := range r.IngredientLines {
+		lines[i] = toIngredientLineResponse(&r.IngredientLines[i])
 	}
 	resp.IngredientLines = lines
 
 	steps := make([]*pb.RecipeStep, len(r.Steps))
-	for i, step := range r.Steps {
-		stepResp := &pb.RecipeStep{
-			StepIndex:       int32(step.StepIndex),
-			Instruction:     step.Instruction,
-			TemperatureUnit: step.TemperatureUnit,
-			MediaUrl:        step.MediaURL,
-		}
-		if step.DurationSeconds != nil {
-			stepResp.DurationSeconds = wrapperspb.Int32(int32(*step.DurationSeconds))
-		}
-		if step.TemperatureValue != nil {
-			stepResp.TemperatureValue = wrapperspb.Double(*step.TemperatureValue)
-		}
-		steps[i] = stepResp
+	for i := range r.Steps {
+		steps[i] = toStepResponse(&r.Steps[i])
 	}
 	resp.Steps = steps
 
+	for _, allergy := range r.Allergies() {
+		resp.Allergies = append(resp.Allergies, toAllergyResponse(&allergy))
+	}
+
+	for _, label := range r.DietLabels {
+		resp.DietLabels = append(resp.DietLabels, string(label))
+	}
+
+	return resp
+}
+
+func toAllergyResponse(allergy *domain.Allergy) *pb.Allergy {
+	return &pb.Allergy{
+		Id:   allergy.ID.String(),
+		Name: allergy.Name,
+	}
+}
+
+func toIngredientLineResponse(line *domain.RecipeIngredientLine) *pb.IngredientLine {
+	resp := &pb.IngredientLine{
+		Ingredient: &pb.IngredientRef{
+			Id:   line.Ingredient.ID.String(),
+			Name: line.Ingredient.Name,
+		},
+		QuantityText:        line.QuantityText,
+		Unit:                line.Unit,
+		IsOptional:          line.IsOptional,
+		Note:                line.Note,
+		SortOrder:           int32(line.SortOrder),
+		NutritionUnresolved: line.NutritionUnresolved,
+	}
+	if line.QuantityValue != nil {
+		resp.QuantityValue = wrapperspb.Double(*line.QuantityValue)
+	}
+	return resp
+}
+
+func toStepResponse(step *domain.RecipeStep) *pb.RecipeStep {
+	resp := &pb.RecipeStep{
+		StepIndex:       int32(step.StepIndex),
+		Instruction:     step.Instruction,
+		TemperatureUnit: step.TemperatureUnit,
+		MediaUrl:        step.MediaURL,
+	}
+	if step.DurationSeconds != nil {
+		resp.DurationSeconds = wrapperspb.Int32(int32(*step.DurationSeconds))
+	}
+	if step.TemperatureValue != nil {
+		resp.TemperatureValue = wrapperspb.Double(*step.TemperatureValue)
+	}
 	return resp
 }
 
@@ -1,14 +1,21 @@
 package handler_test
 
 import (
+	"context"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/platepilot/backend/internal/common/blobstore"
 	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/handler"
 	pb "github.com/platepilot/backend/internal/recipe/pb"
 	"github.com/platepilot/backend/internal/recipe/testutil"
 )
@@ -46,6 +53,28 @@ func TestListRecipes_Pagination_ReturnsTotals(t *testing.T) {
 	}
 }
 
+func TestListRecipes_WithQuery_ReturnsScoredMatches(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenRecipeExistsWithName(tc, "Pasta Carbonara")
+	givenRecipeExistsWithName(tc, "Chicken Curry")
+
+	resp, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId: tc.UserID.String(),
+		Query:  "pasta",
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 1 {
+		t.Fatalf("expected 1 recipe, got %d", len(resp.GetRecipes()))
+	}
+	if resp.GetRecipes()[0].GetSearchScore() <= 0 {
+		t.Fatalf("expected a positive search score, got %f", resp.GetRecipes()[0].GetSearchScore())
+	}
+	if resp.GetTotalCount() != 1 {
+		t.Fatalf("expected total count 1, got %d", resp.GetTotalCount())
+	}
+}
+
 func TestCreateRecipe_ValidInput_PersistsAndPublishes(t *testing.T) {
 	tc := givenRecipeAPI()
 
@@ -95,47 +124,2625 @@ func TestCreateRecipe_ValidInput_PersistsAndPublishes(t *testing.T) {
 	}
 }
 
-func TestDeleteRecipe_NotFound_ReturnsNotFound(t *testing.T) {
+func TestCreateRecipe_FreeTextLines_AreParsed(t *testing.T) {
 	tc := givenRecipeAPI()
-	nonExistentID := uuid.New()
 
-	_, err := tc.Handler.DeleteRecipe(tc.Ctx, &pb.DeleteRecipeRequest{
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: &pb.RecipeInput{
+			Name:     "Garlic Bread",
+			Servings: 4,
+			IngredientLines: []*pb.IngredientLineInput{
+				{IngredientName: "1 1/2 cups flour, sifted"},
+				{IngredientName: "2–3 cloves garlic (optional)"},
+				{IngredientName: "Butter", QuantityText: "1/2 cup, softened"},
+			},
+		},
+	})
+
+	thenNoError(t, err)
+	lines := resp.GetIngredientLines()
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 ingredient lines, got %d", len(lines))
+	}
+	if lines[0].GetIngredient().GetName() != "flour" || lines[0].GetQuantityValue().GetValue() != 1.5 ||
+		lines[0].GetUnit() != "cup" || lines[0].GetNote() != "sifted" {
+		t.Fatalf("unexpected first line %+v", lines[0])
+	}
+	if lines[1].GetIngredient().GetName() != "garlic" || lines[1].GetQuantityValue() != nil ||
+		lines[1].GetQuantityText() != "2-3" || lines[1].GetUnit() != "clove" || !lines[1].GetIsOptional() {
+		t.Fatalf("unexpected second line %+v", lines[1])
+	}
+	if lines[2].GetIngredient().GetName() != "Butter" || lines[2].GetQuantityValue().GetValue() != 0.5 ||
+		lines[2].GetUnit() != "cup" || lines[2].GetNote() != "softened" {
+		t.Fatalf("unexpected third line %+v", lines[2])
+	}
+}
+
+func TestCreateRecipe_ComputesNutritionFromIngredients(t *testing.T) {
+	tc := givenRecipeAPI()
+	tomato := givenIngredientWithNutrition(tc, "Tomato", domain.IngredientNutrition{
+		ServingSizeValue: 100, ServingUnit: "g", Calories: 18, ProteinG: 0.9,
+	})
+
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: &pb.RecipeInput{
+			Name:     "Tomato Salad",
+			Servings: 2,
+			IngredientLines: []*pb.IngredientLineInput{
+				{IngredientId: tomato.ID.String(), QuantityValue: wrapperspb.Double(500), Unit: "g"},
+				{IngredientName: "Basil", QuantityText: "a few leaves"},
+			},
+			Nutrition: &pb.RecipeNutrition{CaloriesTotal: 1000},
+		},
+	})
+
+	thenNoError(t, err)
+	nutrition := resp.GetNutrition()
+	if nutrition.GetCaloriesTotal() != 90 || nutrition.GetCaloriesPerServing() != 45 || nutrition.GetProteinG() != 2.25 {
+		t.Fatalf("unexpected computed nutrition %+v", nutrition)
+	}
+	if nutrition.GetIsOverride() {
+		t.Fatalf("expected computed nutrition not to be flagged as an override")
+	}
+	lines := resp.GetIngredientLines()
+	if lines[0].GetNutritionUnresolved() || !lines[1].GetNutritionUnresolved() {
+		t.Fatalf("expected only the basil line to be unresolved, got %+v", lines)
+	}
+}
+
+func TestCreateRecipe_NutritionOverride_KeepsManualValues(t *testing.T) {
+	tc := givenRecipeAPI()
+
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: &pb.RecipeInput{
+			Name:            "Mystery Stew",
+			Servings:        4,
+			IngredientLines: []*pb.IngredientLineInput{{IngredientName: "Beans", QuantityText: "some"}},
+			Nutrition:       &pb.RecipeNutrition{CaloriesTotal: 1200, CaloriesPerServing: 300, IsOverride: true},
+		},
+	})
+
+	thenNoError(t, err)
+	if resp.GetNutrition().GetCaloriesTotal() != 1200 || !resp.GetNutrition().GetIsOverride() {
+		t.Fatalf("expected the override to be kept, got %+v", resp.GetNutrition())
+	}
+	if resp.GetIngredientLines()[0].GetNutritionUnresolved() {
+		t.Fatalf("expected no unresolved lines for overridden nutrition")
+	}
+}
+
+func TestImportRecipe_Draft_DoesNotPersist(t *testing.T) {
+	tc := givenRecipeAPI()
+
+	resp, err := tc.Handler.ImportRecipe(tc.Ctx, &pb.ImportRecipeRequest{
 		UserId:   tc.UserID.String(),
-		RecipeId: nonExistentID.String(),
+		Document: importDocument,
+	})
+
+	thenNoError(t, err)
+	if resp.GetDraft().GetName() != "Lemon Risotto" {
+		t.Fatalf("expected draft name Lemon Risotto, got %q", resp.GetDraft().GetName())
+	}
+	if resp.GetRecipe() != nil {
+		t.Fatalf("expected no created recipe for a draft import")
+	}
+	if len(tc.Repo.CreateCalls) != 0 {
+		t.Fatalf("expected no repository writes, got %d", len(tc.Repo.CreateCalls))
+	}
+}
+
+func TestImportRecipe_FromURLWithCreate_PersistsAndPublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	tc.Fetcher.Documents["https://example.com/risotto"] = []byte(importDocument)
+
+	resp, err := tc.Handler.ImportRecipe(tc.Ctx, &pb.ImportRecipeRequest{
+		UserId: tc.UserID.String(),
+		Url:    "https://example.com/risotto",
+		Create: true,
+	})
+
+	thenNoError(t, err)
+	if resp.GetRecipe().GetId() == "" {
+		t.Fatalf("expected created recipe id to be set")
+	}
+	if resp.GetRecipe().GetCuisine().GetName() != "Italian" {
+		t.Fatalf("expected cuisine Italian, got %q", resp.GetRecipe().GetCuisine().GetName())
+	}
+	if tc.Publisher.UpsertedEventCount() != 1 {
+		t.Fatalf("expected 1 RecipeUpsertedEvent, got %d", tc.Publisher.UpsertedEventCount())
+	}
+}
+
+func TestImportRecipe_NoRecipeInDocument_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+
+	_, err := tc.Handler.ImportRecipe(tc.Ctx, &pb.ImportRecipeRequest{
+		UserId:   tc.UserID.String(),
+		Document: "<html><body>No recipe here</body></html>",
 	})
 
 	thenErrorHasCode(t, err, codes.NotFound)
 }
 
-// Helpers
+func TestExportRecipe_Markdown_ReturnsAttachment(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenRecipeExistsWithName(tc, "Tomato Soup")
 
-func givenRecipeAPI() *testutil.TestContext {
-	return testutil.NewTestContext()
+	resp, err := tc.Handler.ExportRecipe(tc.Ctx, &pb.ExportRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.ID.String(),
+		Format:   "markdown",
+	})
+
+	thenNoError(t, err)
+	if resp.GetFilename() != "tomato-soup.md" {
+		t.Fatalf("expected filename tomato-soup.md, got %q", resp.GetFilename())
+	}
+	if !strings.HasPrefix(string(resp.GetContent()), "# Tomato Soup") {
+		t.Fatalf("expected markdown heading, got %q", resp.GetContent())
+	}
 }
 
-func givenRecipeExists(tc *testutil.TestContext) *domain.Recipe {
-	builder := testutil.NewRecipeBuilder().WithUserID(tc.UserID)
-	cuisine := testutil.NewCuisineBuilder().WithName("Italian").Build()
-	ingredient := testutil.NewIngredientBuilder().WithName("Tomato").Build()
-	builder.WithCuisine(cuisine).WithMainIngredient(ingredient)
-	recipe := builder.Build()
-	tc.Repo.AddCuisine(cuisine)
-	tc.Repo.AddIngredient(ingredient)
-	tc.Repo.AddRecipe(recipe)
-	return recipe
+func TestExportRecipe_UnknownFormat_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenRecipeExists(tc)
+
+	_, err := tc.Handler.ExportRecipe(tc.Ctx, &pb.ExportRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.ID.String(),
+		Format:   "pdf",
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
 }
 
-func givenRecipeExistsWithName(tc *testutil.TestContext, name string) *domain.Recipe {
-	builder := testutil.NewRecipeBuilder().WithUserID(tc.UserID).WithName(name)
-	recipe := builder.Build()
+func TestScaleRecipe_DoubleServings_ScalesAndFlagsFreeText(t *testing.T) {
+	tc := givenRecipeAPI()
+	flour := 250.0
+	recipe := testutil.NewRecipeBuilder().WithUserID(tc.UserID).WithIngredientLines([]domain.RecipeIngredientLine{
+		{Ingredient: domain.Ingredient{Name: "flour"}, QuantityValue: &flour, Unit: "g"},
+		{Ingredient: domain.Ingredient{Name: "garlic"}, QuantityText: "2-3"},
+	}).Build()
 	tc.Repo.AddRecipe(recipe)
-	return recipe
+
+	resp, err := tc.Handler.ScaleRecipe(tc.Ctx, &pb.ScaleRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.ID.String(),
+		Servings: 4,
+	})
+
+	thenNoError(t, err)
+	if resp.GetFactor() != 2 || resp.GetRecipe().GetServings() != 4 {
+		t.Fatalf("expected factor 2 and 4 servings, got %v and %d", resp.GetFactor(), resp.GetRecipe().GetServings())
+	}
+	lines := resp.GetRecipe().GetIngredientLines()
+	if lines[0].GetQuantityValue().GetValue() != 500 || lines[0].GetUnit() != "g" || lines[0].GetNotScaled() {
+		t.Fatalf("expected 500 g of flour, got %+v", lines[0])
+	}
+	if !lines[1].GetNotScaled() {
+		t.Fatalf("expected free-text line to be flagged as not scaled, got %+v", lines[1])
+	}
 }
 
-func thenNoError(t *testing.T, err error) {
-	t.Helper()
-	if err != nil {
-		t.Fatalf("expected no error, got %v", err)
+func TestScaleRecipe_ZeroServings_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenRecipeExists(tc)
+
+	_, err := tc.Handler.ScaleRecipe(tc.Ctx, &pb.ScaleRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.ID.String(),
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateRecipe_RecordsRevisionsAndDiffs(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	givenLasagnaEdited(t, tc, recipe)
+
+	list, err := tc.Handler.ListRecipeRevisions(tc.Ctx, &pb.ListRecipeRevisionsRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+	thenNoError(t, err)
+	if len(list.GetRevisions()) != 2 || list.GetRevisions()[0].GetRevision() != 2 {
+		t.Fatalf("expected two revisions newest first, got %+v", list.GetRevisions())
+	}
+
+	diff, err := tc.Handler.DiffRecipeRevisions(tc.Ctx, &pb.DiffRecipeRevisionsRequest{
+		UserId:       tc.UserID.String(),
+		RecipeId:     recipe.GetId(),
+		FromRevision: 1,
+		ToRevision:   2,
+	})
+	thenNoError(t, err)
+	if len(diff.GetFields()) != 1 || diff.GetFields()[0].GetField() != "name" || diff.GetFields()[0].GetTo() != "Quick Lasagna" {
+		t.Fatalf("expected only the name to change, got %+v", diff.GetFields())
+	}
+	lines := diff.GetIngredientLines()
+	if len(lines) != 2 || lines[0].GetChange() != "modified" || lines[1].GetChange() != "removed" {
+		t.Fatalf("expected a modified and a removed line, got %+v", lines)
+	}
+	if len(diff.GetSteps()) != 0 {
+		t.Fatalf("expected steps to be unchanged, got %+v", diff.GetSteps())
+	}
+}
+
+func TestRestoreRecipeRevision_RestoresAndPublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	givenLasagnaEdited(t, tc, recipe)
+
+	resp, err := tc.Handler.RestoreRecipeRevision(tc.Ctx, &pb.RestoreRecipeRevisionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Revision: 1,
+	})
+
+	thenNoError(t, err)
+	if resp.GetName() != "Lasagna" || len(resp.GetIngredientLines()) != 2 {
+		t.Fatalf("expected original recipe restored, got %+v", resp)
+	}
+	if tc.Publisher.UpsertedEventCount() != 3 {
+		t.Fatalf("expected 3 RecipeUpsertedEvents, got %d", tc.Publisher.UpsertedEventCount())
+	}
+	if len(tc.Repo.Revisions[uuid.MustParse(recipe.GetId())]) != 3 {
+		t.Fatalf("expected the restore to be recorded as a new revision")
+	}
+}
+
+func TestGetRecipeRevision_Unknown_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	_, err := tc.Handler.GetRecipeRevision(tc.Ctx, &pb.GetRecipeRevisionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Revision: 5,
+	})
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestListIngredientMatches_DefaultsToAmbiguous(t *testing.T) {
+	tc := givenRecipeAPI()
+	onion := givenAmbiguousIngredientMatch(tc, "Onion", "Onions, red, raw", "Onions, white, raw")
+	givenIngredientMatch(tc, "Garlic", domain.FoodMatchMatched)
+
+	resp, err := tc.Handler.ListIngredientMatches(tc.Ctx, &pb.ListIngredientMatchesRequest{
+		UserId: tc.UserID.String(),
+	})
+
+	thenNoError(t, err)
+	matches := resp.GetMatches()
+	if len(matches) != 1 || matches[0].GetIngredient().GetId() != onion.Ingredient.ID.String() {
+		t.Fatalf("expected only the ambiguous match, got %+v", matches)
+	}
+	if len(matches[0].GetCandidates()) != 2 || matches[0].GetStatus() != "ambiguous" {
+		t.Fatalf("expected two candidates to review, got %+v", matches[0])
+	}
+}
+
+func TestResolveIngredientMatch_PickCandidate_Confirms(t *testing.T) {
+	tc := givenRecipeAPI()
+	onion := givenAmbiguousIngredientMatch(tc, "Onion", "Onions, red, raw", "Onions, white, raw")
+	picked := onion.Candidates[1]
+
+	resp, err := tc.Handler.ResolveIngredientMatch(tc.Ctx, &pb.ResolveIngredientMatchRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: onion.Ingredient.ID.String(),
+		FoodId:       picked.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if resp.GetStatus() != "confirmed" || resp.GetFood().GetId() != picked.ID.String() {
+		t.Fatalf("expected match confirmed with the picked food, got %+v", resp)
+	}
+}
+
+func TestResolveIngredientMatch_UnknownFood_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	onion := givenAmbiguousIngredientMatch(tc, "Onion", "Onions, red, raw", "Onions, white, raw")
+
+	_, err := tc.Handler.ResolveIngredientMatch(tc.Ctx, &pb.ResolveIngredientMatchRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: onion.Ingredient.ID.String(),
+		FoodId:       uuid.New().String(),
+	})
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestShareRecipe_ByEmail_SharesAndPublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	friend := givenUser(tc, "friend@example.com")
+
+	resp, err := tc.Handler.ShareRecipe(tc.Ctx, &pb.ShareRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Email:    " Friend@Example.com ",
+	})
+
+	thenNoError(t, err)
+	if resp.GetSharedWithUserId() != friend.ID.String() || resp.GetPermission() != "read" {
+		t.Fatalf("expected read-only share with friend, got %+v", resp)
+	}
+	if len(tc.Publisher.RecipeSharedEvents) != 1 {
+		t.Fatalf("expected 1 RecipeSharedEvent, got %d", len(tc.Publisher.RecipeSharedEvents))
+	}
+
+	shared, err := tc.Handler.ListSharedWithMe(tc.Ctx, &pb.ListRecipeSharesRequest{UserId: friend.ID.String()})
+	thenNoError(t, err)
+	if len(shared.GetShares()) != 1 || shared.GetShares()[0].GetRecipeName() != "Lasagna" {
+		t.Fatalf("expected lasagna shared with friend, got %+v", shared.GetShares())
+	}
+}
+
+func TestShareRecipe_UnknownEmail_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	_, err := tc.Handler.ShareRecipe(tc.Ctx, &pb.ShareRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Email:    "nobody@example.com",
+	})
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestShareRecipe_InvalidPermission_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	givenUser(tc, "friend@example.com")
+
+	_, err := tc.Handler.ShareRecipe(tc.Ctx, &pb.ShareRecipeRequest{
+		UserId:     tc.UserID.String(),
+		RecipeId:   recipe.GetId(),
+		Email:      "friend@example.com",
+		Permission: "owner",
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateRecipe_SharedForEditing_UpdatesOwnersRecipe(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	editor := givenRecipeSharedWith(t, tc, recipe, "editor@example.com", "edit")
+
+	resp, err := tc.Handler.UpdateRecipe(tc.Ctx, &pb.UpdateRecipeRequest{
+		UserId:   editor.ID.String(),
+		RecipeId: recipe.GetId(),
+		Recipe: lasagnaInput("Family Lasagna",
+			&pb.IngredientLineInput{IngredientName: "Lasagna sheets", QuantityValue: wrapperspb.Double(250), Unit: "g"},
+		),
+	})
+
+	thenNoError(t, err)
+	if resp.GetName() != "Family Lasagna" || resp.GetUserId() != tc.UserID.String() {
+		t.Fatalf("expected the owner's recipe to be updated, got %+v", resp)
+	}
+}
+
+func TestUpdateRecipe_SharedReadOnly_ReturnsPermissionDenied(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	reader := givenRecipeSharedWith(t, tc, recipe, "reader@example.com", "read")
+
+	_, err := tc.Handler.UpdateRecipe(tc.Ctx, &pb.UpdateRecipeRequest{
+		UserId:   reader.ID.String(),
+		RecipeId: recipe.GetId(),
+		Recipe:   lasagnaInput("Family Lasagna"),
+	})
+
+	thenErrorHasCode(t, err, codes.PermissionDenied)
+}
+
+func TestRevokeRecipeShare_RemovesAccessAndPublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	reader := givenRecipeSharedWith(t, tc, recipe, "reader@example.com", "read")
+
+	_, err := tc.Handler.RevokeRecipeShare(tc.Ctx, &pb.RevokeRecipeShareRequest{
+		UserId:           tc.UserID.String(),
+		RecipeId:         recipe.GetId(),
+		SharedWithUserId: reader.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if len(tc.Publisher.ShareRevokedEvents) != 1 {
+		t.Fatalf("expected 1 RecipeShareRevokedEvent, got %d", len(tc.Publisher.ShareRevokedEvents))
+	}
+	_, err = tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: reader.ID.String(), RecipeId: recipe.GetId()})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestReorderCollection_ListRecipesKeepsCollectionOrder(t *testing.T) {
+	tc := givenRecipeAPI()
+	soup := givenRecipeExistsWithName(tc, "Soup")
+	salad := givenRecipeExistsWithName(tc, "Salad")
+	givenRecipeExistsWithName(tc, "Stew")
+	collection := givenCollectionWith(t, tc, "Weeknights", soup, salad)
+
+	_, err := tc.Handler.ReorderCollection(tc.Ctx, &pb.ReorderCollectionRequest{
+		UserId:       tc.UserID.String(),
+		CollectionId: collection.GetId(),
+		RecipeIds:    []string{salad.ID.String(), soup.ID.String()},
+	})
+	thenNoError(t, err)
+
+	resp, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:       tc.UserID.String(),
+		PageIndex:    1,
+		PageSize:     10,
+		CollectionId: collection.GetId(),
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 2 || resp.GetRecipes()[0].GetName() != "Salad" || resp.GetRecipes()[1].GetName() != "Soup" {
+		t.Fatalf("expected salad then soup, got %+v", resp.GetRecipes())
+	}
+}
+
+func TestReorderCollection_MissingRecipe_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	soup := givenRecipeExistsWithName(tc, "Soup")
+	salad := givenRecipeExistsWithName(tc, "Salad")
+	collection := givenCollectionWith(t, tc, "Weeknights", soup, salad)
+
+	_, err := tc.Handler.ReorderCollection(tc.Ctx, &pb.ReorderCollectionRequest{
+		UserId:       tc.UserID.String(),
+		CollectionId: collection.GetId(),
+		RecipeIds:    []string{salad.ID.String()},
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestShareCollection_ReadOnly_GrantsRecipeAccessButNotEdits(t *testing.T) {
+	tc := givenRecipeAPI()
+	soup := givenRecipeExistsWithName(tc, "Soup")
+	stew := givenRecipeExistsWithName(tc, "Stew")
+	collection := givenCollectionWith(t, tc, "Weeknights", soup)
+	friend := givenUser(tc, "friend@example.com")
+
+	_, err := tc.Handler.ShareCollection(tc.Ctx, &pb.ShareCollectionRequest{
+		UserId:       tc.UserID.String(),
+		CollectionId: collection.GetId(),
+		Email:        "friend@example.com",
+	})
+	thenNoError(t, err)
+
+	recipe, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: friend.ID.String(), RecipeId: soup.ID.String()})
+	thenNoError(t, err)
+	if recipe.GetName() != "Soup" {
+		t.Fatalf("expected soup through the collection share, got %q", recipe.GetName())
+	}
+
+	_, err = tc.Handler.AddRecipeToCollection(tc.Ctx, &pb.CollectionRecipeRequest{
+		UserId:       friend.ID.String(),
+		CollectionId: collection.GetId(),
+		RecipeId:     stew.ID.String(),
+	})
+	thenErrorHasCode(t, err, codes.PermissionDenied)
+}
+
+func TestShareCollection_PublishesShareWithRecipesAndMembershipChanges(t *testing.T) {
+	tc := givenRecipeAPI()
+	soup := givenRecipeExistsWithName(tc, "Soup")
+	stew := givenRecipeExistsWithName(tc, "Stew")
+	collection := givenCollectionWith(t, tc, "Weeknights", soup)
+	friend := givenUser(tc, "friend@example.com")
+
+	_, err := tc.Handler.ShareCollection(tc.Ctx, &pb.ShareCollectionRequest{
+		UserId:       tc.UserID.String(),
+		CollectionId: collection.GetId(),
+		Email:        "friend@example.com",
+	})
+	thenNoError(t, err)
+	_, err = tc.Handler.AddRecipeToCollection(tc.Ctx, &pb.CollectionRecipeRequest{
+		UserId:       tc.UserID.String(),
+		CollectionId: collection.GetId(),
+		RecipeId:     stew.ID.String(),
+	})
+	thenNoError(t, err)
+	_, err = tc.Handler.RemoveRecipeFromCollection(tc.Ctx, &pb.CollectionRecipeRequest{
+		UserId:       tc.UserID.String(),
+		CollectionId: collection.GetId(),
+		RecipeId:     soup.ID.String(),
+	})
+	thenNoError(t, err)
+	_, err = tc.Handler.RevokeCollectionShare(tc.Ctx, &pb.RevokeCollectionShareRequest{
+		UserId:           tc.UserID.String(),
+		CollectionId:     collection.GetId(),
+		SharedWithUserId: friend.ID.String(),
+	})
+	thenNoError(t, err)
+	_, err = tc.Handler.DeleteCollection(tc.Ctx, &pb.DeleteCollectionRequest{
+		UserId:       tc.UserID.String(),
+		CollectionId: collection.GetId(),
+	})
+	thenNoError(t, err)
+
+	shared := tc.Publisher.CollectionSharedEvents
+	if len(shared) != 1 || shared[0].Share.SharedWithUserID != friend.ID || len(shared[0].RecipeIDs) != 1 || shared[0].RecipeIDs[0] != soup.ID {
+		t.Errorf("expected the share to be published with the soup, got %+v", shared)
+	}
+	added := tc.Publisher.CollectionRecipeAddedEvents
+	if len(added) != 2 || added[1].RecipeID != stew.ID || added[1].UserID != tc.UserID {
+		t.Errorf("expected the soup and stew additions to be published, got %+v", added)
+	}
+	removed := tc.Publisher.CollectionRecipeRemovedEvents
+	if len(removed) != 1 || removed[0].RecipeID != soup.ID {
+		t.Errorf("expected the soup removal to be published, got %+v", removed)
+	}
+	revoked := tc.Publisher.CollectionShareRevokedEvents
+	if len(revoked) != 1 || revoked[0].SharedWithUserID != friend.ID {
+		t.Errorf("expected the revoked share to be published, got %+v", revoked)
+	}
+	if len(tc.Publisher.CollectionDeletedEvents) != 1 || tc.Publisher.CollectionDeletedEvents[0].String() != collection.GetId() {
+		t.Errorf("expected the deletion to be published, got %+v", tc.Publisher.CollectionDeletedEvents)
+	}
+}
+
+func TestSetCollectionPublic_LetsEveryUserReadItsRecipes(t *testing.T) {
+	tc := givenRecipeAPI()
+	soup := givenRecipeExistsWithName(tc, "Soup")
+	collection := givenCollectionWith(t, tc, "Library", soup)
+	collectionID := uuid.MustParse(collection.GetId())
+	stranger := givenUser(tc, "stranger@example.com")
+
+	err := tc.Handler.SetCollectionPublic(tc.Ctx, tc.UserID, collectionID, true)
+
+	thenNoError(t, err)
+	_, err = tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: stranger.ID.String(), RecipeId: soup.ID.String()})
+	thenNoError(t, err)
+	listed, err := tc.Handler.ListCollections(tc.Ctx, &pb.ListCollectionsRequest{UserId: stranger.ID.String()})
+	thenNoError(t, err)
+	if len(listed.GetCollections()) != 1 || !listed.GetCollections()[0].GetPublic() || listed.GetCollections()[0].GetPermission() != "read" {
+		t.Errorf("expected the public collection to be listed read-only, got %+v", listed.GetCollections())
+	}
+	_, err = tc.Handler.RemoveRecipeFromCollection(tc.Ctx, &pb.CollectionRecipeRequest{
+		UserId:       stranger.ID.String(),
+		CollectionId: collection.GetId(),
+		RecipeId:     soup.ID.String(),
+	})
+	thenErrorHasCode(t, err, codes.PermissionDenied)
+	events := tc.Publisher.CollectionVisibilityEvents
+	if len(events) != 1 || !events[0].Public || len(events[0].RecipeIDs) != 1 || events[0].RecipeIDs[0] != soup.ID {
+		t.Errorf("expected the visibility change to be published with the soup, got %+v", events)
+	}
+}
+
+func TestSetCollectionPublic_NotOwner_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	collection := givenCollectionWith(t, tc, "Library")
+	stranger := givenUser(tc, "stranger@example.com")
+
+	err := tc.Handler.SetCollectionPublic(tc.Ctx, stranger.ID, uuid.MustParse(collection.GetId()), true)
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestLogCook_UpdatesStatsAndPublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	givenRecipeCooked(t, tc, recipe.GetId(), "2026-03-01", 4)
+
+	entry, err := tc.Handler.LogCook(tc.Ctx, &pb.LogCookRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Entry:    &pb.CookLogEntryInput{CookedOn: "2026-03-08", Servings: 2, Rating: 5, Notes: "More basil"},
+	})
+
+	thenNoError(t, err)
+	if entry.GetRecipeName() != "Lasagna" || entry.GetCookedOn() != "2026-03-08" || entry.GetRating() != 5 {
+		t.Fatalf("unexpected cook log entry: %+v", entry)
+	}
+
+	resp, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()})
+	thenNoError(t, err)
+	stats := resp.GetCookStats()
+	if stats.GetTimesCooked() != 2 || stats.GetLastCookedOn() != "2026-03-08" || stats.GetAverageRating().GetValue() != 4.5 {
+		t.Fatalf("expected 2 cooks, last on 2026-03-08 averaging 4.5, got %+v", stats)
+	}
+	if len(tc.Publisher.CookStatsEvents) != 2 || tc.Publisher.CookStatsEvents[1].Stats.TimesCooked != 2 {
+		t.Fatalf("expected cook stats events with the recomputed aggregates, got %+v", tc.Publisher.CookStatsEvents)
+	}
+}
+
+func TestLogCook_RatingOutOfRange_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	_, err := tc.Handler.LogCook(tc.Ctx, &pb.LogCookRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Entry:    &pb.CookLogEntryInput{Rating: 6},
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestDeleteCookLogEntry_RecomputesStats(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	entry := givenRecipeCooked(t, tc, recipe.GetId(), "2026-03-01", 4)
+
+	_, err := tc.Handler.DeleteCookLogEntry(tc.Ctx, &pb.DeleteCookLogEntryRequest{
+		UserId:  tc.UserID.String(),
+		EntryId: entry.GetId(),
+	})
+
+	thenNoError(t, err)
+	log, err := tc.Handler.ListCookLog(tc.Ctx, &pb.ListCookLogRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()})
+	thenNoError(t, err)
+	if len(log.GetEntries()) != 0 || log.GetStats().GetTimesCooked() != 0 {
+		t.Fatalf("expected an empty cook log, got %+v", log)
+	}
+}
+
+func TestListRecipes_SortMostCooked_OrdersByCookCount(t *testing.T) {
+	tc := givenRecipeAPI()
+	soup := givenRecipeExistsWithName(tc, "Soup")
+	salad := givenRecipeExistsWithName(tc, "Salad")
+	givenRecipeCooked(t, tc, salad.ID.String(), "2026-03-01", 0)
+	givenRecipeCooked(t, tc, salad.ID.String(), "2026-03-05", 0)
+	givenRecipeCooked(t, tc, soup.ID.String(), "2026-03-03", 0)
+
+	resp, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:    tc.UserID.String(),
+		PageIndex: 1,
+		PageSize:  10,
+		Sort:      "most_cooked",
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 2 || resp.GetRecipes()[0].GetName() != "Salad" {
+		t.Fatalf("expected salad first, got %+v", resp.GetRecipes())
+	}
+}
+
+func TestListRecipes_UnknownSort_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+
+	_, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:    tc.UserID.String(),
+		PageIndex: 1,
+		PageSize:  10,
+		Sort:      "spiciest",
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestListRecipes_Cursor_PagesWithoutDuplicates(t *testing.T) {
+	tc := givenRecipeAPI()
+	for _, name := range []string{"Pesto", "focaccia", "Risotto", "Arancini", "Lasagna"} {
+		givenRecipeExistsWithName(tc, name)
+	}
+
+	var names []string
+	resp, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:   tc.UserID.String(),
+		PageSize: 2,
+		Sort:     "name",
+	})
+	thenNoError(t, err)
+	for page := 1; ; page++ {
+		for _, recipe := range resp.GetRecipes() {
+			names = append(names, recipe.GetName())
+		}
+		if resp.GetNextCursor() == "" {
+			break
+		}
+		if page == 1 {
+			// A recipe sorting before the cursor does not shift later pages.
+			givenRecipeExistsWithName(tc, "Bruschetta")
+		}
+		resp, err = tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+			UserId:   tc.UserID.String(),
+			PageSize: 2,
+			Sort:     "name",
+			Cursor:   resp.GetNextCursor(),
+		})
+		thenNoError(t, err)
+	}
+
+	if !slices.Equal(names, []string{"Arancini", "focaccia", "Lasagna", "Pesto", "Risotto"}) {
+		t.Fatalf("expected every recipe once in name order, got %v", names)
+	}
+}
+
+func TestListRecipes_SortTotalTimeDescending_FollowsCursor(t *testing.T) {
+	tc := givenRecipeAPI()
+	for name, minutes := range map[string]int{"Stew": 180, "Salad": 10, "Curry": 45} {
+		givenRecipeExistsWithName(tc, name).TotalTimeMinutes = minutes
+	}
+
+	first, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:    tc.UserID.String(),
+		PageIndex: 1,
+		PageSize:  1,
+		Sort:      "total_time",
+		SortOrder: "desc",
+	})
+	thenNoError(t, err)
+	if first.GetRecipes()[0].GetName() != "Stew" || first.GetNextCursor() == "" {
+		t.Fatalf("expected the slowest recipe first with a cursor, got %+v", first)
+	}
+
+	rest, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:    tc.UserID.String(),
+		PageSize:  10,
+		Sort:      "total_time",
+		SortOrder: "desc",
+		Cursor:    first.GetNextCursor(),
+	})
+
+	thenNoError(t, err)
+	if len(rest.GetRecipes()) != 2 || rest.GetRecipes()[0].GetName() != "Curry" || rest.GetRecipes()[1].GetName() != "Salad" {
+		t.Fatalf("expected curry then salad after the cursor, got %+v", rest.GetRecipes())
+	}
+	if rest.GetNextCursor() != "" {
+		t.Fatal("expected no cursor on the last page")
+	}
+}
+
+func TestListRecipes_CursorFromOtherSort_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenRecipeExistsWithName(tc, "Soup")
+	givenRecipeExistsWithName(tc, "Salad")
+	first, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:   tc.UserID.String(),
+		PageSize: 1,
+		Sort:     "name",
+	})
+	thenNoError(t, err)
+
+	_, err = tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:   tc.UserID.String(),
+		PageSize: 1,
+		Sort:     "calories",
+		Cursor:   first.GetNextCursor(),
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestListRecipes_CursorWithCookLogSort_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenRecipeExistsWithName(tc, "Soup")
+	givenRecipeExistsWithName(tc, "Salad")
+	first, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:   tc.UserID.String(),
+		PageSize: 1,
+	})
+	thenNoError(t, err)
+
+	_, err = tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:   tc.UserID.String(),
+		PageSize: 1,
+		Sort:     "top_rated",
+		Cursor:   first.GetNextCursor(),
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestDeleteRecipe_MovesRecipeToTrash(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	givenRecipeDeleted(t, tc, recipe.GetId())
+
+	trash, err := tc.Handler.ListDeletedRecipes(tc.Ctx, &pb.ListDeletedRecipesRequest{UserId: tc.UserID.String()})
+	thenNoError(t, err)
+	if len(trash.GetRecipes()) != 1 || trash.GetRecipes()[0].GetId() != recipe.GetId() || trash.GetRecipes()[0].GetDeletedAt() == "" {
+		t.Fatalf("expected the deleted recipe in the trash, got %+v", trash.GetRecipes())
+	}
+	_, err = tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestRestoreRecipe_RepublishesRecipeSharesAndCookStats(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	friend := givenRecipeSharedWith(t, tc, recipe, "friend@example.com", "read")
+	givenRecipeCooked(t, tc, recipe.GetId(), "2026-03-01", 5)
+	givenRecipeDeleted(t, tc, recipe.GetId())
+	tc.Publisher.RecipeUpsertedEvents = nil
+	tc.Publisher.RecipeSharedEvents = nil
+	tc.Publisher.CookStatsEvents = nil
+
+	restored, err := tc.Handler.RestoreRecipe(tc.Ctx, &pb.RestoreRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+
+	thenNoError(t, err)
+	if restored.GetDeletedAt() != "" {
+		t.Errorf("expected restored recipe to have no deleted at, got %q", restored.GetDeletedAt())
+	}
+	if tc.Publisher.UpsertedEventCount() != 1 || tc.Publisher.RecipeUpsertedEvents[0].ID.String() != recipe.GetId() {
+		t.Errorf("expected one upserted event for the restored recipe, got %d", tc.Publisher.UpsertedEventCount())
+	}
+	if len(tc.Publisher.RecipeSharedEvents) != 1 || tc.Publisher.RecipeSharedEvents[0].SharedWithUserID != friend.ID {
+		t.Errorf("expected the share to be republished, got %+v", tc.Publisher.RecipeSharedEvents)
+	}
+	if len(tc.Publisher.CookStatsEvents) != 1 || tc.Publisher.CookStatsEvents[0].Stats.TimesCooked != 1 {
+		t.Errorf("expected the cook stats to be republished, got %+v", tc.Publisher.CookStatsEvents)
+	}
+}
+
+func TestRestoreRecipe_RepublishesCollectionMembership(t *testing.T) {
+	tc := givenRecipeAPI()
+	soup := givenRecipeExistsWithName(tc, "Soup")
+	collection := givenCollectionWith(t, tc, "Weeknights", soup)
+	givenRecipeDeleted(t, tc, soup.ID.String())
+	tc.Publisher.CollectionRecipeAddedEvents = nil
+
+	_, err := tc.Handler.RestoreRecipe(tc.Ctx, &pb.RestoreRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: soup.ID.String(),
+	})
+
+	thenNoError(t, err)
+	added := tc.Publisher.CollectionRecipeAddedEvents
+	if len(added) != 1 || added[0].CollectionID.String() != collection.GetId() || added[0].RecipeID != soup.ID {
+		t.Errorf("expected the collection membership to be republished, got %+v", added)
+	}
+}
+
+func TestRestoreRecipe_NotInTrash_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	_, err := tc.Handler.RestoreRecipe(tc.Ctx, &pb.RestoreRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestPurgeRecipe_RequiresRecipeInTrash(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	req := &pb.PurgeRecipeRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()}
+
+	_, err := tc.Handler.PurgeRecipe(tc.Ctx, req)
+	thenErrorHasCode(t, err, codes.NotFound)
+
+	givenRecipeDeleted(t, tc, recipe.GetId())
+	_, err = tc.Handler.PurgeRecipe(tc.Ctx, req)
+
+	thenNoError(t, err)
+	_, err = tc.Handler.RestoreRecipe(tc.Ctx, &pb.RestoreRecipeRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestDuplicateRecipe_SharedRecipe_CopiesIntoOwnCatalog(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	friend := givenRecipeSharedWith(t, tc, recipe, "friend@example.com", "read")
+
+	duplicate, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{
+		UserId:   friend.ID.String(),
+		RecipeId: recipe.GetId(),
+		Name:     "My Lasagna",
+	})
+
+	thenNoError(t, err)
+	if duplicate.GetId() == recipe.GetId() || duplicate.GetUserId() != friend.ID.String() || duplicate.GetName() != "My Lasagna" {
+		t.Fatalf("expected a copy owned by the friend, got %+v", duplicate)
+	}
+	if len(duplicate.GetIngredientLines()) != 2 || len(duplicate.GetSteps()) != 1 {
+		t.Fatalf("expected ingredient lines and steps to be copied, got %+v", duplicate)
+	}
+	for _, line := range duplicate.GetIngredientLines() {
+		id := uuid.MustParse(line.GetIngredient().GetId())
+		if tc.Repo.Ingredients[id].UserID != friend.ID {
+			t.Errorf("expected %q to resolve to the friend's ingredient", line.GetIngredient().GetName())
+		}
+	}
+	if duplicate.GetCuisine().GetId() == recipe.GetCuisine().GetId() || duplicate.GetCuisine().GetName() != "Italian" {
+		t.Errorf("expected the cuisine to resolve to the friend's Italian, got %+v", duplicate.GetCuisine())
+	}
+	fork := duplicate.GetForkedFrom()
+	if fork.GetRecipeId() != recipe.GetId() || fork.GetRecipeName() != "Lasagna" || fork.GetHasUpstreamChanges() {
+		t.Errorf("unexpected fork link: %+v", fork)
+	}
+}
+
+func TestDuplicateRecipe_SharedRecipe_KeepsIngredientAllergiesAndNutrition(t *testing.T) {
+	tc := givenRecipeAPI()
+	dairy := givenAllergy(tc, "Dairy")
+	ricotta, err := tc.Repo.GetOrCreateIngredient(tc.Ctx, tc.UserID, "Ricotta")
+	thenNoError(t, err)
+	thenNoError(t, tc.Repo.AddIngredientAllergy(tc.Ctx, ricotta.ID, dairy.ID))
+	tc.Repo.AddIngredientNutrition(domain.IngredientNutrition{IngredientID: ricotta.ID, ServingSizeValue: 100, ServingUnit: "g", Calories: 174})
+	recipe := givenLasagnaCreated(t, tc)
+	friend := givenRecipeSharedWith(t, tc, recipe, "friend@example.com", "read")
+
+	duplicate, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{
+		UserId:   friend.ID.String(),
+		RecipeId: recipe.GetId(),
+	})
+
+	thenNoError(t, err)
+	allergies := duplicate.GetAllergies()
+	if len(allergies) != 1 || allergies[0].GetId() != dairy.ID.String() {
+		t.Fatalf("expected the duplicate to keep the dairy allergy, got %+v", allergies)
+	}
+	for _, line := range duplicate.GetIngredientLines() {
+		if line.GetIngredient().GetName() != "Ricotta" {
+			continue
+		}
+		id := uuid.MustParse(line.GetIngredient().GetId())
+		if id == ricotta.ID {
+			t.Fatal("expected the friend's own ricotta")
+		}
+		if fact, ok := tc.Repo.Nutrition[id]; !ok || fact.Calories != 174 {
+			t.Errorf("expected the friend's ricotta to keep its nutrition facts, got %+v", fact)
+		}
+	}
+}
+
+func TestDuplicateRecipe_KeepsDietLabels(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenClassifiedLasagnaCreated(t, tc)
+	thenDietLabels(t, recipe, "vegetarian", "pescatarian", "nut-free")
+
+	duplicate, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+
+	thenNoError(t, err)
+	thenDietLabels(t, duplicate, "vegetarian", "pescatarian", "nut-free")
+	stored, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: duplicate.GetId()})
+	thenNoError(t, err)
+	thenDietLabels(t, stored, "vegetarian", "pescatarian", "nut-free")
+}
+
+func TestPullUpstreamRecipe_ClassifiesPulledDiet(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenClassifiedLasagnaCreated(t, tc)
+	duplicate, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+	thenNoError(t, err)
+	givenClassifiedIngredient(tc, "Bacon", domain.IngredientAttributeMeat)
+	_, err = tc.Handler.UpdateRecipe(tc.Ctx, &pb.UpdateRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Recipe: lasagnaInput("Lasagna",
+			&pb.IngredientLineInput{IngredientName: "Pasta"},
+			&pb.IngredientLineInput{IngredientName: "Bacon"},
+		),
+	})
+	thenNoError(t, err)
+
+	pulled, err := tc.Handler.PullUpstreamRecipe(tc.Ctx, &pb.PullUpstreamRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: duplicate.GetId(),
+	})
+
+	thenNoError(t, err)
+	thenDietLabels(t, pulled, "dairy-free", "nut-free")
+	stored, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: duplicate.GetId()})
+	thenNoError(t, err)
+	thenDietLabels(t, stored, "dairy-free", "nut-free")
+}
+
+func TestPullUpstreamRecipe_CopiesUpstreamEditsAndKeepsName(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	friend := givenRecipeSharedWith(t, tc, recipe, "friend@example.com", "read")
+	duplicate, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{
+		UserId:   friend.ID.String(),
+		RecipeId: recipe.GetId(),
+		Name:     "My Lasagna",
+	})
+	thenNoError(t, err)
+	givenLasagnaEdited(t, tc, recipe)
+
+	before, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: friend.ID.String(), RecipeId: duplicate.GetId()})
+	thenNoError(t, err)
+	if !before.GetForkedFrom().GetHasUpstreamChanges() {
+		t.Fatalf("expected upstream changes to be reported, got %+v", before.GetForkedFrom())
+	}
+
+	pulled, err := tc.Handler.PullUpstreamRecipe(tc.Ctx, &pb.PullUpstreamRecipeRequest{
+		UserId:   friend.ID.String(),
+		RecipeId: duplicate.GetId(),
+	})
+
+	thenNoError(t, err)
+	if pulled.GetName() != "My Lasagna" || len(pulled.GetIngredientLines()) != 1 {
+		t.Fatalf("expected the upstream edit under the duplicate's name, got %+v", pulled)
+	}
+	if pulled.GetForkedFrom().GetHasUpstreamChanges() {
+		t.Errorf("expected the duplicate to be in sync, got %+v", pulled.GetForkedFrom())
+	}
+}
+
+func TestGetRecipe_UpstreamNoLongerShared_DropsForkLink(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	friend := givenRecipeSharedWith(t, tc, recipe, "friend@example.com", "read")
+	duplicate, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{
+		UserId:   friend.ID.String(),
+		RecipeId: recipe.GetId(),
+	})
+	thenNoError(t, err)
+	_, err = tc.Handler.RevokeRecipeShare(tc.Ctx, &pb.RevokeRecipeShareRequest{
+		UserId:           tc.UserID.String(),
+		RecipeId:         recipe.GetId(),
+		SharedWithUserId: friend.ID.String(),
+	})
+	thenNoError(t, err)
+
+	resp, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: friend.ID.String(), RecipeId: duplicate.GetId()})
+
+	thenNoError(t, err)
+	if resp.GetForkedFrom() != nil {
+		t.Errorf("expected no fork link to an upstream the friend cannot read, got %+v", resp.GetForkedFrom())
+	}
+}
+
+func TestPullUpstreamRecipe_UpstreamDeleted_ReturnsFailedPrecondition(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	duplicate, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+	thenNoError(t, err)
+	givenRecipeDeleted(t, tc, recipe.GetId())
+
+	resp, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: duplicate.GetId()})
+	thenNoError(t, err)
+	if resp.GetForkedFrom() != nil {
+		t.Errorf("expected no fork link to a deleted upstream, got %+v", resp.GetForkedFrom())
+	}
+
+	_, err = tc.Handler.PullUpstreamRecipe(tc.Ctx, &pb.PullUpstreamRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: duplicate.GetId(),
+	})
+
+	thenErrorHasCode(t, err, codes.FailedPrecondition)
+}
+
+func TestPullUpstreamRecipe_NotDuplicated_ReturnsFailedPrecondition(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	_, err := tc.Handler.PullUpstreamRecipe(tc.Ctx, &pb.PullUpstreamRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+
+	thenErrorHasCode(t, err, codes.FailedPrecondition)
+}
+
+func TestSetRecipeImage_SetsRecipeAndStepImages(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	withImage, err := tc.Handler.SetRecipeImage(tc.Ctx, &pb.SetRecipeImageRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		ImageUrl: "http://localhost:8080/media/images/lasagna/large.jpg",
+	})
+	thenNoError(t, err)
+	withStepImage, err := tc.Handler.SetRecipeImage(tc.Ctx, &pb.SetRecipeImageRequest{
+		UserId:    tc.UserID.String(),
+		RecipeId:  recipe.GetId(),
+		ImageUrl:  "http://localhost:8080/media/images/layering/large.jpg",
+		StepIndex: 1,
+	})
+
+	thenNoError(t, err)
+	if withImage.GetImageUrl() != "http://localhost:8080/media/images/lasagna/large.jpg" {
+		t.Errorf("expected recipe image to be set, got %q", withImage.GetImageUrl())
+	}
+	if withStepImage.GetImageUrl() != withImage.GetImageUrl() {
+		t.Errorf("expected recipe image to be kept, got %q", withStepImage.GetImageUrl())
+	}
+	if got := withStepImage.GetSteps()[0].GetMediaUrl(); got != "http://localhost:8080/media/images/layering/large.jpg" {
+		t.Errorf("expected step media to be set, got %q", got)
+	}
+	if withStepImage.GetName() != "Lasagna" || len(withStepImage.GetIngredientLines()) != 2 {
+		t.Errorf("expected the rest of the recipe to be unchanged, got %+v", withStepImage)
+	}
+}
+
+func TestSetRecipeImage_UnknownStep_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+
+	_, err := tc.Handler.SetRecipeImage(tc.Ctx, &pb.SetRecipeImageRequest{
+		UserId:    tc.UserID.String(),
+		RecipeId:  recipe.GetId(),
+		ImageUrl:  "http://localhost:8080/media/images/layering/large.jpg",
+		StepIndex: 2,
+	})
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestPurgeRecipe_DeletesImagesNoOtherRecipeUses(t *testing.T) {
+	tc := givenRecipeAPI()
+	store := givenMediaStore(t, tc)
+	recipe := givenLasagnaCreated(t, tc)
+	sharedImage := givenImageStored(t, store)
+	stepImage := givenImageStored(t, store)
+	givenRecipeImageSet(t, tc, recipe.GetId(), 0, sharedImage)
+	_, err := tc.Handler.DuplicateRecipe(tc.Ctx, &pb.DuplicateRecipeRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()})
+	thenNoError(t, err)
+	givenRecipeImageSet(t, tc, recipe.GetId(), 1, stepImage)
+	givenRecipeDeleted(t, tc, recipe.GetId())
+
+	_, err = tc.Handler.PurgeRecipe(tc.Ctx, &pb.PurgeRecipeRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()})
+
+	thenNoError(t, err)
+	thenImageStored(t, store, sharedImage, true)
+	thenImageStored(t, store, stepImage, false)
+}
+
+func TestStartCookSession_MovesThroughSteps(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenTimedRecipeCreated(t, tc)
+
+	session, err := tc.Handler.StartCookSession(tc.Ctx, &pb.StartCookSessionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Servings: 8,
+	})
+	thenNoError(t, err)
+	if session.GetCurrentStep() != 1 || session.GetStatus() != "active" || len(session.GetTimers()) != 1 {
+		t.Fatalf("expected an active session on step 1 with one timer, got %+v", session)
+	}
+	if session.GetTimers()[0].GetName() != "Step 2: Simmer the sauce" {
+		t.Errorf("expected the timer to be named after its step, got %q", session.GetTimers()[0].GetName())
+	}
+	if got := session.GetRecipe().GetServings(); got != 8 {
+		t.Errorf("expected the recipe scaled to 8 servings, got %d", got)
+	}
+
+	joined, err := tc.Handler.StartCookSession(tc.Ctx, &pb.StartCookSessionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+	thenNoError(t, err)
+	if joined.GetId() != session.GetId() {
+		t.Errorf("expected the running session to be joined, got a new session %s", joined.GetId())
+	}
+
+	moved, err := tc.Handler.MoveCookSessionStep(tc.Ctx, &pb.MoveCookSessionStepRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+		Delta:     5,
+	})
+	thenNoError(t, err)
+	if moved.GetCurrentStep() != 3 {
+		t.Errorf("expected the move to stop at the last step, got %d", moved.GetCurrentStep())
+	}
+
+	moved, err = tc.Handler.MoveCookSessionStep(tc.Ctx, &pb.MoveCookSessionStepRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+		Delta:     -1,
+	})
+	thenNoError(t, err)
+	if moved.GetCurrentStep() != 2 || moved.GetVersion() != session.GetVersion()+2 {
+		t.Errorf("expected step 2 after two changes, got step %d at version %d", moved.GetCurrentStep(), moved.GetVersion())
+	}
+}
+
+func TestStartCookSession_RecipeWithoutSteps_ReturnsFailedPrecondition(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := testutil.NewRecipeBuilder().WithUserID(tc.UserID).WithSteps(nil).Build()
+	tc.Repo.AddRecipe(recipe)
+
+	_, err := tc.Handler.StartCookSession(tc.Ctx, &pb.StartCookSessionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.ID.String(),
+	})
+
+	thenErrorHasCode(t, err, codes.FailedPrecondition)
+}
+
+func TestStartCookSession_ConflictingSessionGone_ReturnsAborted(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenTimedRecipeCreated(t, tc)
+	tc.Repo.ConflictOnCreateCookSession = true
+
+	_, err := tc.Handler.StartCookSession(tc.Ctx, &pb.StartCookSessionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+
+	thenErrorHasCode(t, err, codes.Aborted)
+}
+
+func TestStartCookSession_InvalidServings_DescribesAcceptedRange(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenTimedRecipeCreated(t, tc)
+
+	_, err := tc.Handler.StartCookSession(tc.Ctx, &pb.StartCookSessionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Servings: -1,
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+	if msg := status.Convert(err).Message(); !strings.Contains(msg, "between 0") {
+		t.Errorf("expected the message to accept 0 servings, got %q", msg)
+	}
+}
+
+func TestCookTimer_StartAndPause(t *testing.T) {
+	tc := givenRecipeAPI()
+	session := givenCookSessionStarted(t, tc, givenTimedRecipeCreated(t, tc).GetId())
+
+	started, err := tc.Handler.StartCookTimer(tc.Ctx, &pb.CookTimerRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+		StepIndex: 2,
+	})
+	thenNoError(t, err)
+	timer := started.GetTimers()[0]
+	if !timer.GetRunning() || timer.GetEndsAt() == "" {
+		t.Fatalf("expected a running timer with an end time, got %+v", timer)
+	}
+
+	paused, err := tc.Handler.PauseCookTimer(tc.Ctx, &pb.CookTimerRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+		StepIndex: 2,
+	})
+	thenNoError(t, err)
+	timer = paused.GetTimers()[0]
+	if timer.GetRunning() || timer.GetEndsAt() != "" || timer.GetRemainingSeconds() > 600 || timer.GetRemainingSeconds() < 599 {
+		t.Errorf("expected a paused timer keeping its time left, got %+v", timer)
+	}
+
+	_, err = tc.Handler.StartCookTimer(tc.Ctx, &pb.CookTimerRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+		StepIndex: 1,
+	})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestCompleteCookSession_LogsCookOnce(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenTimedRecipeCreated(t, tc)
+	session := givenCookSessionStarted(t, tc, recipe.GetId())
+
+	completed, err := tc.Handler.CompleteCookSession(tc.Ctx, &pb.CompleteCookSessionRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+		Entry:     &pb.CookLogEntryInput{Rating: 4},
+	})
+
+	thenNoError(t, err)
+	if completed.GetStatus() != "completed" || completed.GetCookLogEntryId() == "" {
+		t.Fatalf("expected a completed session linked to its cook log entry, got %+v", completed)
+	}
+	if len(tc.Repo.CookLog) != 1 || tc.Repo.CookLog[0].Rating == nil || *tc.Repo.CookLog[0].Rating != 4 {
+		t.Fatalf("expected one rated cook log entry, got %+v", tc.Repo.CookLog)
+	}
+	if len(tc.Publisher.CookStatsEvents) != 1 {
+		t.Errorf("expected a cook stats event, got %d", len(tc.Publisher.CookStatsEvents))
+	}
+
+	_, err = tc.Handler.CompleteCookSession(tc.Ctx, &pb.CompleteCookSessionRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+	})
+	thenErrorHasCode(t, err, codes.FailedPrecondition)
+}
+
+func TestGetCookSession_Expired_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	tc.Handler.WithCookSessionTTL(time.Nanosecond)
+	session := givenCookSessionStarted(t, tc, givenTimedRecipeCreated(t, tc).GetId())
+
+	_, err := tc.Handler.GetCookSession(tc.Ctx, &pb.CookSessionRequest{
+		UserId:    tc.UserID.String(),
+		SessionId: session.GetId(),
+	})
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestListRecipeSubstitutions_GroupsByIngredient(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	dairy := givenSubstitutionsExist(tc)
+
+	resp, err := tc.Handler.ListRecipeSubstitutions(tc.Ctx, &pb.ListRecipeSubstitutionsRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetIngredients()) != 1 || resp.GetIngredients()[0].GetIngredient().GetName() != "Ricotta" {
+		t.Fatalf("expected substitutions for ricotta only, got %+v", resp.GetIngredients())
+	}
+	if got := len(resp.GetIngredients()[0].GetSubstitutions()); got != 2 {
+		t.Errorf("expected 2 ricotta substitutions, got %d", got)
+	}
+
+	resp, err = tc.Handler.ListRecipeSubstitutions(tc.Ctx, &pb.ListRecipeSubstitutionsRequest{
+		UserId:    tc.UserID.String(),
+		RecipeId:  recipe.GetId(),
+		AllergyId: dairy.ID.String(),
+	})
+
+	thenNoError(t, err)
+	subs := resp.GetIngredients()[0].GetSubstitutions()
+	if len(subs) != 1 || subs[0].GetSubstituteName() != "Crumbled firm tofu" || subs[0].GetAvoidsAllergy().GetName() != "Dairy" {
+		t.Errorf("expected only the dairy-free substitution, got %+v", subs)
+	}
+}
+
+func TestSubstituteRecipe_CopiesWithAdjustedQuantitiesAndAllergies(t *testing.T) {
+	tc := givenRecipeAPI()
+	dairy := givenSubstitutionsExist(tc)
+	tc.Repo.AddIngredient(&domain.Ingredient{ID: uuid.New(), UserID: tc.UserID, Name: "Ricotta", Allergies: []domain.Allergy{*dairy}})
+	recipe := givenLasagnaCreated(t, tc)
+	if len(recipe.GetAllergies()) != 1 {
+		t.Fatalf("expected the lasagna to contain dairy, got %+v", recipe.GetAllergies())
+	}
+
+	copied, err := tc.Handler.SubstituteRecipe(tc.Ctx, &pb.SubstituteRecipeRequest{
+		UserId:          tc.UserID.String(),
+		RecipeId:        recipe.GetId(),
+		SubstitutionIds: []string{tc.Repo.Substitutions[0].ID.String()},
+	})
+
+	thenNoError(t, err)
+	if copied.GetId() == recipe.GetId() || copied.GetName() != "Lasagna (with substitutions)" {
+		t.Fatalf("expected a renamed copy, got %s %q", copied.GetId(), copied.GetName())
+	}
+	line := copied.GetIngredientLines()[1]
+	if line.GetIngredient().GetName() != "Crumbled firm tofu" || line.GetQuantityValue().GetValue() != 250 || line.GetNote() != "instead of ricotta" {
+		t.Errorf("expected 250 g of tofu instead of ricotta, got %+v", line)
+	}
+	if len(copied.GetAllergies()) != 0 {
+		t.Errorf("expected the copy to be dairy-free, got %+v", copied.GetAllergies())
+	}
+
+	original, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: recipe.GetId()})
+	thenNoError(t, err)
+	if original.GetIngredientLines()[1].GetIngredient().GetName() != "Ricotta" {
+		t.Error("expected the original recipe to be unchanged")
+	}
+}
+
+func TestSubstituteRecipe_SubstituteContainingAllergen_KeepsAllergy(t *testing.T) {
+	tc := givenRecipeAPI()
+	dairy := givenSubstitutionsExist(tc)
+	tc.Repo.AddIngredient(&domain.Ingredient{ID: uuid.New(), UserID: tc.UserID, Name: "Ricotta", Allergies: []domain.Allergy{*dairy}})
+	recipe := givenLasagnaCreated(t, tc)
+
+	copied, err := tc.Handler.SubstituteRecipe(tc.Ctx, &pb.SubstituteRecipeRequest{
+		UserId:          tc.UserID.String(),
+		RecipeId:        recipe.GetId(),
+		SubstitutionIds: []string{tc.Repo.Substitutions[1].ID.String()},
+	})
+
+	thenNoError(t, err)
+	if copied.GetIngredientLines()[1].GetIngredient().GetName() != "Cottage cheese" {
+		t.Fatalf("expected cottage cheese instead of ricotta, got %+v", copied.GetIngredientLines()[1])
+	}
+	if len(copied.GetAllergies()) != 1 || copied.GetAllergies()[0].GetName() != "Dairy" {
+		t.Errorf("expected the copy to still contain dairy, got %+v", copied.GetAllergies())
+	}
+	for _, ingredient := range tc.Repo.Ingredients {
+		if ingredient.Name == "Cottage cheese" && len(ingredient.Allergies) != 1 {
+			t.Errorf("expected the substitute ingredient to be saved with dairy, got %+v", ingredient.Allergies)
+		}
+	}
+}
+
+func TestSubstituteRecipe_IngredientNotInRecipe_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	recipe := givenLasagnaCreated(t, tc)
+	givenSubstitutionsExist(tc)
+
+	_, err := tc.Handler.SubstituteRecipe(tc.Ctx, &pb.SubstituteRecipeRequest{
+		UserId:          tc.UserID.String(),
+		RecipeId:        recipe.GetId(),
+		SubstitutionIds: []string{tc.Repo.Substitutions[2].ID.String()},
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateDietaryProfile_NormalizesSavesAndPublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	dairy := givenAllergy(tc, "Dairy")
+
+	resp, err := tc.Handler.UpdateDietaryProfile(tc.Ctx, &pb.UpdateDietaryProfileRequest{
+		UserId:              tc.UserID.String(),
+		AllergyIds:          []string{dairy.ID.String(), dairy.ID.String()},
+		Diets:               []string{"Vegetarian", " vegetarian "},
+		DislikedIngredients: []string{" Cilantro ", "cilantro", ""},
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetAllergies()) != 1 || resp.GetAllergies()[0].GetName() != "Dairy" {
+		t.Errorf("expected the dairy allergy once, got %+v", resp.GetAllergies())
+	}
+	if len(resp.GetDiets()) != 1 || resp.GetDiets()[0] != "vegetarian" {
+		t.Errorf("expected the vegetarian diet once, got %v", resp.GetDiets())
+	}
+	if len(resp.GetDislikedIngredients()) != 1 || resp.GetDislikedIngredients()[0] != "Cilantro" {
+		t.Errorf("expected cilantro once, got %v", resp.GetDislikedIngredients())
+	}
+	if resp.GetUpdatedAt() == "" {
+		t.Error("expected an updated timestamp")
+	}
+	if len(tc.Publisher.DietaryProfileEvents) != 1 {
+		t.Fatalf("expected a dietary profile event, got %d", len(tc.Publisher.DietaryProfileEvents))
+	}
+
+	saved, err := tc.Handler.GetDietaryProfile(tc.Ctx, &pb.GetDietaryProfileRequest{UserId: tc.UserID.String()})
+	thenNoError(t, err)
+	if len(saved.GetAllergies()) != 1 || len(saved.GetDiets()) != 1 || len(saved.GetDislikedIngredients()) != 1 {
+		t.Errorf("expected the saved profile, got %+v", saved)
+	}
+}
+
+func TestUpdateDietaryProfile_InvalidInput_ReturnsError(t *testing.T) {
+	tc := givenRecipeAPI()
+
+	_, err := tc.Handler.UpdateDietaryProfile(tc.Ctx, &pb.UpdateDietaryProfileRequest{
+		UserId: tc.UserID.String(),
+		Diets:  []string{"carnivore"},
+	})
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+
+	_, err = tc.Handler.UpdateDietaryProfile(tc.Ctx, &pb.UpdateDietaryProfileRequest{
+		UserId:     tc.UserID.String(),
+		AllergyIds: []string{uuid.New().String()},
+	})
+	thenErrorHasCode(t, err, codes.NotFound)
+
+	if len(tc.Publisher.DietaryProfileEvents) != 0 {
+		t.Error("expected no dietary profile event")
+	}
+}
+
+func TestListRecipes_DietaryProfile_ExcludesConflictingRecipesUnlessIgnored(t *testing.T) {
+	tc := givenRecipeAPI()
+	dairy := givenAllergy(tc, "Dairy")
+	tc.Repo.AddIngredient(&domain.Ingredient{ID: uuid.New(), UserID: tc.UserID, Name: "Ricotta", Allergies: []domain.Allergy{*dairy}})
+	givenLasagnaCreated(t, tc)
+	salad := givenRecipeExistsWithName(tc, "Green Salad")
+	givenDietaryProfile(tc, &domain.DietaryProfile{Allergies: []domain.Allergy{*dairy}})
+
+	resp, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{UserId: tc.UserID.String()})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 1 || resp.GetRecipes()[0].GetId() != salad.ID.String() || resp.GetTotalCount() != 1 {
+		t.Fatalf("expected only the salad, got %d recipes of %d", len(resp.GetRecipes()), resp.GetTotalCount())
+	}
+
+	resp, err = tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:               tc.UserID.String(),
+		IgnoreDietaryProfile: true,
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 2 {
+		t.Fatalf("expected both recipes when ignoring the profile, got %d", len(resp.GetRecipes()))
+	}
+}
+
+func TestGetSimilarRecipes_DietaryProfile_ExcludesDislikedIngredients(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenLasagnaCreated(t, tc)
+	salad := givenRecipeExistsWithName(tc, "Green Salad")
+	givenDietaryProfile(tc, &domain.DietaryProfile{DislikedIngredients: []string{"ricotta"}})
+
+	resp, err := tc.Handler.GetSimilarRecipes(tc.Ctx, &pb.GetSimilarRecipesRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: salad.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 0 {
+		t.Fatalf("expected the lasagna to be left out, got %d recipes", len(resp.GetRecipes()))
+	}
+
+	resp, err = tc.Handler.GetSimilarRecipes(tc.Ctx, &pb.GetSimilarRecipesRequest{
+		UserId:               tc.UserID.String(),
+		RecipeId:             salad.ID.String(),
+		IgnoreDietaryProfile: true,
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 1 {
+		t.Fatalf("expected the lasagna when ignoring the profile, got %d recipes", len(resp.GetRecipes()))
+	}
+}
+
+func TestCreateRecipe_ClassifiesDietFromIngredients(t *testing.T) {
+	tc := givenRecipeAPI()
+	pasta := givenClassifiedIngredient(tc, "Pasta", domain.IngredientAttributeGluten)
+	ricotta := givenClassifiedIngredient(tc, "Ricotta", domain.IngredientAttributeDairy)
+	bacon := givenClassifiedIngredient(tc, "Bacon", domain.IngredientAttributeMeat)
+
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Lasagna",
+			&pb.IngredientLineInput{IngredientId: pasta.ID.String()},
+			&pb.IngredientLineInput{IngredientId: ricotta.ID.String()},
+			&pb.IngredientLineInput{IngredientId: bacon.ID.String(), IsOptional: true},
+		),
+	})
+
+	thenNoError(t, err)
+	thenDietLabels(t, resp, "vegetarian", "pescatarian", "nut-free")
+}
+
+func TestCreateRecipe_UnclassifiedIngredient_LeavesRecipeUnlabeled(t *testing.T) {
+	tc := givenRecipeAPI()
+	pasta := givenClassifiedIngredient(tc, "Pasta", domain.IngredientAttributeGluten)
+
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Lasagna",
+			&pb.IngredientLineInput{IngredientId: pasta.ID.String()},
+			&pb.IngredientLineInput{IngredientName: "Mystery Sauce"},
+		),
+	})
+
+	thenNoError(t, err)
+	thenDietLabels(t, resp)
+}
+
+func TestCreateRecipe_NewIngredientsListedInCatalog_AreClassified(t *testing.T) {
+	tc := givenRecipeAPI()
+
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Carbonara",
+			&pb.IngredientLineInput{IngredientName: "Spaghetti"},
+			&pb.IngredientLineInput{IngredientName: "Eggs"},
+			&pb.IngredientLineInput{IngredientName: "Parmesan"},
+		),
+	})
+
+	thenNoError(t, err)
+	thenDietLabels(t, resp, "vegetarian", "pescatarian", "nut-free")
+}
+
+func TestListRecipes_DietProfile_KeepsUnclassifiedRecipes(t *testing.T) {
+	tc := givenRecipeAPI()
+	bacon := givenClassifiedIngredient(tc, "Bacon", domain.IngredientAttributeMeat)
+	_, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Bacon Lasagna", &pb.IngredientLineInput{IngredientId: bacon.ID.String()}),
+	})
+	thenNoError(t, err)
+	unknown, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("House Lasagna", &pb.IngredientLineInput{IngredientName: "Mystery Sauce"}),
+	})
+	thenNoError(t, err)
+	givenDietaryProfile(tc, &domain.DietaryProfile{Diets: []domain.Diet{domain.DietVegetarian}})
+
+	resp, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{UserId: tc.UserID.String()})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 1 || resp.GetRecipes()[0].GetId() != unknown.GetId() {
+		t.Fatalf("expected only the unclassified recipe, got %d recipes", len(resp.GetRecipes()))
+	}
+}
+
+func TestSetIngredientDietaryAttributes_ReclassifiesRecipesUsingIt(t *testing.T) {
+	tc := givenRecipeAPI()
+	pasta := givenClassifiedIngredient(tc, "Pasta", domain.IngredientAttributeGluten)
+	created, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Lasagna",
+			&pb.IngredientLineInput{IngredientId: pasta.ID.String()},
+			&pb.IngredientLineInput{IngredientName: "House cheese"},
+		),
+	})
+	thenNoError(t, err)
+	cheeseID := created.GetIngredientLines()[1].GetIngredient().GetId()
+	published := len(tc.Publisher.RecipeUpsertedEvents)
+
+	resp, err := tc.Handler.SetIngredientDietaryAttributes(tc.Ctx, &pb.SetIngredientDietaryAttributesRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: cheeseID,
+		Attributes:   []string{"Dairy", "dairy"},
+	})
+
+	thenNoError(t, err)
+	if !resp.GetClassified() || len(resp.GetAttributes()) != 1 || resp.GetAttributes()[0] != "dairy" {
+		t.Fatalf("expected the cheese to be classified as dairy, got %+v", resp)
+	}
+	if resp.GetReclassifiedRecipes() != 1 {
+		t.Fatalf("expected 1 reclassified recipe, got %d", resp.GetReclassifiedRecipes())
+	}
+	if len(tc.Publisher.RecipeUpsertedEvents) != published+1 {
+		t.Fatal("expected the relabeled recipe to be published")
+	}
+
+	recipe, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: created.GetId()})
+	thenNoError(t, err)
+	thenDietLabels(t, recipe, "vegetarian", "pescatarian", "nut-free")
+}
+
+func TestSetIngredientDietaryAttributes_InvalidInput_ReturnsError(t *testing.T) {
+	tc := givenRecipeAPI()
+	pasta := givenClassifiedIngredient(tc, "Pasta")
+
+	_, err := tc.Handler.SetIngredientDietaryAttributes(tc.Ctx, &pb.SetIngredientDietaryAttributesRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: pasta.ID.String(),
+		Attributes:   []string{"sugar"},
+	})
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+
+	_, err = tc.Handler.SetIngredientDietaryAttributes(tc.Ctx, &pb.SetIngredientDietaryAttributesRequest{
+		UserId:       uuid.New().String(),
+		IngredientId: pasta.ID.String(),
+	})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestListRecipes_DietLabels_FiltersRecipesWithEveryLabel(t *testing.T) {
+	tc := givenRecipeAPI()
+	salad := givenRecipeExistsWithName(tc, "Green Salad")
+	salad.DietLabels = []domain.DietLabel{domain.DietLabelVegan, domain.DietLabelGlutenFree}
+	bread := givenRecipeExistsWithName(tc, "Bread")
+	bread.DietLabels = []domain.DietLabel{domain.DietLabelVegan}
+
+	resp, err := tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:     tc.UserID.String(),
+		DietLabels: []string{"Vegan", "gluten-free"},
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetRecipes()) != 1 || resp.GetRecipes()[0].GetId() != salad.ID.String() {
+		t.Fatalf("expected only the salad, got %d recipes", len(resp.GetRecipes()))
+	}
+
+	_, err = tc.Handler.ListRecipes(tc.Ctx, &pb.ListRecipesRequest{
+		UserId:     tc.UserID.String(),
+		DietLabels: []string{"keto"},
+	})
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestListIngredients_Query_RanksSimilarNamesFirst(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenClassifiedIngredient(tc, "Tomato")
+	givenClassifiedIngredient(tc, "Tomatoes")
+	givenClassifiedIngredient(tc, "Basil")
+
+	resp, err := tc.Handler.ListIngredients(tc.Ctx, &pb.ListIngredientsRequest{
+		UserId: tc.UserID.String(),
+		Query:  "tomatoe",
+	})
+
+	thenNoError(t, err)
+	if resp.GetTotalCount() != 2 || len(resp.GetIngredients()) != 2 {
+		t.Fatalf("expected 2 tomato ingredients, got %d", resp.GetTotalCount())
+	}
+	if resp.GetIngredients()[0].GetName() != "Tomatoes" {
+		t.Fatalf("expected the closest match first, got %s", resp.GetIngredients()[0].GetName())
+	}
+}
+
+func TestRenameIngredient_RenamesRecipeLinesAndRepublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenLasagnaCreated(t, tc)
+	sheets := created.GetIngredientLines()[0].GetIngredient()
+	published := len(tc.Publisher.RecipeUpsertedEvents)
+
+	resp, err := tc.Handler.RenameIngredient(tc.Ctx, &pb.RenameIngredientRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: sheets.GetId(),
+		Name:         " Lasagne sheets ",
+	})
+
+	thenNoError(t, err)
+	if resp.GetName() != "Lasagne sheets" || resp.GetRecipeCount() != 1 {
+		t.Fatalf("expected the renamed ingredient used by 1 recipe, got %+v", resp)
+	}
+	if len(tc.Publisher.RecipeUpsertedEvents) != published+1 {
+		t.Fatal("expected the recipe using the ingredient to be published")
+	}
+	recipe, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: created.GetId()})
+	thenNoError(t, err)
+	if recipe.GetIngredientLines()[0].GetIngredient().GetName() != "Lasagne sheets" {
+		t.Fatal("expected the recipe line to show the new name")
+	}
+}
+
+func TestRenameIngredient_NameTaken_ReturnsAlreadyExists(t *testing.T) {
+	tc := givenRecipeAPI()
+	tomatoes := givenClassifiedIngredient(tc, "Tomatoes")
+	givenClassifiedIngredient(tc, "Tomato")
+
+	_, err := tc.Handler.RenameIngredient(tc.Ctx, &pb.RenameIngredientRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: tomatoes.ID.String(),
+		Name:         "Tomato",
+	})
+
+	thenErrorHasCode(t, err, codes.AlreadyExists)
+}
+
+func TestSetIngredientCategory_FilesIngredientUnderCategory(t *testing.T) {
+	tc := givenRecipeAPI()
+	basil := givenClassifiedIngredient(tc, "Basil")
+	produce := &domain.IngredientCategory{ID: uuid.New(), Name: "Produce", DisplayOrder: 1}
+	tc.Repo.AddIngredientCategory(produce)
+
+	resp, err := tc.Handler.SetIngredientCategory(tc.Ctx, &pb.SetIngredientCategoryRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: basil.ID.String(),
+		CategoryId:   produce.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if resp.GetCategory().GetName() != "Produce" {
+		t.Fatalf("expected basil to be filed under produce, got %+v", resp.GetCategory())
+	}
+
+	_, err = tc.Handler.SetIngredientCategory(tc.Ctx, &pb.SetIngredientCategoryRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: basil.ID.String(),
+		CategoryId:   uuid.New().String(),
+	})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestMergeIngredients_RewritesRecipesAndDeletesSource(t *testing.T) {
+	tc := givenRecipeAPI()
+	tomato := givenClassifiedIngredient(tc, "Tomato")
+	tomato.Allergies = []domain.Allergy{*givenAllergy(tc, "Nightshade")}
+	tomatoes := givenClassifiedIngredient(tc, "tomatoes")
+	tomatoes.Allergies = []domain.Allergy{*givenAllergy(tc, "Salicylate")}
+	ricotta := givenClassifiedIngredient(tc, "Ricotta", domain.IngredientAttributeDairy)
+	created, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Lasagna",
+			&pb.IngredientLineInput{IngredientId: tomatoes.ID.String()},
+			&pb.IngredientLineInput{IngredientId: ricotta.ID.String()},
+		),
+	})
+	thenNoError(t, err)
+	published := len(tc.Publisher.RecipeUpsertedEvents)
+
+	resp, err := tc.Handler.MergeIngredients(tc.Ctx, &pb.MergeIngredientsRequest{
+		UserId:             tc.UserID.String(),
+		SourceIngredientId: tomatoes.ID.String(),
+		TargetIngredientId: tomato.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if resp.GetUpdatedRecipes() != 1 || resp.GetIngredient().GetRecipeCount() != 1 {
+		t.Fatalf("expected 1 updated recipe now using the target, got %+v", resp)
+	}
+	if len(resp.GetIngredient().GetAllergies()) != 2 {
+		t.Fatalf("expected the allergies to be combined, got %d", len(resp.GetIngredient().GetAllergies()))
+	}
+	if len(tc.Publisher.RecipeUpsertedEvents) != published+1 {
+		t.Fatal("expected the updated recipe to be published")
+	}
+	recipe, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: created.GetId()})
+	thenNoError(t, err)
+	if recipe.GetIngredientLines()[0].GetIngredient().GetId() != tomato.ID.String() {
+		t.Fatal("expected the recipe line to use the target ingredient")
+	}
+	_, err = tc.Handler.GetIngredientDietaryAttributes(tc.Ctx, &pb.GetIngredientDietaryAttributesRequest{
+		UserId:       tc.UserID.String(),
+		IngredientId: tomatoes.ID.String(),
+	})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestMergeIngredients_RevisionUsingSource_StillRestores(t *testing.T) {
+	tc := givenRecipeAPI()
+	tomato := givenClassifiedIngredient(tc, "Tomato")
+	tomatoes := givenClassifiedIngredient(tc, "tomatoes")
+	created, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Lasagna", &pb.IngredientLineInput{IngredientId: tomatoes.ID.String()}),
+	})
+	thenNoError(t, err)
+	_, err = tc.Handler.MergeIngredients(tc.Ctx, &pb.MergeIngredientsRequest{
+		UserId:             tc.UserID.String(),
+		SourceIngredientId: tomatoes.ID.String(),
+		TargetIngredientId: tomato.ID.String(),
+	})
+	thenNoError(t, err)
+
+	resp, err := tc.Handler.RestoreRecipeRevision(tc.Ctx, &pb.RestoreRecipeRevisionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: created.GetId(),
+		Revision: 1,
+	})
+
+	thenNoError(t, err)
+	if name := resp.GetIngredientLines()[0].GetIngredient().GetName(); name != "tomatoes" {
+		t.Fatalf("expected the merged ingredient to be resolved by name, got %q", name)
+	}
+}
+
+func TestMergeIngredients_IntoItself_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	tomato := givenClassifiedIngredient(tc, "Tomato")
+
+	_, err := tc.Handler.MergeIngredients(tc.Ctx, &pb.MergeIngredientsRequest{
+		UserId:             tc.UserID.String(),
+		SourceIngredientId: tomato.ID.String(),
+		TargetIngredientId: tomato.ID.String(),
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestListDuplicateIngredients_PairsSimilarNames(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenClassifiedIngredient(tc, "Tomato")
+	givenClassifiedIngredient(tc, "Tomatoes")
+	givenClassifiedIngredient(tc, "Basil")
+
+	resp, err := tc.Handler.ListDuplicateIngredients(tc.Ctx, &pb.ListDuplicateIngredientsRequest{
+		UserId: tc.UserID.String(),
+	})
+
+	thenNoError(t, err)
+	if len(resp.GetDuplicates()) != 1 {
+		t.Fatalf("expected 1 suggested duplicate, got %d", len(resp.GetDuplicates()))
+	}
+	names := []string{resp.GetDuplicates()[0].GetIngredient().GetName(), resp.GetDuplicates()[0].GetDuplicate().GetName()}
+	slices.Sort(names)
+	if !slices.Equal(names, []string{"Tomato", "Tomatoes"}) {
+		t.Fatalf("expected tomato and tomatoes to be paired, got %v", names)
+	}
+
+	_, err = tc.Handler.ListDuplicateIngredients(tc.Ctx, &pb.ListDuplicateIngredientsRequest{
+		UserId:        tc.UserID.String(),
+		MinSimilarity: 1.5,
+	})
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdateCuisine_RenamesAndRepublishesRecipes(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenLasagnaCreated(t, tc)
+	published := len(tc.Publisher.RecipeUpsertedEvents)
+
+	resp, err := tc.Handler.UpdateCuisine(tc.Ctx, &pb.UpdateCuisineRequest{
+		UserId:    tc.UserID.String(),
+		CuisineId: created.GetCuisine().GetId(),
+		Name:      " Italian food ",
+	})
+
+	thenNoError(t, err)
+	if resp.GetName() != "Italian food" {
+		t.Fatalf("expected the cuisine to be renamed, got %s", resp.GetName())
+	}
+	if len(tc.Publisher.RecipeUpsertedEvents) != published+1 {
+		t.Fatal("expected the recipe using the cuisine to be published")
+	}
+	event := tc.Publisher.RecipeUpsertedEvents[published]
+	if event.ID.String() != created.GetId() || event.Cuisine.Name != "Italian food" {
+		t.Fatalf("expected the published recipe to carry the new cuisine name, got %+v", event.Cuisine)
+	}
+}
+
+func TestUpdateCuisine_NameTaken_ReturnsAlreadyExists(t *testing.T) {
+	tc := givenRecipeAPI()
+	thai := givenCuisine(tc, "thai food")
+	givenCuisine(tc, "Thai")
+
+	_, err := tc.Handler.UpdateCuisine(tc.Ctx, &pb.UpdateCuisineRequest{
+		UserId:    tc.UserID.String(),
+		CuisineId: thai.ID.String(),
+		Name:      "Thai",
+	})
+
+	thenErrorHasCode(t, err, codes.AlreadyExists)
+}
+
+func TestDeleteCuisine_UsedByRecipes_RequiresReassignment(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenLasagnaCreated(t, tc)
+	mediterranean := givenCuisine(tc, "Mediterranean")
+
+	_, err := tc.Handler.DeleteCuisine(tc.Ctx, &pb.DeleteCuisineRequest{
+		UserId:    tc.UserID.String(),
+		CuisineId: created.GetCuisine().GetId(),
+	})
+	thenErrorHasCode(t, err, codes.FailedPrecondition)
+
+	resp, err := tc.Handler.DeleteCuisine(tc.Ctx, &pb.DeleteCuisineRequest{
+		UserId:              tc.UserID.String(),
+		CuisineId:           created.GetCuisine().GetId(),
+		ReassignToCuisineId: mediterranean.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if resp.GetUpdatedRecipes() != 1 {
+		t.Fatalf("expected 1 reassigned recipe, got %d", resp.GetUpdatedRecipes())
+	}
+	recipe, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: created.GetId()})
+	thenNoError(t, err)
+	if recipe.GetCuisine().GetName() != "Mediterranean" {
+		t.Fatalf("expected the recipe to move to the reassignment cuisine, got %s", recipe.GetCuisine().GetName())
+	}
+}
+
+func TestDeleteCuisine_Unused_DeletesWithoutReassignment(t *testing.T) {
+	tc := givenRecipeAPI()
+	thai := givenCuisine(tc, "Thai")
+
+	resp, err := tc.Handler.DeleteCuisine(tc.Ctx, &pb.DeleteCuisineRequest{
+		UserId:    tc.UserID.String(),
+		CuisineId: thai.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if resp.GetUpdatedRecipes() != 0 {
+		t.Fatalf("expected no reassigned recipes, got %d", resp.GetUpdatedRecipes())
+	}
+	if _, ok := tc.Repo.Cuisines[thai.ID]; ok {
+		t.Fatal("expected the cuisine to be deleted")
+	}
+}
+
+func TestMergeCuisines_MovesRecipesAndDeletesSource(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenLasagnaCreated(t, tc)
+	italy := givenCuisine(tc, "Italy")
+	published := len(tc.Publisher.RecipeUpsertedEvents)
+
+	resp, err := tc.Handler.MergeCuisines(tc.Ctx, &pb.MergeCuisinesRequest{
+		UserId:          tc.UserID.String(),
+		SourceCuisineId: created.GetCuisine().GetId(),
+		TargetCuisineId: italy.ID.String(),
+	})
+
+	thenNoError(t, err)
+	if resp.GetCuisine().GetId() != italy.ID.String() || resp.GetUpdatedRecipes() != 1 {
+		t.Fatalf("expected 1 recipe merged into Italy, got %+v", resp)
+	}
+	if len(tc.Publisher.RecipeUpsertedEvents) != published+1 {
+		t.Fatal("expected the moved recipe to be published")
+	}
+
+	_, err = tc.Handler.MergeCuisines(tc.Ctx, &pb.MergeCuisinesRequest{
+		UserId:          tc.UserID.String(),
+		SourceCuisineId: italy.ID.String(),
+		TargetCuisineId: italy.ID.String(),
+	})
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestRecipeImport_MatchesByExternalIDThenName(t *testing.T) {
+	tc := givenRecipeAPI()
+	existing := givenLasagnaCreated(t, tc)
+	first := tc.Handler.NewRecipeImport(tc.UserID, handler.ImportOptions{})
+
+	created := first.Import(tc.Ctx, portableLasagna("ext-1", "Baked Ziti"))
+	byName := first.Import(tc.Ctx, portableLasagna("", "lasagna"))
+	repeated := first.Import(tc.Ctx, portableLasagna("ext-1", "Baked Ziti"))
+
+	if created.GetStatus() != handler.ImportStatusCreated || created.GetRecipeId() == "" {
+		t.Fatalf("expected a new recipe to be created, got %+v", created)
+	}
+	if byName.GetStatus() != handler.ImportStatusSkipped || byName.GetRecipeId() != existing.GetId() {
+		t.Fatalf("expected the item to match the existing recipe by name, got %+v", byName)
+	}
+	if repeated.GetStatus() != handler.ImportStatusSkipped || repeated.GetMessage() != "duplicate of an earlier item" {
+		t.Fatalf("expected a repeated item to be skipped, got %+v", repeated)
+	}
+
+	second := tc.Handler.NewRecipeImport(tc.UserID, handler.ImportOptions{UpdateExisting: true})
+	updated := second.Import(tc.Ctx, portableLasagna("ext-1", "Baked Ziti al Forno"))
+
+	if updated.GetStatus() != handler.ImportStatusUpdated || updated.GetRecipeId() != created.GetRecipeId() {
+		t.Fatalf("expected the item to update the recipe imported under its external ID, got %+v", updated)
+	}
+	recipe, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: created.GetRecipeId()})
+	thenNoError(t, err)
+	if recipe.GetName() != "Baked Ziti al Forno" {
+		t.Fatalf("expected the recipe to be updated, got %s", recipe.GetName())
+	}
+}
+
+func TestRecipeImport_DryRun_WritesNothingAndReportsErrors(t *testing.T) {
+	tc := givenRecipeAPI()
+	imp := tc.Handler.NewRecipeImport(tc.UserID, handler.ImportOptions{DryRun: true})
+
+	valid := imp.Import(tc.Ctx, portableLasagna("ext-1", "Baked Ziti"))
+	invalid := imp.Import(tc.Ctx, &pb.PortableRecipe{Recipe: &pb.RecipeInput{Name: "Toast"}})
+
+	if valid.GetStatus() != handler.ImportStatusCreated {
+		t.Fatalf("expected the valid item to be reported as created, got %+v", valid)
+	}
+	if invalid.GetStatus() != handler.ImportStatusError || invalid.GetMessage() != "main ingredient is required" {
+		t.Fatalf("expected the invalid item to fail, got %+v", invalid)
+	}
+	resp := imp.Response()
+	if !resp.GetDryRun() || resp.GetCreated() != 1 || resp.GetFailed() != 1 {
+		t.Fatalf("expected 1 created and 1 failed item, got %+v", resp)
+	}
+	if len(tc.Repo.Recipes) != 0 || len(tc.Repo.Ingredients) != 0 || len(tc.Repo.Cuisines) != 0 {
+		t.Fatal("expected a dry run to write nothing")
+	}
+}
+
+func TestRecipeImport_DryRun_FallsBackToNameOfUnknownIngredientID(t *testing.T) {
+	tc := givenRecipeAPI()
+	item := portableLasagna("ext-1", "Baked Ziti")
+	item.Recipe.IngredientLines[0].IngredientId = uuid.NewString()
+	item.Recipe.MainIngredientId = uuid.NewString()
+	item.Recipe.MainIngredientName = "Pasta"
+
+	result := tc.Handler.NewRecipeImport(tc.UserID, handler.ImportOptions{DryRun: true}).Import(tc.Ctx, item)
+
+	if result.GetStatus() != handler.ImportStatusCreated {
+		t.Fatalf("expected the item to be reported as created like a real import would, got %+v", result)
+	}
+	if len(tc.Repo.Ingredients) != 0 {
+		t.Fatal("expected a dry run to create no ingredients")
+	}
+}
+
+func TestRecipeImport_TrashedMatch_IsSkipped(t *testing.T) {
+	tc := givenRecipeAPI()
+	imp := tc.Handler.NewRecipeImport(tc.UserID, handler.ImportOptions{UpdateExisting: true})
+	created := imp.Import(tc.Ctx, portableLasagna("ext-1", "Baked Ziti"))
+	_, err := tc.Handler.DeleteRecipe(tc.Ctx, &pb.DeleteRecipeRequest{UserId: tc.UserID.String(), RecipeId: created.GetRecipeId()})
+	thenNoError(t, err)
+
+	result := tc.Handler.NewRecipeImport(tc.UserID, handler.ImportOptions{UpdateExisting: true}).
+		Import(tc.Ctx, portableLasagna("ext-1", "Baked Ziti"))
+
+	if result.GetStatus() != handler.ImportStatusSkipped || result.GetRecipeId() != created.GetRecipeId() {
+		t.Fatalf("expected the item matching a trashed recipe to be skipped, got %+v", result)
+	}
+}
+
+func TestExportRecipes_RoundTripsThroughImport(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenLasagnaCreated(t, tc)
+	stream := &portableRecipeStream{ctx: tc.Ctx}
+
+	err := tc.Handler.ExportRecipes(&pb.ExportRecipesRequest{UserId: tc.UserID.String()}, stream)
+
+	thenNoError(t, err)
+	if len(stream.recipes) != 1 {
+		t.Fatalf("expected 1 exported recipe, got %d", len(stream.recipes))
+	}
+	exported := stream.recipes[0]
+	if exported.GetExternalId() != created.GetId() || exported.GetRecipe().GetCuisineName() != "Italian" {
+		t.Fatalf("expected the recipe ID and cuisine name to be exported, got %+v", exported)
+	}
+	if line := exported.GetRecipe().GetIngredientLines()[0]; line.GetIngredientId() != "" || line.GetIngredientName() != "Lasagna sheets" {
+		t.Fatalf("expected ingredients to be exported by name, got %+v", line)
+	}
+
+	other := uuid.New()
+	result := tc.Handler.NewRecipeImport(other, handler.ImportOptions{}).Import(tc.Ctx, exported)
+
+	if result.GetStatus() != handler.ImportStatusCreated {
+		t.Fatalf("expected the exported recipe to import, got %+v", result)
+	}
+	imported, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: other.String(), RecipeId: result.GetRecipeId()})
+	thenNoError(t, err)
+	if imported.GetName() != "Lasagna" || len(imported.GetIngredientLines()) != 2 {
+		t.Fatalf("expected the recipe to be imported into the other library, got %+v", imported)
+	}
+}
+
+func TestListTags_CountsUsageAndFiltersByPrefixAndGroup(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenTaggedRecipe(t, tc, "Lasagna", "Dinner", "baked")
+	givenTaggedRecipe(t, tc, "Tiramisu", "dessert", "dinner")
+	givenTaggedRecipe(t, tc, "Focaccia", "baked", "bread")
+
+	resp, err := tc.Handler.ListTags(tc.Ctx, &pb.ListTagsRequest{UserId: tc.UserID.String()})
+
+	thenNoError(t, err)
+	var names []string
+	for _, tag := range resp.GetTags() {
+		names = append(names, tag.GetName())
+	}
+	if !slices.Equal(names, []string{"baked", "dinner", "bread", "dessert"}) {
+		t.Fatalf("expected tags by usage then name, got %v", names)
+	}
+	if resp.GetTags()[0].GetRecipeCount() != 2 {
+		t.Fatalf("expected baked on 2 recipes, got %d", resp.GetTags()[0].GetRecipeCount())
+	}
+
+	resp, err = tc.Handler.ListTags(tc.Ctx, &pb.ListTagsRequest{UserId: tc.UserID.String(), Prefix: " B"})
+	thenNoError(t, err)
+	if len(resp.GetTags()) != 2 {
+		t.Fatalf("expected 2 tags starting with b, got %d", len(resp.GetTags()))
+	}
+
+	_, err = tc.Handler.SetTagGroup(tc.Ctx, &pb.SetTagGroupRequest{UserId: tc.UserID.String(), Tag: "dessert", Group: "course"})
+	thenNoError(t, err)
+	resp, err = tc.Handler.ListTags(tc.Ctx, &pb.ListTagsRequest{UserId: tc.UserID.String(), Group: "course"})
+	thenNoError(t, err)
+	if len(resp.GetTags()) != 1 || resp.GetTags()[0].GetName() != "dessert" || resp.GetTags()[0].GetGroup() != "course" {
+		t.Fatalf("expected only dessert in the course group, got %v", resp.GetTags())
+	}
+}
+
+func TestSetTagGroup_UnknownGroup_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenTaggedRecipe(t, tc, "Lasagna", "dinner")
+
+	_, err := tc.Handler.SetTagGroup(tc.Ctx, &pb.SetTagGroupRequest{UserId: tc.UserID.String(), Tag: "dinner", Group: "cuisine"})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestRenameTag_RewritesRecipesAndRepublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenTaggedRecipe(t, tc, "Lasagna", "weeknite", "baked")
+	published := len(tc.Publisher.RecipeUpsertedEvents)
+
+	resp, err := tc.Handler.RenameTag(tc.Ctx, &pb.RenameTagRequest{
+		UserId: tc.UserID.String(),
+		Tag:    "weeknite",
+		Name:   " Weeknight ",
+	})
+
+	thenNoError(t, err)
+	if resp.GetUpdatedRecipes() != 1 {
+		t.Fatalf("expected 1 updated recipe, got %d", resp.GetUpdatedRecipes())
+	}
+	if len(tc.Publisher.RecipeUpsertedEvents) != published+1 {
+		t.Fatal("expected the retagged recipe to be published")
+	}
+	event := tc.Publisher.RecipeUpsertedEvents[published]
+	if event.ID.String() != created.GetId() || !slices.Equal(event.Tags, []string{"weeknight", "baked"}) {
+		t.Fatalf("expected the published recipe to carry the new tag, got %v", event.Tags)
+	}
+}
+
+func TestRenameTag_NameTaken_ReturnsAlreadyExists(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenTaggedRecipe(t, tc, "Lasagna", "weeknite")
+	givenTaggedRecipe(t, tc, "Risotto", "weeknight")
+
+	_, err := tc.Handler.RenameTag(tc.Ctx, &pb.RenameTagRequest{
+		UserId: tc.UserID.String(),
+		Tag:    "weeknite",
+		Name:   "weeknight",
+	})
+
+	thenErrorHasCode(t, err, codes.AlreadyExists)
+}
+
+func TestMergeTags_ReplacesSourcesAndMovesGroup(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenTaggedRecipe(t, tc, "Lasagna", "oven-baked", "dinner", "baked")
+	givenTaggedRecipe(t, tc, "Focaccia", "roasted")
+	_, err := tc.Handler.SetTagGroup(tc.Ctx, &pb.SetTagGroupRequest{UserId: tc.UserID.String(), Tag: "roasted", Group: "technique"})
+	thenNoError(t, err)
+
+	resp, err := tc.Handler.MergeTags(tc.Ctx, &pb.MergeTagsRequest{
+		UserId:     tc.UserID.String(),
+		SourceTags: []string{"Oven-Baked", "roasted"},
+		TargetTag:  "baked",
+	})
+
+	thenNoError(t, err)
+	if resp.GetUpdatedRecipes() != 2 {
+		t.Fatalf("expected 2 updated recipes, got %d", resp.GetUpdatedRecipes())
+	}
+	recipe, err := tc.Handler.GetRecipe(tc.Ctx, &pb.GetRecipeRequest{UserId: tc.UserID.String(), RecipeId: created.GetId()})
+	thenNoError(t, err)
+	if !slices.Equal(recipe.GetTags(), []string{"baked", "dinner"}) {
+		t.Fatalf("expected the sources replaced without duplicates, got %v", recipe.GetTags())
+	}
+	tags, err := tc.Handler.ListTags(tc.Ctx, &pb.ListTagsRequest{UserId: tc.UserID.String(), Group: "technique"})
+	thenNoError(t, err)
+	if len(tags.GetTags()) != 1 || tags.GetTags()[0].GetName() != "baked" || tags.GetTags()[0].GetRecipeCount() != 2 {
+		t.Fatalf("expected the target to take the source group, got %v", tags.GetTags())
+	}
+}
+
+func TestMergeTags_IntoItself_ReturnsInvalidArgument(t *testing.T) {
+	tc := givenRecipeAPI()
+	givenTaggedRecipe(t, tc, "Lasagna", "baked")
+
+	_, err := tc.Handler.MergeTags(tc.Ctx, &pb.MergeTagsRequest{
+		UserId:     tc.UserID.String(),
+		SourceTags: []string{"baked"},
+		TargetTag:  "Baked",
+	})
+
+	thenErrorHasCode(t, err, codes.InvalidArgument)
+}
+
+func TestDeleteTag_RemovesFromRecipesAndRepublishes(t *testing.T) {
+	tc := givenRecipeAPI()
+	created := givenTaggedRecipe(t, tc, "Lasagna", "dinner", "baked")
+	published := len(tc.Publisher.RecipeUpsertedEvents)
+
+	resp, err := tc.Handler.DeleteTag(tc.Ctx, &pb.DeleteTagRequest{UserId: tc.UserID.String(), Tag: "dinner"})
+
+	thenNoError(t, err)
+	if resp.GetUpdatedRecipes() != 1 {
+		t.Fatalf("expected 1 updated recipe, got %d", resp.GetUpdatedRecipes())
+	}
+	if len(tc.Publisher.RecipeUpsertedEvents) != published+1 {
+		t.Fatal("expected the untagged recipe to be published")
+	}
+	event := tc.Publisher.RecipeUpsertedEvents[published]
+	if event.ID.String() != created.GetId() || !slices.Equal(event.Tags, []string{"baked"}) {
+		t.Fatalf("expected the tag to be removed, got %v", event.Tags)
+	}
+
+	_, err = tc.Handler.DeleteTag(tc.Ctx, &pb.DeleteTagRequest{UserId: tc.UserID.String(), Tag: "dinner"})
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+func TestDeleteRecipe_NotFound_ReturnsNotFound(t *testing.T) {
+	tc := givenRecipeAPI()
+	nonExistentID := uuid.New()
+
+	_, err := tc.Handler.DeleteRecipe(tc.Ctx, &pb.DeleteRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: nonExistentID.String(),
+	})
+
+	thenErrorHasCode(t, err, codes.NotFound)
+}
+
+// Helpers
+
+const importDocument = `{
+	"@context": "https://schema.org",
+	"@type": "Recipe",
+	"name": "Lemon Risotto",
+	"recipeCuisine": "Italian",
+	"prepTime": "PT5M",
+	"cookTime": "PT25M",
+	"recipeYield": "2",
+	"recipeIngredient": ["arborio rice", "lemon"],
+	"recipeInstructions": [{"@type": "HowToStep", "text": "Toast the rice."}]
+}`
+
+func givenRecipeAPI() *testutil.TestContext {
+	return testutil.NewTestContext()
+}
+
+func givenRecipeExists(tc *testutil.TestContext) *domain.Recipe {
+	builder := testutil.NewRecipeBuilder().WithUserID(tc.UserID)
+	cuisine := testutil.NewCuisineBuilder().WithName("Italian").Build()
+	ingredient := testutil.NewIngredientBuilder().WithName("Tomato").Build()
+	builder.WithCuisine(cuisine).WithMainIngredient(ingredient)
+	recipe := builder.Build()
+	tc.Repo.AddCuisine(cuisine)
+	tc.Repo.AddIngredient(ingredient)
+	tc.Repo.AddRecipe(recipe)
+	return recipe
+}
+
+func givenRecipeExistsWithName(tc *testutil.TestContext, name string) *domain.Recipe {
+	builder := testutil.NewRecipeBuilder().WithUserID(tc.UserID).WithName(name)
+	recipe := builder.Build()
+	tc.Repo.AddRecipe(recipe)
+	return recipe
+}
+
+func givenIngredientWithNutrition(tc *testutil.TestContext, name string, fact domain.IngredientNutrition) *domain.Ingredient {
+	ingredient := testutil.NewIngredientBuilder().WithUserID(tc.UserID).WithName(name).Build()
+	tc.Repo.AddIngredient(ingredient)
+	fact.IngredientID = ingredient.ID
+	tc.Repo.AddIngredientNutrition(fact)
+	return ingredient
+}
+
+func lasagnaInput(name string, lines ...*pb.IngredientLineInput) *pb.RecipeInput {
+	return &pb.RecipeInput{
+		Name:            name,
+		Servings:        4,
+		CuisineName:     "Italian",
+		IngredientLines: lines,
+		Steps:           []*pb.RecipeStepInput{{StepIndex: 1, Instruction: "Layer and bake."}},
+	}
+}
+
+func givenLasagnaCreated(t *testing.T, tc *testutil.TestContext) *pb.Recipe {
+	t.Helper()
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Lasagna",
+			&pb.IngredientLineInput{IngredientName: "Lasagna sheets", QuantityValue: wrapperspb.Double(250), Unit: "g"},
+			&pb.IngredientLineInput{IngredientName: "Ricotta", QuantityValue: wrapperspb.Double(500), Unit: "g"},
+		),
+	})
+	thenNoError(t, err)
+	return resp
+}
+
+func givenClassifiedLasagnaCreated(t *testing.T, tc *testutil.TestContext) *pb.Recipe {
+	t.Helper()
+	givenClassifiedIngredient(tc, "Pasta", domain.IngredientAttributeGluten)
+	givenClassifiedIngredient(tc, "Ricotta", domain.IngredientAttributeDairy)
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{
+		UserId: tc.UserID.String(),
+		Recipe: lasagnaInput("Lasagna",
+			&pb.IngredientLineInput{IngredientName: "Pasta"},
+			&pb.IngredientLineInput{IngredientName: "Ricotta"},
+		),
+	})
+	thenNoError(t, err)
+	return resp
+}
+
+func givenLasagnaEdited(t *testing.T, tc *testutil.TestContext, recipe *pb.Recipe) {
+	t.Helper()
+	_, err := tc.Handler.UpdateRecipe(tc.Ctx, &pb.UpdateRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipe.GetId(),
+		Recipe: lasagnaInput("Quick Lasagna",
+			&pb.IngredientLineInput{IngredientName: "Lasagna sheets", QuantityValue: wrapperspb.Double(200), Unit: "g"},
+		),
+	})
+	thenNoError(t, err)
+}
+
+func givenIngredientMatch(tc *testutil.TestContext, name string, matchStatus domain.FoodMatchStatus) *domain.IngredientFoodMatch {
+	ingredient := testutil.NewIngredientBuilder().WithUserID(tc.UserID).WithName(name).Build()
+	tc.Repo.AddIngredient(ingredient)
+	match := &domain.IngredientFoodMatch{Ingredient: *ingredient, Status: matchStatus}
+	tc.Repo.AddFoodMatch(match)
+	return match
+}
+
+func givenAmbiguousIngredientMatch(tc *testutil.TestContext, name string, foods ...string) *domain.IngredientFoodMatch {
+	match := givenIngredientMatch(tc, name, domain.FoodMatchAmbiguous)
+	for i, food := range foods {
+		match.Candidates = append(match.Candidates, domain.NutritionFood{
+			ID: uuid.New(), Source: "fdc", SourceID: strings.Repeat("1", i+1), Name: food,
+		})
+	}
+	tc.Repo.AddFoodMatch(match)
+	return match
+}
+
+func givenUser(tc *testutil.TestContext, email string) *domain.User {
+	user := &domain.User{ID: uuid.New(), Email: email}
+	tc.Repo.AddUser(user)
+	return user
+}
+
+func givenRecipeSharedWith(t *testing.T, tc *testutil.TestContext, recipe *pb.Recipe, email, permission string) *domain.User {
+	t.Helper()
+	user := givenUser(tc, email)
+	_, err := tc.Handler.ShareRecipe(tc.Ctx, &pb.ShareRecipeRequest{
+		UserId:     tc.UserID.String(),
+		RecipeId:   recipe.GetId(),
+		Email:      email,
+		Permission: permission,
+	})
+	thenNoError(t, err)
+	return user
+}
+
+func givenCollectionWith(t *testing.T, tc *testutil.TestContext, name string, recipes ...*domain.Recipe) *pb.Collection {
+	t.Helper()
+	collection, err := tc.Handler.CreateCollection(tc.Ctx, &pb.CreateCollectionRequest{
+		UserId:     tc.UserID.String(),
+		Collection: &pb.CollectionInput{Name: name},
+	})
+	thenNoError(t, err)
+	for _, recipe := range recipes {
+		collection, err = tc.Handler.AddRecipeToCollection(tc.Ctx, &pb.CollectionRecipeRequest{
+			UserId:       tc.UserID.String(),
+			CollectionId: collection.GetId(),
+			RecipeId:     recipe.ID.String(),
+		})
+		thenNoError(t, err)
+	}
+	return collection
+}
+
+func givenRecipeCooked(t *testing.T, tc *testutil.TestContext, recipeID, cookedOn string, rating int32) *pb.CookLogEntry {
+	t.Helper()
+	entry, err := tc.Handler.LogCook(tc.Ctx, &pb.LogCookRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipeID,
+		Entry:    &pb.CookLogEntryInput{CookedOn: cookedOn, Rating: rating},
+	})
+	thenNoError(t, err)
+	return entry
+}
+
+func givenRecipeDeleted(t *testing.T, tc *testutil.TestContext, recipeID string) {
+	t.Helper()
+	_, err := tc.Handler.DeleteRecipe(tc.Ctx, &pb.DeleteRecipeRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipeID,
+	})
+	thenNoError(t, err)
+}
+
+func givenMediaStore(t *testing.T, tc *testutil.TestContext) *blobstore.LocalStore {
+	t.Helper()
+	store, err := blobstore.NewLocalStore(t.TempDir(), "http://localhost:8080/media")
+	thenNoError(t, err)
+	tc.Handler.WithMediaStore(store)
+	return store
+}
+
+func givenImageStored(t *testing.T, store *blobstore.LocalStore) string {
+	t.Helper()
+	key := "images/" + uuid.NewString() + "/large.jpg"
+	err := store.Put(context.Background(), key, strings.NewReader("jpeg"), "image/jpeg")
+	thenNoError(t, err)
+	return store.URL(key)
+}
+
+func givenRecipeImageSet(t *testing.T, tc *testutil.TestContext, recipeID string, stepIndex int32, url string) {
+	t.Helper()
+	_, err := tc.Handler.SetRecipeImage(tc.Ctx, &pb.SetRecipeImageRequest{
+		UserId:    tc.UserID.String(),
+		RecipeId:  recipeID,
+		ImageUrl:  url,
+		StepIndex: stepIndex,
+	})
+	thenNoError(t, err)
+}
+
+func thenImageStored(t *testing.T, store *blobstore.LocalStore, url string, want bool) {
+	t.Helper()
+	key, ok := store.Key(url)
+	if !ok {
+		t.Fatalf("url %q is not served by the store", url)
+	}
+	blob, _, err := store.Open(context.Background(), key)
+	if err == nil {
+		blob.Close()
+	}
+	if stored := err == nil; stored != want {
+		t.Errorf("expected %s stored=%v, got error %v", url, want, err)
+	}
+}
+
+func givenTimedRecipeCreated(t *testing.T, tc *testutil.TestContext) *pb.Recipe {
+	t.Helper()
+	input := lasagnaInput("Bolognese",
+		&pb.IngredientLineInput{IngredientName: "Minced beef", QuantityValue: wrapperspb.Double(500), Unit: "g"},
+	)
+	input.Steps = []*pb.RecipeStepInput{
+		{StepIndex: 1, Instruction: "Brown the beef."},
+		{StepIndex: 2, Instruction: "Simmer the sauce. Stir now and then.", DurationSeconds: wrapperspb.Int32(600)},
+		{StepIndex: 3, Instruction: "Serve."},
+	}
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{UserId: tc.UserID.String(), Recipe: input})
+	thenNoError(t, err)
+	return resp
+}
+
+func givenCookSessionStarted(t *testing.T, tc *testutil.TestContext, recipeID string) *pb.CookSession {
+	t.Helper()
+	session, err := tc.Handler.StartCookSession(tc.Ctx, &pb.StartCookSessionRequest{
+		UserId:   tc.UserID.String(),
+		RecipeId: recipeID,
+	})
+	thenNoError(t, err)
+	return session
+}
+
+// givenSubstitutionsExist adds two ricotta substitutions, one of them
+// dairy-free and the other containing dairy, and one for eggs. It returns the
+// dairy allergy.
+func givenSubstitutionsExist(tc *testutil.TestContext) *domain.Allergy {
+	dairy := givenAllergy(tc, "Dairy")
+	tc.Repo.Substitutions = []domain.Substitution{
+		{ID: uuid.New(), IngredientName: "Ricotta", SubstituteName: "Crumbled firm tofu", Ratio: 0.5, AvoidsAllergy: dairy},
+		{ID: uuid.New(), IngredientName: "ricotta", SubstituteName: "Cottage cheese", Ratio: 1, ContainsAllergies: []domain.Allergy{*dairy}},
+		{ID: uuid.New(), IngredientName: "Eggs", SubstituteName: "Flax egg", Ratio: 1},
+	}
+	return dairy
+}
+
+func givenAllergy(tc *testutil.TestContext, name string) *domain.Allergy {
+	allergy := &domain.Allergy{ID: uuid.New(), Name: name}
+	tc.Repo.Allergies[allergy.ID] = allergy
+	return allergy
+}
+
+func givenDietaryProfile(tc *testutil.TestContext, profile *domain.DietaryProfile) {
+	profile.UserID = tc.UserID
+	tc.Repo.DietaryProfiles[tc.UserID] = profile
+}
+
+func givenClassifiedIngredient(tc *testutil.TestContext, name string, attributes ...domain.IngredientAttribute) *domain.Ingredient {
+	ingredient := testutil.NewIngredientBuilder().WithUserID(tc.UserID).WithName(name).Build()
+	tc.Repo.AddIngredient(ingredient)
+	tc.Repo.AddIngredientDietaryAttributes(ingredient.ID, attributes...)
+	return ingredient
+}
+
+func givenCuisine(tc *testutil.TestContext, name string) *domain.Cuisine {
+	cuisine := &domain.Cuisine{ID: uuid.New(), UserID: tc.UserID, Name: name}
+	tc.Repo.Cuisines[cuisine.ID] = cuisine
+	return cuisine
+}
+
+func portableLasagna(externalID, name string) *pb.PortableRecipe {
+	return &pb.PortableRecipe{
+		ExternalId: externalID,
+		Recipe: lasagnaInput(name,
+			&pb.IngredientLineInput{IngredientName: "Lasagna sheets", QuantityValue: wrapperspb.Double(250), Unit: "g"},
+		),
+	}
+}
+
+// portableRecipeStream collects the recipes an export sends.
+type portableRecipeStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	recipes []*pb.PortableRecipe
+}
+
+func (s *portableRecipeStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *portableRecipeStream) Send(recipe *pb.PortableRecipe) error {
+	s.recipes = append(s.recipes, recipe)
+	return nil
+}
+
+func givenTaggedRecipe(t *testing.T, tc *testutil.TestContext, name string, tags ...string) *pb.Recipe {
+	t.Helper()
+	input := lasagnaInput(name,
+		&pb.IngredientLineInput{IngredientName: "Lasagna sheets", QuantityValue: wrapperspb.Double(250), Unit: "g"},
+	)
+	input.Tags = tags
+	resp, err := tc.Handler.CreateRecipe(tc.Ctx, &pb.CreateRecipeRequest{UserId: tc.UserID.String(), Recipe: input})
+	thenNoError(t, err)
+	return resp
+}
+
+func thenNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func thenDietLabels(t *testing.T, recipe *pb.Recipe, labels ...string) {
+	t.Helper()
+	if !slices.Equal(recipe.GetDietLabels(), labels) {
+		t.Fatalf("expected diet labels %v, got %v", labels, recipe.GetDietLabels())
 	}
 }
 
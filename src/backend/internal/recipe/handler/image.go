@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// SetRecipeImage sets or clears the image of a recipe or of one of its steps.
+// The change is saved as a new revision like any other edit.
+func (h *GRPCHandler) SetRecipeImage(ctx context.Context, req *pb.SetRecipeImageRequest) (*pb.Recipe, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	stepIndex := int(req.GetStepIndex())
+	if stepIndex < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "step index must not be negative")
+	}
+
+	current, err := h.repo.GetByID(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to set recipe image")
+	}
+
+	imageURL := strings.TrimSpace(req.GetImageUrl())
+	input := toRecipeInput(current)
+	if stepIndex == 0 {
+		input.ImageUrl = imageURL
+	} else {
+		found := false
+		for _, step := range input.Steps {
+			if int(step.GetStepIndex()) == stepIndex {
+				step.MediaUrl = imageURL
+				found = true
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "step %d not found", stepIndex)
+		}
+	}
+
+	recipe, err := h.updateRecipe(ctx, userID, recipeID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("recipe image set", "recipeId", recipeID, "stepIndex", stepIndex)
+
+	return toRecipeResponse(recipe), nil
+}
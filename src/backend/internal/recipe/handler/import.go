@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/recipe/importer"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// ImportRecipe extracts a schema.org Recipe from an HTML or JSON-LD document
+// (or a URL to fetch one from) and returns it as a draft, optionally creating it.
+func (h *GRPCHandler) ImportRecipe(ctx context.Context, req *pb.ImportRecipeRequest) (*pb.ImportRecipeResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	document := []byte(req.GetDocument())
+	if strings.TrimSpace(req.GetDocument()) == "" {
+		url := strings.TrimSpace(req.GetUrl())
+		if url == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "document or url is required")
+		}
+
+		document, err = h.fetcher.Fetch(ctx, url)
+		if err != nil {
+			if errors.Is(err, importer.ErrInvalidURL) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid url: %s", url)
+			}
+			if errors.Is(err, importer.ErrForbiddenAddress) {
+				return nil, status.Errorf(codes.InvalidArgument, "url must point to a public address: %s", url)
+			}
+			h.logger.Error("failed to fetch recipe document", "error", err, "url", url)
+			return nil, status.Errorf(codes.Unavailable, "failed to fetch recipe document")
+		}
+	}
+
+	draft, err := importer.ExtractRecipe(document)
+	if err != nil {
+		if errors.Is(err, importer.ErrNoRecipe) {
+			return nil, status.Errorf(codes.NotFound, "no schema.org recipe found in document")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe document: %v", err)
+	}
+
+	resp := &pb.ImportRecipeResponse{Draft: draft}
+	if !req.GetCreate() {
+		return resp, nil
+	}
+
+	recipe, err := h.createRecipe(ctx, userID, draft)
+	if err != nil {
+		return nil, err
+	}
+	resp.Recipe = toRecipeResponse(recipe)
+
+	return resp, nil
+}
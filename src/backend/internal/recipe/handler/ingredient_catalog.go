@@ -0,0 +1,345 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// Duplicate report tuning. Names such as "tomato" and "tomatoes" score about
+// 0.6; unrelated names rarely pass 0.3.
+const (
+	defaultDuplicateSimilarity = 0.5
+	defaultDuplicateLimit      = 50
+	maxDuplicateLimit          = 200
+)
+
+// ListIngredients lists the user's ingredient catalog, ranked by fuzzy name
+// similarity when a query is given.
+func (h *GRPCHandler) ListIngredients(ctx context.Context, req *pb.ListIngredientsRequest) (*pb.ListIngredientsResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	pageIndex := int(req.GetPageIndex())
+	pageSize := int(req.GetPageSize())
+	if pageIndex < 1 {
+		pageIndex = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	query := strings.TrimSpace(req.GetQuery())
+
+	ingredients, err := h.repo.ListIngredients(ctx, userID, query, pageSize, (pageIndex-1)*pageSize)
+	if err != nil {
+		h.logger.Error("failed to list ingredients", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list ingredients")
+	}
+
+	totalCount, err := h.repo.CountIngredients(ctx, userID, query)
+	if err != nil {
+		h.logger.Error("failed to count ingredients", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to count ingredients")
+	}
+
+	resp := &pb.ListIngredientsResponse{
+		Ingredients: make([]*pb.Ingredient, 0, len(ingredients)),
+		PageIndex:   int32(pageIndex),
+		PageSize:    int32(pageSize),
+		TotalCount:  int32(totalCount),
+		TotalPages:  int32((totalCount + int64(pageSize) - 1) / int64(pageSize)),
+	}
+	for i := range ingredients {
+		resp.Ingredients = append(resp.Ingredients, toIngredientResponse(&ingredients[i]))
+	}
+	return resp, nil
+}
+
+// RenameIngredient renames one of the user's ingredients and republishes the
+// recipes using it. Renaming to the name of another ingredient is refused;
+// those two should be merged instead.
+func (h *GRPCHandler) RenameIngredient(ctx context.Context, req *pb.RenameIngredientRequest) (*pb.Ingredient, error) {
+	ingredient, err := h.ownIngredient(ctx, req.GetUserId(), req.GetIngredientId())
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+
+	renamed := name != ingredient.Name
+	if renamed {
+		if err := h.repo.RenameIngredient(ctx, ingredient.UserID, ingredient.ID, name); err != nil {
+			switch {
+			case errors.Is(err, repository.ErrIngredientNotFound):
+				return nil, status.Errorf(codes.NotFound, "ingredient not found")
+			case errors.Is(err, repository.ErrIngredientNameTaken):
+				return nil, status.Errorf(codes.AlreadyExists, "another ingredient is named %q; merge them instead", name)
+			}
+			h.logger.Error("failed to rename ingredient", "error", err, "ingredientId", ingredient.ID)
+			return nil, status.Errorf(codes.Internal, "failed to rename ingredient")
+		}
+		h.logger.Info("ingredient renamed", "ingredientId", ingredient.ID, "name", name)
+	}
+
+	return h.refreshIngredient(ctx, ingredient.UserID, ingredient.ID, renamed)
+}
+
+// SetIngredientCategory files one of the user's ingredients under a category,
+// which groups it on shopping lists.
+func (h *GRPCHandler) SetIngredientCategory(ctx context.Context, req *pb.SetIngredientCategoryRequest) (*pb.Ingredient, error) {
+	ingredient, err := h.ownIngredient(ctx, req.GetUserId(), req.GetIngredientId())
+	if err != nil {
+		return nil, err
+	}
+
+	var categoryID *uuid.UUID
+	if idStr := strings.TrimSpace(req.GetCategoryId()); idStr != "" {
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid category ID: %v", err)
+		}
+		categoryID = &id
+	}
+
+	if err := h.repo.SetIngredientCategory(ctx, ingredient.UserID, ingredient.ID, categoryID); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrIngredientNotFound):
+			return nil, status.Errorf(codes.NotFound, "ingredient not found")
+		case errors.Is(err, repository.ErrIngredientCategoryNotFound):
+			return nil, status.Errorf(codes.NotFound, "ingredient category not found")
+		}
+		h.logger.Error("failed to set ingredient category", "error", err, "ingredientId", ingredient.ID)
+		return nil, status.Errorf(codes.Internal, "failed to set ingredient category")
+	}
+
+	return h.refreshIngredient(ctx, ingredient.UserID, ingredient.ID, false)
+}
+
+// ListIngredientCategories lists the categories ingredients can be filed
+// under.
+func (h *GRPCHandler) ListIngredientCategories(ctx context.Context, req *pb.ListIngredientCategoriesRequest) (*pb.ListIngredientCategoriesResponse, error) {
+	if _, err := uuid.Parse(req.GetUserId()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	categories, err := h.repo.ListIngredientCategories(ctx)
+	if err != nil {
+		h.logger.Error("failed to list ingredient categories", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list ingredient categories")
+	}
+
+	resp := &pb.ListIngredientCategoriesResponse{
+		Categories: make([]*pb.IngredientCategory, 0, len(categories)),
+	}
+	for i := range categories {
+		resp.Categories = append(resp.Categories, toIngredientCategoryResponse(&categories[i]))
+	}
+	return resp, nil
+}
+
+// MergeIngredients replaces every use of the source ingredient with the target,
+// deletes the source and republishes the recipes that used it.
+func (h *GRPCHandler) MergeIngredients(ctx context.Context, req *pb.MergeIngredientsRequest) (*pb.MergeIngredientsResponse, error) {
+	source, err := h.ownIngredient(ctx, req.GetUserId(), req.GetSourceIngredientId())
+	if err != nil {
+		return nil, err
+	}
+	target, err := h.ownIngredient(ctx, req.GetUserId(), req.GetTargetIngredientId())
+	if err != nil {
+		return nil, err
+	}
+	if source.ID == target.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "ingredient cannot be merged into itself")
+	}
+
+	recipeIDs, err := h.repo.MergeIngredients(ctx, source.UserID, source.ID, target.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrIngredientNotFound):
+			return nil, status.Errorf(codes.NotFound, "ingredient not found")
+		case errors.Is(err, repository.ErrIngredientMergeSelf):
+			return nil, status.Errorf(codes.InvalidArgument, "ingredient cannot be merged into itself")
+		}
+		h.logger.Error("failed to merge ingredients", "error", err, "sourceId", source.ID, "targetId", target.ID)
+		return nil, status.Errorf(codes.Internal, "failed to merge ingredients")
+	}
+
+	h.logger.Info("ingredients merged",
+		"sourceId", source.ID,
+		"targetId", target.ID,
+		"updatedRecipes", len(recipeIDs),
+	)
+
+	if err := h.republishRecipes(ctx, target.UserID, recipeIDs); err != nil {
+		return nil, err
+	}
+
+	ingredient, err := h.refreshIngredient(ctx, target.UserID, target.ID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MergeIngredientsResponse{
+		Ingredient:     ingredient,
+		UpdatedRecipes: int32(len(recipeIDs)),
+	}, nil
+}
+
+// ListDuplicateIngredients reports pairs of the user's ingredients whose names
+// are similar enough that they are likely the same food.
+func (h *GRPCHandler) ListDuplicateIngredients(ctx context.Context, req *pb.ListDuplicateIngredientsRequest) (*pb.ListDuplicateIngredientsResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	minSimilarity := req.GetMinSimilarity()
+	if minSimilarity == 0 {
+		minSimilarity = defaultDuplicateSimilarity
+	}
+	if minSimilarity < 0 || minSimilarity > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "min similarity must be between 0 and 1")
+	}
+
+	limit := int(req.GetLimit())
+	if limit < 1 {
+		limit = defaultDuplicateLimit
+	}
+	if limit > maxDuplicateLimit {
+		limit = maxDuplicateLimit
+	}
+
+	duplicates, err := h.repo.ListDuplicateIngredients(ctx, userID, minSimilarity, limit)
+	if err != nil {
+		h.logger.Error("failed to list duplicate ingredients", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list duplicate ingredients")
+	}
+
+	resp := &pb.ListDuplicateIngredientsResponse{
+		Duplicates: make([]*pb.IngredientDuplicate, 0, len(duplicates)),
+	}
+	for i := range duplicates {
+		resp.Duplicates = append(resp.Duplicates, &pb.IngredientDuplicate{
+			Ingredient: toIngredientResponse(&duplicates[i].Ingredient),
+			Duplicate:  toIngredientResponse(&duplicates[i].Duplicate),
+			Similarity: duplicates[i].Similarity,
+		})
+	}
+	return resp, nil
+}
+
+// refreshIngredient reloads an ingredient with the number of recipes using it,
+// republishing those recipes first when republish is set.
+func (h *GRPCHandler) refreshIngredient(ctx context.Context, userID, id uuid.UUID, republish bool) (*pb.Ingredient, error) {
+	recipeIDs, err := h.repo.ListRecipeIDsUsingIngredient(ctx, id)
+	if err != nil {
+		h.logger.Error("failed to list recipes using ingredient", "error", err, "ingredientId", id)
+		return nil, status.Errorf(codes.Internal, "failed to get ingredient")
+	}
+
+	if republish {
+		if err := h.republishRecipes(ctx, userID, recipeIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	ingredient, err := h.repo.GetIngredientByID(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, repository.ErrIngredientNotFound) {
+			return nil, status.Errorf(codes.NotFound, "ingredient not found")
+		}
+		h.logger.Error("failed to get ingredient", "error", err, "ingredientId", id)
+		return nil, status.Errorf(codes.Internal, "failed to get ingredient")
+	}
+
+	resp := toIngredientResponse(ingredient)
+	resp.RecipeCount = int32(len(recipeIDs))
+	return resp, nil
+}
+
+// republishRecipes publishes recipes changed underneath them by edits to the
+// ingredient or cuisine catalog, so that read models pick up the new names.
+// Diet labels are recomputed first since merging ingredients can swap in one
+// with other dietary attributes.
+func (h *GRPCHandler) republishRecipes(ctx context.Context, userID uuid.UUID, recipeIDs []uuid.UUID) error {
+	for _, recipeID := range recipeIDs {
+		recipe, err := h.repo.GetByID(ctx, userID, recipeID)
+		if err != nil {
+			if errors.Is(err, repository.ErrRecipeNotFound) {
+				continue
+			}
+			h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+			return status.Errorf(codes.Internal, "failed to update recipes")
+		}
+
+		previous, wasClassified := recipe.DietLabels, recipe.DietClassified
+		if err := h.classifyDiet(ctx, recipe); err != nil {
+			return err
+		}
+		if !slices.Equal(previous, recipe.DietLabels) || wasClassified != recipe.DietClassified {
+			if err := h.repo.UpdateRecipeDietLabels(ctx, recipe.ID, recipe.DietLabels, recipe.DietClassified); err != nil {
+				if errors.Is(err, repository.ErrRecipeNotFound) {
+					continue
+				}
+				h.logger.Error("failed to update recipe diet labels", "error", err, "recipeId", recipe.ID)
+				return status.Errorf(codes.Internal, "failed to update recipes")
+			}
+		}
+
+		if h.publisher != nil {
+			if err := h.publisher.PublishRecipeUpserted(ctx, recipe); err != nil {
+				h.logger.Error("failed to publish recipe upserted event",
+					"error", err,
+					"recipeId", recipe.ID,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+func toIngredientResponse(ingredient *domain.Ingredient) *pb.Ingredient {
+	resp := &pb.Ingredient{
+		Id:          ingredient.ID.String(),
+		Name:        ingredient.Name,
+		Description: ingredient.Description,
+		RecipeCount: int32(ingredient.RecipeCount),
+		SearchScore: ingredient.SearchScore,
+	}
+	if ingredient.Category != nil {
+		resp.Category = toIngredientCategoryResponse(ingredient.Category)
+	}
+	for i := range ingredient.Allergies {
+		resp.Allergies = append(resp.Allergies, toAllergyResponse(&ingredient.Allergies[i]))
+	}
+	if !ingredient.CreatedAt.IsZero() {
+		resp.CreatedAt = ingredient.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	if !ingredient.UpdatedAt.IsZero() {
+		resp.UpdatedAt = ingredient.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return resp
+}
+
+func toIngredientCategoryResponse(category *domain.IngredientCategory) *pb.IngredientCategory {
+	return &pb.IngredientCategory{
+		Id:           category.ID.String(),
+		Name:         category.Name,
+		DisplayOrder: int32(category.DisplayOrder),
+	}
+}
@@ -0,0 +1,170 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// GetIngredientDietaryAttributes returns what one of the user's ingredients
+// contains, or an unclassified record when nothing was set yet.
+func (h *GRPCHandler) GetIngredientDietaryAttributes(ctx context.Context, req *pb.GetIngredientDietaryAttributesRequest) (*pb.IngredientDietaryAttributes, error) {
+	ingredient, err := h.ownIngredient(ctx, req.GetUserId(), req.GetIngredientId())
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := h.repo.GetIngredientDietaryAttributes(ctx, []uuid.UUID{ingredient.ID})
+	if err != nil {
+		h.logger.Error("failed to get ingredient dietary attributes", "error", err, "ingredientId", ingredient.ID)
+		return nil, status.Errorf(codes.Internal, "failed to get ingredient dietary attributes")
+	}
+
+	record, ok := attributes[ingredient.ID]
+	return toIngredientDietaryAttributesResponse(ingredient, &record, ok), nil
+}
+
+// SetIngredientDietaryAttributes replaces what one of the user's ingredients
+// contains and relabels the recipes using it.
+func (h *GRPCHandler) SetIngredientDietaryAttributes(ctx context.Context, req *pb.SetIngredientDietaryAttributesRequest) (*pb.IngredientDietaryAttributes, error) {
+	ingredient, err := h.ownIngredient(ctx, req.GetUserId(), req.GetIngredientId())
+	if err != nil {
+		return nil, err
+	}
+
+	record := &domain.IngredientDietaryAttributes{IngredientID: ingredient.ID}
+	for _, value := range req.GetAttributes() {
+		attribute := domain.IngredientAttribute(strings.ToLower(strings.TrimSpace(value)))
+		if !attribute.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid dietary attribute: %s", value)
+		}
+		if !record.Has(attribute) {
+			record.Attributes = append(record.Attributes, attribute)
+		}
+	}
+
+	if err := h.repo.SaveIngredientDietaryAttributes(ctx, record); err != nil {
+		h.logger.Error("failed to save ingredient dietary attributes", "error", err, "ingredientId", ingredient.ID)
+		return nil, status.Errorf(codes.Internal, "failed to set ingredient dietary attributes")
+	}
+
+	reclassified, err := h.ReclassifyRecipesUsing(ctx, ingredient)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("ingredient dietary attributes set",
+		"ingredientId", ingredient.ID,
+		"attributes", len(record.Attributes),
+		"reclassifiedRecipes", reclassified,
+	)
+
+	resp := toIngredientDietaryAttributesResponse(ingredient, record, true)
+	resp.ReclassifiedRecipes = int32(reclassified)
+	return resp, nil
+}
+
+// ReclassifyRecipesUsing recomputes the diet labels of the recipes using an
+// ingredient and publishes those whose labels changed. Ingredients belong to
+// one user, and so do the recipes that can use them.
+func (h *GRPCHandler) ReclassifyRecipesUsing(ctx context.Context, ingredient *domain.Ingredient) (int, error) {
+	recipeIDs, err := h.repo.ListRecipeIDsUsingIngredient(ctx, ingredient.ID)
+	if err != nil {
+		h.logger.Error("failed to list recipes using ingredient", "error", err, "ingredientId", ingredient.ID)
+		return 0, status.Errorf(codes.Internal, "failed to reclassify recipes")
+	}
+
+	reclassified := 0
+	for _, recipeID := range recipeIDs {
+		recipe, err := h.repo.GetByID(ctx, ingredient.UserID, recipeID)
+		if err != nil {
+			if errors.Is(err, repository.ErrRecipeNotFound) {
+				continue
+			}
+			h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+			return 0, status.Errorf(codes.Internal, "failed to reclassify recipes")
+		}
+
+		previous, wasClassified := recipe.DietLabels, recipe.DietClassified
+		if err := h.classifyDiet(ctx, recipe); err != nil {
+			return 0, err
+		}
+		if slices.Equal(previous, recipe.DietLabels) && wasClassified == recipe.DietClassified {
+			continue
+		}
+
+		if err := h.repo.UpdateRecipeDietLabels(ctx, recipe.ID, recipe.DietLabels, recipe.DietClassified); err != nil {
+			if errors.Is(err, repository.ErrRecipeNotFound) {
+				continue
+			}
+			h.logger.Error("failed to update recipe diet labels", "error", err, "recipeId", recipe.ID)
+			return 0, status.Errorf(codes.Internal, "failed to reclassify recipes")
+		}
+		reclassified++
+
+		if h.publisher != nil {
+			if err := h.publisher.PublishRecipeUpserted(ctx, recipe); err != nil {
+				h.logger.Error("failed to publish recipe upserted event",
+					"error", err,
+					"recipeId", recipe.ID,
+				)
+			}
+		}
+	}
+
+	return reclassified, nil
+}
+
+// ownIngredient parses the IDs of a request and loads the user's ingredient.
+func (h *GRPCHandler) ownIngredient(ctx context.Context, userIDStr, ingredientIDStr string) (*domain.Ingredient, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	ingredientID, err := uuid.Parse(ingredientIDStr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ingredient ID: %v", err)
+	}
+
+	ingredient, err := h.repo.GetIngredientByID(ctx, userID, ingredientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrIngredientNotFound) {
+			return nil, status.Errorf(codes.NotFound, "ingredient not found")
+		}
+		h.logger.Error("failed to get ingredient", "error", err, "ingredientId", ingredientID)
+		return nil, status.Errorf(codes.Internal, "failed to get ingredient")
+	}
+	return ingredient, nil
+}
+
+func toIngredientDietaryAttributesResponse(ingredient *domain.Ingredient, record *domain.IngredientDietaryAttributes, classified bool) *pb.IngredientDietaryAttributes {
+	resp := &pb.IngredientDietaryAttributes{
+		Ingredient: &pb.IngredientRef{
+			Id:   ingredient.ID.String(),
+			Name: ingredient.Name,
+		},
+		Classified: classified,
+	}
+	if !classified {
+		return resp
+	}
+
+	for _, attribute := range record.Attributes {
+		resp.Attributes = append(resp.Attributes, string(attribute))
+	}
+	if !record.UpdatedAt.IsZero() {
+		resp.UpdatedAt = record.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return resp
+}
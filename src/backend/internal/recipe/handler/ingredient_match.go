@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// ListIngredientMatches returns the user's ingredients linked to the nutrition
+// reference with the given status, by default those awaiting review.
+func (h *GRPCHandler) ListIngredientMatches(ctx context.Context, req *pb.ListIngredientMatchesRequest) (*pb.ListIngredientMatchesResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	matchStatus := domain.FoodMatchAmbiguous
+	if req.GetStatus() != "" {
+		matchStatus = domain.FoodMatchStatus(req.GetStatus())
+		if !matchStatus.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid match status: %s", req.GetStatus())
+		}
+	}
+
+	matches, err := h.repo.ListIngredientMatches(ctx, userID, matchStatus)
+	if err != nil {
+		h.logger.Error("failed to list ingredient matches", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list ingredient matches")
+	}
+
+	resp := &pb.ListIngredientMatchesResponse{
+		Matches: make([]*pb.IngredientMatch, len(matches)),
+	}
+	for i := range matches {
+		resp.Matches[i] = toIngredientMatchResponse(&matches[i])
+	}
+
+	return resp, nil
+}
+
+// ResolveIngredientMatch links an ingredient to the reference food picked by
+// the user, or records that none fits when no food is given.
+func (h *GRPCHandler) ResolveIngredientMatch(ctx context.Context, req *pb.ResolveIngredientMatchRequest) (*pb.IngredientMatch, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	ingredientID, err := uuid.Parse(req.GetIngredientId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ingredient ID: %v", err)
+	}
+
+	var foodID *uuid.UUID
+	if req.GetFoodId() != "" {
+		id, err := uuid.Parse(req.GetFoodId())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid food ID: %v", err)
+		}
+		foodID = &id
+	}
+
+	match, err := h.repo.ResolveIngredientMatch(ctx, userID, ingredientID, foodID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrIngredientNotFound):
+			return nil, status.Errorf(codes.NotFound, "ingredient not found")
+		case errors.Is(err, repository.ErrNutritionFoodNotFound):
+			return nil, status.Errorf(codes.NotFound, "nutrition food not found")
+		}
+		h.logger.Error("failed to resolve ingredient match", "error", err, "ingredientId", ingredientID)
+		return nil, status.Errorf(codes.Internal, "failed to resolve ingredient match")
+	}
+
+	return toIngredientMatchResponse(match), nil
+}
+
+func toIngredientMatchResponse(match *domain.IngredientFoodMatch) *pb.IngredientMatch {
+	resp := &pb.IngredientMatch{
+		Ingredient: &pb.IngredientRef{
+			Id:   match.Ingredient.ID.String(),
+			Name: match.Ingredient.Name,
+		},
+		Status: string(match.Status),
+	}
+
+	if match.Food != nil {
+		resp.Food = toNutritionFoodResponse(match.Food)
+	}
+	for i := range match.Candidates {
+		resp.Candidates = append(resp.Candidates, toNutritionFoodResponse(&match.Candidates[i]))
+	}
+
+	return resp
+}
+
+func toNutritionFoodResponse(food *domain.NutritionFood) *pb.NutritionFood {
+	return &pb.NutritionFood{
+		Id:       food.ID.String(),
+		Source:   food.Source,
+		SourceId: food.SourceID,
+		Name:     food.Name,
+		Calories: food.Calories,
+		ProteinG: food.ProteinG,
+		CarbsG:   food.CarbsG,
+		FatG:     food.FatG,
+		FiberG:   food.FiberG,
+		SugarG:   food.SugarG,
+		SodiumMg: food.SodiumMg,
+	}
+}
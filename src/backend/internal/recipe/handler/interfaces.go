@@ -16,20 +16,124 @@ type RecipeRepository interface {
 	Create(ctx context.Context, recipe *domain.Recipe) error
 	Update(ctx context.Context, recipe *domain.Recipe) error
 	Delete(ctx context.Context, userID, id uuid.UUID) error
-	GetSimilar(ctx context.Context, userID, recipeID uuid.UUID, limit int) ([]domain.Recipe, error)
+	GetSimilar(ctx context.Context, userID, recipeID uuid.UUID, profile *domain.DietaryProfile, limit int) ([]domain.Recipe, error)
+	FindImportedRecipe(ctx context.Context, userID uuid.UUID, externalID, name string) (uuid.UUID, bool, error)
+
+	// Fork operations
+	SyncForkRevision(ctx context.Context, recipeID uuid.UUID, revision int) error
+
+	// Trash operations
+	ListDeletedRecipes(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.Recipe, error)
+	CountDeletedRecipes(ctx context.Context, userID uuid.UUID) (int64, error)
+	RestoreRecipe(ctx context.Context, userID, id uuid.UUID) error
+	PurgeRecipe(ctx context.Context, userID, id uuid.UUID) ([]string, error)
+	ListRecipeShares(ctx context.Context, ownerID, recipeID uuid.UUID) ([]domain.RecipeShare, error)
+	ListRecipeCookStats(ctx context.Context, recipeID uuid.UUID) (map[uuid.UUID]domain.RecipeCookStats, error)
+
+	// Revision operations
+	ListRecipeRevisions(ctx context.Context, userID, recipeID uuid.UUID) ([]domain.RecipeRevision, error)
+	GetRecipeRevision(ctx context.Context, userID, recipeID uuid.UUID, revision int) (*domain.RecipeRevision, error)
+
+	// Share operations
+	ShareRecipe(ctx context.Context, ownerID, recipeID uuid.UUID, email string, permission domain.SharePermission) (*domain.RecipeShare, error)
+	ListSharedWithMe(ctx context.Context, userID uuid.UUID) ([]domain.RecipeShare, error)
+	ListSharedByMe(ctx context.Context, ownerID uuid.UUID) ([]domain.RecipeShare, error)
+	RevokeShare(ctx context.Context, ownerID, recipeID, sharedWithUserID uuid.UUID) error
+	GetEditableRecipeOwner(ctx context.Context, userID, recipeID uuid.UUID) (uuid.UUID, error)
+
+	// Collection operations
+	ListCollections(ctx context.Context, userID uuid.UUID) ([]domain.Collection, error)
+	GetCollection(ctx context.Context, userID, id uuid.UUID) (*domain.Collection, error)
+	CreateCollection(ctx context.Context, collection *domain.Collection) error
+	UpdateCollection(ctx context.Context, userID uuid.UUID, collection *domain.Collection) error
+	DeleteCollection(ctx context.Context, userID, id uuid.UUID) error
+	SetCollectionPublic(ctx context.Context, userID, id uuid.UUID, public bool) error
+	AddCollectionRecipe(ctx context.Context, userID, collectionID, recipeID uuid.UUID) error
+	RemoveCollectionRecipe(ctx context.Context, userID, collectionID, recipeID uuid.UUID) error
+	ReorderCollection(ctx context.Context, userID, collectionID uuid.UUID, recipeIDs []uuid.UUID) error
+	ShareCollection(ctx context.Context, ownerID, collectionID uuid.UUID, email string, permission domain.SharePermission) (*domain.CollectionShare, error)
+	ListCollectionShares(ctx context.Context, ownerID, collectionID uuid.UUID) ([]domain.CollectionShare, error)
+	RevokeCollectionShare(ctx context.Context, ownerID, collectionID, sharedWithUserID uuid.UUID) error
+	ListRecipeCollectionIDs(ctx context.Context, recipeID uuid.UUID) ([]uuid.UUID, error)
+
+	// Cook log operations
+	LogCook(ctx context.Context, entry *domain.CookLogEntry) error
+	ListCookLog(ctx context.Context, userID uuid.UUID, recipeID *uuid.UUID, limit int) ([]domain.CookLogEntry, error)
+	UpdateCookLogEntry(ctx context.Context, entry *domain.CookLogEntry) error
+	DeleteCookLogEntry(ctx context.Context, userID, id uuid.UUID) (uuid.UUID, error)
+	GetCookStats(ctx context.Context, userID, recipeID uuid.UUID) (*domain.RecipeCookStats, error)
+
+	// Cook session operations
+	CreateCookSession(ctx context.Context, session *domain.CookSession) error
+	GetCookSession(ctx context.Context, userID, id uuid.UUID) (*domain.CookSession, error)
+	GetActiveCookSession(ctx context.Context, userID, recipeID uuid.UUID) (*domain.CookSession, error)
+	ListCookSessions(ctx context.Context, userID uuid.UUID) ([]domain.CookSession, error)
+	UpdateCookSession(ctx context.Context, session *domain.CookSession) error
+	CompleteCookSession(ctx context.Context, session *domain.CookSession, entry *domain.CookLogEntry) error
+	DeleteCookSession(ctx context.Context, userID, id uuid.UUID) error
+
+	// Substitution operations
+	ListSubstitutions(ctx context.Context, ingredientNames []string, allergyID *uuid.UUID) ([]domain.Substitution, error)
+	GetSubstitutions(ctx context.Context, ids []uuid.UUID) ([]domain.Substitution, error)
+
+	// Dietary profile operations
+	GetDietaryProfile(ctx context.Context, userID uuid.UUID) (*domain.DietaryProfile, error)
+	SaveDietaryProfile(ctx context.Context, profile *domain.DietaryProfile) error
+	GetAllergyByID(ctx context.Context, id uuid.UUID) (*domain.Allergy, error)
+	ListAllergies(ctx context.Context) ([]domain.Allergy, error)
+	AddIngredientAllergy(ctx context.Context, ingredientID, allergyID uuid.UUID) error
 
 	// Ingredient operations
 	GetIngredientByID(ctx context.Context, userID, id uuid.UUID) (*domain.Ingredient, error)
 	GetOrCreateIngredient(ctx context.Context, userID uuid.UUID, name string) (*domain.Ingredient, error)
+	CopyIngredient(ctx context.Context, ownerID uuid.UUID, source *domain.Ingredient) (*domain.Ingredient, error)
+	GetIngredientNutrition(ctx context.Context, ingredientIDs []uuid.UUID) (map[uuid.UUID]domain.IngredientNutrition, error)
+	ListIngredientMatches(ctx context.Context, userID uuid.UUID, status domain.FoodMatchStatus) ([]domain.IngredientFoodMatch, error)
+	ResolveIngredientMatch(ctx context.Context, userID, ingredientID uuid.UUID, foodID *uuid.UUID) (*domain.IngredientFoodMatch, error)
+	GetIngredientDietaryAttributes(ctx context.Context, ingredientIDs []uuid.UUID) (map[uuid.UUID]domain.IngredientDietaryAttributes, error)
+	SaveIngredientDietaryAttributes(ctx context.Context, record *domain.IngredientDietaryAttributes) error
+	ListRecipeIDsUsingIngredient(ctx context.Context, ingredientID uuid.UUID) ([]uuid.UUID, error)
+	UpdateRecipeDietLabels(ctx context.Context, recipeID uuid.UUID, labels []domain.DietLabel, classified bool) error
+	ListIngredients(ctx context.Context, userID uuid.UUID, query string, limit, offset int) ([]domain.Ingredient, error)
+	CountIngredients(ctx context.Context, userID uuid.UUID, query string) (int64, error)
+	RenameIngredient(ctx context.Context, userID, id uuid.UUID, name string) error
+	SetIngredientCategory(ctx context.Context, userID, id uuid.UUID, categoryID *uuid.UUID) error
+	ListIngredientCategories(ctx context.Context) ([]domain.IngredientCategory, error)
+	MergeIngredients(ctx context.Context, userID, sourceID, targetID uuid.UUID) ([]uuid.UUID, error)
+	ListDuplicateIngredients(ctx context.Context, userID uuid.UUID, minSimilarity float64, limit int) ([]domain.IngredientDuplicate, error)
 
 	// Cuisine operations
 	GetCuisineByID(ctx context.Context, userID, id uuid.UUID) (*domain.Cuisine, error)
 	GetOrCreateCuisine(ctx context.Context, userID uuid.UUID, name string) (*domain.Cuisine, error)
 	GetCuisines(ctx context.Context, userID uuid.UUID) ([]domain.Cuisine, error)
+	RenameCuisine(ctx context.Context, userID, id uuid.UUID, name string) ([]uuid.UUID, error)
+	DeleteCuisine(ctx context.Context, userID, id uuid.UUID, reassignTo *uuid.UUID) ([]uuid.UUID, error)
+
+	// Tag operations
+	ListTags(ctx context.Context, userID uuid.UUID, prefix string, group domain.TagGroup, limit int) ([]domain.Tag, error)
+	RenameTag(ctx context.Context, userID uuid.UUID, tag, name string) ([]uuid.UUID, error)
+	MergeTags(ctx context.Context, userID uuid.UUID, sources []string, target string) ([]uuid.UUID, error)
+	DeleteTag(ctx context.Context, userID uuid.UUID, tag string) ([]uuid.UUID, error)
+	SetTagGroup(ctx context.Context, userID uuid.UUID, tag string, group domain.TagGroup) (*domain.Tag, error)
 }
 
 // EventPublisher defines the event publishing operations needed by the handler
 type EventPublisher interface {
 	PublishRecipeUpserted(ctx context.Context, recipe *domain.Recipe) error
 	PublishRecipeDeleted(ctx context.Context, recipeID, userID uuid.UUID) error
+	PublishRecipeShared(ctx context.Context, share *domain.RecipeShare) error
+	PublishRecipeShareRevoked(ctx context.Context, recipeID, userID, sharedWithUserID uuid.UUID) error
+	PublishRecipeCookStatsUpdated(ctx context.Context, recipeID, userID uuid.UUID, stats *domain.RecipeCookStats) error
+	PublishDietaryProfileUpdated(ctx context.Context, profile *domain.DietaryProfile) error
+	PublishCollectionShared(ctx context.Context, share *domain.CollectionShare, recipeIDs []uuid.UUID) error
+	PublishCollectionShareRevoked(ctx context.Context, collectionID, userID, sharedWithUserID uuid.UUID) error
+	PublishCollectionRecipeAdded(ctx context.Context, collectionID, userID, recipeID uuid.UUID) error
+	PublishCollectionRecipeRemoved(ctx context.Context, collectionID, userID, recipeID uuid.UUID) error
+	PublishCollectionDeleted(ctx context.Context, collectionID, userID uuid.UUID) error
+	PublishCollectionVisibilityChanged(ctx context.Context, collectionID, userID uuid.UUID, public bool, recipeIDs []uuid.UUID) error
+}
+
+// DocumentFetcher retrieves remote documents for recipe import
+type DocumentFetcher interface {
+	Fetch(ctx context.Context, url string) ([]byte, error)
 }
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+	"github.com/platepilot/backend/internal/recipe/revision"
+)
+
+// ListRecipeRevisions lists the saved versions of a recipe, newest first.
+func (h *GRPCHandler) ListRecipeRevisions(ctx context.Context, req *pb.ListRecipeRevisionsRequest) (*pb.ListRecipeRevisionsResponse, error) {
+	userID, recipeID, err := parseRevisionIDs(req.GetUserId(), req.GetRecipeId())
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := h.repo.ListRecipeRevisions(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to list recipe revisions", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to list recipe revisions")
+	}
+
+	resp := &pb.ListRecipeRevisionsResponse{
+		Revisions: make([]*pb.RecipeRevisionSummary, len(revisions)),
+	}
+	for i, rev := range revisions {
+		resp.Revisions[i] = &pb.RecipeRevisionSummary{
+			Revision:  int32(rev.Revision),
+			Name:      rev.Recipe.Name,
+			CreatedAt: rev.CreatedAt.UTC().Format(time.RFC3339),
+		}
+	}
+
+	return resp, nil
+}
+
+// GetRecipeRevision returns a recipe as it was saved at a revision.
+func (h *GRPCHandler) GetRecipeRevision(ctx context.Context, req *pb.GetRecipeRevisionRequest) (*pb.RecipeRevision, error) {
+	userID, recipeID, err := parseRevisionIDs(req.GetUserId(), req.GetRecipeId())
+	if err != nil {
+		return nil, err
+	}
+
+	rev, err := h.getRevision(ctx, userID, recipeID, req.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RecipeRevision{
+		Revision:  int32(rev.Revision),
+		CreatedAt: rev.CreatedAt.UTC().Format(time.RFC3339),
+		Recipe:    toRecipeResponse(&rev.Recipe),
+	}, nil
+}
+
+// DiffRecipeRevisions lists the changes between two revisions of a recipe.
+func (h *GRPCHandler) DiffRecipeRevisions(ctx context.Context, req *pb.DiffRecipeRevisionsRequest) (*pb.RecipeDiff, error) {
+	userID, recipeID, err := parseRevisionIDs(req.GetUserId(), req.GetRecipeId())
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := h.getRevision(ctx, userID, recipeID, req.GetFromRevision())
+	if err != nil {
+		return nil, err
+	}
+	to, err := h.getRevision(ctx, userID, recipeID, req.GetToRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	diff := revision.Compare(&from.Recipe, &to.Recipe)
+
+	resp := &pb.RecipeDiff{
+		FromRevision: int32(from.Revision),
+		ToRevision:   int32(to.Revision),
+	}
+	for _, change := range diff.Fields {
+		resp.Fields = append(resp.Fields, &pb.FieldChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+	for _, change := range diff.IngredientLines {
+		lineChange := &pb.IngredientLineChange{Change: string(change.Kind)}
+		if change.From != nil {
+			lineChange.From = toIngredientLineResponse(change.From)
+		}
+		if change.To != nil {
+			lineChange.To = toIngredientLineResponse(change.To)
+		}
+		resp.IngredientLines = append(resp.IngredientLines, lineChange)
+	}
+	for _, change := range diff.Steps {
+		stepChange := &pb.StepChange{Change: string(change.Kind)}
+		if change.From != nil {
+			stepChange.From = toStepResponse(change.From)
+		}
+		if change.To != nil {
+			stepChange.To = toStepResponse(change.To)
+		}
+		resp.Steps = append(resp.Steps, stepChange)
+	}
+
+	return resp, nil
+}
+
+// RestoreRecipeRevision saves a past revision as the current version of a
+// recipe. The restore is an ordinary update, so it is itself recorded as a new
+// revision and published like any other change.
+func (h *GRPCHandler) RestoreRecipeRevision(ctx context.Context, req *pb.RestoreRecipeRevisionRequest) (*pb.Recipe, error) {
+	userID, recipeID, err := parseRevisionIDs(req.GetUserId(), req.GetRecipeId())
+	if err != nil {
+		return nil, err
+	}
+
+	rev, err := h.getRevision(ctx, userID, recipeID, req.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+
+	recipe, err := h.updateRecipe(ctx, userID, recipeID, toRecipeInput(&rev.Recipe))
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("recipe revision restored", "recipeId", recipeID, "revision", rev.Revision)
+
+	return toRecipeResponse(recipe), nil
+}
+
+func (h *GRPCHandler) getRevision(ctx context.Context, userID, recipeID uuid.UUID, number int32) (*domain.RecipeRevision, error) {
+	if number < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "revision must be at least 1")
+	}
+
+	rev, err := h.repo.GetRecipeRevision(ctx, userID, recipeID, int(number))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecipeNotFound):
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		case errors.Is(err, repository.ErrRevisionNotFound):
+			return nil, status.Errorf(codes.NotFound, "revision %d not found", number)
+		}
+		h.logger.Error("failed to get recipe revision", "error", err, "recipeId", recipeID, "revision", number)
+		return nil, status.Errorf(codes.Internal, "failed to get recipe revision")
+	}
+	return rev, nil
+}
+
+func parseRevisionIDs(userIDStr, recipeIDStr string) (uuid.UUID, uuid.UUID, error) {
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(recipeIDStr)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	return userID, recipeID, nil
+}
+
+// toRecipeInput turns a stored recipe back into update input. Ingredients are
+// referenced by ID with their name as a fallback, and the cuisine by name, so
+// that a restore still works after the original ingredient was merged away or
+// the cuisine removed.
+func toRecipeInput(r *domain.Recipe) *pb.RecipeInput {
+	input := &pb.RecipeInput{
+		Name:            r.Name,
+		Description:     r.Description,
+		PrepTimeMinutes: int32(r.PrepTimeMinutes),
+		CookTimeMinutes: int32(r.CookTimeMinutes),
+		Servings:        int32(r.Servings),
+		YieldUnit:       r.YieldUnit,
+		Tags:            r.Tags,
+		ImageUrl:        r.ImageURL,
+	}
+
+	if r.YieldQuantity != nil {
+		input.YieldQuantity = wrapperspb.Double(*r.YieldQuantity)
+	}
+	if r.MainIngredient != nil {
+		input.MainIngredientId = r.MainIngredient.ID.String()
+		input.MainIngredientName = r.MainIngredient.Name
+	}
+	if r.Cuisine != nil {
+		input.CuisineName = r.Cuisine.Name
+	}
+	if r.Nutrition.IsOverride {
+		input.Nutrition = &pb.RecipeNutrition{
+			CaloriesTotal:      int32(r.Nutrition.CaloriesTotal),
+			CaloriesPerServing: int32(r.Nutrition.CaloriesPerServing),
+			ProteinG:           r.Nutrition.ProteinG,
+			CarbsG:             r.Nutrition.CarbsG,
+			FatG:               r.Nutrition.FatG,
+			FiberG:             r.Nutrition.FiberG,
+			SugarG:             r.Nutrition.SugarG,
+			SodiumMg:           r.Nutrition.SodiumMg,
+			IsOverride:         true,
+		}
+	}
+
+	for _, line := range r.IngredientLines {
+		lineInput := &pb.IngredientLineInput{
+			IngredientId:   line.Ingredient.ID.String(),
+			IngredientName: line.Ingredient.Name,
+			QuantityText:   line.QuantityText,
+			Unit:           line.Unit,
+			IsOptional:     line.IsOptional,
+			Note:           line.Note,
+			SortOrder:      int32(line.SortOrder),
+		}
+		if line.QuantityValue != nil {
+			lineInput.QuantityValue = wrapperspb.Double(*line.QuantityValue)
+		}
+		input.IngredientLines = append(input.IngredientLines, lineInput)
+	}
+
+	for _, step := range r.Steps {
+		stepInput := &pb.RecipeStepInput{
+			StepIndex:       int32(step.StepIndex),
+			Instruction:     step.Instruction,
+			TemperatureUnit: step.TemperatureUnit,
+			MediaUrl:        step.MediaURL,
+		}
+		if step.DurationSeconds != nil {
+			stepInput.DurationSeconds = wrapperspb.Int32(int32(*step.DurationSeconds))
+		}
+		if step.TemperatureValue != nil {
+			stepInput.TemperatureValue = wrapperspb.Double(*step.TemperatureValue)
+		}
+		input.Steps = append(input.Steps, stepInput)
+	}
+
+	return input
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+	"github.com/platepilot/backend/internal/recipe/scaler"
+)
+
+// maxScaleServings bounds the servings a recipe can be scaled to.
+const maxScaleServings = 1000
+
+// ScaleRecipe returns a recipe scaled to the requested number of servings.
+func (h *GRPCHandler) ScaleRecipe(ctx context.Context, req *pb.ScaleRecipeRequest) (*pb.ScaleRecipeResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	servings := int(req.GetServings())
+	if servings <= 0 || servings > maxScaleServings {
+		return nil, status.Errorf(codes.InvalidArgument, "servings must be between 1 and %d", maxScaleServings)
+	}
+
+	recipe, err := h.repo.GetByID(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to get recipe")
+	}
+
+	result, err := scaler.Scale(recipe, servings)
+	if err != nil {
+		if errors.Is(err, scaler.ErrNoServings) {
+			return nil, status.Errorf(codes.FailedPrecondition, "recipe has no servings to scale from")
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return &pb.ScaleRecipeResponse{
+		Recipe: toScaledRecipeResponse(result),
+		Factor: result.Factor,
+	}, nil
+}
+
+func toScaledRecipeResponse(result *scaler.Result) *pb.Recipe {
+	resp := toRecipeResponse(result.Recipe)
+	for _, index := range result.NotScaled {
+		resp.IngredientLines[index].NotScaled = true
+	}
+	return resp
+}
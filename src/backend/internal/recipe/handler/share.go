@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// ShareRecipe shares a recipe the user owns with another user, found by
+// email. Sharing with a user who already has access changes the permission.
+func (h *GRPCHandler) ShareRecipe(ctx context.Context, req *pb.ShareRecipeRequest) (*pb.RecipeShare, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	email := strings.TrimSpace(req.GetEmail())
+	if email == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "email is required")
+	}
+
+	permission := domain.SharePermissionRead
+	if req.GetPermission() != "" {
+		permission = domain.SharePermission(req.GetPermission())
+		if !permission.IsValid() {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid permission: %s", req.GetPermission())
+		}
+	}
+
+	share, err := h.repo.ShareRecipe(ctx, userID, recipeID, email, permission)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecipeNotFound):
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, status.Errorf(codes.NotFound, "no user with email %s", email)
+		case errors.Is(err, repository.ErrShareWithOwner):
+			return nil, status.Errorf(codes.InvalidArgument, "recipe cannot be shared with its owner")
+		}
+		h.logger.Error("failed to share recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to share recipe")
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishRecipeShared(ctx, share); err != nil {
+			h.logger.Error("failed to publish recipe shared event",
+				"error", err,
+				"recipeId", recipeID,
+			)
+		}
+	}
+
+	h.logger.Info("recipe shared", "recipeId", recipeID, "sharedWithUserId", share.SharedWithUserID, "permission", share.Permission)
+
+	return toRecipeShareResponse(share), nil
+}
+
+// ListSharedWithMe lists the recipes other users shared with the user.
+func (h *GRPCHandler) ListSharedWithMe(ctx context.Context, req *pb.ListRecipeSharesRequest) (*pb.ListRecipeSharesResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	shares, err := h.repo.ListSharedWithMe(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to list recipes shared with user", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list shared recipes")
+	}
+
+	return toRecipeSharesResponse(shares), nil
+}
+
+// ListSharedByMe lists who the user's recipes are shared with.
+func (h *GRPCHandler) ListSharedByMe(ctx context.Context, req *pb.ListRecipeSharesRequest) (*pb.ListRecipeSharesResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	shares, err := h.repo.ListSharedByMe(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to list recipes shared by user", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list shared recipes")
+	}
+
+	return toRecipeSharesResponse(shares), nil
+}
+
+// RevokeRecipeShare removes another user's access to a recipe the user owns.
+func (h *GRPCHandler) RevokeRecipeShare(ctx context.Context, req *pb.RevokeRecipeShareRequest) (*emptypb.Empty, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	sharedWithUserID, err := uuid.Parse(req.GetSharedWithUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid shared with user ID: %v", err)
+	}
+
+	if err := h.repo.RevokeShare(ctx, userID, recipeID, sharedWithUserID); err != nil {
+		if errors.Is(err, repository.ErrShareNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe share not found")
+		}
+		h.logger.Error("failed to revoke recipe share", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to revoke recipe share")
+	}
+
+	if h.publisher != nil {
+		if err := h.publisher.PublishRecipeShareRevoked(ctx, recipeID, userID, sharedWithUserID); err != nil {
+			h.logger.Error("failed to publish recipe share revoked event",
+				"error", err,
+				"recipeId", recipeID,
+			)
+		}
+	}
+
+	h.logger.Info("recipe share revoked", "recipeId", recipeID, "sharedWithUserId", sharedWithUserID)
+
+	return &emptypb.Empty{}, nil
+}
+
+func toRecipeSharesResponse(shares []domain.RecipeShare) *pb.ListRecipeSharesResponse {
+	resp := &pb.ListRecipeSharesResponse{
+		Shares: make([]*pb.RecipeShare, len(shares)),
+	}
+	for i := range shares {
+		resp.Shares[i] = toRecipeShareResponse(&shares[i])
+	}
+	return resp
+}
+
+func toRecipeShareResponse(share *domain.RecipeShare) *pb.RecipeShare {
+	return &pb.RecipeShare{
+		RecipeId:         share.RecipeID.String(),
+		RecipeName:       share.RecipeName,
+		OwnerId:          share.OwnerID.String(),
+		OwnerEmail:       share.OwnerEmail,
+		SharedWithUserId: share.SharedWithUserID.String(),
+		SharedWithEmail:  share.SharedWithEmail,
+		Permission:       string(share.Permission),
+		CreatedAt:        share.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
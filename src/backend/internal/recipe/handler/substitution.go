@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+	"github.com/platepilot/backend/internal/recipe/substitution"
+)
+
+// substitutedNameSuffix is appended to the name of a substituted copy when
+// the request does not name it.
+const substitutedNameSuffix = " (with substitutions)"
+
+// ListRecipeSubstitutions returns the catalog substitutions for the
+// ingredients of a recipe, grouped by ingredient in recipe order.
+func (h *GRPCHandler) ListRecipeSubstitutions(ctx context.Context, req *pb.ListRecipeSubstitutionsRequest) (*pb.ListRecipeSubstitutionsResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	var allergyID *uuid.UUID
+	if value := strings.TrimSpace(req.GetAllergyId()); value != "" {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid allergy ID: %v", err)
+		}
+		allergyID = &id
+	}
+
+	recipe, err := h.repo.GetByID(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to list substitutions")
+	}
+
+	names := make([]string, len(recipe.IngredientLines))
+	for i, line := range recipe.IngredientLines {
+		names[i] = line.Ingredient.Name
+	}
+
+	subs, err := h.repo.ListSubstitutions(ctx, names, allergyID)
+	if err != nil {
+		h.logger.Error("failed to list substitutions", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to list substitutions")
+	}
+
+	byKey := make(map[string][]*pb.Substitution)
+	for i := range subs {
+		key := substitution.Key(subs[i].IngredientName)
+		byKey[key] = append(byKey[key], toSubstitutionResponse(&subs[i]))
+	}
+
+	resp := &pb.ListRecipeSubstitutionsResponse{}
+	for _, line := range recipe.IngredientLines {
+		key := substitution.Key(line.Ingredient.Name)
+		if len(byKey[key]) == 0 {
+			continue
+		}
+		resp.Ingredients = append(resp.Ingredients, &pb.IngredientSubstitutions{
+			Ingredient: &pb.IngredientRef{
+				Id:   line.Ingredient.ID.String(),
+				Name: line.Ingredient.Name,
+			},
+			Substitutions: byKey[key],
+		})
+		delete(byKey, key)
+	}
+
+	return resp, nil
+}
+
+// SubstituteRecipe copies a recipe into the user's library with the chosen
+// substitutions applied. Quantities are adjusted by each substitution's ratio,
+// and the copy's allergies and nutrition follow from its new ingredients.
+func (h *GRPCHandler) SubstituteRecipe(ctx context.Context, req *pb.SubstituteRecipeRequest) (*pb.Recipe, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	sourceID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	if len(req.GetSubstitutionIds()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one substitution is required")
+	}
+	ids := make([]uuid.UUID, len(req.GetSubstitutionIds()))
+	for i, value := range req.GetSubstitutionIds() {
+		ids[i], err = uuid.Parse(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid substitution ID: %v", err)
+		}
+	}
+
+	source, err := h.repo.GetByID(ctx, userID, sourceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found")
+		}
+		h.logger.Error("failed to get recipe", "error", err, "recipeId", sourceID)
+		return nil, status.Errorf(codes.Internal, "failed to substitute recipe")
+	}
+
+	subs, err := h.repo.GetSubstitutions(ctx, ids)
+	if err != nil {
+		h.logger.Error("failed to get substitutions", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to substitute recipe")
+	}
+
+	byKey := make(map[string]domain.Substitution, len(subs))
+	for _, sub := range subs {
+		key := substitution.Key(sub.IngredientName)
+		if _, ok := byKey[key]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "only one substitution per ingredient is allowed, got several for %s", sub.IngredientName)
+		}
+		byKey[key] = sub
+	}
+	for _, id := range ids {
+		if !containsSubstitution(subs, id) {
+			return nil, status.Errorf(codes.NotFound, "substitution %s not found", id)
+		}
+	}
+
+	recipe, err := h.copyRecipe(ctx, userID, source)
+	if err != nil {
+		return nil, err
+	}
+	recipe.Name = source.Name + substitutedNameSuffix
+	if name := strings.TrimSpace(req.GetName()); name != "" {
+		recipe.Name = name
+	}
+
+	substitutes := make(map[string]*domain.Ingredient)
+	substituteFor := func(sub domain.Substitution) (*domain.Ingredient, error) {
+		if ingredient, ok := substitutes[sub.SubstituteName]; ok {
+			return ingredient, nil
+		}
+		ingredient, err := h.repo.GetOrCreateIngredient(ctx, userID, sub.SubstituteName)
+		if err != nil {
+			h.logger.Error("failed to get or create ingredient", "error", err, "ingredientName", sub.SubstituteName)
+			return nil, status.Errorf(codes.Internal, "failed to create ingredient")
+		}
+		ingredient, err = h.addContainedAllergies(ctx, ingredient, sub)
+		if err != nil {
+			return nil, err
+		}
+		substitutes[sub.SubstituteName] = ingredient
+		return ingredient, nil
+	}
+
+	applied := make(map[uuid.UUID]bool)
+	for i, line := range recipe.IngredientLines {
+		sub, ok := byKey[substitution.Key(line.Ingredient.Name)]
+		if !ok {
+			continue
+		}
+		ingredient, err := substituteFor(sub)
+		if err != nil {
+			return nil, err
+		}
+		recipe.IngredientLines[i] = substitution.Apply(line, sub, *ingredient)
+		applied[sub.ID] = true
+	}
+	for _, sub := range subs {
+		if !applied[sub.ID] {
+			return nil, status.Errorf(codes.InvalidArgument, "recipe has no %s to substitute", strings.ToLower(sub.IngredientName))
+		}
+	}
+
+	if recipe.MainIngredient != nil {
+		if sub, ok := byKey[substitution.Key(recipe.MainIngredient.Name)]; ok {
+			recipe.MainIngredient, err = substituteFor(sub)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := h.computeNutrition(ctx, recipe); err != nil {
+		return nil, err
+	}
+
+	if err := h.classifyDiet(ctx, recipe); err != nil {
+		return nil, err
+	}
+
+	recipe, err = h.insertRecipe(ctx, recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	h.logger.Info("recipe substituted", "recipeId", recipe.ID, "source", source.ID, "substitutions", len(subs))
+
+	return toRecipeResponse(recipe), nil
+}
+
+// addContainedAllergies links the allergens the catalog lists for a
+// substitute to its ingredient, so the substituted recipe still warns about
+// them, and returns the ingredient with them.
+func (h *GRPCHandler) addContainedAllergies(ctx context.Context, ingredient *domain.Ingredient, sub domain.Substitution) (*domain.Ingredient, error) {
+	substitute := *ingredient
+	substitute.Allergies = slices.Clone(ingredient.Allergies)
+	for _, allergy := range sub.ContainsAllergies {
+		if slices.ContainsFunc(substitute.Allergies, func(own domain.Allergy) bool { return own.ID == allergy.ID }) {
+			continue
+		}
+		if err := h.repo.AddIngredientAllergy(ctx, substitute.ID, allergy.ID); err != nil {
+			h.logger.Error("failed to add ingredient allergy", "error", err, "ingredientId", substitute.ID, "allergyId", allergy.ID)
+			return nil, status.Errorf(codes.Internal, "failed to create ingredient")
+		}
+		substitute.Allergies = append(substitute.Allergies, allergy)
+	}
+	return &substitute, nil
+}
+
+func containsSubstitution(subs []domain.Substitution, id uuid.UUID) bool {
+	for _, sub := range subs {
+		if sub.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func toSubstitutionResponse(sub *domain.Substitution) *pb.Substitution {
+	resp := &pb.Substitution{
+		Id:             sub.ID.String(),
+		IngredientName: sub.IngredientName,
+		SubstituteName: sub.SubstituteName,
+		Ratio:          sub.Ratio,
+		Notes:          sub.Notes,
+	}
+	if sub.AvoidsAllergy != nil {
+		resp.AvoidsAllergy = toAllergyResponse(sub.AvoidsAllergy)
+	}
+	return resp
+}
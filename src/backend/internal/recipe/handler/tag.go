@@ -0,0 +1,201 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+const (
+	defaultTagLimit = 100
+	maxTagLimit     = 500
+)
+
+// ListTags lists the tags on the user's active recipes with their usage
+// counts, most used first. A prefix narrows it down for autocomplete and a
+// group for faceted browsing.
+func (h *GRPCHandler) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	group, err := parseTagGroup(req.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit < 1 {
+		limit = defaultTagLimit
+	}
+	if limit > maxTagLimit {
+		limit = maxTagLimit
+	}
+
+	tags, err := h.repo.ListTags(ctx, userID, normalizeTag(req.GetPrefix()), group, limit)
+	if err != nil {
+		h.logger.Error("failed to list tags", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list tags")
+	}
+
+	resp := &pb.ListTagsResponse{Tags: make([]*pb.Tag, 0, len(tags))}
+	for i := range tags {
+		resp.Tags = append(resp.Tags, toTagResponse(&tags[i]))
+	}
+	return resp, nil
+}
+
+// RenameTag renames a tag on every recipe of the user and republishes the
+// recipes carrying it. Renaming to a tag already in use is refused; those two
+// should be merged instead.
+func (h *GRPCHandler) RenameTag(ctx context.Context, req *pb.RenameTagRequest) (*pb.TagChangeResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	tag := normalizeTag(req.GetTag())
+	name := normalizeTag(req.GetName())
+	if tag == "" || name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag and name are required")
+	}
+	if tag == name {
+		return &pb.TagChangeResponse{}, nil
+	}
+
+	recipeIDs, err := h.repo.RenameTag(ctx, userID, tag, name)
+	if err != nil {
+		return nil, h.tagError(err, "failed to rename tag", tag)
+	}
+
+	h.logger.Info("tag renamed", "userId", userID, "tag", tag, "name", name, "recipes", len(recipeIDs))
+
+	return h.tagChanged(ctx, userID, recipeIDs)
+}
+
+// MergeTags replaces the source tags with the target on every recipe of the
+// user and republishes the recipes that changed.
+func (h *GRPCHandler) MergeTags(ctx context.Context, req *pb.MergeTagsRequest) (*pb.TagChangeResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	target := normalizeTag(req.GetTargetTag())
+	if target == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "target tag is required")
+	}
+	sources := normalizeTags(req.GetSourceTags())
+	if len(sources) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source tags are required")
+	}
+
+	recipeIDs, err := h.repo.MergeTags(ctx, userID, sources, target)
+	if err != nil {
+		return nil, h.tagError(err, "failed to merge tags", target)
+	}
+
+	h.logger.Info("tags merged", "userId", userID, "sources", sources, "target", target, "recipes", len(recipeIDs))
+
+	return h.tagChanged(ctx, userID, recipeIDs)
+}
+
+// DeleteTag removes a tag from every recipe of the user and republishes the
+// recipes that carried it.
+func (h *GRPCHandler) DeleteTag(ctx context.Context, req *pb.DeleteTagRequest) (*pb.TagChangeResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	tag := normalizeTag(req.GetTag())
+	if tag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+
+	recipeIDs, err := h.repo.DeleteTag(ctx, userID, tag)
+	if err != nil {
+		return nil, h.tagError(err, "failed to delete tag", tag)
+	}
+
+	h.logger.Info("tag deleted", "userId", userID, "tag", tag, "recipes", len(recipeIDs))
+
+	return h.tagChanged(ctx, userID, recipeIDs)
+}
+
+// SetTagGroup files a tag under a group for faceted browsing, or ungroups it
+// when the group is empty. Recipes are unchanged, so nothing is republished.
+func (h *GRPCHandler) SetTagGroup(ctx context.Context, req *pb.SetTagGroupRequest) (*pb.Tag, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	tag := normalizeTag(req.GetTag())
+	if tag == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	group, err := parseTagGroup(req.GetGroup())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := h.repo.SetTagGroup(ctx, userID, tag, group)
+	if err != nil {
+		return nil, h.tagError(err, "failed to set tag group", tag)
+	}
+
+	return toTagResponse(updated), nil
+}
+
+// tagChanged republishes the recipes a tag change touched.
+func (h *GRPCHandler) tagChanged(ctx context.Context, userID uuid.UUID, recipeIDs []uuid.UUID) (*pb.TagChangeResponse, error) {
+	if err := h.republishRecipes(ctx, userID, recipeIDs); err != nil {
+		return nil, err
+	}
+	return &pb.TagChangeResponse{UpdatedRecipes: int32(len(recipeIDs))}, nil
+}
+
+// tagError maps repository errors of tag changes to gRPC errors.
+func (h *GRPCHandler) tagError(err error, message, tag string) error {
+	switch {
+	case errors.Is(err, repository.ErrTagNotFound):
+		return status.Errorf(codes.NotFound, "tag not found")
+	case errors.Is(err, repository.ErrTagNameTaken):
+		return status.Errorf(codes.AlreadyExists, "another tag has that name; merge them instead")
+	case errors.Is(err, repository.ErrTagMergeSelf):
+		return status.Errorf(codes.InvalidArgument, "tag cannot be merged into itself")
+	}
+	h.logger.Error(message, "error", err, "tag", tag)
+	return status.Errorf(codes.Internal, "%s", message)
+}
+
+// normalizeTag cleans a tag the way normalizeTags does.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+func parseTagGroup(value string) (domain.TagGroup, error) {
+	group := domain.TagGroup(strings.ToLower(strings.TrimSpace(value)))
+	if group != "" && !group.IsValid() {
+		return "", status.Errorf(codes.InvalidArgument, "invalid tag group: %s", value)
+	}
+	return group, nil
+}
+
+func toTagResponse(tag *domain.Tag) *pb.Tag {
+	return &pb.Tag{
+		Name:        tag.Name,
+		RecipeCount: int32(tag.RecipeCount),
+		Group:       string(tag.Group),
+	}
+}
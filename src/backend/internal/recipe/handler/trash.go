@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/common/media"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// ListDeletedRecipes lists the recipes in the user's trash, most recently
+// deleted first.
+func (h *GRPCHandler) ListDeletedRecipes(ctx context.Context, req *pb.ListDeletedRecipesRequest) (*pb.ListRecipesResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	pageIndex := int(req.GetPageIndex())
+	pageSize := int(req.GetPageSize())
+	if pageIndex < 1 {
+		pageIndex = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	offset := (pageIndex - 1) * pageSize
+
+	recipes, err := h.repo.ListDeletedRecipes(ctx, userID, pageSize, offset)
+	if err != nil {
+		h.logger.Error("failed to list deleted recipes", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list deleted recipes")
+	}
+
+	totalCount, err := h.repo.CountDeletedRecipes(ctx, userID)
+	if err != nil {
+		h.logger.Error("failed to count deleted recipes", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to count deleted recipes")
+	}
+
+	totalPages := int32((totalCount + int64(pageSize) - 1) / int64(pageSize))
+	return toRecipesResponseWithPagination(recipes, int32(pageIndex), int32(pageSize), int32(totalCount), totalPages), nil
+}
+
+// RestoreRecipe moves a recipe out of the user's trash. Deleting a recipe
+// removes it from downstream read models, so the recipe, its shares and its
+// cook stats are published again.
+func (h *GRPCHandler) RestoreRecipe(ctx context.Context, req *pb.RestoreRecipeRequest) (*pb.Recipe, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	if err := h.repo.RestoreRecipe(ctx, userID, recipeID); err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found in trash")
+		}
+		h.logger.Error("failed to restore recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to restore recipe")
+	}
+
+	recipe, err := h.repo.GetByID(ctx, userID, recipeID)
+	if err != nil {
+		h.logger.Error("failed to get restored recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to restore recipe")
+	}
+
+	h.publishRestoredRecipe(ctx, recipe)
+
+	h.logger.Info("recipe restored", "recipeId", recipeID)
+
+	return toRecipeResponse(recipe), nil
+}
+
+// PurgeRecipe permanently deletes a recipe from the user's trash.
+func (h *GRPCHandler) PurgeRecipe(ctx context.Context, req *pb.PurgeRecipeRequest) (*emptypb.Empty, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user ID: %v", err)
+	}
+
+	recipeID, err := uuid.Parse(req.GetRecipeId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recipe ID: %v", err)
+	}
+
+	orphaned, err := h.repo.PurgeRecipe(ctx, userID, recipeID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRecipeNotFound) {
+			return nil, status.Errorf(codes.NotFound, "recipe not found in trash")
+		}
+		h.logger.Error("failed to purge recipe", "error", err, "recipeId", recipeID)
+		return nil, status.Errorf(codes.Internal, "failed to purge recipe")
+	}
+
+	// The recipe is gone either way; images that fail to delete are only
+	// logged.
+	if h.media != nil && len(orphaned) > 0 {
+		if err := media.DeleteImages(ctx, h.media, orphaned); err != nil {
+			h.logger.Error("failed to delete orphaned images", "error", err, "recipeId", recipeID)
+		}
+	}
+
+	h.logger.Info("recipe purged", "recipeId", recipeID)
+
+	return &emptypb.Empty{}, nil
+}
+
+// publishRestoredRecipe republishes everything the read models dropped when
+// the recipe was deleted. Failures are logged; the restore already happened.
+func (h *GRPCHandler) publishRestoredRecipe(ctx context.Context, recipe *domain.Recipe) {
+	if h.publisher == nil {
+		return
+	}
+
+	recipeID := recipe.ID
+	if err := h.publisher.PublishRecipeUpserted(ctx, recipe); err != nil {
+		h.logger.Error("failed to publish recipe upserted event",
+			"error", err,
+			"recipeId", recipeID,
+		)
+	}
+
+	shares, err := h.repo.ListRecipeShares(ctx, recipe.UserID, recipeID)
+	if err != nil {
+		h.logger.Error("failed to list recipe shares", "error", err, "recipeId", recipeID)
+	}
+	for i := range shares {
+		if err := h.publisher.PublishRecipeShared(ctx, &shares[i]); err != nil {
+			h.logger.Error("failed to publish recipe shared event",
+				"error", err,
+				"recipeId", recipeID,
+			)
+		}
+	}
+
+	collectionIDs, err := h.repo.ListRecipeCollectionIDs(ctx, recipeID)
+	if err != nil {
+		h.logger.Error("failed to list recipe collections", "error", err, "recipeId", recipeID)
+	}
+	for _, collectionID := range collectionIDs {
+		if err := h.publisher.PublishCollectionRecipeAdded(ctx, collectionID, recipe.UserID, recipeID); err != nil {
+			h.logger.Error("failed to publish collection recipe added event",
+				"error", err,
+				"recipeId", recipeID,
+				"collectionId", collectionID,
+			)
+		}
+	}
+
+	stats, err := h.repo.ListRecipeCookStats(ctx, recipeID)
+	if err != nil {
+		h.logger.Error("failed to list cook stats", "error", err, "recipeId", recipeID)
+	}
+	for cookID, s := range stats {
+		if err := h.publisher.PublishRecipeCookStatsUpdated(ctx, recipeID, cookID, &s); err != nil {
+			h.logger.Error("failed to publish recipe cook stats updated event",
+				"error", err,
+				"recipeId", recipeID,
+			)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package importer
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// isoDurationPattern matches ISO-8601 durations such as PT1H30M, P0DT0H20M or
+// PT0.5H. Years, months and weeks are not meaningful for recipes.
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// parseDurationMinutes converts an ISO-8601 duration to whole minutes,
+// rounding up partial minutes. Invalid or empty values yield 0.
+func parseDurationMinutes(value string) int {
+	match := isoDurationPattern.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(value)))
+	if match == nil {
+		return 0
+	}
+
+	factors := []float64{24 * 60, 60, 1, 1.0 / 60}
+	var minutes float64
+	for i, factor := range factors {
+		if match[i+1] == "" {
+			continue
+		}
+		amount, err := strconv.ParseFloat(match[i+1], 64)
+		if err != nil {
+			return 0
+		}
+		minutes += amount * factor
+	}
+
+	return int(math.Ceil(minutes - 1e-9))
+}
@@ -0,0 +1,145 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultFetchTimeout = 15 * time.Second
+	maxDocumentBytes    = 5 << 20
+	maxRedirects        = 10
+	fetchUserAgent      = "PlatePilot-RecipeImporter/1.0"
+)
+
+var (
+	// ErrInvalidURL is returned when a URL is not an absolute http(s) URL.
+	ErrInvalidURL = errors.New("invalid url")
+	// ErrForbiddenAddress is returned when a URL, or a redirect it leads to,
+	// points at an address that is not publicly routable, such as loopback,
+	// a private network or the cloud metadata service.
+	ErrForbiddenAddress = errors.New("url does not point to a public address")
+)
+
+// reservedPrefixes are non-public ranges that netip does not already rule
+// out: "this network" and the carrier-grade NAT range.
+var reservedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// HTTPFetcher downloads documents over HTTP(S) from public addresses only.
+type HTTPFetcher struct {
+	client *http.Client
+}
+
+// NewHTTPFetcher creates a fetcher with a bounded request timeout. Every
+// connection, including those of redirects, is checked after DNS resolution
+// so a public name cannot lead to an internal address. Proxies from the
+// environment are not used, since the proxy would be dialed instead.
+func NewHTTPFetcher() *HTTPFetcher {
+	dialer := &net.Dialer{
+		Timeout: defaultFetchTimeout,
+		Control: rejectNonPublicAddress,
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPFetcher{
+		client: &http.Client{
+			Timeout:       defaultFetchTimeout,
+			Transport:     transport,
+			CheckRedirect: checkRedirect,
+		},
+	}
+}
+
+// Fetch downloads the document at rawURL, refusing bodies larger than 5 MiB.
+func (f *HTTPFetcher) Fetch(ctx context.Context, rawURL string) ([]byte, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, ErrInvalidURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, parsed.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("User-Agent", fetchUserAgent)
+	req.Header.Set("Accept", "text/html,application/ld+json,application/json;q=0.9,*/*;q=0.8")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch document: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("fetch document: unexpected status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("read document: %w", err)
+	}
+	if len(body) > maxDocumentBytes {
+		return nil, fmt.Errorf("document exceeds %d bytes", maxDocumentBytes)
+	}
+
+	return body, nil
+}
+
+// checkRedirect follows at most maxRedirects http(s) redirects and refuses
+// those to a literal non-public address before dialing. Names are checked
+// once resolved, when the connection is made.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return ErrInvalidURL
+	}
+	if addr, err := netip.ParseAddr(req.URL.Hostname()); err == nil && !isPublicAddress(addr) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// rejectNonPublicAddress is a dialer control function that refuses to
+// connect to resolved addresses that are not publicly routable.
+func rejectNonPublicAddress(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("parse dial address %q: %w", address, err)
+	}
+	if !isPublicAddress(addrPort.Addr()) {
+		return ErrForbiddenAddress
+	}
+	return nil
+}
+
+// isPublicAddress reports whether addr is publicly routable. Loopback,
+// link-local (which holds the metadata service at 169.254.169.254),
+// private, multicast and reserved addresses are not.
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range reservedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
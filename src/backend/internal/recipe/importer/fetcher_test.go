@@ -0,0 +1,55 @@
+package importer_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/platepilot/backend/internal/recipe/importer"
+)
+
+func TestFetch_LoopbackServer_IsRefused(t *testing.T) {
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+		w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+	fetcher := importer.NewHTTPFetcher()
+
+	for _, url := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+		_, err := fetcher.Fetch(context.Background(), url)
+
+		if !errors.Is(err, importer.ErrForbiddenAddress) {
+			t.Fatalf("expected %s to be refused as non-public, got %v", url, err)
+		}
+	}
+	if requested {
+		t.Fatal("expected no request to reach the loopback server")
+	}
+}
+
+func TestFetch_NonPublicAddresses_AreRefused(t *testing.T) {
+	urls := []string{
+		"http://169.254.169.254/latest/meta-data/",
+		"http://10.0.0.1/",
+		"http://192.168.1.1/",
+		"http://[::1]/",
+		"http://[::ffff:127.0.0.1]/",
+		"http://0.0.0.0/",
+	}
+
+	fetcher := importer.NewHTTPFetcher()
+	for _, url := range urls {
+		t.Run(url, func(t *testing.T) {
+			_, err := fetcher.Fetch(context.Background(), url)
+
+			if !errors.Is(err, importer.ErrForbiddenAddress) {
+				t.Fatalf("expected a forbidden address error, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+// Package importer extracts schema.org Recipe objects from HTML documents and
+// JSON-LD blobs and maps them onto recipe inputs.
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+// ErrNoRecipe is returned when a document does not contain a schema.org Recipe.
+var ErrNoRecipe = errors.New("no schema.org recipe found")
+
+var (
+	ldJSONScriptPattern = regexp.MustCompile(`(?is)<script[^>]*type\s*=\s*["']?application/ld\+json["']?[^>]*>(.*?)</script>`)
+	htmlTagPattern      = regexp.MustCompile(`(?s)<[^>]*>`)
+	lineBreakPattern    = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>`)
+	whitespacePattern   = regexp.MustCompile(`\s+`)
+	leadingNumber       = regexp.MustCompile(`^\s*(\d+(?:[.,]\d+)?)\s*(.*)$`)
+	firstNumber         = regexp.MustCompile(`\d+(?:[.,]\d+)?`)
+)
+
+// servingUnits are yield units that describe servings rather than a product.
+var servingUnits = map[string]bool{
+	"":         true,
+	"serving":  true,
+	"servings": true,
+	"people":   true,
+	"persons":  true,
+	"person":   true,
+	"portion":  true,
+	"portions": true,
+	"porties":  true,
+	"portie":   true,
+	"personen": true,
+	"persoon":  true,
+}
+
+// ExtractRecipe finds the first schema.org Recipe in an HTML document or a
+// JSON-LD blob and converts it to a recipe input.
+//
+// Ingredient lines are returned as free text in IngredientName; quantities and
+// units are left for the recipe service to resolve.
+func ExtractRecipe(document []byte) (*pb.RecipeInput, error) {
+	trimmed := bytes.TrimSpace(document)
+	if len(trimmed) == 0 {
+		return nil, ErrNoRecipe
+	}
+
+	isJSON := trimmed[0] == '{' || trimmed[0] == '['
+
+	var blobs [][]byte
+	if isJSON {
+		blobs = append(blobs, trimmed)
+	} else {
+		for _, match := range ldJSONScriptPattern.FindAllSubmatch(trimmed, -1) {
+			blobs = append(blobs, bytes.TrimSpace(match[1]))
+		}
+	}
+
+	for _, blob := range blobs {
+		var node any
+		if err := json.Unmarshal(blob, &node); err != nil {
+			if isJSON {
+				return nil, fmt.Errorf("decode json-ld: %w", err)
+			}
+			// Pages regularly carry broken JSON-LD for unrelated types.
+			continue
+		}
+		if recipe := findRecipe(node); recipe != nil {
+			return toRecipeInput(recipe), nil
+		}
+	}
+
+	return nil, ErrNoRecipe
+}
+
+// findRecipe walks a decoded JSON-LD tree (including @graph and mainEntity
+// wrappers) and returns the first object typed as a Recipe.
+func findRecipe(node any) map[string]any {
+	switch v := node.(type) {
+	case map[string]any:
+		if hasType(v, "Recipe") {
+			return v
+		}
+		for _, child := range v {
+			if found := findRecipe(child); found != nil {
+				return found
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if found := findRecipe(child); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+func hasType(obj map[string]any, want string) bool {
+	for _, t := range stringList(obj["@type"]) {
+		t = strings.TrimPrefix(t, "http://schema.org/")
+		t = strings.TrimPrefix(t, "https://schema.org/")
+		t = strings.TrimPrefix(t, "schema:")
+		if strings.EqualFold(t, want) {
+			return true
+		}
+	}
+	return false
+}
+
+func toRecipeInput(obj map[string]any) *pb.RecipeInput {
+	input := &pb.RecipeInput{
+		Name:        cleanText(firstString(obj["name"])),
+		Description: cleanText(firstString(obj["description"])),
+		CuisineName: cleanText(firstString(obj["recipeCuisine"])),
+		ImageUrl:    imageURL(obj["image"]),
+	}
+
+	prep := parseDurationMinutes(firstString(obj["prepTime"]))
+	cook := parseDurationMinutes(firstString(obj["cookTime"]))
+	total := parseDurationMinutes(firstString(obj["totalTime"]))
+	if cook == 0 && total > prep {
+		cook = total - prep
+	}
+	input.PrepTimeMinutes = int32(prep)
+	input.CookTimeMinutes = int32(cook)
+
+	servings, yieldQuantity, yieldUnit := parseYield(obj["recipeYield"])
+	input.Servings = int32(servings)
+	if yieldQuantity != nil {
+		input.YieldQuantity = wrapperspb.Double(*yieldQuantity)
+		input.YieldUnit = yieldUnit
+	}
+
+	ingredients := obj["recipeIngredient"]
+	if ingredients == nil {
+		ingredients = obj["ingredients"]
+	}
+	for _, line := range stringList(ingredients) {
+		line = cleanText(line)
+		if line == "" {
+			continue
+		}
+		input.IngredientLines = append(input.IngredientLines, &pb.IngredientLineInput{
+			IngredientName: line,
+			SortOrder:      int32(len(input.IngredientLines) + 1),
+		})
+	}
+
+	for _, instruction := range instructions(obj["recipeInstructions"]) {
+		input.Steps = append(input.Steps, &pb.RecipeStepInput{
+			StepIndex:   int32(len(input.Steps) + 1),
+			Instruction: instruction,
+		})
+	}
+
+	input.Tags = keywords(obj["keywords"], obj["recipeCategory"])
+	input.Nutrition = nutrition(obj["nutrition"], servings)
+
+	return input
+}
+
+// instructions flattens recipeInstructions, which may be a plain string, a
+// list of strings, HowToStep objects or HowToSection/ItemList groups.
+func instructions(node any) []string {
+	var steps []string
+
+	switch v := node.(type) {
+	case string:
+		for _, line := range strings.Split(stripTags(lineBreakPattern.ReplaceAllString(v, "\n")), "\n") {
+			if line = cleanText(line); line != "" {
+				steps = append(steps, line)
+			}
+		}
+	case []any:
+		for _, child := range v {
+			steps = append(steps, instructions(child)...)
+		}
+	case map[string]any:
+		if items, ok := v["itemListElement"]; ok {
+			return instructions(items)
+		}
+		text := cleanText(firstString(v["text"]))
+		if text == "" {
+			text = cleanText(firstString(v["name"]))
+		}
+		if text != "" {
+			steps = append(steps, text)
+		}
+	}
+
+	return steps
+}
+
+func keywords(values ...any) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		for _, entry := range stringList(value) {
+			for _, tag := range strings.Split(entry, ",") {
+				tag = strings.ToLower(cleanText(tag))
+				if tag == "" || seen[tag] {
+					continue
+				}
+				seen[tag] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+	return tags
+}
+
+// nutrition maps NutritionInformation, which schema.org defines per serving.
+// The publisher's values are kept as an override of the computed nutrition.
+func nutrition(node any, servings int) *pb.RecipeNutrition {
+	obj, ok := node.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	calories := int(parseAmount(obj["calories"]))
+	if servings < 1 {
+		servings = 1
+	}
+
+	return &pb.RecipeNutrition{
+		CaloriesPerServing: int32(calories),
+		CaloriesTotal:      int32(calories * servings),
+		ProteinG:           parseAmount(obj["proteinContent"]),
+		CarbsG:             parseAmount(obj["carbohydrateContent"]),
+		FatG:               parseAmount(obj["fatContent"]),
+		FiberG:             parseAmount(obj["fiberContent"]),
+		SugarG:             parseAmount(obj["sugarContent"]),
+		SodiumMg:           parseAmount(obj["sodiumContent"]),
+		IsOverride:         true,
+	}
+}
+
+// parseYield interprets recipeYield values such as 4, "4 servings" or
+// "12 cookies". Serving counts fill servings; anything else becomes a yield.
+func parseYield(node any) (int, *float64, string) {
+	var servings int
+	var quantity *float64
+	var unit string
+
+	values := stringList(node)
+	if number, ok := node.(float64); ok {
+		values = []string{strconv.FormatFloat(number, 'f', -1, 64)}
+	}
+
+	for _, value := range values {
+		match := leadingNumber.FindStringSubmatch(cleanText(value))
+		if match == nil {
+			continue
+		}
+		amount, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", "."), 64)
+		if err != nil || amount <= 0 {
+			continue
+		}
+		rest := strings.ToLower(strings.TrimSpace(match[2]))
+		if servingUnits[rest] {
+			if servings == 0 {
+				servings = int(amount)
+			}
+			continue
+		}
+		if quantity == nil {
+			quantity = &amount
+			unit = rest
+		}
+	}
+
+	return servings, quantity, unit
+}
+
+// parseAmount extracts the leading number from values like "240 kcal" or "12 g".
+func parseAmount(node any) float64 {
+	if number, ok := node.(float64); ok {
+		return number
+	}
+	match := firstNumber.FindString(firstString(node))
+	if match == "" {
+		return 0
+	}
+	value, err := strconv.ParseFloat(strings.ReplaceAll(match, ",", "."), 64)
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+func imageURL(node any) string {
+	switch v := node.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case []any:
+		for _, child := range v {
+			if url := imageURL(child); url != "" {
+				return url
+			}
+		}
+	case map[string]any:
+		if url := firstString(v["url"]); url != "" {
+			return strings.TrimSpace(url)
+		}
+		return strings.TrimSpace(firstString(v["contentUrl"]))
+	}
+	return ""
+}
+
+// stringList normalizes a JSON-LD value that may be a single string or a list.
+func stringList(node any) []string {
+	switch v := node.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, child := range v {
+			switch c := child.(type) {
+			case string:
+				values = append(values, c)
+			case float64:
+				values = append(values, strconv.FormatFloat(c, 'f', -1, 64))
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+func firstString(node any) string {
+	if number, ok := node.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	values := stringList(node)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func stripTags(value string) string {
+	return htmlTagPattern.ReplaceAllString(value, "")
+}
+
+// cleanText removes markup, decodes entities and collapses whitespace.
+func cleanText(value string) string {
+	value = html.UnescapeString(stripTags(value))
+	return strings.TrimSpace(whitespacePattern.ReplaceAllString(value, " "))
+}
@@ -0,0 +1,129 @@
+package importer_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/platepilot/backend/internal/recipe/importer"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+)
+
+func TestExtractRecipe_BlogHTML_MapsSchemaOrgFields(t *testing.T) {
+	document := givenFixture(t, "blog_recipe.html")
+
+	input, err := importer.ExtractRecipe(document)
+
+	thenNoError(t, err)
+	if input.GetName() != "Weeknight Shakshuka" {
+		t.Fatalf("expected name Weeknight Shakshuka, got %q", input.GetName())
+	}
+	if input.GetDescription() != "Eggs poached in a spicy tomato & pepper sauce." {
+		t.Fatalf("unexpected description %q", input.GetDescription())
+	}
+	if input.GetImageUrl() != "https://example.com/shakshuka.jpg" {
+		t.Fatalf("unexpected image url %q", input.GetImageUrl())
+	}
+	if input.GetCuisineName() != "Middle Eastern" {
+		t.Fatalf("expected cuisine Middle Eastern, got %q", input.GetCuisineName())
+	}
+	if input.GetPrepTimeMinutes() != 10 || input.GetCookTimeMinutes() != 50 {
+		t.Fatalf("expected prep 10 / cook 50, got %d / %d", input.GetPrepTimeMinutes(), input.GetCookTimeMinutes())
+	}
+	if input.GetServings() != 4 {
+		t.Fatalf("expected 4 servings, got %d", input.GetServings())
+	}
+	thenIngredientNames(t, input, "2 tbsp olive oil", "1 red bell pepper, diced", "1 (28 oz) can crushed tomatoes", "6 large eggs")
+	if len(input.GetSteps()) != 3 {
+		t.Fatalf("expected 3 flattened steps, got %d", len(input.GetSteps()))
+	}
+	if input.GetSteps()[2].GetStepIndex() != 3 {
+		t.Fatalf("expected last step index 3, got %d", input.GetSteps()[2].GetStepIndex())
+	}
+	thenTags(t, input, "eggs", "vegetarian", "one pan", "breakfast")
+	if input.GetNutrition().GetCaloriesPerServing() != 320 || input.GetNutrition().GetCaloriesTotal() != 1280 {
+		t.Fatalf("unexpected calories %d / %d", input.GetNutrition().GetCaloriesPerServing(), input.GetNutrition().GetCaloriesTotal())
+	}
+	if input.GetNutrition().GetFatG() != 19.5 || input.GetNutrition().GetSodiumMg() != 640 {
+		t.Fatalf("unexpected nutrition %+v", input.GetNutrition())
+	}
+}
+
+func TestExtractRecipe_JSONLDBlob_ParsesStringInstructionsAndYield(t *testing.T) {
+	document := []byte(`{
+		"@context": "http://schema.org",
+		"@type": "Recipe",
+		"name": "Pancakes",
+		"cookTime": "PT0.5H",
+		"recipeYield": "12 pancakes",
+		"recipeIngredient": "250 g flour",
+		"recipeInstructions": "<p>Mix everything.</p><p>Fry in butter.</p>"
+	}`)
+
+	input, err := importer.ExtractRecipe(document)
+
+	thenNoError(t, err)
+	if input.GetCookTimeMinutes() != 30 {
+		t.Fatalf("expected cook time 30, got %d", input.GetCookTimeMinutes())
+	}
+	if input.GetServings() != 0 {
+		t.Fatalf("expected no servings, got %d", input.GetServings())
+	}
+	if input.GetYieldQuantity().GetValue() != 12 || input.GetYieldUnit() != "pancakes" {
+		t.Fatalf("expected yield 12 pancakes, got %v %q", input.GetYieldQuantity().GetValue(), input.GetYieldUnit())
+	}
+	thenIngredientNames(t, input, "250 g flour")
+	if len(input.GetSteps()) != 2 || input.GetSteps()[1].GetInstruction() != "Fry in butter." {
+		t.Fatalf("unexpected steps %v", input.GetSteps())
+	}
+}
+
+func TestExtractRecipe_NoRecipe_ReturnsErrNoRecipe(t *testing.T) {
+	_, err := importer.ExtractRecipe([]byte(`<html><body>Just a page</body></html>`))
+
+	if !errors.Is(err, importer.ErrNoRecipe) {
+		t.Fatalf("expected ErrNoRecipe, got %v", err)
+	}
+}
+
+// Helpers
+
+func givenFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", name, err)
+	}
+	return data
+}
+
+func thenNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func thenIngredientNames(t *testing.T, input *pb.RecipeInput, names ...string) {
+	t.Helper()
+	if len(input.GetIngredientLines()) != len(names) {
+		t.Fatalf("expected %d ingredient lines, got %d", len(names), len(input.GetIngredientLines()))
+	}
+	for i, name := range names {
+		if got := input.GetIngredientLines()[i].GetIngredientName(); got != name {
+			t.Fatalf("ingredient %d: expected %q, got %q", i, name, got)
+		}
+	}
+}
+
+func thenTags(t *testing.T, input *pb.RecipeInput, tags ...string) {
+	t.Helper()
+	if len(input.GetTags()) != len(tags) {
+		t.Fatalf("expected tags %v, got %v", tags, input.GetTags())
+	}
+	for i, tag := range tags {
+		if input.GetTags()[i] != tag {
+			t.Fatalf("expected tags %v, got %v", tags, input.GetTags())
+		}
+	}
+}
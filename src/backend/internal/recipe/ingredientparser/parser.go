@@ -0,0 +1,389 @@
+// Package ingredientparser turns free-text ingredient lines such as
+// "1 1/2 cups flour, sifted" or "2–3 teentjes knoflook" into a quantity,
+// a normalized unit, the ingredient name and any notes.
+package ingredientparser
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Line is the structured form of a free-text ingredient line.
+type Line struct {
+	// Quantity is the parsed amount, or the lower bound of a range. Nil when
+	// the line has no recognizable amount.
+	Quantity *float64
+	// QuantityMax is the upper bound of a range such as "2-3"; nil otherwise.
+	QuantityMax *float64
+	// Unit is the normalized unit, e.g. "tbsp" for "eetlepels". Empty when
+	// the line has no recognized unit.
+	Unit     string
+	Name     string
+	Optional bool
+	Note     string
+}
+
+// IsRange reports whether the line specifies a range of quantities.
+func (l Line) IsRange() bool {
+	return l.Quantity != nil && l.QuantityMax != nil
+}
+
+// Amount returns the quantity in the shape stored on recipe ingredient lines:
+// a numeric value for exact amounts, or text such as "2-3" for ranges.
+func (l Line) Amount() (*float64, string) {
+	if l.Quantity == nil {
+		return nil, ""
+	}
+	if l.IsRange() {
+		return nil, formatNumber(*l.Quantity) + "-" + formatNumber(*l.QuantityMax)
+	}
+	value := *l.Quantity
+	return &value, ""
+}
+
+const amountPattern = `(\d+(?:[.,]\d+)?(?: +|-)\d+/\d+|\d+/\d+|\d+(?:[.,]\d+)?)`
+
+var (
+	rangeAmount  = regexp.MustCompile(`^` + amountPattern + `(?: *- *| +(?:to|tot|à|a|or|of) +)` + amountPattern + `(?: |$|\pL)`)
+	singleAmount = regexp.MustCompile(`^` + amountPattern + `(?: |$|\pL)`)
+)
+
+var vulgarFractions = map[rune]string{
+	'½': "1/2",
+	'⅓': "1/3",
+	'⅔': "2/3",
+	'¼': "1/4",
+	'¾': "3/4",
+	'⅕': "1/5",
+	'⅖': "2/5",
+	'⅗': "3/5",
+	'⅘': "4/5",
+	'⅙': "1/6",
+	'⅚': "5/6",
+	'⅛': "1/8",
+	'⅜': "3/8",
+	'⅝': "5/8",
+	'⅞': "7/8",
+}
+
+// wordAmounts are spelled-out quantities accepted at the start of a line.
+var wordAmounts = map[string]float64{
+	"a":          1,
+	"an":         1,
+	"one":        1,
+	"een":        1,
+	"één":        1,
+	"two":        2,
+	"twee":       2,
+	"three":      3,
+	"drie":       3,
+	"four":       4,
+	"vier":       4,
+	"five":       5,
+	"vijf":       5,
+	"six":        6,
+	"zes":        6,
+	"seven":      7,
+	"zeven":      7,
+	"eight":      8,
+	"acht":       8,
+	"nine":       9,
+	"negen":      9,
+	"ten":        10,
+	"tien":       10,
+	"eleven":     11,
+	"twelve":     12,
+	"twaalf":     12,
+	"half":       0.5,
+	"halve":      0.5,
+	"anderhalf":  1.5,
+	"anderhalve": 1.5,
+}
+
+// vagueWords follow an article without making it a quantity, as in
+// "a few sprigs" or "een paar blaadjes".
+var vagueWords = map[string]bool{
+	"few":    true,
+	"little": true,
+	"bit":    true,
+	"couple": true,
+	"lot":    true,
+	"paar":   true,
+	"beetje": true,
+	"aantal": true,
+}
+
+var optionalMarkers = map[string]bool{
+	"optional":       true,
+	"optioneel":      true,
+	"if desired":     true,
+	"indien gewenst": true,
+	"facultatief":    true,
+}
+
+// impliedSingleUnits may appear without an amount, meaning one of them.
+var impliedSingleUnits = map[string]bool{
+	"pinch":   true,
+	"dash":    true,
+	"handful": true,
+}
+
+var tasteSuffixes = []string{"to taste", "naar smaak"}
+
+// Parse splits a free-text ingredient line into its parts. Text it cannot
+// interpret ends up in Name, so Parse never fails.
+func Parse(text string) Line {
+	var line Line
+	var notes []string
+
+	s, parentheticals := extractParentheticals(normalize(text))
+	for _, p := range parentheticals {
+		if optionalMarkers[strings.ToLower(p)] {
+			line.Optional = true
+		} else if p != "" {
+			notes = append(notes, p)
+		}
+	}
+
+	parts := splitTopLevel(s)
+	head := parts[0]
+	for _, part := range parts[1:] {
+		if optionalMarkers[strings.ToLower(part)] {
+			line.Optional = true
+		} else if part != "" {
+			notes = append(notes, part)
+		}
+	}
+
+	head, taste := trimTasteSuffix(head)
+	if taste != "" {
+		notes = append(notes, taste)
+	}
+	head = trimOptionalPrefix(head, &line)
+
+	if quantity, quantityMax, rest, ok := parseAmount(head); ok {
+		line.Quantity = &quantity
+		if quantityMax > quantity {
+			line.QuantityMax = &quantityMax
+		}
+		if unit, afterUnit, ok := parseUnit(rest); ok {
+			line.Unit = unit
+			rest = afterUnit
+			if after, found := strings.CutPrefix(rest, "of "); found {
+				rest = after
+			}
+		}
+		head = rest
+	} else if unit, rest, ok := parseUnit(head); ok && impliedSingleUnits[unit] && rest != "" {
+		// "snufje zout", "pinch of salt"
+		one := 1.0
+		line.Quantity = &one
+		line.Unit = unit
+		head = strings.TrimPrefix(rest, "of ")
+	}
+
+	line.Name = strings.TrimSpace(head)
+	line.Note = strings.Join(notes, ", ")
+	return line
+}
+
+// ParseQuantity parses text that holds only an amount with its unit and
+// notes, such as "1 cup, grated" or "4 large". Leftover words are folded
+// into the note.
+func ParseQuantity(text string) Line {
+	line := Parse(text)
+	line.Note = JoinNotes(line.Name, line.Note)
+	line.Name = ""
+	return line
+}
+
+// JoinNotes joins non-empty notes with a comma.
+func JoinNotes(notes ...string) string {
+	var parts []string
+	for _, note := range notes {
+		if note = strings.TrimSpace(note); note != "" {
+			parts = append(parts, note)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// normalize collapses whitespace, unifies dashes and fraction slashes and
+// expands unicode vulgar fractions to ASCII, e.g. "1½" to "1 1/2".
+func normalize(text string) string {
+	var b strings.Builder
+	var prev rune
+	for _, r := range text {
+		switch {
+		case vulgarFractions[r] != "":
+			if unicode.IsDigit(prev) {
+				b.WriteByte(' ')
+			}
+			b.WriteString(vulgarFractions[r])
+		case r == '–' || r == '—' || r == '‒' || r == '−' || r == '‐':
+			b.WriteByte('-')
+		case r == '⁄':
+			b.WriteByte('/')
+		case unicode.IsSpace(r):
+			b.WriteByte(' ')
+		default:
+			b.WriteRune(r)
+		}
+		prev = r
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// extractParentheticals removes "(...)" groups from s and returns their
+// trimmed contents.
+func extractParentheticals(s string) (string, []string) {
+	var contents []string
+	for {
+		open := strings.IndexByte(s, '(')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(s[open:], ')')
+		if end < 0 {
+			contents = append(contents, strings.TrimSpace(s[open+1:]))
+			s = s[:open]
+			break
+		}
+		contents = append(contents, strings.TrimSpace(s[open+1:open+end]))
+		s = s[:open] + " " + s[open+end+1:]
+	}
+	return strings.Join(strings.Fields(s), " "), contents
+}
+
+// splitTopLevel splits s on commas, leaving decimal commas such as "1,5"
+// intact.
+func splitTopLevel(s string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] != ',' {
+			continue
+		}
+		if i > 0 && i+1 < len(s) && isDigit(s[i-1]) && isDigit(s[i+1]) {
+			continue
+		}
+		parts = append(parts, strings.TrimSpace(s[start:i]))
+		start = i + 1
+	}
+	return append(parts, strings.TrimSpace(s[start:]))
+}
+
+func trimTasteSuffix(s string) (string, string) {
+	lower := strings.ToLower(s)
+	for _, suffix := range tasteSuffixes {
+		if lower == suffix {
+			return "", s
+		}
+		if strings.HasSuffix(lower, " "+suffix) {
+			cut := len(s) - len(suffix)
+			return strings.TrimSpace(s[:cut]), s[cut:]
+		}
+	}
+	return s, ""
+}
+
+func trimOptionalPrefix(s string, line *Line) string {
+	lower := strings.ToLower(s)
+	for _, prefix := range []string{"optional:", "optioneel:"} {
+		if strings.HasPrefix(lower, prefix) {
+			line.Optional = true
+			return strings.TrimSpace(s[len(prefix):])
+		}
+	}
+	return s
+}
+
+// parseAmount reads a numeric, fractional, ranged or spelled-out quantity
+// from the start of s.
+func parseAmount(s string) (float64, float64, string, bool) {
+	if m := rangeAmount.FindStringSubmatchIndex(s); m != nil {
+		low, lowOK := parseNumber(s[m[2]:m[3]])
+		high, highOK := parseNumber(s[m[4]:m[5]])
+		// "1-1/2" is a mixed number, not a descending range.
+		if lowOK && highOK && high > low {
+			return low, high, strings.TrimSpace(s[m[5]:]), true
+		}
+	}
+
+	if m := singleAmount.FindStringSubmatchIndex(s); m != nil {
+		if value, ok := parseNumber(s[m[2]:m[3]]); ok {
+			return value, value, strings.TrimSpace(s[m[3]:]), true
+		}
+	}
+
+	word, rest, found := strings.Cut(s, " ")
+	value, ok := wordAmounts[strings.ToLower(word)]
+	if !ok || !found {
+		return 0, 0, s, false
+	}
+	next, _, _ := strings.Cut(rest, " ")
+	if vagueWords[strings.ToLower(next)] {
+		return 0, 0, s, false
+	}
+	if value == 0.5 {
+		// "half a cup", "half an onion"
+		switch strings.ToLower(next) {
+		case "a", "an", "een":
+			_, rest, _ = strings.Cut(rest, " ")
+		}
+	}
+	return value, value, rest, true
+}
+
+// parseNumber parses integers, decimals with a point or comma, fractions
+// and mixed numbers such as "1 1/2" or "1-1/2".
+func parseNumber(s string) (float64, bool) {
+	if i := strings.LastIndexAny(s, " -"); i >= 0 {
+		whole, ok := parseNumber(s[:i])
+		if !ok {
+			return 0, false
+		}
+		fraction, ok := parseNumber(s[i+1:])
+		if !ok {
+			return 0, false
+		}
+		return whole + fraction, true
+	}
+
+	if numerator, denominator, ok := strings.Cut(s, "/"); ok {
+		n, err := strconv.ParseFloat(numerator, 64)
+		if err != nil {
+			return 0, false
+		}
+		d, err := strconv.ParseFloat(denominator, 64)
+		if err != nil || d == 0 {
+			return 0, false
+		}
+		return n / d, true
+	}
+
+	if whole, decimals, ok := strings.Cut(s, ","); ok {
+		if len(decimals) == 3 {
+			// Thousands separator, e.g. "1,000".
+			s = whole + decimals
+		} else {
+			s = whole + "." + decimals
+		}
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
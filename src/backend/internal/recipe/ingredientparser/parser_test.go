@@ -0,0 +1,265 @@
+package ingredientparser_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/platepilot/backend/internal/recipe/ingredientparser"
+)
+
+type expectedLine struct {
+	quantity    float64
+	quantityMax float64
+	unit        string
+	name        string
+	optional    bool
+	note        string
+}
+
+// none marks a line without a quantity.
+const none = -1
+
+func TestParse_English(t *testing.T) {
+	cases := []struct {
+		line string
+		want expectedLine
+	}{
+		// Integers, decimals and fractions
+		{"2 cups flour", expectedLine{quantity: 2, unit: "cup", name: "flour"}},
+		{"1 cup sugar", expectedLine{quantity: 1, unit: "cup", name: "sugar"}},
+		{"1.5 cups milk", expectedLine{quantity: 1.5, unit: "cup", name: "milk"}},
+		{"1/2 cup butter", expectedLine{quantity: 0.5, unit: "cup", name: "butter"}},
+		{"1 1/2 cups flour, sifted", expectedLine{quantity: 1.5, unit: "cup", name: "flour", note: "sifted"}},
+		{"1-1/2 cups rolled oats", expectedLine{quantity: 1.5, unit: "cup", name: "rolled oats"}},
+		{"3/4 tsp baking soda", expectedLine{quantity: 0.75, unit: "tsp", name: "baking soda"}},
+		{"2 3/4 cups all-purpose flour", expectedLine{quantity: 2.75, unit: "cup", name: "all-purpose flour"}},
+		{"1⁄3 cup honey", expectedLine{quantity: 1.0 / 3, unit: "cup", name: "honey"}},
+
+		// Unicode vulgar fractions
+		{"½ cup milk", expectedLine{quantity: 0.5, unit: "cup", name: "milk"}},
+		{"1½ cups water", expectedLine{quantity: 1.5, unit: "cup", name: "water"}},
+		{"1 ½ cups water", expectedLine{quantity: 1.5, unit: "cup", name: "water"}},
+		{"¼ teaspoon cayenne pepper", expectedLine{quantity: 0.25, unit: "tsp", name: "cayenne pepper"}},
+		{"¾ lb ground beef", expectedLine{quantity: 0.75, unit: "lb", name: "ground beef"}},
+		{"⅔ cup rice", expectedLine{quantity: 2.0 / 3, unit: "cup", name: "rice"}},
+		{"2⅛ oz dark chocolate", expectedLine{quantity: 2.125, unit: "oz", name: "dark chocolate"}},
+
+		// Ranges
+		{"2-3 cloves garlic", expectedLine{quantity: 2, quantityMax: 3, unit: "clove", name: "garlic"}},
+		{"2–3 cloves garlic, minced", expectedLine{quantity: 2, quantityMax: 3, unit: "clove", name: "garlic", note: "minced"}},
+		{"2 — 3 tbsp lemon juice", expectedLine{quantity: 2, quantityMax: 3, unit: "tbsp", name: "lemon juice"}},
+		{"2 to 3 tablespoons olive oil", expectedLine{quantity: 2, quantityMax: 3, unit: "tbsp", name: "olive oil"}},
+		{"1 or 2 jalapeños", expectedLine{quantity: 1, quantityMax: 2, name: "jalapeños"}},
+		{"1/2-1 tsp chili flakes", expectedLine{quantity: 0.5, quantityMax: 1, unit: "tsp", name: "chili flakes"}},
+		{"1 1/2 to 2 cups stock", expectedLine{quantity: 1.5, quantityMax: 2, unit: "cup", name: "stock"}},
+
+		// Units and their spellings
+		{"2 tbsp olive oil", expectedLine{quantity: 2, unit: "tbsp", name: "olive oil"}},
+		{"2 Tbsp. soy sauce", expectedLine{quantity: 2, unit: "tbsp", name: "soy sauce"}},
+		{"1 T butter", expectedLine{quantity: 1, unit: "tbsp", name: "butter"}},
+		{"1 t salt", expectedLine{quantity: 1, unit: "tsp", name: "salt"}},
+		{"2 c. flour", expectedLine{quantity: 2, unit: "cup", name: "flour"}},
+		{"200g flour", expectedLine{quantity: 200, unit: "g", name: "flour"}},
+		{"200 grams flour", expectedLine{quantity: 200, unit: "g", name: "flour"}},
+		{"1.5kg potatoes", expectedLine{quantity: 1.5, unit: "kg", name: "potatoes"}},
+		{"250ml cream", expectedLine{quantity: 250, unit: "ml", name: "cream"}},
+		{"1 litre water", expectedLine{quantity: 1, unit: "l", name: "water"}},
+		{"8 oz cream cheese", expectedLine{quantity: 8, unit: "oz", name: "cream cheese"}},
+		{"4 fl oz whole milk", expectedLine{quantity: 4, unit: "fl oz", name: "whole milk"}},
+		{"2 fluid ounces bourbon", expectedLine{quantity: 2, unit: "fl oz", name: "bourbon"}},
+		{"2 lbs chicken thighs", expectedLine{quantity: 2, unit: "lb", name: "chicken thighs"}},
+		{"1 pint heavy cream", expectedLine{quantity: 1, unit: "pint", name: "heavy cream"}},
+		{"1 bunch cilantro", expectedLine{quantity: 1, unit: "bunch", name: "cilantro"}},
+		{"3 sprigs thyme", expectedLine{quantity: 3, unit: "sprig", name: "thyme"}},
+		{"2 slices bread", expectedLine{quantity: 2, unit: "slice", name: "bread"}},
+		{"2 heads", expectedLine{quantity: 2, unit: "head"}},
+		{"12 pieces", expectedLine{quantity: 12, unit: "piece"}},
+		{"1 cup", expectedLine{quantity: 1, unit: "cup"}},
+		{"2 cups of flour", expectedLine{quantity: 2, unit: "cup", name: "flour"}},
+
+		// Counted items and parentheticals
+		{"6 large eggs", expectedLine{quantity: 6, name: "large eggs"}},
+		{"1 red bell pepper, diced", expectedLine{quantity: 1, name: "red bell pepper", note: "diced"}},
+		{"1 (28 oz) can crushed tomatoes", expectedLine{quantity: 1, unit: "can", name: "crushed tomatoes", note: "28 oz"}},
+		{"2 (15-ounce) cans black beans, drained and rinsed", expectedLine{quantity: 2, unit: "can", name: "black beans", note: "15-ounce, drained and rinsed"}},
+		{"3 cloves garlic (minced)", expectedLine{quantity: 3, unit: "clove", name: "garlic", note: "minced"}},
+
+		// Spelled-out quantities
+		{"a pinch of salt", expectedLine{quantity: 1, unit: "pinch", name: "salt"}},
+		{"pinch of nutmeg", expectedLine{quantity: 1, unit: "pinch", name: "nutmeg"}},
+		{"one onion, chopped", expectedLine{quantity: 1, name: "onion", note: "chopped"}},
+		{"two eggs", expectedLine{quantity: 2, name: "eggs"}},
+		{"half a lemon", expectedLine{quantity: 0.5, name: "lemon"}},
+		{"an apple", expectedLine{quantity: 1, name: "apple"}},
+		{"a handful of basil leaves", expectedLine{quantity: 1, unit: "handful", name: "basil leaves"}},
+		{"a few sprigs of parsley", expectedLine{quantity: none, name: "a few sprigs of parsley"}},
+
+		// Optional flags and notes
+		{"1 tsp vanilla extract (optional)", expectedLine{quantity: 1, unit: "tsp", name: "vanilla extract", optional: true}},
+		{"1/4 cup walnuts, chopped, optional", expectedLine{quantity: 0.25, unit: "cup", name: "walnuts", note: "chopped", optional: true}},
+		{"optional: 1 tbsp maple syrup", expectedLine{quantity: 1, unit: "tbsp", name: "maple syrup", optional: true}},
+		{"fresh parsley, if desired", expectedLine{quantity: none, name: "fresh parsley", optional: true}},
+		{"salt and pepper to taste", expectedLine{quantity: none, name: "salt and pepper", note: "to taste"}},
+		{"salt, to taste", expectedLine{quantity: none, name: "salt", note: "to taste"}},
+		{"1 cup, grated", expectedLine{quantity: 1, unit: "cup", note: "grated"}},
+
+		// Lines without quantities
+		{"olive oil", expectedLine{quantity: none, name: "olive oil"}},
+		{"  Freshly ground   black pepper ", expectedLine{quantity: none, name: "Freshly ground black pepper"}},
+		{"half-and-half", expectedLine{quantity: none, name: "half-and-half"}},
+		{"", expectedLine{quantity: none}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.line, func(t *testing.T) {
+			thenLineMatches(t, ingredientparser.Parse(tc.line), tc.want)
+		})
+	}
+}
+
+func TestParse_Dutch(t *testing.T) {
+	cases := []struct {
+		line string
+		want expectedLine
+	}{
+		// Decimal commas and fractions
+		{"1,5 dl melk", expectedLine{quantity: 1.5, unit: "dl", name: "melk"}},
+		{"0,5 l bouillon", expectedLine{quantity: 0.5, unit: "l", name: "bouillon"}},
+		{"1/2 theelepel zout", expectedLine{quantity: 0.5, unit: "tsp", name: "zout"}},
+		{"½ kopje suiker", expectedLine{quantity: 0.5, unit: "cup", name: "suiker"}},
+		{"1½ kilo aardappelen", expectedLine{quantity: 1.5, unit: "kg", name: "aardappelen"}},
+
+		// Units
+		{"2 eetlepels olijfolie", expectedLine{quantity: 2, unit: "tbsp", name: "olijfolie"}},
+		{"2 el olijfolie", expectedLine{quantity: 2, unit: "tbsp", name: "olijfolie"}},
+		{"1 tl kaneel", expectedLine{quantity: 1, unit: "tsp", name: "kaneel"}},
+		{"250 gram bloem", expectedLine{quantity: 250, unit: "g", name: "bloem"}},
+		{"250 gr. boter", expectedLine{quantity: 250, unit: "g", name: "boter"}},
+		{"500g gehakt", expectedLine{quantity: 500, unit: "g", name: "gehakt"}},
+		{"1 liter water", expectedLine{quantity: 1, unit: "l", name: "water"}},
+		{"200 ml slagroom", expectedLine{quantity: 200, unit: "ml", name: "slagroom"}},
+		{"1 ons ham", expectedLine{quantity: 1, unit: "ons", name: "ham"}},
+		{"1 blik tomatenblokjes", expectedLine{quantity: 1, unit: "can", name: "tomatenblokjes"}},
+		{"1 bosje peterselie", expectedLine{quantity: 1, unit: "bunch", name: "peterselie"}},
+		{"3 takjes tijm", expectedLine{quantity: 3, unit: "sprig", name: "tijm"}},
+		{"4 plakjes kaas", expectedLine{quantity: 4, unit: "slice", name: "kaas"}},
+		{"2 stuks prei", expectedLine{quantity: 2, unit: "piece", name: "prei"}},
+		{"1 zakje bakpoeder", expectedLine{quantity: 1, unit: "package", name: "bakpoeder"}},
+		{"1 krop sla", expectedLine{quantity: 1, unit: "head", name: "sla"}},
+		{"2 blaadjes laurier", expectedLine{quantity: 2, unit: "leaf", name: "laurier"}},
+
+		// Ranges
+		{"2-3 teentjes knoflook", expectedLine{quantity: 2, quantityMax: 3, unit: "clove", name: "knoflook"}},
+		{"2–3 teentjes knoflook, geperst", expectedLine{quantity: 2, quantityMax: 3, unit: "clove", name: "knoflook", note: "geperst"}},
+		{"2 à 3 eetlepels honing", expectedLine{quantity: 2, quantityMax: 3, unit: "tbsp", name: "honing"}},
+		{"2 a 3 uien", expectedLine{quantity: 2, quantityMax: 3, name: "uien"}},
+		{"4 tot 5 aardappelen", expectedLine{quantity: 4, quantityMax: 5, name: "aardappelen"}},
+		{"1 of 2 rode pepers", expectedLine{quantity: 1, quantityMax: 2, name: "rode pepers"}},
+
+		// Spelled-out quantities
+		{"een ui, gesnipperd", expectedLine{quantity: 1, name: "ui", note: "gesnipperd"}},
+		{"twee eieren", expectedLine{quantity: 2, name: "eieren"}},
+		{"halve citroen", expectedLine{quantity: 0.5, name: "citroen"}},
+		{"anderhalve liter melk", expectedLine{quantity: 1.5, unit: "l", name: "melk"}},
+		{"een snufje zout", expectedLine{quantity: 1, unit: "pinch", name: "zout"}},
+		{"snufje nootmuskaat", expectedLine{quantity: 1, unit: "pinch", name: "nootmuskaat"}},
+		{"scheutje melk", expectedLine{quantity: 1, unit: "dash", name: "melk"}},
+		{"een paar blaadjes basilicum", expectedLine{quantity: none, name: "een paar blaadjes basilicum"}},
+
+		// Optional flags and notes
+		{"1 el kappertjes (optioneel)", expectedLine{quantity: 1, unit: "tbsp", name: "kappertjes", optional: true}},
+		{"verse koriander, optioneel", expectedLine{quantity: none, name: "verse koriander", optional: true}},
+		{"peper en zout naar smaak", expectedLine{quantity: none, name: "peper en zout", note: "naar smaak"}},
+		{"1 rode ui (in ringen)", expectedLine{quantity: 1, name: "rode ui", note: "in ringen"}},
+		{"200 g kipfilet, in blokjes, gemarineerd", expectedLine{quantity: 200, unit: "g", name: "kipfilet", note: "in blokjes, gemarineerd"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.line, func(t *testing.T) {
+			thenLineMatches(t, ingredientparser.Parse(tc.line), tc.want)
+		})
+	}
+}
+
+func TestParseQuantity_FoldsLeftoverWordsIntoNote(t *testing.T) {
+	cases := []struct {
+		text string
+		want expectedLine
+	}{
+		{"1 cup, grated", expectedLine{quantity: 1, unit: "cup", note: "grated"}},
+		{"1 medium, diced", expectedLine{quantity: 1, note: "medium, diced"}},
+		{"4 large", expectedLine{quantity: 4, note: "large"}},
+		{"1/2 cup, chopped", expectedLine{quantity: 0.5, unit: "cup", note: "chopped"}},
+		{"14 oz", expectedLine{quantity: 14, unit: "oz"}},
+		{"to taste", expectedLine{quantity: none, note: "to taste"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.text, func(t *testing.T) {
+			thenLineMatches(t, ingredientparser.ParseQuantity(tc.text), tc.want)
+		})
+	}
+}
+
+func TestLineAmount_RangeBecomesText(t *testing.T) {
+	value, text := ingredientparser.Parse("2-3 cloves garlic").Amount()
+	if value != nil || text != "2-3" {
+		t.Fatalf("expected text 2-3 and no value, got %v / %q", value, text)
+	}
+
+	value, text = ingredientparser.Parse("1 1/2 cups flour").Amount()
+	if value == nil || *value != 1.5 || text != "" {
+		t.Fatalf("expected value 1.5, got %v / %q", value, text)
+	}
+
+	value, text = ingredientparser.Parse("salt").Amount()
+	if value != nil || text != "" {
+		t.Fatalf("expected no amount, got %v / %q", value, text)
+	}
+}
+
+// Helpers
+
+func thenLineMatches(t *testing.T, got ingredientparser.Line, want expectedLine) {
+	t.Helper()
+
+	if want.quantity == none {
+		if got.Quantity != nil {
+			t.Fatalf("expected no quantity, got %v", *got.Quantity)
+		}
+	} else if got.Quantity == nil || !approxEqual(*got.Quantity, want.quantity) {
+		t.Fatalf("expected quantity %v, got %v", want.quantity, deref(got.Quantity))
+	}
+
+	if want.quantityMax == 0 {
+		if got.QuantityMax != nil {
+			t.Fatalf("expected no range, got max %v", *got.QuantityMax)
+		}
+	} else if got.QuantityMax == nil || !approxEqual(*got.QuantityMax, want.quantityMax) {
+		t.Fatalf("expected max quantity %v, got %v", want.quantityMax, deref(got.QuantityMax))
+	}
+
+	if got.Unit != want.unit {
+		t.Fatalf("expected unit %q, got %q", want.unit, got.Unit)
+	}
+	if got.Name != want.name {
+		t.Fatalf("expected name %q, got %q", want.name, got.Name)
+	}
+	if got.Optional != want.optional {
+		t.Fatalf("expected optional %v, got %v", want.optional, got.Optional)
+	}
+	if got.Note != want.note {
+		t.Fatalf("expected note %q, got %q", want.note, got.Note)
+	}
+}
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func deref(value *float64) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,39 @@
+package ingredientparser
+
+import (
+	"strings"
+
+	"github.com/platepilot/backend/internal/common/units"
+)
+
+// parseUnit reads a unit from the start of s, trying two-word spellings such
+// as "fl oz" before single words, and returns it with the remaining text.
+func parseUnit(s string) (string, string, bool) {
+	fields := strings.SplitN(s, " ", 3)
+	if len(fields) >= 2 {
+		if unit, ok := lookupUnit(fields[0] + " " + fields[1]); ok {
+			return unit, restAfter(fields, 2), true
+		}
+	}
+	if unit, ok := lookupUnit(fields[0]); ok {
+		return unit, restAfter(fields, 1), true
+	}
+	return "", s, false
+}
+
+// lookupUnit resolves ingredient quantity units; temperatures never measure
+// an ingredient.
+func lookupUnit(token string) (string, bool) {
+	unit, ok := units.Lookup(token)
+	if !ok || unit.Dimension == units.DimensionTemperature {
+		return "", false
+	}
+	return unit.Name, true
+}
+
+func restAfter(fields []string, n int) string {
+	if len(fields) <= n {
+		return ""
+	}
+	return strings.TrimSpace(strings.Join(fields[n:], " "))
+}
@@ -0,0 +1,102 @@
+// Package nutrition computes recipe nutrition from the nutrition facts of its
+// ingredients.
+package nutrition
+
+import (
+	"math"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/common/units"
+)
+
+// Calculate sets the nutrition of recipe from its ingredient lines and the
+// nutrition facts per ingredient ID. Calories are stored as a total and per
+// serving; macros are per serving. Lines that cannot be counted, because the
+// ingredient has no facts or the amount cannot be converted to the serving
+// unit, are flagged as unresolved and left out. Optional lines are left out
+// without being flagged.
+func Calculate(recipe *domain.Recipe, facts map[uuid.UUID]domain.IngredientNutrition) {
+	var calories, protein, carbs, fat, fiber, sugar, sodium float64
+
+	for i := range recipe.IngredientLines {
+		line := &recipe.IngredientLines[i]
+		line.NutritionUnresolved = false
+		if line.IsOptional {
+			continue
+		}
+
+		fact, ok := facts[line.Ingredient.ID]
+		if !ok {
+			line.NutritionUnresolved = true
+			continue
+		}
+		portions, ok := Portions(*line, fact)
+		if !ok {
+			line.NutritionUnresolved = true
+			continue
+		}
+
+		calories += portions * float64(fact.Calories)
+		protein += portions * fact.ProteinG
+		carbs += portions * fact.CarbsG
+		fat += portions * fact.FatG
+		fiber += portions * fact.FiberG
+		sugar += portions * fact.SugarG
+		sodium += portions * fact.SodiumMg
+	}
+
+	servings := float64(recipe.Servings)
+	if servings < 1 {
+		servings = 1
+	}
+
+	recipe.Nutrition = domain.RecipeNutrition{
+		CaloriesTotal:      int(math.Round(calories)),
+		CaloriesPerServing: int(math.Round(calories / servings)),
+		ProteinG:           round2(protein / servings),
+		CarbsG:             round2(carbs / servings),
+		FatG:               round2(fat / servings),
+		FiberG:             round2(fiber / servings),
+		SugarG:             round2(sugar / servings),
+		SodiumMg:           round2(sodium / servings),
+	}
+}
+
+// Portions returns how many servings of fact the line amounts to, converting
+// the line unit to the serving unit. Lines without a unit and servings of
+// "piece" both count whole items.
+func Portions(line domain.RecipeIngredientLine, fact domain.IngredientNutrition) (float64, bool) {
+	if line.QuantityValue == nil || fact.ServingSizeValue <= 0 {
+		return 0, false
+	}
+	amount := *line.QuantityValue
+
+	if sameUnit(line.Unit, fact.ServingUnit) {
+		return amount / fact.ServingSizeValue, true
+	}
+
+	converted, err := units.ConvertWithDensity(amount, line.Unit, fact.ServingUnit, fact.GramsPerML)
+	if err != nil {
+		return 0, false
+	}
+	return converted / fact.ServingSizeValue, true
+}
+
+func sameUnit(a, b string) bool {
+	if isItem(a) && isItem(b) {
+		return true
+	}
+	return strings.EqualFold(units.Canonical(a), units.Canonical(b))
+}
+
+func isItem(unit string) bool {
+	unit = strings.TrimSpace(unit)
+	return unit == "" || units.Canonical(unit) == "piece"
+}
+
+func round2(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,103 @@
+package nutrition_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/nutrition"
+)
+
+func TestCalculate_SumsLinesAndDividesPerServing(t *testing.T) {
+	flour := ingredient("flour")
+	egg := ingredient("egg")
+	milk := ingredient("milk")
+	recipe := givenRecipe(2,
+		line(flour, 250, "g"),
+		line(egg, 2, ""),
+		line(milk, 1, "cup"),
+	)
+	facts := map[uuid.UUID]domain.IngredientNutrition{
+		flour.ID: {IngredientID: flour.ID, ServingSizeValue: 100, ServingUnit: "g", Calories: 364, ProteinG: 10, CarbsG: 76},
+		egg.ID:   {IngredientID: egg.ID, ServingSizeValue: 1, ServingUnit: "piece", Calories: 72, ProteinG: 6.3},
+		// 100 ml of milk, converted from cups through the volume ladder.
+		milk.ID: {IngredientID: milk.ID, ServingSizeValue: 100, ServingUnit: "ml", Calories: 42, ProteinG: 3.4},
+	}
+
+	nutrition.Calculate(recipe, facts)
+
+	// 2.5 × 364 + 2 × 72 + 2.366 × 42 = 1153.37
+	if recipe.Nutrition.CaloriesTotal != 1153 || recipe.Nutrition.CaloriesPerServing != 577 {
+		t.Fatalf("unexpected calories %+v", recipe.Nutrition)
+	}
+	// (25 + 12.6 + 8.04) / 2
+	if recipe.Nutrition.ProteinG != 22.82 {
+		t.Fatalf("expected 22.82 g protein per serving, got %v", recipe.Nutrition.ProteinG)
+	}
+	if recipe.Nutrition.IsOverride {
+		t.Fatal("expected computed nutrition not to be flagged as an override")
+	}
+	for i, l := range recipe.IngredientLines {
+		if l.NutritionUnresolved {
+			t.Fatalf("expected line %d to be resolved", i)
+		}
+	}
+}
+
+func TestCalculate_FlagsUnresolvedLines(t *testing.T) {
+	butter := ingredient("butter")
+	salt := ingredient("salt")
+	garlic := ingredient("garlic")
+	parsley := ingredient("parsley")
+	recipe := givenRecipe(1,
+		line(butter, 2, "tbsp"),
+		line(salt, 1, "pinch"),
+		domain.RecipeIngredientLine{Ingredient: garlic, QuantityText: "2-3", Unit: "clove"},
+		domain.RecipeIngredientLine{Ingredient: parsley, IsOptional: true},
+	)
+	facts := map[uuid.UUID]domain.IngredientNutrition{
+		// Butter is listed by weight; its density bridges the spoons.
+		butter.ID: {IngredientID: butter.ID, ServingSizeValue: 100, ServingUnit: "g", Calories: 717, GramsPerML: 0.911},
+		garlic.ID: {IngredientID: garlic.ID, ServingSizeValue: 1, ServingUnit: "clove", Calories: 4},
+	}
+
+	nutrition.Calculate(recipe, facts)
+
+	// 2 tbsp = 29.57 ml = 26.94 g of butter.
+	if recipe.Nutrition.CaloriesTotal != 193 {
+		t.Fatalf("expected 193 kcal, got %d", recipe.Nutrition.CaloriesTotal)
+	}
+	want := []bool{false, true, true, false}
+	for i, l := range recipe.IngredientLines {
+		if l.NutritionUnresolved != want[i] {
+			t.Fatalf("line %d (%s): expected unresolved=%v", i, l.Ingredient.Name, want[i])
+		}
+	}
+}
+
+func TestPortions_IncompatibleUnits(t *testing.T) {
+	rice := ingredient("rice")
+	fact := domain.IngredientNutrition{IngredientID: rice.ID, ServingSizeValue: 100, ServingUnit: "g"}
+
+	if _, ok := nutrition.Portions(line(rice, 1, "cup"), fact); ok {
+		t.Fatal("expected volume without density not to convert to mass")
+	}
+	if portions, ok := nutrition.Portions(line(rice, 0.5, "kg"), fact); !ok || portions != 5 {
+		t.Fatalf("expected 5 portions, got %v (%v)", portions, ok)
+	}
+}
+
+// Helpers
+
+func givenRecipe(servings int, lines ...domain.RecipeIngredientLine) *domain.Recipe {
+	return &domain.Recipe{Name: "Test", Servings: servings, IngredientLines: lines}
+}
+
+func ingredient(name string) domain.Ingredient {
+	return domain.Ingredient{ID: uuid.New(), Name: name}
+}
+
+func line(ingredient domain.Ingredient, value float64, unit string) domain.RecipeIngredientLine {
+	return domain.RecipeIngredientLine{Ingredient: ingredient, QuantityValue: &value, Unit: unit}
+}
@@ -76,16 +76,26 @@ func (x *GetRecipeRequest) GetUserId() string {
 }
 
 type ListRecipesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageIndex     int32                  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
-	CuisineId     string                 `protobuf:"bytes,4,opt,name=cuisine_id,json=cuisineId,proto3" json:"cuisine_id,omitempty"`          // UUID string
-	IngredientId  string                 `protobuf:"bytes,5,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
-	AllergyId     string                 `protobuf:"bytes,6,opt,name=allergy_id,json=allergyId,proto3" json:"allergy_id,omitempty"`          // UUID string
-	Tags          []string               `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState  `protogen:"open.v1"`
+	PageIndex            int32                   `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize             int32                   `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UserId               string                  `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	CuisineId            string                  `protobuf:"bytes,4,opt,name=cuisine_id,json=cuisineId,proto3" json:"cuisine_id,omitempty"`          // UUID string
+	IngredientId         string                  `protobuf:"bytes,5,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
+	AllergyId            string                  `protobuf:"bytes,6,opt,name=allergy_id,json=allergyId,proto3" json:"allergy_id,omitempty"`          // UUID string
+	Tags                 []string                `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	Query                string                  `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`                                                               // free-text search; results are ranked by relevance
+	CollectionId         string                  `protobuf:"bytes,9,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`                             // UUID string; results keep the collection's order
+	Sort                 string                  `protobuf:"bytes,10,opt,name=sort,proto3" json:"sort,omitempty"`                                                                // last_cooked, most_cooked, top_rated, least_recent, name, created_at, updated_at, total_time, calories or rating; empty for the default order
+	MinRating            *wrapperspb.DoubleValue `protobuf:"bytes,11,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`                                     // minimum average rating from the user's cook log
+	CookedSince          string                  `protobuf:"bytes,12,opt,name=cooked_since,json=cookedSince,proto3" json:"cooked_since,omitempty"`                               // YYYY-MM-DD; only recipes the user cooked on or after this date
+	NotCookedSince       string                  `protobuf:"bytes,13,opt,name=not_cooked_since,json=notCookedSince,proto3" json:"not_cooked_since,omitempty"`                    // YYYY-MM-DD; only recipes the user has not cooked since this date, or never
+	IgnoreDietaryProfile bool                    `protobuf:"varint,14,opt,name=ignore_dietary_profile,json=ignoreDietaryProfile,proto3" json:"ignore_dietary_profile,omitempty"` // include recipes conflicting with the user's dietary profile
+	DietLabels           []string                `protobuf:"bytes,15,rep,name=diet_labels,json=dietLabels,proto3" json:"diet_labels,omitempty"`                                  // vegan, vegetarian, pescatarian, gluten-free, dairy-free or nut-free; recipes must carry every label
+	SortOrder            string                  `protobuf:"bytes,16,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`                                     // asc or desc for name, created_at, updated_at, total_time, calories and rating; empty for the sort's default
+	Cursor               string                  `protobuf:"bytes,17,opt,name=cursor,proto3" json:"cursor,omitempty"`                                                            // next_cursor of the previous page; switches to keyset pagination and ignores page_index
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *ListRecipesRequest) Reset() {
@@ -167,13 +177,84 @@ func (x *ListRecipesRequest) GetTags() []string {
 	return nil
 }
 
+func (x *ListRecipesRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetMinRating() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.MinRating
+	}
+	return nil
+}
+
+func (x *ListRecipesRequest) GetCookedSince() string {
+	if x != nil {
+		return x.CookedSince
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetNotCookedSince() string {
+	if x != nil {
+		return x.NotCookedSince
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetIgnoreDietaryProfile() bool {
+	if x != nil {
+		return x.IgnoreDietaryProfile
+	}
+	return false
+}
+
+func (x *ListRecipesRequest) GetDietLabels() []string {
+	if x != nil {
+		return x.DietLabels
+	}
+	return nil
+}
+
+func (x *ListRecipesRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListRecipesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type ListRecipesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Recipes       []*Recipe              `protobuf:"bytes,1,rep,name=recipes,proto3" json:"recipes,omitempty"`
-	PageIndex     int32                  `protobuf:"varint,2,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageIndex     int32                  `protobuf:"varint,2,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"` // 0 for keyset pages
 	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"` // not counted for keyset pages
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // not counted for keyset pages
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`  // continues the listing after this page; empty on the last page or when the order does not support cursors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -243,6 +324,13 @@ func (x *ListRecipesResponse) GetTotalPages() int32 {
 	return 0
 }
 
+func (x *ListRecipesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type CreateRecipeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Recipe        *RecipeInput           `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`
@@ -407,29 +495,29 @@ func (x *DeleteRecipeRequest) GetUserId() string {
 	return ""
 }
 
-type GetSimilarRecipesRequest struct {
+type ListDeletedRecipesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
-	Amount        int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	PageIndex     int32                  `protobuf:"varint,1,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSimilarRecipesRequest) Reset() {
-	*x = GetSimilarRecipesRequest{}
+func (x *ListDeletedRecipesRequest) Reset() {
+	*x = ListDeletedRecipesRequest{}
 	mi := &file_recipe_v1_recipe_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSimilarRecipesRequest) String() string {
+func (x *ListDeletedRecipesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSimilarRecipesRequest) ProtoMessage() {}
+func (*ListDeletedRecipesRequest) ProtoMessage() {}
 
-func (x *GetSimilarRecipesRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDeletedRecipesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_recipe_v1_recipe_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -441,69 +529,54 @@ func (x *GetSimilarRecipesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSimilarRecipesRequest.ProtoReflect.Descriptor instead.
-func (*GetSimilarRecipesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDeletedRecipesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedRecipesRequest) Descriptor() ([]byte, []int) {
 	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetSimilarRecipesRequest) GetRecipeId() string {
+func (x *ListDeletedRecipesRequest) GetPageIndex() int32 {
 	if x != nil {
-		return x.RecipeId
+		return x.PageIndex
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSimilarRecipesRequest) GetAmount() int32 {
+func (x *ListDeletedRecipesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Amount
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *GetSimilarRecipesRequest) GetUserId() string {
+func (x *ListDeletedRecipesRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type Recipe struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	Id               string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // UUID string
-	UserId           string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
-	Name             string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description      string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	PrepTimeMinutes  int32                   `protobuf:"varint,5,opt,name=prep_time_minutes,json=prepTimeMinutes,proto3" json:"prep_time_minutes,omitempty"`
-	CookTimeMinutes  int32                   `protobuf:"varint,6,opt,name=cook_time_minutes,json=cookTimeMinutes,proto3" json:"cook_time_minutes,omitempty"`
-	TotalTimeMinutes int32                   `protobuf:"varint,7,opt,name=total_time_minutes,json=totalTimeMinutes,proto3" json:"total_time_minutes,omitempty"`
-	Servings         int32                   `protobuf:"varint,8,opt,name=servings,proto3" json:"servings,omitempty"`
-	YieldQuantity    *wrapperspb.DoubleValue `protobuf:"bytes,9,opt,name=yield_quantity,json=yieldQuantity,proto3" json:"yield_quantity,omitempty"`
-	YieldUnit        string                  `protobuf:"bytes,10,opt,name=yield_unit,json=yieldUnit,proto3" json:"yield_unit,omitempty"`
-	MainIngredient   *IngredientRef          `protobuf:"bytes,11,opt,name=main_ingredient,json=mainIngredient,proto3" json:"main_ingredient,omitempty"`
-	Cuisine          *Cuisine                `protobuf:"bytes,12,opt,name=cuisine,proto3" json:"cuisine,omitempty"`
-	IngredientLines  []*IngredientLine       `protobuf:"bytes,13,rep,name=ingredient_lines,json=ingredientLines,proto3" json:"ingredient_lines,omitempty"`
-	Steps            []*RecipeStep           `protobuf:"bytes,14,rep,name=steps,proto3" json:"steps,omitempty"`
-	Tags             []string                `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
-	ImageUrl         string                  `protobuf:"bytes,16,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Nutrition        *RecipeNutrition        `protobuf:"bytes,17,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type RestoreRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Recipe) Reset() {
-	*x = Recipe{}
+func (x *RestoreRecipeRequest) Reset() {
+	*x = RestoreRecipeRequest{}
 	mi := &file_recipe_v1_recipe_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Recipe) String() string {
+func (x *RestoreRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Recipe) ProtoMessage() {}
+func (*RestoreRecipeRequest) ProtoMessage() {}
 
-func (x *Recipe) ProtoReflect() protoreflect.Message {
+func (x *RestoreRecipeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_recipe_v1_recipe_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,167 +588,171 @@ func (x *Recipe) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
-func (*Recipe) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreRecipeRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRecipeRequest) Descriptor() ([]byte, []int) {
 	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Recipe) GetId() string {
+func (x *RestoreRecipeRequest) GetRecipeId() string {
 	if x != nil {
-		return x.Id
+		return x.RecipeId
 	}
 	return ""
 }
 
-func (x *Recipe) GetUserId() string {
+func (x *RestoreRecipeRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Recipe) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type PurgeRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Recipe) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *PurgeRecipeRequest) Reset() {
+	*x = PurgeRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Recipe) GetPrepTimeMinutes() int32 {
-	if x != nil {
-		return x.PrepTimeMinutes
-	}
-	return 0
+func (x *PurgeRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Recipe) GetCookTimeMinutes() int32 {
-	if x != nil {
-		return x.CookTimeMinutes
-	}
-	return 0
-}
+func (*PurgeRecipeRequest) ProtoMessage() {}
 
-func (x *Recipe) GetTotalTimeMinutes() int32 {
+func (x *PurgeRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[8]
 	if x != nil {
-		return x.TotalTimeMinutes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Recipe) GetServings() int32 {
-	if x != nil {
-		return x.Servings
-	}
-	return 0
+// Deprecated: Use PurgeRecipeRequest.ProtoReflect.Descriptor instead.
+func (*PurgeRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Recipe) GetYieldQuantity() *wrapperspb.DoubleValue {
+func (x *PurgeRecipeRequest) GetRecipeId() string {
 	if x != nil {
-		return x.YieldQuantity
+		return x.RecipeId
 	}
-	return nil
+	return ""
 }
 
-func (x *Recipe) GetYieldUnit() string {
+func (x *PurgeRecipeRequest) GetUserId() string {
 	if x != nil {
-		return x.YieldUnit
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Recipe) GetMainIngredient() *IngredientRef {
-	if x != nil {
-		return x.MainIngredient
-	}
-	return nil
+// Sets the image of a recipe or, when step_index is set, of one of its steps.
+// An empty image_url removes the image.
+type SetRecipeImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	StepIndex     int32                  `protobuf:"varint,4,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"` // 1-based step index; 0 targets the recipe itself
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Recipe) GetCuisine() *Cuisine {
-	if x != nil {
-		return x.Cuisine
-	}
-	return nil
+func (x *SetRecipeImageRequest) Reset() {
+	*x = SetRecipeImageRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Recipe) GetIngredientLines() []*IngredientLine {
+func (x *SetRecipeImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecipeImageRequest) ProtoMessage() {}
+
+func (x *SetRecipeImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[9]
 	if x != nil {
-		return x.IngredientLines
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Recipe) GetSteps() []*RecipeStep {
+// Deprecated: Use SetRecipeImageRequest.ProtoReflect.Descriptor instead.
+func (*SetRecipeImageRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetRecipeImageRequest) GetRecipeId() string {
 	if x != nil {
-		return x.Steps
+		return x.RecipeId
 	}
-	return nil
+	return ""
 }
 
-func (x *Recipe) GetTags() []string {
+func (x *SetRecipeImageRequest) GetUserId() string {
 	if x != nil {
-		return x.Tags
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *Recipe) GetImageUrl() string {
+func (x *SetRecipeImageRequest) GetImageUrl() string {
 	if x != nil {
 		return x.ImageUrl
 	}
 	return ""
 }
 
-func (x *Recipe) GetNutrition() *RecipeNutrition {
+func (x *SetRecipeImageRequest) GetStepIndex() int32 {
 	if x != nil {
-		return x.Nutrition
+		return x.StepIndex
 	}
-	return nil
+	return 0
 }
 
-type RecipeInput struct {
-	state              protoimpl.MessageState  `protogen:"open.v1"`
-	Name               string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description        string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	PrepTimeMinutes    int32                   `protobuf:"varint,3,opt,name=prep_time_minutes,json=prepTimeMinutes,proto3" json:"prep_time_minutes,omitempty"`
-	CookTimeMinutes    int32                   `protobuf:"varint,4,opt,name=cook_time_minutes,json=cookTimeMinutes,proto3" json:"cook_time_minutes,omitempty"`
-	Servings           int32                   `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`
-	YieldQuantity      *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=yield_quantity,json=yieldQuantity,proto3" json:"yield_quantity,omitempty"`
-	YieldUnit          string                  `protobuf:"bytes,7,opt,name=yield_unit,json=yieldUnit,proto3" json:"yield_unit,omitempty"`
-	MainIngredientId   string                  `protobuf:"bytes,8,opt,name=main_ingredient_id,json=mainIngredientId,proto3" json:"main_ingredient_id,omitempty"` // UUID string
-	MainIngredientName string                  `protobuf:"bytes,9,opt,name=main_ingredient_name,json=mainIngredientName,proto3" json:"main_ingredient_name,omitempty"`
-	CuisineId          string                  `protobuf:"bytes,10,opt,name=cuisine_id,json=cuisineId,proto3" json:"cuisine_id,omitempty"` // UUID string
-	CuisineName        string                  `protobuf:"bytes,11,opt,name=cuisine_name,json=cuisineName,proto3" json:"cuisine_name,omitempty"`
-	IngredientLines    []*IngredientLineInput  `protobuf:"bytes,12,rep,name=ingredient_lines,json=ingredientLines,proto3" json:"ingredient_lines,omitempty"`
-	Steps              []*RecipeStepInput      `protobuf:"bytes,13,rep,name=steps,proto3" json:"steps,omitempty"`
-	Tags               []string                `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
-	ImageUrl           string                  `protobuf:"bytes,15,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Nutrition          *RecipeNutrition        `protobuf:"bytes,16,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type DuplicateRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string; any recipe the user can read
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                         // optional; defaults to the original name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeInput) Reset() {
-	*x = RecipeInput{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[8]
+func (x *DuplicateRecipeRequest) Reset() {
+	*x = DuplicateRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeInput) String() string {
+func (x *DuplicateRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeInput) ProtoMessage() {}
+func (*DuplicateRecipeRequest) ProtoMessage() {}
 
-func (x *RecipeInput) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[8]
+func (x *DuplicateRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,146 +763,177 @@ func (x *RecipeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeInput.ProtoReflect.Descriptor instead.
-func (*RecipeInput) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DuplicateRecipeRequest.ProtoReflect.Descriptor instead.
+func (*DuplicateRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *RecipeInput) GetName() string {
+func (x *DuplicateRecipeRequest) GetRecipeId() string {
 	if x != nil {
-		return x.Name
+		return x.RecipeId
 	}
 	return ""
 }
 
-func (x *RecipeInput) GetDescription() string {
+func (x *DuplicateRecipeRequest) GetUserId() string {
 	if x != nil {
-		return x.Description
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RecipeInput) GetPrepTimeMinutes() int32 {
+func (x *DuplicateRecipeRequest) GetName() string {
 	if x != nil {
-		return x.PrepTimeMinutes
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeInput) GetCookTimeMinutes() int32 {
-	if x != nil {
-		return x.CookTimeMinutes
-	}
-	return 0
+type PullUpstreamRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string of the duplicated recipe
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeInput) GetServings() int32 {
-	if x != nil {
-		return x.Servings
-	}
-	return 0
+func (x *PullUpstreamRecipeRequest) Reset() {
+	*x = PullUpstreamRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeInput) GetYieldQuantity() *wrapperspb.DoubleValue {
-	if x != nil {
-		return x.YieldQuantity
-	}
-	return nil
+func (x *PullUpstreamRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RecipeInput) GetYieldUnit() string {
+func (*PullUpstreamRecipeRequest) ProtoMessage() {}
+
+func (x *PullUpstreamRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[11]
 	if x != nil {
-		return x.YieldUnit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeInput) GetMainIngredientId() string {
-	if x != nil {
-		return x.MainIngredientId
-	}
-	return ""
+// Deprecated: Use PullUpstreamRecipeRequest.ProtoReflect.Descriptor instead.
+func (*PullUpstreamRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RecipeInput) GetMainIngredientName() string {
+func (x *PullUpstreamRecipeRequest) GetRecipeId() string {
 	if x != nil {
-		return x.MainIngredientName
+		return x.RecipeId
 	}
 	return ""
 }
 
-func (x *RecipeInput) GetCuisineId() string {
+func (x *PullUpstreamRecipeRequest) GetUserId() string {
 	if x != nil {
-		return x.CuisineId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RecipeInput) GetCuisineName() string {
-	if x != nil {
-		return x.CuisineName
-	}
-	return ""
+type GetSimilarRecipesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId             string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	Amount               int32                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	UserId               string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                              // UUID string
+	IgnoreDietaryProfile bool                   `protobuf:"varint,4,opt,name=ignore_dietary_profile,json=ignoreDietaryProfile,proto3" json:"ignore_dietary_profile,omitempty"` // include recipes conflicting with the user's dietary profile
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *RecipeInput) GetIngredientLines() []*IngredientLineInput {
+func (x *GetSimilarRecipesRequest) Reset() {
+	*x = GetSimilarRecipesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSimilarRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSimilarRecipesRequest) ProtoMessage() {}
+
+func (x *GetSimilarRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[12]
 	if x != nil {
-		return x.IngredientLines
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeInput) GetSteps() []*RecipeStepInput {
+// Deprecated: Use GetSimilarRecipesRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSimilarRecipesRequest) GetRecipeId() string {
 	if x != nil {
-		return x.Steps
+		return x.RecipeId
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeInput) GetTags() []string {
+func (x *GetSimilarRecipesRequest) GetAmount() int32 {
 	if x != nil {
-		return x.Tags
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *RecipeInput) GetImageUrl() string {
+func (x *GetSimilarRecipesRequest) GetUserId() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RecipeInput) GetNutrition() *RecipeNutrition {
+func (x *GetSimilarRecipesRequest) GetIgnoreDietaryProfile() bool {
 	if x != nil {
-		return x.Nutrition
+		return x.IgnoreDietaryProfile
 	}
-	return nil
+	return false
 }
 
-type IngredientRef struct {
+type ImportRecipeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Document      string                 `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`           // raw HTML or JSON-LD
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                     // fetched when document is empty
+	Create        bool                   `protobuf:"varint,4,opt,name=create,proto3" json:"create,omitempty"`              // persist the recipe instead of returning a draft
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IngredientRef) Reset() {
-	*x = IngredientRef{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[9]
+func (x *ImportRecipeRequest) Reset() {
+	*x = ImportRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IngredientRef) String() string {
+func (x *ImportRecipeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IngredientRef) ProtoMessage() {}
+func (*ImportRecipeRequest) ProtoMessage() {}
 
-func (x *IngredientRef) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[9]
+func (x *ImportRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -836,53 +944,62 @@ func (x *IngredientRef) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IngredientRef.ProtoReflect.Descriptor instead.
-func (*IngredientRef) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ImportRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ImportRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *IngredientRef) GetId() string {
+func (x *ImportRecipeRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *IngredientRef) GetName() string {
+func (x *ImportRecipeRequest) GetDocument() string {
 	if x != nil {
-		return x.Name
+		return x.Document
 	}
 	return ""
 }
 
-type IngredientLine struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Ingredient    *IngredientRef          `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
-	QuantityValue *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=quantity_value,json=quantityValue,proto3" json:"quantity_value,omitempty"`
-	QuantityText  string                  `protobuf:"bytes,3,opt,name=quantity_text,json=quantityText,proto3" json:"quantity_text,omitempty"`
-	Unit          string                  `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
-	IsOptional    bool                    `protobuf:"varint,5,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
-	Note          string                  `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
-	SortOrder     int32                   `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+func (x *ImportRecipeRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImportRecipeRequest) GetCreate() bool {
+	if x != nil {
+		return x.Create
+	}
+	return false
+}
+
+type ImportRecipeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Draft         *RecipeInput           `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft,omitempty"`
+	Recipe        *Recipe                `protobuf:"bytes,2,opt,name=recipe,proto3" json:"recipe,omitempty"` // set when create was requested
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IngredientLine) Reset() {
-	*x = IngredientLine{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[10]
+func (x *ImportRecipeResponse) Reset() {
+	*x = ImportRecipeResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IngredientLine) String() string {
+func (x *ImportRecipeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IngredientLine) ProtoMessage() {}
+func (*ImportRecipeResponse) ProtoMessage() {}
 
-func (x *IngredientLine) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[10]
+func (x *ImportRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,89 +1010,109 @@ func (x *IngredientLine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IngredientLine.ProtoReflect.Descriptor instead.
-func (*IngredientLine) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ImportRecipeResponse.ProtoReflect.Descriptor instead.
+func (*ImportRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *IngredientLine) GetIngredient() *IngredientRef {
+func (x *ImportRecipeResponse) GetDraft() *RecipeInput {
 	if x != nil {
-		return x.Ingredient
+		return x.Draft
 	}
 	return nil
 }
 
-func (x *IngredientLine) GetQuantityValue() *wrapperspb.DoubleValue {
+func (x *ImportRecipeResponse) GetRecipe() *Recipe {
 	if x != nil {
-		return x.QuantityValue
+		return x.Recipe
 	}
 	return nil
 }
 
-func (x *IngredientLine) GetQuantityText() string {
-	if x != nil {
-		return x.QuantityText
-	}
-	return ""
+type ExportRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`                     // jsonld (default), markdown or text
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IngredientLine) GetUnit() string {
+func (x *ExportRecipeRequest) Reset() {
+	*x = ExportRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRecipeRequest) ProtoMessage() {}
+
+func (x *ExportRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[15]
 	if x != nil {
-		return x.Unit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *IngredientLine) GetIsOptional() bool {
+// Deprecated: Use ExportRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ExportRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportRecipeRequest) GetRecipeId() string {
 	if x != nil {
-		return x.IsOptional
+		return x.RecipeId
 	}
-	return false
+	return ""
 }
 
-func (x *IngredientLine) GetNote() string {
+func (x *ExportRecipeRequest) GetUserId() string {
 	if x != nil {
-		return x.Note
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *IngredientLine) GetSortOrder() int32 {
+func (x *ExportRecipeRequest) GetFormat() string {
 	if x != nil {
-		return x.SortOrder
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-type IngredientLineInput struct {
-	state          protoimpl.MessageState  `protogen:"open.v1"`
-	IngredientId   string                  `protobuf:"bytes,1,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
-	IngredientName string                  `protobuf:"bytes,2,opt,name=ingredient_name,json=ingredientName,proto3" json:"ingredient_name,omitempty"`
-	QuantityValue  *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=quantity_value,json=quantityValue,proto3" json:"quantity_value,omitempty"`
-	QuantityText   string                  `protobuf:"bytes,4,opt,name=quantity_text,json=quantityText,proto3" json:"quantity_text,omitempty"`
-	Unit           string                  `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
-	IsOptional     bool                    `protobuf:"varint,6,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
-	Note           string                  `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
-	SortOrder      int32                   `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ExportRecipeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IngredientLineInput) Reset() {
-	*x = IngredientLineInput{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[11]
+func (x *ExportRecipeResponse) Reset() {
+	*x = ExportRecipeResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IngredientLineInput) String() string {
+func (x *ExportRecipeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IngredientLineInput) ProtoMessage() {}
+func (*ExportRecipeResponse) ProtoMessage() {}
 
-func (x *IngredientLineInput) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[11]
+func (x *ExportRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,94 +1123,7035 @@ func (x *IngredientLineInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IngredientLineInput.ProtoReflect.Descriptor instead.
-func (*IngredientLineInput) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ExportRecipeResponse.ProtoReflect.Descriptor instead.
+func (*ExportRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *IngredientLineInput) GetIngredientId() string {
+func (x *ExportRecipeResponse) GetContent() []byte {
 	if x != nil {
-		return x.IngredientId
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *IngredientLineInput) GetIngredientName() string {
+func (x *ExportRecipeResponse) GetContentType() string {
 	if x != nil {
-		return x.IngredientName
+		return x.ContentType
 	}
 	return ""
 }
 
-func (x *IngredientLineInput) GetQuantityValue() *wrapperspb.DoubleValue {
+func (x *ExportRecipeResponse) GetFilename() string {
 	if x != nil {
-		return x.QuantityValue
+		return x.Filename
 	}
-	return nil
+	return ""
 }
 
-func (x *IngredientLineInput) GetQuantityText() string {
+type ExportRecipeArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`               // format of each file in the zip archive
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRecipeArchiveRequest) Reset() {
+	*x = ExportRecipeArchiveRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRecipeArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRecipeArchiveRequest) ProtoMessage() {}
+
+func (x *ExportRecipeArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRecipeArchiveRequest.ProtoReflect.Descriptor instead.
+func (*ExportRecipeArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExportRecipeArchiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportRecipeArchiveRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// PortableRecipe is a recipe in a form that moves between libraries:
+// ingredients and the cuisine are referenced by name.
+type PortableRecipe struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExternalId    string                 `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"` // ID of the recipe in the library it comes from; matched before the name on import
+	Recipe        *RecipeInput           `protobuf:"bytes,2,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortableRecipe) Reset() {
+	*x = PortableRecipe{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortableRecipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortableRecipe) ProtoMessage() {}
+
+func (x *PortableRecipe) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortableRecipe.ProtoReflect.Descriptor instead.
+func (*PortableRecipe) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PortableRecipe) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *PortableRecipe) GetRecipe() *RecipeInput {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+// The first message of a bulk import carries the options; every following
+// message carries one recipe.
+type BulkImportRecipesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*BulkImportRecipesRequest_Options
+	//	*BulkImportRecipesRequest_Recipe
+	Payload       isBulkImportRecipesRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportRecipesRequest) Reset() {
+	*x = BulkImportRecipesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportRecipesRequest) ProtoMessage() {}
+
+func (x *BulkImportRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportRecipesRequest.ProtoReflect.Descriptor instead.
+func (*BulkImportRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BulkImportRecipesRequest) GetPayload() isBulkImportRecipesRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *BulkImportRecipesRequest) GetOptions() *BulkImportOptions {
+	if x != nil {
+		if x, ok := x.Payload.(*BulkImportRecipesRequest_Options); ok {
+			return x.Options
+		}
+	}
+	return nil
+}
+
+func (x *BulkImportRecipesRequest) GetRecipe() *PortableRecipe {
+	if x != nil {
+		if x, ok := x.Payload.(*BulkImportRecipesRequest_Recipe); ok {
+			return x.Recipe
+		}
+	}
+	return nil
+}
+
+type isBulkImportRecipesRequest_Payload interface {
+	isBulkImportRecipesRequest_Payload()
+}
+
+type BulkImportRecipesRequest_Options struct {
+	Options *BulkImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type BulkImportRecipesRequest_Recipe struct {
+	Recipe *PortableRecipe `protobuf:"bytes,2,opt,name=recipe,proto3,oneof"`
+}
+
+func (*BulkImportRecipesRequest_Options) isBulkImportRecipesRequest_Payload() {}
+
+func (*BulkImportRecipesRequest_Recipe) isBulkImportRecipesRequest_Payload() {}
+
+type BulkImportOptions struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                          // UUID string
+	DryRun         bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                         // report what would happen without writing anything
+	UpdateExisting bool                   `protobuf:"varint,3,opt,name=update_existing,json=updateExisting,proto3" json:"update_existing,omitempty"` // update the recipe an item matches instead of skipping the item
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkImportOptions) Reset() {
+	*x = BulkImportOptions{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportOptions) ProtoMessage() {}
+
+func (x *BulkImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportOptions.ProtoReflect.Descriptor instead.
+func (*BulkImportOptions) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BulkImportOptions) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BulkImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkImportOptions) GetUpdateExisting() bool {
+	if x != nil {
+		return x.UpdateExisting
+	}
+	return false
+}
+
+type BulkImportResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // position of the item in the stream, from 0
+	ExternalId    string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                     // created, updated, skipped or error
+	RecipeId      string                 `protobuf:"bytes,5,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string of the created, updated or matching recipe; empty for a dry-run create
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                   // why the item was skipped or failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportResult) Reset() {
+	*x = BulkImportResult{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportResult) ProtoMessage() {}
+
+func (x *BulkImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportResult.ProtoReflect.Descriptor instead.
+func (*BulkImportResult) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BulkImportResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BulkImportResult) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *BulkImportResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BulkImportResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkImportResult) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *BulkImportResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BulkImportRecipesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkImportResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Created       int32                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Updated       int32                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+	Skipped       int32                  `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Failed        int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	DryRun        bool                   `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkImportRecipesResponse) Reset() {
+	*x = BulkImportRecipesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkImportRecipesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkImportRecipesResponse) ProtoMessage() {}
+
+func (x *BulkImportRecipesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkImportRecipesResponse.ProtoReflect.Descriptor instead.
+func (*BulkImportRecipesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BulkImportRecipesResponse) GetResults() []*BulkImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkImportRecipesResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *BulkImportRecipesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BulkImportRecipesResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *BulkImportRecipesResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BulkImportRecipesResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ExportRecipesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRecipesRequest) Reset() {
+	*x = ExportRecipesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRecipesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRecipesRequest) ProtoMessage() {}
+
+func (x *ExportRecipesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRecipesRequest.ProtoReflect.Descriptor instead.
+func (*ExportRecipesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportRecipesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ScaleRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	Servings      int32                  `protobuf:"varint,3,opt,name=servings,proto3" json:"servings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleRecipeRequest) Reset() {
+	*x = ScaleRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleRecipeRequest) ProtoMessage() {}
+
+func (x *ScaleRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ScaleRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ScaleRecipeRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ScaleRecipeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScaleRecipeRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+type ScaleRecipeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recipe        *Recipe                `protobuf:"bytes,1,opt,name=recipe,proto3" json:"recipe,omitempty"`   // recipe with quantities, yield and nutrition total scaled
+	Factor        float64                `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"` // requested servings divided by the recipe's servings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleRecipeResponse) Reset() {
+	*x = ScaleRecipeResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleRecipeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleRecipeResponse) ProtoMessage() {}
+
+func (x *ScaleRecipeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleRecipeResponse.ProtoReflect.Descriptor instead.
+func (*ScaleRecipeResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ScaleRecipeResponse) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+func (x *ScaleRecipeResponse) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type ListRecipeRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeRevisionsRequest) Reset() {
+	*x = ListRecipeRevisionsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeRevisionsRequest) ProtoMessage() {}
+
+func (x *ListRecipeRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListRecipeRevisionsRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ListRecipeRevisionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListRecipeRevisionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Revisions     []*RecipeRevisionSummary `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeRevisionsResponse) Reset() {
+	*x = ListRecipeRevisionsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeRevisionsResponse) ProtoMessage() {}
+
+func (x *ListRecipeRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListRecipeRevisionsResponse) GetRevisions() []*RecipeRevisionSummary {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type RecipeRevisionSummary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                            // recipe name at this revision
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO 8601 timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeRevisionSummary) Reset() {
+	*x = RecipeRevisionSummary{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeRevisionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeRevisionSummary) ProtoMessage() {}
+
+func (x *RecipeRevisionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeRevisionSummary.ProtoReflect.Descriptor instead.
+func (*RecipeRevisionSummary) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RecipeRevisionSummary) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RecipeRevisionSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeRevisionSummary) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetRecipeRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecipeRevisionRequest) Reset() {
+	*x = GetRecipeRevisionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecipeRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeRevisionRequest) ProtoMessage() {}
+
+func (x *GetRecipeRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetRecipeRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetRecipeRevisionRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *GetRecipeRevisionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetRecipeRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RecipeRevision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int32                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO 8601 timestamp
+	Recipe        *Recipe                `protobuf:"bytes,3,opt,name=recipe,proto3" json:"recipe,omitempty"`                        // recipe as saved at this revision
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeRevision) Reset() {
+	*x = RecipeRevision{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeRevision) ProtoMessage() {}
+
+func (x *RecipeRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeRevision.ProtoReflect.Descriptor instead.
+func (*RecipeRevision) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RecipeRevision) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RecipeRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *RecipeRevision) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+type DiffRecipeRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	FromRevision  int32                  `protobuf:"varint,3,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    int32                  `protobuf:"varint,4,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRecipeRevisionsRequest) Reset() {
+	*x = DiffRecipeRevisionsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRecipeRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRecipeRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffRecipeRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRecipeRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffRecipeRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DiffRecipeRevisionsRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *DiffRecipeRevisionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DiffRecipeRevisionsRequest) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *DiffRecipeRevisionsRequest) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+type RecipeDiff struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	FromRevision    int32                   `protobuf:"varint,1,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision      int32                   `protobuf:"varint,2,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	Fields          []*FieldChange          `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	IngredientLines []*IngredientLineChange `protobuf:"bytes,4,rep,name=ingredient_lines,json=ingredientLines,proto3" json:"ingredient_lines,omitempty"`
+	Steps           []*StepChange           `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecipeDiff) Reset() {
+	*x = RecipeDiff{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeDiff) ProtoMessage() {}
+
+func (x *RecipeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeDiff.ProtoReflect.Descriptor instead.
+func (*RecipeDiff) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RecipeDiff) GetFromRevision() int32 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+func (x *RecipeDiff) GetToRevision() int32 {
+	if x != nil {
+		return x.ToRevision
+	}
+	return 0
+}
+
+func (x *RecipeDiff) GetFields() []*FieldChange {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RecipeDiff) GetIngredientLines() []*IngredientLineChange {
+	if x != nil {
+		return x.IngredientLines
+	}
+	return nil
+}
+
+func (x *RecipeDiff) GetSteps() []*StepChange {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type FieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // e.g. name, servings, cuisine
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type IngredientLineChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        string                 `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // added, removed or modified
+	From          *IngredientLine        `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`     // unset for added lines
+	To            *IngredientLine        `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`         // unset for removed lines
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientLineChange) Reset() {
+	*x = IngredientLineChange{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientLineChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientLineChange) ProtoMessage() {}
+
+func (x *IngredientLineChange) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientLineChange.ProtoReflect.Descriptor instead.
+func (*IngredientLineChange) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *IngredientLineChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *IngredientLineChange) GetFrom() *IngredientLine {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *IngredientLineChange) GetTo() *IngredientLine {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type StepChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        string                 `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"` // added, removed or modified
+	From          *RecipeStep            `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`     // unset for added steps
+	To            *RecipeStep            `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`         // unset for removed steps
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepChange) Reset() {
+	*x = StepChange{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepChange) ProtoMessage() {}
+
+func (x *StepChange) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepChange.ProtoReflect.Descriptor instead.
+func (*StepChange) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StepChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *StepChange) GetFrom() *RecipeStep {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StepChange) GetTo() *RecipeStep {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type RestoreRecipeRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	Revision      int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreRecipeRevisionRequest) Reset() {
+	*x = RestoreRecipeRevisionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreRecipeRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRecipeRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreRecipeRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRecipeRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRecipeRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RestoreRecipeRevisionRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *RestoreRecipeRevisionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreRecipeRevisionRequest) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ListIngredientMatchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // matched, ambiguous, unmatched, confirmed or rejected; defaults to ambiguous
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientMatchesRequest) Reset() {
+	*x = ListIngredientMatchesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientMatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientMatchesRequest) ProtoMessage() {}
+
+func (x *ListIngredientMatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientMatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListIngredientMatchesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListIngredientMatchesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListIngredientMatchesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListIngredientMatchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Matches       []*IngredientMatch     `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientMatchesResponse) Reset() {
+	*x = ListIngredientMatchesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientMatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientMatchesResponse) ProtoMessage() {}
+
+func (x *ListIngredientMatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientMatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListIngredientMatchesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListIngredientMatchesResponse) GetMatches() []*IngredientMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type ResolveIngredientMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	IngredientId  string                 `protobuf:"bytes,2,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
+	FoodId        string                 `protobuf:"bytes,3,opt,name=food_id,json=foodId,proto3" json:"food_id,omitempty"`                   // UUID string; empty rejects every candidate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveIngredientMatchRequest) Reset() {
+	*x = ResolveIngredientMatchRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveIngredientMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIngredientMatchRequest) ProtoMessage() {}
+
+func (x *ResolveIngredientMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIngredientMatchRequest.ProtoReflect.Descriptor instead.
+func (*ResolveIngredientMatchRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ResolveIngredientMatchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResolveIngredientMatchRequest) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *ResolveIngredientMatchRequest) GetFoodId() string {
+	if x != nil {
+		return x.FoodId
+	}
+	return ""
+}
+
+type GetIngredientDietaryAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	IngredientId  string                 `protobuf:"bytes,2,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIngredientDietaryAttributesRequest) Reset() {
+	*x = GetIngredientDietaryAttributesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIngredientDietaryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIngredientDietaryAttributesRequest) ProtoMessage() {}
+
+func (x *GetIngredientDietaryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIngredientDietaryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetIngredientDietaryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetIngredientDietaryAttributesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetIngredientDietaryAttributesRequest) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+type SetIngredientDietaryAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	IngredientId  string                 `protobuf:"bytes,2,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
+	Attributes    []string               `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`                         // meat, fish, dairy, egg, animal_product, gluten or nuts; empty for none
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIngredientDietaryAttributesRequest) Reset() {
+	*x = SetIngredientDietaryAttributesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIngredientDietaryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIngredientDietaryAttributesRequest) ProtoMessage() {}
+
+func (x *SetIngredientDietaryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIngredientDietaryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetIngredientDietaryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetIngredientDietaryAttributesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIngredientDietaryAttributesRequest) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *SetIngredientDietaryAttributesRequest) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type IngredientDietaryAttributes struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient          *IngredientRef         `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	Classified          bool                   `protobuf:"varint,2,opt,name=classified,proto3" json:"classified,omitempty"` // false until attributes were set; recipes using unclassified ingredients get no diet labels
+	Attributes          []string               `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	UpdatedAt           string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                // ISO 8601 timestamp
+	ReclassifiedRecipes int32                  `protobuf:"varint,5,opt,name=reclassified_recipes,json=reclassifiedRecipes,proto3" json:"reclassified_recipes,omitempty"` // recipes whose diet labels changed with this update
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *IngredientDietaryAttributes) Reset() {
+	*x = IngredientDietaryAttributes{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientDietaryAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientDietaryAttributes) ProtoMessage() {}
+
+func (x *IngredientDietaryAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientDietaryAttributes.ProtoReflect.Descriptor instead.
+func (*IngredientDietaryAttributes) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *IngredientDietaryAttributes) GetIngredient() *IngredientRef {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *IngredientDietaryAttributes) GetClassified() bool {
+	if x != nil {
+		return x.Classified
+	}
+	return false
+}
+
+func (x *IngredientDietaryAttributes) GetAttributes() []string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *IngredientDietaryAttributes) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *IngredientDietaryAttributes) GetReclassifiedRecipes() int32 {
+	if x != nil {
+		return x.ReclassifiedRecipes
+	}
+	return 0
+}
+
+type ListIngredientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Query         string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`                 // fuzzy name search; results are ranked by similarity
+	PageIndex     int32                  `protobuf:"varint,3,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsRequest) Reset() {
+	*x = ListIngredientsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsRequest) ProtoMessage() {}
+
+func (x *ListIngredientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsRequest.ProtoReflect.Descriptor instead.
+func (*ListIngredientsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListIngredientsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListIngredientsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListIngredientsRequest) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *ListIngredientsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListIngredientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredients   []*Ingredient          `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	PageIndex     int32                  `protobuf:"varint,2,opt,name=page_index,json=pageIndex,proto3" json:"page_index,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientsResponse) Reset() {
+	*x = ListIngredientsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientsResponse) ProtoMessage() {}
+
+func (x *ListIngredientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientsResponse.ProtoReflect.Descriptor instead.
+func (*ListIngredientsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListIngredientsResponse) GetIngredients() []*Ingredient {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+func (x *ListIngredientsResponse) GetPageIndex() int32 {
+	if x != nil {
+		return x.PageIndex
+	}
+	return 0
+}
+
+func (x *ListIngredientsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListIngredientsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListIngredientsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+type RenameIngredientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	IngredientId  string                 `protobuf:"bytes,2,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameIngredientRequest) Reset() {
+	*x = RenameIngredientRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameIngredientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameIngredientRequest) ProtoMessage() {}
+
+func (x *RenameIngredientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameIngredientRequest.ProtoReflect.Descriptor instead.
+func (*RenameIngredientRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RenameIngredientRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RenameIngredientRequest) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *RenameIngredientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SetIngredientCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	IngredientId  string                 `protobuf:"bytes,2,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`       // UUID string; empty clears the category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIngredientCategoryRequest) Reset() {
+	*x = SetIngredientCategoryRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIngredientCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIngredientCategoryRequest) ProtoMessage() {}
+
+func (x *SetIngredientCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIngredientCategoryRequest.ProtoReflect.Descriptor instead.
+func (*SetIngredientCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SetIngredientCategoryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIngredientCategoryRequest) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *SetIngredientCategoryRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type ListIngredientCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientCategoriesRequest) Reset() {
+	*x = ListIngredientCategoriesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientCategoriesRequest) ProtoMessage() {}
+
+func (x *ListIngredientCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListIngredientCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListIngredientCategoriesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListIngredientCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*IngredientCategory  `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIngredientCategoriesResponse) Reset() {
+	*x = ListIngredientCategoriesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIngredientCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIngredientCategoriesResponse) ProtoMessage() {}
+
+func (x *ListIngredientCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIngredientCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListIngredientCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListIngredientCategoriesResponse) GetCategories() []*IngredientCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type MergeIngredientsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                       // UUID string
+	SourceIngredientId string                 `protobuf:"bytes,2,opt,name=source_ingredient_id,json=sourceIngredientId,proto3" json:"source_ingredient_id,omitempty"` // UUID string; deleted by the merge
+	TargetIngredientId string                 `protobuf:"bytes,3,opt,name=target_ingredient_id,json=targetIngredientId,proto3" json:"target_ingredient_id,omitempty"` // UUID string; replaces the source everywhere
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MergeIngredientsRequest) Reset() {
+	*x = MergeIngredientsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeIngredientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeIngredientsRequest) ProtoMessage() {}
+
+func (x *MergeIngredientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeIngredientsRequest.ProtoReflect.Descriptor instead.
+func (*MergeIngredientsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MergeIngredientsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MergeIngredientsRequest) GetSourceIngredientId() string {
+	if x != nil {
+		return x.SourceIngredientId
+	}
+	return ""
+}
+
+func (x *MergeIngredientsRequest) GetTargetIngredientId() string {
+	if x != nil {
+		return x.TargetIngredientId
+	}
+	return ""
+}
+
+type MergeIngredientsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient     *Ingredient            `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`                                // the target after the merge
+	UpdatedRecipes int32                  `protobuf:"varint,2,opt,name=updated_recipes,json=updatedRecipes,proto3" json:"updated_recipes,omitempty"` // active recipes that used the source
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MergeIngredientsResponse) Reset() {
+	*x = MergeIngredientsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeIngredientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeIngredientsResponse) ProtoMessage() {}
+
+func (x *MergeIngredientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeIngredientsResponse.ProtoReflect.Descriptor instead.
+func (*MergeIngredientsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MergeIngredientsResponse) GetIngredient() *Ingredient {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *MergeIngredientsResponse) GetUpdatedRecipes() int32 {
+	if x != nil {
+		return x.UpdatedRecipes
+	}
+	return 0
+}
+
+type ListDuplicateIngredientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                        // UUID string
+	MinSimilarity float64                `protobuf:"fixed64,2,opt,name=min_similarity,json=minSimilarity,proto3" json:"min_similarity,omitempty"` // 0 to 1; defaults to 0.5
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                                       // defaults to 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateIngredientsRequest) Reset() {
+	*x = ListDuplicateIngredientsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateIngredientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateIngredientsRequest) ProtoMessage() {}
+
+func (x *ListDuplicateIngredientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateIngredientsRequest.ProtoReflect.Descriptor instead.
+func (*ListDuplicateIngredientsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListDuplicateIngredientsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListDuplicateIngredientsRequest) GetMinSimilarity() float64 {
+	if x != nil {
+		return x.MinSimilarity
+	}
+	return 0
+}
+
+func (x *ListDuplicateIngredientsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDuplicateIngredientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicates    []*IngredientDuplicate `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDuplicateIngredientsResponse) Reset() {
+	*x = ListDuplicateIngredientsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDuplicateIngredientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDuplicateIngredientsResponse) ProtoMessage() {}
+
+func (x *ListDuplicateIngredientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDuplicateIngredientsResponse.ProtoReflect.Descriptor instead.
+func (*ListDuplicateIngredientsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListDuplicateIngredientsResponse) GetDuplicates() []*IngredientDuplicate {
+	if x != nil {
+		return x.Duplicates
+	}
+	return nil
+}
+
+// Two ingredients whose names are similar enough to likely be the same food
+type IngredientDuplicate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient    *Ingredient            `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"` // used by more recipes; usually the one to keep
+	Duplicate     *Ingredient            `protobuf:"bytes,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+	Similarity    float64                `protobuf:"fixed64,3,opt,name=similarity,proto3" json:"similarity,omitempty"` // trigram similarity of the names, 0 to 1
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientDuplicate) Reset() {
+	*x = IngredientDuplicate{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientDuplicate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientDuplicate) ProtoMessage() {}
+
+func (x *IngredientDuplicate) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientDuplicate.ProtoReflect.Descriptor instead.
+func (*IngredientDuplicate) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *IngredientDuplicate) GetIngredient() *Ingredient {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *IngredientDuplicate) GetDuplicate() *Ingredient {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+func (x *IngredientDuplicate) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+type Ingredient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category      *IngredientCategory    `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Allergies     []*Allergy             `protobuf:"bytes,5,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	RecipeCount   int32                  `protobuf:"varint,6,opt,name=recipe_count,json=recipeCount,proto3" json:"recipe_count,omitempty"`  // active recipes using the ingredient
+	SearchScore   float64                `protobuf:"fixed64,7,opt,name=search_score,json=searchScore,proto3" json:"search_score,omitempty"` // similarity to the query when listed with one
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`         // ISO 8601 timestamp
+	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`         // ISO 8601 timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ingredient) Reset() {
+	*x = Ingredient{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ingredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ingredient) ProtoMessage() {}
+
+func (x *Ingredient) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ingredient.ProtoReflect.Descriptor instead.
+func (*Ingredient) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Ingredient) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Ingredient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Ingredient) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Ingredient) GetCategory() *IngredientCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *Ingredient) GetAllergies() []*Allergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *Ingredient) GetRecipeCount() int32 {
+	if x != nil {
+		return x.RecipeCount
+	}
+	return 0
+}
+
+func (x *Ingredient) GetSearchScore() float64 {
+	if x != nil {
+		return x.SearchScore
+	}
+	return 0
+}
+
+func (x *Ingredient) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Ingredient) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type IngredientCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayOrder  int32                  `protobuf:"varint,3,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientCategory) Reset() {
+	*x = IngredientCategory{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientCategory) ProtoMessage() {}
+
+func (x *IngredientCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientCategory.ProtoReflect.Descriptor instead.
+func (*IngredientCategory) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *IngredientCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IngredientCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IngredientCategory) GetDisplayOrder() int32 {
+	if x != nil {
+		return x.DisplayOrder
+	}
+	return 0
+}
+
+type ShareRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string of the owner
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                       // email of the user to share with
+	Permission    string                 `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`             // read (default) or edit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareRecipeRequest) Reset() {
+	*x = ShareRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareRecipeRequest) ProtoMessage() {}
+
+func (x *ShareRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareRecipeRequest.ProtoReflect.Descriptor instead.
+func (*ShareRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ShareRecipeRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ShareRecipeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareRecipeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ShareRecipeRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type ListRecipeSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeSharesRequest) Reset() {
+	*x = ListRecipeSharesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeSharesRequest) ProtoMessage() {}
+
+func (x *ListRecipeSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeSharesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListRecipeSharesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListRecipeSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*RecipeShare         `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeSharesResponse) Reset() {
+	*x = ListRecipeSharesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeSharesResponse) ProtoMessage() {}
+
+func (x *ListRecipeSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeSharesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListRecipeSharesResponse) GetShares() []*RecipeShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RevokeRecipeShareRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId         string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`                             // UUID string
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                   // UUID string of the owner
+	SharedWithUserId string                 `protobuf:"bytes,3,opt,name=shared_with_user_id,json=sharedWithUserId,proto3" json:"shared_with_user_id,omitempty"` // UUID string
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RevokeRecipeShareRequest) Reset() {
+	*x = RevokeRecipeShareRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeRecipeShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRecipeShareRequest) ProtoMessage() {}
+
+func (x *RevokeRecipeShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRecipeShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRecipeShareRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RevokeRecipeShareRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *RevokeRecipeShareRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeRecipeShareRequest) GetSharedWithUserId() string {
+	if x != nil {
+		return x.SharedWithUserId
+	}
+	return ""
+}
+
+type RecipeShare struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId         string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	RecipeName       string                 `protobuf:"bytes,2,opt,name=recipe_name,json=recipeName,proto3" json:"recipe_name,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // UUID string
+	OwnerEmail       string                 `protobuf:"bytes,4,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	SharedWithUserId string                 `protobuf:"bytes,5,opt,name=shared_with_user_id,json=sharedWithUserId,proto3" json:"shared_with_user_id,omitempty"` // UUID string
+	SharedWithEmail  string                 `protobuf:"bytes,6,opt,name=shared_with_email,json=sharedWithEmail,proto3" json:"shared_with_email,omitempty"`
+	Permission       string                 `protobuf:"bytes,7,opt,name=permission,proto3" json:"permission,omitempty"`                // read or edit
+	CreatedAt        string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO 8601 timestamp
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecipeShare) Reset() {
+	*x = RecipeShare{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeShare) ProtoMessage() {}
+
+func (x *RecipeShare) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeShare.ProtoReflect.Descriptor instead.
+func (*RecipeShare) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RecipeShare) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *RecipeShare) GetRecipeName() string {
+	if x != nil {
+		return x.RecipeName
+	}
+	return ""
+}
+
+func (x *RecipeShare) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RecipeShare) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *RecipeShare) GetSharedWithUserId() string {
+	if x != nil {
+		return x.SharedWithUserId
+	}
+	return ""
+}
+
+func (x *RecipeShare) GetSharedWithEmail() string {
+	if x != nil {
+		return x.SharedWithEmail
+	}
+	return ""
+}
+
+func (x *RecipeShare) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *RecipeShare) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string of the owner
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CoverImageUrl string                 `protobuf:"bytes,5,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`
+	RecipeCount   int32                  `protobuf:"varint,6,opt,name=recipe_count,json=recipeCount,proto3" json:"recipe_count,omitempty"`
+	Items         []*CollectionItem      `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`                           // in order; only set on GetCollection and changes
+	Permission    string                 `protobuf:"bytes,8,opt,name=permission,proto3" json:"permission,omitempty"`                 // read or edit when shared with the user; empty for the owner
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // ISO 8601 timestamp
+	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // ISO 8601 timestamp
+	Public        bool                   `protobuf:"varint,11,opt,name=public,proto3" json:"public,omitempty"`                       // readable by every user, like the recipe library
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collection) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
+	}
+	return ""
+}
+
+func (x *Collection) GetRecipeCount() int32 {
+	if x != nil {
+		return x.RecipeCount
+	}
+	return 0
+}
+
+func (x *Collection) GetItems() []*CollectionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Collection) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *Collection) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Collection) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Collection) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+type CollectionItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	RecipeName    string                 `protobuf:"bytes,2,opt,name=recipe_name,json=recipeName,proto3" json:"recipe_name,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionItem) Reset() {
+	*x = CollectionItem{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionItem) ProtoMessage() {}
+
+func (x *CollectionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionItem.ProtoReflect.Descriptor instead.
+func (*CollectionItem) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CollectionItem) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CollectionItem) GetRecipeName() string {
+	if x != nil {
+		return x.RecipeName
+	}
+	return ""
+}
+
+func (x *CollectionItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CollectionItem) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type CollectionInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	CoverImageUrl string                 `protobuf:"bytes,3,opt,name=cover_image_url,json=coverImageUrl,proto3" json:"cover_image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionInput) Reset() {
+	*x = CollectionInput{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionInput) ProtoMessage() {}
+
+func (x *CollectionInput) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionInput.ProtoReflect.Descriptor instead.
+func (*CollectionInput) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CollectionInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CollectionInput) GetCoverImageUrl() string {
+	if x != nil {
+		return x.CoverImageUrl
+	}
+	return ""
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListCollectionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"` // own and shared collections by name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type GetCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *GetCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *CollectionInput       `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateCollectionRequest) GetCollection() *CollectionInput {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *CreateCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	Collection    *CollectionInput       `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *UpdateCollectionRequest) GetCollection() *CollectionInput {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *UpdateCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *DeleteCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CollectionRecipeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	RecipeId      string                 `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`             // UUID string
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionRecipeRequest) Reset() {
+	*x = CollectionRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionRecipeRequest) ProtoMessage() {}
+
+func (x *CollectionRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionRecipeRequest.ProtoReflect.Descriptor instead.
+func (*CollectionRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CollectionRecipeRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionRecipeRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CollectionRecipeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReorderCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	RecipeIds     []string               `protobuf:"bytes,2,rep,name=recipe_ids,json=recipeIds,proto3" json:"recipe_ids,omitempty"`          // UUID strings; every recipe in the collection once
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderCollectionRequest) Reset() {
+	*x = ReorderCollectionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderCollectionRequest) ProtoMessage() {}
+
+func (x *ReorderCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ReorderCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReorderCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ReorderCollectionRequest) GetRecipeIds() []string {
+	if x != nil {
+		return x.RecipeIds
+	}
+	return nil
+}
+
+func (x *ReorderCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ShareCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string of the owner
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`                                   // email of the user to share with
+	Permission    string                 `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`                         // read (default) or edit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCollectionRequest) Reset() {
+	*x = ShareCollectionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCollectionRequest) ProtoMessage() {}
+
+func (x *ShareCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCollectionRequest.ProtoReflect.Descriptor instead.
+func (*ShareCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ShareCollectionRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ShareCollectionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareCollectionRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ShareCollectionRequest) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+type ListCollectionSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                   // UUID string of the owner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSharesRequest) Reset() {
+	*x = ListCollectionSharesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSharesRequest) ProtoMessage() {}
+
+func (x *ListCollectionSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionSharesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListCollectionSharesRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *ListCollectionSharesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCollectionSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*CollectionShare     `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"` // newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionSharesResponse) Reset() {
+	*x = ListCollectionSharesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionSharesResponse) ProtoMessage() {}
+
+func (x *ListCollectionSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionSharesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListCollectionSharesResponse) GetShares() []*CollectionShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RevokeCollectionShareRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId     string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`                 // UUID string
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                   // UUID string of the owner
+	SharedWithUserId string                 `protobuf:"bytes,3,opt,name=shared_with_user_id,json=sharedWithUserId,proto3" json:"shared_with_user_id,omitempty"` // UUID string
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RevokeCollectionShareRequest) Reset() {
+	*x = RevokeCollectionShareRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeCollectionShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeCollectionShareRequest) ProtoMessage() {}
+
+func (x *RevokeCollectionShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeCollectionShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeCollectionShareRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RevokeCollectionShareRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *RevokeCollectionShareRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RevokeCollectionShareRequest) GetSharedWithUserId() string {
+	if x != nil {
+		return x.SharedWithUserId
+	}
+	return ""
+}
+
+type CookLogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                             // UUID string
+	RecipeId      string                 `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	RecipeName    string                 `protobuf:"bytes,3,opt,name=recipe_name,json=recipeName,proto3" json:"recipe_name,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	CookedOn      string                 `protobuf:"bytes,5,opt,name=cooked_on,json=cookedOn,proto3" json:"cooked_on,omitempty"` // YYYY-MM-DD
+	Servings      int32                  `protobuf:"varint,6,opt,name=servings,proto3" json:"servings,omitempty"`                // 0 when not recorded
+	Rating        int32                  `protobuf:"varint,7,opt,name=rating,proto3" json:"rating,omitempty"`                    // 1-5; 0 when not rated
+	Notes         string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // ISO 8601 timestamp
+	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // ISO 8601 timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CookLogEntry) Reset() {
+	*x = CookLogEntry{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookLogEntry) ProtoMessage() {}
+
+func (x *CookLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookLogEntry.ProtoReflect.Descriptor instead.
+func (*CookLogEntry) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CookLogEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CookLogEntry) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CookLogEntry) GetRecipeName() string {
+	if x != nil {
+		return x.RecipeName
+	}
+	return ""
+}
+
+func (x *CookLogEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CookLogEntry) GetCookedOn() string {
+	if x != nil {
+		return x.CookedOn
+	}
+	return ""
+}
+
+func (x *CookLogEntry) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CookLogEntry) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CookLogEntry) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CookLogEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *CookLogEntry) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CookLogEntryInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CookedOn      string                 `protobuf:"bytes,1,opt,name=cooked_on,json=cookedOn,proto3" json:"cooked_on,omitempty"` // YYYY-MM-DD; defaults to today
+	Servings      int32                  `protobuf:"varint,2,opt,name=servings,proto3" json:"servings,omitempty"`                // 0 when not recorded
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`                    // 1-5; 0 when not rated
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CookLogEntryInput) Reset() {
+	*x = CookLogEntryInput{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookLogEntryInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookLogEntryInput) ProtoMessage() {}
+
+func (x *CookLogEntryInput) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookLogEntryInput.ProtoReflect.Descriptor instead.
+func (*CookLogEntryInput) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CookLogEntryInput) GetCookedOn() string {
+	if x != nil {
+		return x.CookedOn
+	}
+	return ""
+}
+
+func (x *CookLogEntryInput) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CookLogEntryInput) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CookLogEntryInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type LogCookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	Entry         *CookLogEntryInput     `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogCookRequest) Reset() {
+	*x = LogCookRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogCookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogCookRequest) ProtoMessage() {}
+
+func (x *LogCookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogCookRequest.ProtoReflect.Descriptor instead.
+func (*LogCookRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LogCookRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *LogCookRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LogCookRequest) GetEntry() *CookLogEntryInput {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type ListCookLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	RecipeId      string                 `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string; empty lists every recipe
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                      // default 50, max 200
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookLogRequest) Reset() {
+	*x = ListCookLogRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookLogRequest) ProtoMessage() {}
+
+func (x *ListCookLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookLogRequest.ProtoReflect.Descriptor instead.
+func (*ListCookLogRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListCookLogRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListCookLogRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ListCookLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCookLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CookLogEntry        `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // most recent first
+	Stats         *RecipeCookStats       `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`     // only set when listing a single recipe
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookLogResponse) Reset() {
+	*x = ListCookLogResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookLogResponse) ProtoMessage() {}
+
+func (x *ListCookLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookLogResponse.ProtoReflect.Descriptor instead.
+func (*ListCookLogResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListCookLogResponse) GetEntries() []*CookLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListCookLogResponse) GetStats() *RecipeCookStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type UpdateCookLogEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // UUID string
+	Entry         *CookLogEntryInput     `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCookLogEntryRequest) Reset() {
+	*x = UpdateCookLogEntryRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCookLogEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCookLogEntryRequest) ProtoMessage() {}
+
+func (x *UpdateCookLogEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCookLogEntryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCookLogEntryRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateCookLogEntryRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *UpdateCookLogEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateCookLogEntryRequest) GetEntry() *CookLogEntryInput {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type DeleteCookLogEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntryId       string                 `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCookLogEntryRequest) Reset() {
+	*x = DeleteCookLogEntryRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCookLogEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCookLogEntryRequest) ProtoMessage() {}
+
+func (x *DeleteCookLogEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCookLogEntryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCookLogEntryRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DeleteCookLogEntryRequest) GetEntryId() string {
+	if x != nil {
+		return x.EntryId
+	}
+	return ""
+}
+
+func (x *DeleteCookLogEntryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// A substitution replaces an ingredient with quantity_value * ratio of the
+// substitute, in the same unit.
+type Substitution struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
+	IngredientName string                 `protobuf:"bytes,2,opt,name=ingredient_name,json=ingredientName,proto3" json:"ingredient_name,omitempty"`
+	SubstituteName string                 `protobuf:"bytes,3,opt,name=substitute_name,json=substituteName,proto3" json:"substitute_name,omitempty"`
+	Ratio          float64                `protobuf:"fixed64,4,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Notes          string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	AvoidsAllergy  *Allergy               `protobuf:"bytes,6,opt,name=avoids_allergy,json=avoidsAllergy,proto3" json:"avoids_allergy,omitempty"` // set when the substitute is free of an allergen the ingredient has
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Substitution) Reset() {
+	*x = Substitution{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Substitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Substitution) ProtoMessage() {}
+
+func (x *Substitution) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Substitution.ProtoReflect.Descriptor instead.
+func (*Substitution) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *Substitution) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Substitution) GetIngredientName() string {
+	if x != nil {
+		return x.IngredientName
+	}
+	return ""
+}
+
+func (x *Substitution) GetSubstituteName() string {
+	if x != nil {
+		return x.SubstituteName
+	}
+	return ""
+}
+
+func (x *Substitution) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *Substitution) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Substitution) GetAvoidsAllergy() *Allergy {
+	if x != nil {
+		return x.AvoidsAllergy
+	}
+	return nil
+}
+
+type ListRecipeSubstitutionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`    // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // UUID string
+	AllergyId     string                 `protobuf:"bytes,3,opt,name=allergy_id,json=allergyId,proto3" json:"allergy_id,omitempty"` // optional UUID string; only substitutions avoiding this allergy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeSubstitutionsRequest) Reset() {
+	*x = ListRecipeSubstitutionsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeSubstitutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeSubstitutionsRequest) ProtoMessage() {}
+
+func (x *ListRecipeSubstitutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeSubstitutionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRecipeSubstitutionsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListRecipeSubstitutionsRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *ListRecipeSubstitutionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRecipeSubstitutionsRequest) GetAllergyId() string {
+	if x != nil {
+		return x.AllergyId
+	}
+	return ""
+}
+
+type ListRecipeSubstitutionsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Ingredients   []*IngredientSubstitutions `protobuf:"bytes,1,rep,name=ingredients,proto3" json:"ingredients,omitempty"` // ingredients with at least one substitution, in recipe order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRecipeSubstitutionsResponse) Reset() {
+	*x = ListRecipeSubstitutionsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRecipeSubstitutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRecipeSubstitutionsResponse) ProtoMessage() {}
+
+func (x *ListRecipeSubstitutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRecipeSubstitutionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRecipeSubstitutionsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListRecipeSubstitutionsResponse) GetIngredients() []*IngredientSubstitutions {
+	if x != nil {
+		return x.Ingredients
+	}
+	return nil
+}
+
+type IngredientSubstitutions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient    *IngredientRef         `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	Substitutions []*Substitution        `protobuf:"bytes,2,rep,name=substitutions,proto3" json:"substitutions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientSubstitutions) Reset() {
+	*x = IngredientSubstitutions{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientSubstitutions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientSubstitutions) ProtoMessage() {}
+
+func (x *IngredientSubstitutions) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientSubstitutions.ProtoReflect.Descriptor instead.
+func (*IngredientSubstitutions) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *IngredientSubstitutions) GetIngredient() *IngredientRef {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *IngredientSubstitutions) GetSubstitutions() []*Substitution {
+	if x != nil {
+		return x.Substitutions
+	}
+	return nil
+}
+
+// Creates a copy of the recipe in the user's library with the chosen
+// substitutions applied to every line of their ingredient.
+type SubstituteRecipeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId        string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`                      // UUID string
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // UUID string
+	SubstitutionIds []string               `protobuf:"bytes,3,rep,name=substitution_ids,json=substitutionIds,proto3" json:"substitution_ids,omitempty"` // UUID strings; at most one per ingredient
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                              // optional; defaults to the recipe name with a suffix
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubstituteRecipeRequest) Reset() {
+	*x = SubstituteRecipeRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubstituteRecipeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubstituteRecipeRequest) ProtoMessage() {}
+
+func (x *SubstituteRecipeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubstituteRecipeRequest.ProtoReflect.Descriptor instead.
+func (*SubstituteRecipeRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SubstituteRecipeRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *SubstituteRecipeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubstituteRecipeRequest) GetSubstitutionIds() []string {
+	if x != nil {
+		return x.SubstitutionIds
+	}
+	return nil
+}
+
+func (x *SubstituteRecipeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// A dietary profile holds a user's allergies, diets and disliked ingredients.
+// ListRecipes and GetSimilarRecipes leave out conflicting recipes unless asked
+// to ignore the profile, and the meal planner does the same for suggestions.
+type DietaryProfile struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Allergies           []*Allergy             `protobuf:"bytes,1,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	Diets               []string               `protobuf:"bytes,2,rep,name=diets,proto3" json:"diets,omitempty"`                                                        // vegetarian, vegan or pescatarian
+	DislikedIngredients []string               `protobuf:"bytes,3,rep,name=disliked_ingredients,json=dislikedIngredients,proto3" json:"disliked_ingredients,omitempty"` // ingredient names, matched case-insensitively
+	UpdatedAt           string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                               // RFC3339; empty when never saved
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DietaryProfile) Reset() {
+	*x = DietaryProfile{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DietaryProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DietaryProfile) ProtoMessage() {}
+
+func (x *DietaryProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DietaryProfile.ProtoReflect.Descriptor instead.
+func (*DietaryProfile) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DietaryProfile) GetAllergies() []*Allergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *DietaryProfile) GetDiets() []string {
+	if x != nil {
+		return x.Diets
+	}
+	return nil
+}
+
+func (x *DietaryProfile) GetDislikedIngredients() []string {
+	if x != nil {
+		return x.DislikedIngredients
+	}
+	return nil
+}
+
+func (x *DietaryProfile) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetDietaryProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDietaryProfileRequest) Reset() {
+	*x = GetDietaryProfileRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDietaryProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDietaryProfileRequest) ProtoMessage() {}
+
+func (x *GetDietaryProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDietaryProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetDietaryProfileRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetDietaryProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateDietaryProfileRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // UUID string
+	AllergyIds          []string               `protobuf:"bytes,2,rep,name=allergy_ids,json=allergyIds,proto3" json:"allergy_ids,omitempty"` // UUID strings
+	Diets               []string               `protobuf:"bytes,3,rep,name=diets,proto3" json:"diets,omitempty"`
+	DislikedIngredients []string               `protobuf:"bytes,4,rep,name=disliked_ingredients,json=dislikedIngredients,proto3" json:"disliked_ingredients,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateDietaryProfileRequest) Reset() {
+	*x = UpdateDietaryProfileRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDietaryProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDietaryProfileRequest) ProtoMessage() {}
+
+func (x *UpdateDietaryProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDietaryProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDietaryProfileRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *UpdateDietaryProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateDietaryProfileRequest) GetAllergyIds() []string {
+	if x != nil {
+		return x.AllergyIds
+	}
+	return nil
+}
+
+func (x *UpdateDietaryProfileRequest) GetDiets() []string {
+	if x != nil {
+		return x.Diets
+	}
+	return nil
+}
+
+func (x *UpdateDietaryProfileRequest) GetDislikedIngredients() []string {
+	if x != nil {
+		return x.DislikedIngredients
+	}
+	return nil
+}
+
+type ListAllergiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllergiesRequest) Reset() {
+	*x = ListAllergiesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllergiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllergiesRequest) ProtoMessage() {}
+
+func (x *ListAllergiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllergiesRequest.ProtoReflect.Descriptor instead.
+func (*ListAllergiesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ListAllergiesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListAllergiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allergies     []*Allergy             `protobuf:"bytes,1,rep,name=allergies,proto3" json:"allergies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllergiesResponse) Reset() {
+	*x = ListAllergiesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllergiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllergiesResponse) ProtoMessage() {}
+
+func (x *ListAllergiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllergiesResponse.ProtoReflect.Descriptor instead.
+func (*ListAllergiesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListAllergiesResponse) GetAllergies() []*Allergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+// A cook session tracks a user cooking a recipe step by step. Every change
+// returns the full state so all of the user's devices stay in sync.
+type CookSession struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                             // UUID string
+	RecipeId       string                 `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	RecipeName     string                 `protobuf:"bytes,3,opt,name=recipe_name,json=recipeName,proto3" json:"recipe_name,omitempty"`
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // UUID string
+	Servings       int32                  `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`                                       // 0 when cooking the recipe as written
+	CurrentStep    int32                  `protobuf:"varint,6,opt,name=current_step,json=currentStep,proto3" json:"current_step,omitempty"`              // step_index of the current step
+	Timers         []*CookTimer           `protobuf:"bytes,7,rep,name=timers,proto3" json:"timers,omitempty"`                                            // one per step with a duration
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                            // active or completed
+	Version        int32                  `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`                                         // increases with every change
+	CookLogEntryId string                 `protobuf:"bytes,10,opt,name=cook_log_entry_id,json=cookLogEntryId,proto3" json:"cook_log_entry_id,omitempty"` // UUID string; set once completed
+	StartedAt      string                 `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`                    // ISO 8601 timestamp
+	UpdatedAt      string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                    // ISO 8601 timestamp
+	CompletedAt    string                 `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`              // ISO 8601 timestamp; empty while active
+	ExpiresAt      string                 `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                    // ISO 8601 timestamp; extended by every change
+	ServerTime     string                 `protobuf:"bytes,15,opt,name=server_time,json=serverTime,proto3" json:"server_time,omitempty"`                 // ISO 8601 timestamp the timers were computed at
+	Recipe         *Recipe                `protobuf:"bytes,16,opt,name=recipe,proto3" json:"recipe,omitempty"`                                           // scaled to servings; not set when listing sessions
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CookSession) Reset() {
+	*x = CookSession{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookSession) ProtoMessage() {}
+
+func (x *CookSession) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookSession.ProtoReflect.Descriptor instead.
+func (*CookSession) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CookSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CookSession) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CookSession) GetRecipeName() string {
+	if x != nil {
+		return x.RecipeName
+	}
+	return ""
+}
+
+func (x *CookSession) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CookSession) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *CookSession) GetCurrentStep() int32 {
+	if x != nil {
+		return x.CurrentStep
+	}
+	return 0
+}
+
+func (x *CookSession) GetTimers() []*CookTimer {
+	if x != nil {
+		return x.Timers
+	}
+	return nil
+}
+
+func (x *CookSession) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CookSession) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CookSession) GetCookLogEntryId() string {
+	if x != nil {
+		return x.CookLogEntryId
+	}
+	return ""
+}
+
+func (x *CookSession) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *CookSession) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *CookSession) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *CookSession) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *CookSession) GetServerTime() string {
+	if x != nil {
+		return x.ServerTime
+	}
+	return ""
+}
+
+func (x *CookSession) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+type CookTimer struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StepIndex        int32                  `protobuf:"varint,1,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DurationSeconds  int32                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	RemainingSeconds int32                  `protobuf:"varint,4,opt,name=remaining_seconds,json=remainingSeconds,proto3" json:"remaining_seconds,omitempty"` // as of the session's server_time
+	Running          bool                   `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+	EndsAt           string                 `protobuf:"bytes,6,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"` // ISO 8601 timestamp; only set while running
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CookTimer) Reset() {
+	*x = CookTimer{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookTimer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookTimer) ProtoMessage() {}
+
+func (x *CookTimer) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookTimer.ProtoReflect.Descriptor instead.
+func (*CookTimer) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CookTimer) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *CookTimer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CookTimer) GetDurationSeconds() int32 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *CookTimer) GetRemainingSeconds() int32 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+func (x *CookTimer) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *CookTimer) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+type StartCookSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	Servings      int32                  `protobuf:"varint,3,opt,name=servings,proto3" json:"servings,omitempty"`                // optional; scales the recipe
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartCookSessionRequest) Reset() {
+	*x = StartCookSessionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartCookSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCookSessionRequest) ProtoMessage() {}
+
+func (x *StartCookSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCookSessionRequest.ProtoReflect.Descriptor instead.
+func (*StartCookSessionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *StartCookSessionRequest) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *StartCookSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartCookSessionRequest) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+type CookSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CookSessionRequest) Reset() {
+	*x = CookSessionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookSessionRequest) ProtoMessage() {}
+
+func (x *CookSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookSessionRequest.ProtoReflect.Descriptor instead.
+func (*CookSessionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CookSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CookSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCookSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookSessionsRequest) Reset() {
+	*x = ListCookSessionsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookSessionsRequest) ProtoMessage() {}
+
+func (x *ListCookSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCookSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListCookSessionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListCookSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*CookSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"` // active sessions, most recently used first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCookSessionsResponse) Reset() {
+	*x = ListCookSessionsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCookSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCookSessionsResponse) ProtoMessage() {}
+
+func (x *ListCookSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCookSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCookSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListCookSessionsResponse) GetSessions() []*CookSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// Moves to another step, either relative to the current one (delta) or to an
+// absolute step_index, which takes precedence when set.
+type MoveCookSessionStepRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // UUID string
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`                         // e.g. 1 to advance, -1 to rewind
+	StepIndex     int32                  `protobuf:"varint,4,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCookSessionStepRequest) Reset() {
+	*x = MoveCookSessionStepRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCookSessionStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCookSessionStepRequest) ProtoMessage() {}
+
+func (x *MoveCookSessionStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCookSessionStepRequest.ProtoReflect.Descriptor instead.
+func (*MoveCookSessionStepRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *MoveCookSessionStepRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *MoveCookSessionStepRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveCookSessionStepRequest) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *MoveCookSessionStepRequest) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+type CookTimerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // UUID string
+	StepIndex     int32                  `protobuf:"varint,3,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CookTimerRequest) Reset() {
+	*x = CookTimerRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookTimerRequest) ProtoMessage() {}
+
+func (x *CookTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookTimerRequest.ProtoReflect.Descriptor instead.
+func (*CookTimerRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *CookTimerRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CookTimerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CookTimerRequest) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+type CompleteCookSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // UUID string
+	Entry         *CookLogEntryInput     `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`                          // servings default to the session's servings
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteCookSessionRequest) Reset() {
+	*x = CompleteCookSessionRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteCookSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteCookSessionRequest) ProtoMessage() {}
+
+func (x *CompleteCookSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteCookSessionRequest.ProtoReflect.Descriptor instead.
+func (*CompleteCookSessionRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CompleteCookSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *CompleteCookSessionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CompleteCookSessionRequest) GetEntry() *CookLogEntryInput {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type CollectionShare struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId     string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"` // UUID string
+	CollectionName   string                 `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	OwnerId          string                 `protobuf:"bytes,3,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"` // UUID string
+	OwnerEmail       string                 `protobuf:"bytes,4,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	SharedWithUserId string                 `protobuf:"bytes,5,opt,name=shared_with_user_id,json=sharedWithUserId,proto3" json:"shared_with_user_id,omitempty"` // UUID string
+	SharedWithEmail  string                 `protobuf:"bytes,6,opt,name=shared_with_email,json=sharedWithEmail,proto3" json:"shared_with_email,omitempty"`
+	Permission       string                 `protobuf:"bytes,7,opt,name=permission,proto3" json:"permission,omitempty"`                // read or edit
+	CreatedAt        string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // ISO 8601 timestamp
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CollectionShare) Reset() {
+	*x = CollectionShare{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionShare) ProtoMessage() {}
+
+func (x *CollectionShare) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionShare.ProtoReflect.Descriptor instead.
+func (*CollectionShare) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CollectionShare) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetSharedWithUserId() string {
+	if x != nil {
+		return x.SharedWithUserId
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetSharedWithEmail() string {
+	if x != nil {
+		return x.SharedWithEmail
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *CollectionShare) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type IngredientMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ingredient    *IngredientRef         `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Food          *NutritionFood         `protobuf:"bytes,3,opt,name=food,proto3" json:"food,omitempty"`             // linked reference food, if any
+	Candidates    []*NutritionFood       `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"` // foods to choose from when ambiguous
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientMatch) Reset() {
+	*x = IngredientMatch{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientMatch) ProtoMessage() {}
+
+func (x *IngredientMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientMatch.ProtoReflect.Descriptor instead.
+func (*IngredientMatch) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *IngredientMatch) GetIngredient() *IngredientRef {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *IngredientMatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *IngredientMatch) GetFood() *NutritionFood {
+	if x != nil {
+		return x.Food
+	}
+	return nil
+}
+
+func (x *IngredientMatch) GetCandidates() []*NutritionFood {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+// Reference food with nutrition per 100 g
+type NutritionFood struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`         // UUID string
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // e.g. fdc or ciqual
+	SourceId      string                 `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Calories      float64                `protobuf:"fixed64,5,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinG      float64                `protobuf:"fixed64,6,opt,name=protein_g,json=proteinG,proto3" json:"protein_g,omitempty"`
+	CarbsG        float64                `protobuf:"fixed64,7,opt,name=carbs_g,json=carbsG,proto3" json:"carbs_g,omitempty"`
+	FatG          float64                `protobuf:"fixed64,8,opt,name=fat_g,json=fatG,proto3" json:"fat_g,omitempty"`
+	FiberG        float64                `protobuf:"fixed64,9,opt,name=fiber_g,json=fiberG,proto3" json:"fiber_g,omitempty"`
+	SugarG        float64                `protobuf:"fixed64,10,opt,name=sugar_g,json=sugarG,proto3" json:"sugar_g,omitempty"`
+	SodiumMg      float64                `protobuf:"fixed64,11,opt,name=sodium_mg,json=sodiumMg,proto3" json:"sodium_mg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NutritionFood) Reset() {
+	*x = NutritionFood{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutritionFood) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionFood) ProtoMessage() {}
+
+func (x *NutritionFood) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionFood.ProtoReflect.Descriptor instead.
+func (*NutritionFood) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *NutritionFood) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NutritionFood) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *NutritionFood) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+func (x *NutritionFood) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NutritionFood) GetCalories() float64 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *NutritionFood) GetProteinG() float64 {
+	if x != nil {
+		return x.ProteinG
+	}
+	return 0
+}
+
+func (x *NutritionFood) GetCarbsG() float64 {
+	if x != nil {
+		return x.CarbsG
+	}
+	return 0
+}
+
+func (x *NutritionFood) GetFatG() float64 {
+	if x != nil {
+		return x.FatG
+	}
+	return 0
+}
+
+func (x *NutritionFood) GetFiberG() float64 {
+	if x != nil {
+		return x.FiberG
+	}
+	return 0
+}
+
+func (x *NutritionFood) GetSugarG() float64 {
+	if x != nil {
+		return x.SugarG
+	}
+	return 0
+}
+
+func (x *NutritionFood) GetSodiumMg() float64 {
+	if x != nil {
+		return x.SodiumMg
+	}
+	return 0
+}
+
+type Recipe struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	Id               string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // UUID string
+	UserId           string                  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Name             string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description      string                  `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	PrepTimeMinutes  int32                   `protobuf:"varint,5,opt,name=prep_time_minutes,json=prepTimeMinutes,proto3" json:"prep_time_minutes,omitempty"`
+	CookTimeMinutes  int32                   `protobuf:"varint,6,opt,name=cook_time_minutes,json=cookTimeMinutes,proto3" json:"cook_time_minutes,omitempty"`
+	TotalTimeMinutes int32                   `protobuf:"varint,7,opt,name=total_time_minutes,json=totalTimeMinutes,proto3" json:"total_time_minutes,omitempty"`
+	Servings         int32                   `protobuf:"varint,8,opt,name=servings,proto3" json:"servings,omitempty"`
+	YieldQuantity    *wrapperspb.DoubleValue `protobuf:"bytes,9,opt,name=yield_quantity,json=yieldQuantity,proto3" json:"yield_quantity,omitempty"`
+	YieldUnit        string                  `protobuf:"bytes,10,opt,name=yield_unit,json=yieldUnit,proto3" json:"yield_unit,omitempty"`
+	MainIngredient   *IngredientRef          `protobuf:"bytes,11,opt,name=main_ingredient,json=mainIngredient,proto3" json:"main_ingredient,omitempty"`
+	Cuisine          *Cuisine                `protobuf:"bytes,12,opt,name=cuisine,proto3" json:"cuisine,omitempty"`
+	IngredientLines  []*IngredientLine       `protobuf:"bytes,13,rep,name=ingredient_lines,json=ingredientLines,proto3" json:"ingredient_lines,omitempty"`
+	Steps            []*RecipeStep           `protobuf:"bytes,14,rep,name=steps,proto3" json:"steps,omitempty"`
+	Tags             []string                `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	ImageUrl         string                  `protobuf:"bytes,16,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Nutrition        *RecipeNutrition        `protobuf:"bytes,17,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	SearchScore      float64                 `protobuf:"fixed64,18,opt,name=search_score,json=searchScore,proto3" json:"search_score,omitempty"` // relevance score when listed with a search query
+	CookStats        *RecipeCookStats        `protobuf:"bytes,19,opt,name=cook_stats,json=cookStats,proto3" json:"cook_stats,omitempty"`         // from the requesting user's cook log
+	DeletedAt        string                  `protobuf:"bytes,20,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`         // ISO 8601 timestamp; only set for recipes in the trash
+	ForkedFrom       *RecipeFork             `protobuf:"bytes,21,opt,name=forked_from,json=forkedFrom,proto3" json:"forked_from,omitempty"`      // set when the recipe was duplicated from another
+	Allergies        []*Allergy              `protobuf:"bytes,22,rep,name=allergies,proto3" json:"allergies,omitempty"`                          // from the ingredients of the ingredient lines
+	DietLabels       []string                `protobuf:"bytes,23,rep,name=diet_labels,json=dietLabels,proto3" json:"diet_labels,omitempty"`      // derived from the dietary attributes of the required ingredients
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe) ProtoMessage() {}
+
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Recipe) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Recipe) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Recipe) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Recipe) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Recipe) GetPrepTimeMinutes() int32 {
+	if x != nil {
+		return x.PrepTimeMinutes
+	}
+	return 0
+}
+
+func (x *Recipe) GetCookTimeMinutes() int32 {
+	if x != nil {
+		return x.CookTimeMinutes
+	}
+	return 0
+}
+
+func (x *Recipe) GetTotalTimeMinutes() int32 {
+	if x != nil {
+		return x.TotalTimeMinutes
+	}
+	return 0
+}
+
+func (x *Recipe) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *Recipe) GetYieldQuantity() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.YieldQuantity
+	}
+	return nil
+}
+
+func (x *Recipe) GetYieldUnit() string {
+	if x != nil {
+		return x.YieldUnit
+	}
+	return ""
+}
+
+func (x *Recipe) GetMainIngredient() *IngredientRef {
+	if x != nil {
+		return x.MainIngredient
+	}
+	return nil
+}
+
+func (x *Recipe) GetCuisine() *Cuisine {
+	if x != nil {
+		return x.Cuisine
+	}
+	return nil
+}
+
+func (x *Recipe) GetIngredientLines() []*IngredientLine {
+	if x != nil {
+		return x.IngredientLines
+	}
+	return nil
+}
+
+func (x *Recipe) GetSteps() []*RecipeStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *Recipe) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Recipe) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Recipe) GetNutrition() *RecipeNutrition {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+func (x *Recipe) GetSearchScore() float64 {
+	if x != nil {
+		return x.SearchScore
+	}
+	return 0
+}
+
+func (x *Recipe) GetCookStats() *RecipeCookStats {
+	if x != nil {
+		return x.CookStats
+	}
+	return nil
+}
+
+func (x *Recipe) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Recipe) GetForkedFrom() *RecipeFork {
+	if x != nil {
+		return x.ForkedFrom
+	}
+	return nil
+}
+
+func (x *Recipe) GetAllergies() []*Allergy {
+	if x != nil {
+		return x.Allergies
+	}
+	return nil
+}
+
+func (x *Recipe) GetDietLabels() []string {
+	if x != nil {
+		return x.DietLabels
+	}
+	return nil
+}
+
+type Allergy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Allergy) Reset() {
+	*x = Allergy{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allergy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allergy) ProtoMessage() {}
+
+func (x *Allergy) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allergy.ProtoReflect.Descriptor instead.
+func (*Allergy) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *Allergy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Allergy) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RecipeFork struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId           string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"` // UUID string of the upstream recipe
+	RecipeName         string                 `protobuf:"bytes,2,opt,name=recipe_name,json=recipeName,proto3" json:"recipe_name,omitempty"`
+	Revision           int32                  `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`                                   // upstream revision copied or last pulled
+	LatestRevision     int32                  `protobuf:"varint,4,opt,name=latest_revision,json=latestRevision,proto3" json:"latest_revision,omitempty"` // upstream's current revision
+	HasUpstreamChanges bool                   `protobuf:"varint,5,opt,name=has_upstream_changes,json=hasUpstreamChanges,proto3" json:"has_upstream_changes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RecipeFork) Reset() {
+	*x = RecipeFork{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeFork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeFork) ProtoMessage() {}
+
+func (x *RecipeFork) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeFork.ProtoReflect.Descriptor instead.
+func (*RecipeFork) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *RecipeFork) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *RecipeFork) GetRecipeName() string {
+	if x != nil {
+		return x.RecipeName
+	}
+	return ""
+}
+
+func (x *RecipeFork) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RecipeFork) GetLatestRevision() int32 {
+	if x != nil {
+		return x.LatestRevision
+	}
+	return 0
+}
+
+func (x *RecipeFork) GetHasUpstreamChanges() bool {
+	if x != nil {
+		return x.HasUpstreamChanges
+	}
+	return false
+}
+
+type RecipeInput struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	Name               string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                  `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	PrepTimeMinutes    int32                   `protobuf:"varint,3,opt,name=prep_time_minutes,json=prepTimeMinutes,proto3" json:"prep_time_minutes,omitempty"`
+	CookTimeMinutes    int32                   `protobuf:"varint,4,opt,name=cook_time_minutes,json=cookTimeMinutes,proto3" json:"cook_time_minutes,omitempty"`
+	Servings           int32                   `protobuf:"varint,5,opt,name=servings,proto3" json:"servings,omitempty"`
+	YieldQuantity      *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=yield_quantity,json=yieldQuantity,proto3" json:"yield_quantity,omitempty"`
+	YieldUnit          string                  `protobuf:"bytes,7,opt,name=yield_unit,json=yieldUnit,proto3" json:"yield_unit,omitempty"`
+	MainIngredientId   string                  `protobuf:"bytes,8,opt,name=main_ingredient_id,json=mainIngredientId,proto3" json:"main_ingredient_id,omitempty"` // UUID string
+	MainIngredientName string                  `protobuf:"bytes,9,opt,name=main_ingredient_name,json=mainIngredientName,proto3" json:"main_ingredient_name,omitempty"`
+	CuisineId          string                  `protobuf:"bytes,10,opt,name=cuisine_id,json=cuisineId,proto3" json:"cuisine_id,omitempty"` // UUID string
+	CuisineName        string                  `protobuf:"bytes,11,opt,name=cuisine_name,json=cuisineName,proto3" json:"cuisine_name,omitempty"`
+	IngredientLines    []*IngredientLineInput  `protobuf:"bytes,12,rep,name=ingredient_lines,json=ingredientLines,proto3" json:"ingredient_lines,omitempty"`
+	Steps              []*RecipeStepInput      `protobuf:"bytes,13,rep,name=steps,proto3" json:"steps,omitempty"`
+	Tags               []string                `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	ImageUrl           string                  `protobuf:"bytes,15,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Nutrition          *RecipeNutrition        `protobuf:"bytes,16,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RecipeInput) Reset() {
+	*x = RecipeInput{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeInput) ProtoMessage() {}
+
+func (x *RecipeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeInput.ProtoReflect.Descriptor instead.
+func (*RecipeInput) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *RecipeInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetPrepTimeMinutes() int32 {
+	if x != nil {
+		return x.PrepTimeMinutes
+	}
+	return 0
+}
+
+func (x *RecipeInput) GetCookTimeMinutes() int32 {
+	if x != nil {
+		return x.CookTimeMinutes
+	}
+	return 0
+}
+
+func (x *RecipeInput) GetServings() int32 {
+	if x != nil {
+		return x.Servings
+	}
+	return 0
+}
+
+func (x *RecipeInput) GetYieldQuantity() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.YieldQuantity
+	}
+	return nil
+}
+
+func (x *RecipeInput) GetYieldUnit() string {
+	if x != nil {
+		return x.YieldUnit
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetMainIngredientId() string {
+	if x != nil {
+		return x.MainIngredientId
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetMainIngredientName() string {
+	if x != nil {
+		return x.MainIngredientName
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetCuisineId() string {
+	if x != nil {
+		return x.CuisineId
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetCuisineName() string {
+	if x != nil {
+		return x.CuisineName
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetIngredientLines() []*IngredientLineInput {
+	if x != nil {
+		return x.IngredientLines
+	}
+	return nil
+}
+
+func (x *RecipeInput) GetSteps() []*RecipeStepInput {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *RecipeInput) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RecipeInput) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetNutrition() *RecipeNutrition {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+type IngredientRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngredientRef) Reset() {
+	*x = IngredientRef{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientRef) ProtoMessage() {}
+
+func (x *IngredientRef) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientRef.ProtoReflect.Descriptor instead.
+func (*IngredientRef) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *IngredientRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IngredientRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type IngredientLine struct {
+	state               protoimpl.MessageState  `protogen:"open.v1"`
+	Ingredient          *IngredientRef          `protobuf:"bytes,1,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	QuantityValue       *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=quantity_value,json=quantityValue,proto3" json:"quantity_value,omitempty"`
+	QuantityText        string                  `protobuf:"bytes,3,opt,name=quantity_text,json=quantityText,proto3" json:"quantity_text,omitempty"`
+	Unit                string                  `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	IsOptional          bool                    `protobuf:"varint,5,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	Note                string                  `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	SortOrder           int32                   `protobuf:"varint,7,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	NotScaled           bool                    `protobuf:"varint,8,opt,name=not_scaled,json=notScaled,proto3" json:"not_scaled,omitempty"`                               // set on scaled recipes when the free-text quantity was left as written
+	NutritionUnresolved bool                    `protobuf:"varint,9,opt,name=nutrition_unresolved,json=nutritionUnresolved,proto3" json:"nutrition_unresolved,omitempty"` // the line could not be counted in the computed nutrition
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *IngredientLine) Reset() {
+	*x = IngredientLine{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientLine) ProtoMessage() {}
+
+func (x *IngredientLine) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientLine.ProtoReflect.Descriptor instead.
+func (*IngredientLine) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *IngredientLine) GetIngredient() *IngredientRef {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *IngredientLine) GetQuantityValue() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.QuantityValue
+	}
+	return nil
+}
+
+func (x *IngredientLine) GetQuantityText() string {
+	if x != nil {
+		return x.QuantityText
+	}
+	return ""
+}
+
+func (x *IngredientLine) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *IngredientLine) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+func (x *IngredientLine) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *IngredientLine) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *IngredientLine) GetNotScaled() bool {
+	if x != nil {
+		return x.NotScaled
+	}
+	return false
+}
+
+func (x *IngredientLine) GetNutritionUnresolved() bool {
+	if x != nil {
+		return x.NutritionUnresolved
+	}
+	return false
+}
+
+type IngredientLineInput struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	IngredientId   string                  `protobuf:"bytes,1,opt,name=ingredient_id,json=ingredientId,proto3" json:"ingredient_id,omitempty"` // UUID string
+	IngredientName string                  `protobuf:"bytes,2,opt,name=ingredient_name,json=ingredientName,proto3" json:"ingredient_name,omitempty"`
+	QuantityValue  *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=quantity_value,json=quantityValue,proto3" json:"quantity_value,omitempty"`
+	QuantityText   string                  `protobuf:"bytes,4,opt,name=quantity_text,json=quantityText,proto3" json:"quantity_text,omitempty"`
+	Unit           string                  `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	IsOptional     bool                    `protobuf:"varint,6,opt,name=is_optional,json=isOptional,proto3" json:"is_optional,omitempty"`
+	Note           string                  `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	SortOrder      int32                   `protobuf:"varint,8,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *IngredientLineInput) Reset() {
+	*x = IngredientLineInput{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngredientLineInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngredientLineInput) ProtoMessage() {}
+
+func (x *IngredientLineInput) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngredientLineInput.ProtoReflect.Descriptor instead.
+func (*IngredientLineInput) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *IngredientLineInput) GetIngredientId() string {
+	if x != nil {
+		return x.IngredientId
+	}
+	return ""
+}
+
+func (x *IngredientLineInput) GetIngredientName() string {
+	if x != nil {
+		return x.IngredientName
+	}
+	return ""
+}
+
+func (x *IngredientLineInput) GetQuantityValue() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.QuantityValue
+	}
+	return nil
+}
+
+func (x *IngredientLineInput) GetQuantityText() string {
+	if x != nil {
+		return x.QuantityText
+	}
+	return ""
+}
+
+func (x *IngredientLineInput) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *IngredientLineInput) GetIsOptional() bool {
+	if x != nil {
+		return x.IsOptional
+	}
+	return false
+}
+
+func (x *IngredientLineInput) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *IngredientLineInput) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type RecipeStep struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	StepIndex        int32                   `protobuf:"varint,1,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	Instruction      string                  `protobuf:"bytes,2,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	DurationSeconds  *wrapperspb.Int32Value  `protobuf:"bytes,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	TemperatureValue *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=temperature_value,json=temperatureValue,proto3" json:"temperature_value,omitempty"`
+	TemperatureUnit  string                  `protobuf:"bytes,5,opt,name=temperature_unit,json=temperatureUnit,proto3" json:"temperature_unit,omitempty"`
+	MediaUrl         string                  `protobuf:"bytes,6,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecipeStep) Reset() {
+	*x = RecipeStep{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeStep) ProtoMessage() {}
+
+func (x *RecipeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeStep.ProtoReflect.Descriptor instead.
+func (*RecipeStep) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *RecipeStep) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *RecipeStep) GetInstruction() string {
+	if x != nil {
+		return x.Instruction
+	}
+	return ""
+}
+
+func (x *RecipeStep) GetDurationSeconds() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return nil
+}
+
+func (x *RecipeStep) GetTemperatureValue() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.TemperatureValue
+	}
+	return nil
+}
+
+func (x *RecipeStep) GetTemperatureUnit() string {
+	if x != nil {
+		return x.TemperatureUnit
+	}
+	return ""
+}
+
+func (x *RecipeStep) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+type RecipeStepInput struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	StepIndex        int32                   `protobuf:"varint,1,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	Instruction      string                  `protobuf:"bytes,2,opt,name=instruction,proto3" json:"instruction,omitempty"`
+	DurationSeconds  *wrapperspb.Int32Value  `protobuf:"bytes,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	TemperatureValue *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=temperature_value,json=temperatureValue,proto3" json:"temperature_value,omitempty"`
+	TemperatureUnit  string                  `protobuf:"bytes,5,opt,name=temperature_unit,json=temperatureUnit,proto3" json:"temperature_unit,omitempty"`
+	MediaUrl         string                  `protobuf:"bytes,6,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RecipeStepInput) Reset() {
+	*x = RecipeStepInput{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeStepInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeStepInput) ProtoMessage() {}
+
+func (x *RecipeStepInput) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeStepInput.ProtoReflect.Descriptor instead.
+func (*RecipeStepInput) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *RecipeStepInput) GetStepIndex() int32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *RecipeStepInput) GetInstruction() string {
+	if x != nil {
+		return x.Instruction
+	}
+	return ""
+}
+
+func (x *RecipeStepInput) GetDurationSeconds() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return nil
+}
+
+func (x *RecipeStepInput) GetTemperatureValue() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.TemperatureValue
+	}
+	return nil
+}
+
+func (x *RecipeStepInput) GetTemperatureUnit() string {
+	if x != nil {
+		return x.TemperatureUnit
+	}
+	return ""
+}
+
+func (x *RecipeStepInput) GetMediaUrl() string {
+	if x != nil {
+		return x.MediaUrl
+	}
+	return ""
+}
+
+type RecipeNutrition struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CaloriesTotal      int32                  `protobuf:"varint,1,opt,name=calories_total,json=caloriesTotal,proto3" json:"calories_total,omitempty"`
+	CaloriesPerServing int32                  `protobuf:"varint,2,opt,name=calories_per_serving,json=caloriesPerServing,proto3" json:"calories_per_serving,omitempty"`
+	ProteinG           float64                `protobuf:"fixed64,3,opt,name=protein_g,json=proteinG,proto3" json:"protein_g,omitempty"`
+	CarbsG             float64                `protobuf:"fixed64,4,opt,name=carbs_g,json=carbsG,proto3" json:"carbs_g,omitempty"`
+	FatG               float64                `protobuf:"fixed64,5,opt,name=fat_g,json=fatG,proto3" json:"fat_g,omitempty"`
+	FiberG             float64                `protobuf:"fixed64,6,opt,name=fiber_g,json=fiberG,proto3" json:"fiber_g,omitempty"`
+	SugarG             float64                `protobuf:"fixed64,7,opt,name=sugar_g,json=sugarG,proto3" json:"sugar_g,omitempty"`
+	SodiumMg           float64                `protobuf:"fixed64,8,opt,name=sodium_mg,json=sodiumMg,proto3" json:"sodium_mg,omitempty"`
+	IsOverride         bool                   `protobuf:"varint,9,opt,name=is_override,json=isOverride,proto3" json:"is_override,omitempty"` // typed in by the user instead of computed from the ingredients
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RecipeNutrition) Reset() {
+	*x = RecipeNutrition{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeNutrition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeNutrition) ProtoMessage() {}
+
+func (x *RecipeNutrition) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeNutrition.ProtoReflect.Descriptor instead.
+func (*RecipeNutrition) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *RecipeNutrition) GetCaloriesTotal() int32 {
+	if x != nil {
+		return x.CaloriesTotal
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetCaloriesPerServing() int32 {
+	if x != nil {
+		return x.CaloriesPerServing
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetProteinG() float64 {
+	if x != nil {
+		return x.ProteinG
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetCarbsG() float64 {
+	if x != nil {
+		return x.CarbsG
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetFatG() float64 {
+	if x != nil {
+		return x.FatG
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetFiberG() float64 {
+	if x != nil {
+		return x.FiberG
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetSugarG() float64 {
+	if x != nil {
+		return x.SugarG
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetSodiumMg() float64 {
+	if x != nil {
+		return x.SodiumMg
+	}
+	return 0
+}
+
+func (x *RecipeNutrition) GetIsOverride() bool {
+	if x != nil {
+		return x.IsOverride
+	}
+	return false
+}
+
+type RecipeCookStats struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	TimesCooked   int32                   `protobuf:"varint,1,opt,name=times_cooked,json=timesCooked,proto3" json:"times_cooked,omitempty"`
+	LastCookedOn  string                  `protobuf:"bytes,2,opt,name=last_cooked_on,json=lastCookedOn,proto3" json:"last_cooked_on,omitempty"`  // YYYY-MM-DD; empty when never cooked
+	AverageRating *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=average_rating,json=averageRating,proto3" json:"average_rating,omitempty"` // unset when never rated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeCookStats) Reset() {
+	*x = RecipeCookStats{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeCookStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeCookStats) ProtoMessage() {}
+
+func (x *RecipeCookStats) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeCookStats.ProtoReflect.Descriptor instead.
+func (*RecipeCookStats) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *RecipeCookStats) GetTimesCooked() int32 {
+	if x != nil {
+		return x.TimesCooked
+	}
+	return 0
+}
+
+func (x *RecipeCookStats) GetLastCookedOn() string {
+	if x != nil {
+		return x.LastCookedOn
+	}
+	return ""
+}
+
+func (x *RecipeCookStats) GetAverageRating() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.AverageRating
+	}
+	return nil
+}
+
+type Cuisine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Cuisine) Reset() {
+	*x = Cuisine{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cuisine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cuisine) ProtoMessage() {}
+
+func (x *Cuisine) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cuisine.ProtoReflect.Descriptor instead.
+func (*Cuisine) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Cuisine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Cuisine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetCuisinesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCuisinesRequest) Reset() {
+	*x = GetCuisinesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCuisinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCuisinesRequest) ProtoMessage() {}
+
+func (x *GetCuisinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCuisinesRequest.ProtoReflect.Descriptor instead.
+func (*GetCuisinesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetCuisinesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetCuisinesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cuisines      []*Cuisine             `protobuf:"bytes,1,rep,name=cuisines,proto3" json:"cuisines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCuisinesResponse) Reset() {
+	*x = GetCuisinesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCuisinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCuisinesResponse) ProtoMessage() {}
+
+func (x *GetCuisinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCuisinesResponse.ProtoReflect.Descriptor instead.
+func (*GetCuisinesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *GetCuisinesResponse) GetCuisines() []*Cuisine {
+	if x != nil {
+		return x.Cuisines
+	}
+	return nil
+}
+
+type CreateCuisineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCuisineRequest) Reset() {
+	*x = CreateCuisineRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCuisineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCuisineRequest) ProtoMessage() {}
+
+func (x *CreateCuisineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCuisineRequest.ProtoReflect.Descriptor instead.
+func (*CreateCuisineRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CreateCuisineRequest) GetName() string {
 	if x != nil {
-		return x.QuantityText
+		return x.Name
 	}
 	return ""
 }
 
-func (x *IngredientLineInput) GetUnit() string {
+func (x *CreateCuisineRequest) GetUserId() string {
 	if x != nil {
-		return x.Unit
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *IngredientLineInput) GetIsOptional() bool {
+type UpdateCuisineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // UUID string
+	CuisineId     string                 `protobuf:"bytes,2,opt,name=cuisine_id,json=cuisineId,proto3" json:"cuisine_id,omitempty"` // UUID string
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCuisineRequest) Reset() {
+	*x = UpdateCuisineRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCuisineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCuisineRequest) ProtoMessage() {}
+
+func (x *UpdateCuisineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[121]
 	if x != nil {
-		return x.IsOptional
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *IngredientLineInput) GetNote() string {
+// Deprecated: Use UpdateCuisineRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCuisineRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *UpdateCuisineRequest) GetUserId() string {
 	if x != nil {
-		return x.Note
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *IngredientLineInput) GetSortOrder() int32 {
+func (x *UpdateCuisineRequest) GetCuisineId() string {
 	if x != nil {
-		return x.SortOrder
+		return x.CuisineId
 	}
-	return 0
+	return ""
 }
 
-type RecipeStep struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	StepIndex        int32                   `protobuf:"varint,1,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
-	Instruction      string                  `protobuf:"bytes,2,opt,name=instruction,proto3" json:"instruction,omitempty"`
-	DurationSeconds  *wrapperspb.Int32Value  `protobuf:"bytes,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	TemperatureValue *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=temperature_value,json=temperatureValue,proto3" json:"temperature_value,omitempty"`
-	TemperatureUnit  string                  `protobuf:"bytes,5,opt,name=temperature_unit,json=temperatureUnit,proto3" json:"temperature_unit,omitempty"`
-	MediaUrl         string                  `protobuf:"bytes,6,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *UpdateCuisineRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *RecipeStep) Reset() {
-	*x = RecipeStep{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[12]
+type DeleteCuisineRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                            // UUID string
+	CuisineId           string                 `protobuf:"bytes,2,opt,name=cuisine_id,json=cuisineId,proto3" json:"cuisine_id,omitempty"`                                   // UUID string
+	ReassignToCuisineId string                 `protobuf:"bytes,3,opt,name=reassign_to_cuisine_id,json=reassignToCuisineId,proto3" json:"reassign_to_cuisine_id,omitempty"` // UUID string; required while recipes use the cuisine
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeleteCuisineRequest) Reset() {
+	*x = DeleteCuisineRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStep) String() string {
+func (x *DeleteCuisineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStep) ProtoMessage() {}
+func (*DeleteCuisineRequest) ProtoMessage() {}
 
-func (x *RecipeStep) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[12]
+func (x *DeleteCuisineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,80 +8162,159 @@ func (x *RecipeStep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStep.ProtoReflect.Descriptor instead.
-func (*RecipeStep) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DeleteCuisineRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCuisineRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *RecipeStep) GetStepIndex() int32 {
+func (x *DeleteCuisineRequest) GetUserId() string {
 	if x != nil {
-		return x.StepIndex
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeStep) GetInstruction() string {
+func (x *DeleteCuisineRequest) GetCuisineId() string {
 	if x != nil {
-		return x.Instruction
+		return x.CuisineId
 	}
 	return ""
 }
 
-func (x *RecipeStep) GetDurationSeconds() *wrapperspb.Int32Value {
+func (x *DeleteCuisineRequest) GetReassignToCuisineId() string {
 	if x != nil {
-		return x.DurationSeconds
+		return x.ReassignToCuisineId
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStep) GetTemperatureValue() *wrapperspb.DoubleValue {
+type DeleteCuisineResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedRecipes int32                  `protobuf:"varint,1,opt,name=updated_recipes,json=updatedRecipes,proto3" json:"updated_recipes,omitempty"` // active recipes moved to the reassignment cuisine
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteCuisineResponse) Reset() {
+	*x = DeleteCuisineResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCuisineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCuisineResponse) ProtoMessage() {}
+
+func (x *DeleteCuisineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[123]
 	if x != nil {
-		return x.TemperatureValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeStep) GetTemperatureUnit() string {
+// Deprecated: Use DeleteCuisineResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCuisineResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DeleteCuisineResponse) GetUpdatedRecipes() int32 {
 	if x != nil {
-		return x.TemperatureUnit
+		return x.UpdatedRecipes
+	}
+	return 0
+}
+
+type MergeCuisinesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // UUID string
+	SourceCuisineId string                 `protobuf:"bytes,2,opt,name=source_cuisine_id,json=sourceCuisineId,proto3" json:"source_cuisine_id,omitempty"` // UUID string; deleted by the merge
+	TargetCuisineId string                 `protobuf:"bytes,3,opt,name=target_cuisine_id,json=targetCuisineId,proto3" json:"target_cuisine_id,omitempty"` // UUID string; receives the source's recipes
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MergeCuisinesRequest) Reset() {
+	*x = MergeCuisinesRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCuisinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCuisinesRequest) ProtoMessage() {}
+
+func (x *MergeCuisinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCuisinesRequest.ProtoReflect.Descriptor instead.
+func (*MergeCuisinesRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *MergeCuisinesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RecipeStep) GetMediaUrl() string {
+func (x *MergeCuisinesRequest) GetSourceCuisineId() string {
 	if x != nil {
-		return x.MediaUrl
+		return x.SourceCuisineId
 	}
 	return ""
 }
 
-type RecipeStepInput struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	StepIndex        int32                   `protobuf:"varint,1,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
-	Instruction      string                  `protobuf:"bytes,2,opt,name=instruction,proto3" json:"instruction,omitempty"`
-	DurationSeconds  *wrapperspb.Int32Value  `protobuf:"bytes,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	TemperatureValue *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=temperature_value,json=temperatureValue,proto3" json:"temperature_value,omitempty"`
-	TemperatureUnit  string                  `protobuf:"bytes,5,opt,name=temperature_unit,json=temperatureUnit,proto3" json:"temperature_unit,omitempty"`
-	MediaUrl         string                  `protobuf:"bytes,6,opt,name=media_url,json=mediaUrl,proto3" json:"media_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *MergeCuisinesRequest) GetTargetCuisineId() string {
+	if x != nil {
+		return x.TargetCuisineId
+	}
+	return ""
 }
 
-func (x *RecipeStepInput) Reset() {
-	*x = RecipeStepInput{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[13]
+type MergeCuisinesResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Cuisine        *Cuisine               `protobuf:"bytes,1,opt,name=cuisine,proto3" json:"cuisine,omitempty"`                                      // the target
+	UpdatedRecipes int32                  `protobuf:"varint,2,opt,name=updated_recipes,json=updatedRecipes,proto3" json:"updated_recipes,omitempty"` // active recipes moved from the source
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MergeCuisinesResponse) Reset() {
+	*x = MergeCuisinesResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStepInput) String() string {
+func (x *MergeCuisinesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStepInput) ProtoMessage() {}
+func (*MergeCuisinesResponse) ProtoMessage() {}
 
-func (x *RecipeStepInput) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[13]
+func (x *MergeCuisinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1168,82 +8325,175 @@ func (x *RecipeStepInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStepInput.ProtoReflect.Descriptor instead.
-func (*RecipeStepInput) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MergeCuisinesResponse.ProtoReflect.Descriptor instead.
+func (*MergeCuisinesResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *RecipeStepInput) GetStepIndex() int32 {
+func (x *MergeCuisinesResponse) GetCuisine() *Cuisine {
 	if x != nil {
-		return x.StepIndex
+		return x.Cuisine
+	}
+	return nil
+}
+
+func (x *MergeCuisinesResponse) GetUpdatedRecipes() int32 {
+	if x != nil {
+		return x.UpdatedRecipes
 	}
 	return 0
 }
 
-func (x *RecipeStepInput) GetInstruction() string {
+type Tag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RecipeCount   int32                  `protobuf:"varint,2,opt,name=recipe_count,json=recipeCount,proto3" json:"recipe_count,omitempty"` // active recipes carrying the tag
+	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`                                 // course, occasion or technique; empty when ungrouped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[126]
 	if x != nil {
-		return x.Instruction
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *Tag) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RecipeStepInput) GetDurationSeconds() *wrapperspb.Int32Value {
+func (x *Tag) GetRecipeCount() int32 {
 	if x != nil {
-		return x.DurationSeconds
+		return x.RecipeCount
 	}
-	return nil
+	return 0
 }
 
-func (x *RecipeStepInput) GetTemperatureValue() *wrapperspb.DoubleValue {
+func (x *Tag) GetGroup() string {
 	if x != nil {
-		return x.TemperatureValue
+		return x.Group
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStepInput) GetTemperatureUnit() string {
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Prefix        string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`               // only tags starting with it, for autocomplete
+	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`                 // only tags in the group
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                // defaults to 100, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[127]
 	if x != nil {
-		return x.TemperatureUnit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListTagsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RecipeStepInput) GetMediaUrl() string {
+func (x *ListTagsRequest) GetPrefix() string {
 	if x != nil {
-		return x.MediaUrl
+		return x.Prefix
 	}
 	return ""
 }
 
-type RecipeNutrition struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	CaloriesTotal      int32                  `protobuf:"varint,1,opt,name=calories_total,json=caloriesTotal,proto3" json:"calories_total,omitempty"`
-	CaloriesPerServing int32                  `protobuf:"varint,2,opt,name=calories_per_serving,json=caloriesPerServing,proto3" json:"calories_per_serving,omitempty"`
-	ProteinG           float64                `protobuf:"fixed64,3,opt,name=protein_g,json=proteinG,proto3" json:"protein_g,omitempty"`
-	CarbsG             float64                `protobuf:"fixed64,4,opt,name=carbs_g,json=carbsG,proto3" json:"carbs_g,omitempty"`
-	FatG               float64                `protobuf:"fixed64,5,opt,name=fat_g,json=fatG,proto3" json:"fat_g,omitempty"`
-	FiberG             float64                `protobuf:"fixed64,6,opt,name=fiber_g,json=fiberG,proto3" json:"fiber_g,omitempty"`
-	SugarG             float64                `protobuf:"fixed64,7,opt,name=sugar_g,json=sugarG,proto3" json:"sugar_g,omitempty"`
-	SodiumMg           float64                `protobuf:"fixed64,8,opt,name=sodium_mg,json=sodiumMg,proto3" json:"sodium_mg,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ListTagsRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
 }
 
-func (x *RecipeNutrition) Reset() {
-	*x = RecipeNutrition{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[14]
+func (x *ListTagsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tags          []*Tag                 `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"` // most used first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeNutrition) String() string {
+func (x *ListTagsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeNutrition) ProtoMessage() {}
+func (*ListTagsResponse) ProtoMessage() {}
 
-func (x *RecipeNutrition) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[14]
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,90 +8504,102 @@ func (x *RecipeNutrition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeNutrition.ProtoReflect.Descriptor instead.
-func (*RecipeNutrition) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *RecipeNutrition) GetCaloriesTotal() int32 {
+func (x *ListTagsResponse) GetTags() []*Tag {
 	if x != nil {
-		return x.CaloriesTotal
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeNutrition) GetCaloriesPerServing() int32 {
-	if x != nil {
-		return x.CaloriesPerServing
-	}
-	return 0
+type RenameTagRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeNutrition) GetProteinG() float64 {
-	if x != nil {
-		return x.ProteinG
-	}
-	return 0
+func (x *RenameTagRequest) Reset() {
+	*x = RenameTagRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeNutrition) GetCarbsG() float64 {
-	if x != nil {
-		return x.CarbsG
-	}
-	return 0
+func (x *RenameTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RecipeNutrition) GetFatG() float64 {
+func (*RenameTagRequest) ProtoMessage() {}
+
+func (x *RenameTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[129]
 	if x != nil {
-		return x.FatG
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeNutrition) GetFiberG() float64 {
+// Deprecated: Use RenameTagRequest.ProtoReflect.Descriptor instead.
+func (*RenameTagRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RenameTagRequest) GetUserId() string {
 	if x != nil {
-		return x.FiberG
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeNutrition) GetSugarG() float64 {
+func (x *RenameTagRequest) GetTag() string {
 	if x != nil {
-		return x.SugarG
+		return x.Tag
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeNutrition) GetSodiumMg() float64 {
+func (x *RenameTagRequest) GetName() string {
 	if x != nil {
-		return x.SodiumMg
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-type Cuisine struct {
+type MergeTagsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // UUID string
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // UUID string
+	SourceTags    []string               `protobuf:"bytes,2,rep,name=source_tags,json=sourceTags,proto3" json:"source_tags,omitempty"` // replaced by the target
+	TargetTag     string                 `protobuf:"bytes,3,opt,name=target_tag,json=targetTag,proto3" json:"target_tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Cuisine) Reset() {
-	*x = Cuisine{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[15]
+func (x *MergeTagsRequest) Reset() {
+	*x = MergeTagsRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Cuisine) String() string {
+func (x *MergeTagsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Cuisine) ProtoMessage() {}
+func (*MergeTagsRequest) ProtoMessage() {}
 
-func (x *Cuisine) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[15]
+func (x *MergeTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,47 +8610,55 @@ func (x *Cuisine) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Cuisine.ProtoReflect.Descriptor instead.
-func (*Cuisine) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MergeTagsRequest.ProtoReflect.Descriptor instead.
+func (*MergeTagsRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *Cuisine) GetId() string {
+func (x *MergeTagsRequest) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Cuisine) GetName() string {
+func (x *MergeTagsRequest) GetSourceTags() []string {
 	if x != nil {
-		return x.Name
+		return x.SourceTags
+	}
+	return nil
+}
+
+func (x *MergeTagsRequest) GetTargetTag() string {
+	if x != nil {
+		return x.TargetTag
 	}
 	return ""
 }
 
-type GetCuisinesRequest struct {
+type DeleteTagRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCuisinesRequest) Reset() {
-	*x = GetCuisinesRequest{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[16]
+func (x *DeleteTagRequest) Reset() {
+	*x = DeleteTagRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCuisinesRequest) String() string {
+func (x *DeleteTagRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCuisinesRequest) ProtoMessage() {}
+func (*DeleteTagRequest) ProtoMessage() {}
 
-func (x *GetCuisinesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[16]
+func (x *DeleteTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,40 +8669,47 @@ func (x *GetCuisinesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCuisinesRequest.ProtoReflect.Descriptor instead.
-func (*GetCuisinesRequest) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use DeleteTagRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTagRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *GetCuisinesRequest) GetUserId() string {
+func (x *DeleteTagRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetCuisinesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cuisines      []*Cuisine             `protobuf:"bytes,1,rep,name=cuisines,proto3" json:"cuisines,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DeleteTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
 }
 
-func (x *GetCuisinesResponse) Reset() {
-	*x = GetCuisinesResponse{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[17]
+type TagChangeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UpdatedRecipes int32                  `protobuf:"varint,1,opt,name=updated_recipes,json=updatedRecipes,proto3" json:"updated_recipes,omitempty"` // active recipes whose tags changed
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TagChangeResponse) Reset() {
+	*x = TagChangeResponse{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCuisinesResponse) String() string {
+func (x *TagChangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCuisinesResponse) ProtoMessage() {}
+func (*TagChangeResponse) ProtoMessage() {}
 
-func (x *GetCuisinesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[17]
+func (x *TagChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,41 +8720,42 @@ func (x *GetCuisinesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCuisinesResponse.ProtoReflect.Descriptor instead.
-func (*GetCuisinesResponse) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use TagChangeResponse.ProtoReflect.Descriptor instead.
+func (*TagChangeResponse) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *GetCuisinesResponse) GetCuisines() []*Cuisine {
+func (x *TagChangeResponse) GetUpdatedRecipes() int32 {
 	if x != nil {
-		return x.Cuisines
+		return x.UpdatedRecipes
 	}
-	return nil
+	return 0
 }
 
-type CreateCuisineRequest struct {
+type SetTagGroupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"` // course, occasion or technique; empty to ungroup
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCuisineRequest) Reset() {
-	*x = CreateCuisineRequest{}
-	mi := &file_recipe_v1_recipe_proto_msgTypes[18]
+func (x *SetTagGroupRequest) Reset() {
+	*x = SetTagGroupRequest{}
+	mi := &file_recipe_v1_recipe_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCuisineRequest) String() string {
+func (x *SetTagGroupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCuisineRequest) ProtoMessage() {}
+func (*SetTagGroupRequest) ProtoMessage() {}
 
-func (x *CreateCuisineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_recipe_v1_recipe_proto_msgTypes[18]
+func (x *SetTagGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_recipe_v1_recipe_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1488,21 +8766,28 @@ func (x *CreateCuisineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCuisineRequest.ProtoReflect.Descriptor instead.
-func (*CreateCuisineRequest) Descriptor() ([]byte, []int) {
-	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetTagGroupRequest.ProtoReflect.Descriptor instead.
+func (*SetTagGroupRequest) Descriptor() ([]byte, []int) {
+	return file_recipe_v1_recipe_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *CreateCuisineRequest) GetName() string {
+func (x *SetTagGroupRequest) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateCuisineRequest) GetUserId() string {
+func (x *SetTagGroupRequest) GetTag() string {
 	if x != nil {
-		return x.UserId
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SetTagGroupRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
 	}
 	return ""
 }
@@ -1514,7 +8799,7 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\x16recipe/v1/recipe.proto\x12\trecipe.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\"H\n" +
 	"\x10GetRecipeRequest\x12\x1b\n" +
 	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xe0\x01\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc7\x04\n" +
 	"\x12ListRecipesRequest\x12\x1d\n" +
 	"\n" +
 	"page_index\x18\x01 \x01(\x05R\tpageIndex\x12\x1b\n" +
@@ -1525,7 +8810,21 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\ringredient_id\x18\x05 \x01(\tR\fingredientId\x12\x1d\n" +
 	"\n" +
 	"allergy_id\x18\x06 \x01(\tR\tallergyId\x12\x12\n" +
-	"\x04tags\x18\a \x03(\tR\x04tags\"\xc0\x01\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x12\x14\n" +
+	"\x05query\x18\b \x01(\tR\x05query\x12#\n" +
+	"\rcollection_id\x18\t \x01(\tR\fcollectionId\x12\x12\n" +
+	"\x04sort\x18\n" +
+	" \x01(\tR\x04sort\x12;\n" +
+	"\n" +
+	"min_rating\x18\v \x01(\v2\x1c.google.protobuf.DoubleValueR\tminRating\x12!\n" +
+	"\fcooked_since\x18\f \x01(\tR\vcookedSince\x12(\n" +
+	"\x10not_cooked_since\x18\r \x01(\tR\x0enotCookedSince\x124\n" +
+	"\x16ignore_dietary_profile\x18\x0e \x01(\bR\x14ignoreDietaryProfile\x12\x1f\n" +
+	"\vdiet_labels\x18\x0f \x03(\tR\n" +
+	"dietLabels\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x10 \x01(\tR\tsortOrder\x12\x16\n" +
+	"\x06cursor\x18\x11 \x01(\tR\x06cursor\"\xe1\x01\n" +
 	"\x13ListRecipesResponse\x12+\n" +
 	"\arecipes\x18\x01 \x03(\v2\x11.recipe.v1.RecipeR\arecipes\x12\x1d\n" +
 	"\n" +
@@ -1534,7 +8833,9 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\vtotal_count\x18\x04 \x01(\x05R\n" +
 	"totalCount\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
-	"totalPages\"^\n" +
+	"totalPages\x12\x1f\n" +
+	"\vnext_cursor\x18\x06 \x01(\tR\n" +
+	"nextCursor\"^\n" +
 	"\x13CreateRecipeRequest\x12.\n" +
 	"\x06recipe\x18\x01 \x01(\v2\x16.recipe.v1.RecipeInputR\x06recipe\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"{\n" +
@@ -1544,11 +8845,525 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\auser_id\x18\x03 \x01(\tR\x06userId\"K\n" +
 	"\x13DeleteRecipeRequest\x12\x1b\n" +
 	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"h\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"p\n" +
+	"\x19ListDeletedRecipesRequest\x12\x1d\n" +
+	"\n" +
+	"page_index\x18\x01 \x01(\x05R\tpageIndex\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"L\n" +
+	"\x14RestoreRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x12PurgeRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x89\x01\n" +
+	"\x15SetRecipeImageRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"step_index\x18\x04 \x01(\x05R\tstepIndex\"b\n" +
+	"\x16DuplicateRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"Q\n" +
+	"\x19PullUpstreamRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x9e\x01\n" +
 	"\x18GetSimilarRecipesRequest\x12\x1b\n" +
 	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x05R\x06amount\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xbc\x05\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x124\n" +
+	"\x16ignore_dietary_profile\x18\x04 \x01(\bR\x14ignoreDietaryProfile\"t\n" +
+	"\x13ImportRecipeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bdocument\x18\x02 \x01(\tR\bdocument\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x16\n" +
+	"\x06create\x18\x04 \x01(\bR\x06create\"o\n" +
+	"\x14ImportRecipeResponse\x12,\n" +
+	"\x05draft\x18\x01 \x01(\v2\x16.recipe.v1.RecipeInputR\x05draft\x12)\n" +
+	"\x06recipe\x18\x02 \x01(\v2\x11.recipe.v1.RecipeR\x06recipe\"c\n" +
+	"\x13ExportRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\"o\n" +
+	"\x14ExportRecipeResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"M\n" +
+	"\x1aExportRecipeArchiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"a\n" +
+	"\x0ePortableRecipe\x12\x1f\n" +
+	"\vexternal_id\x18\x01 \x01(\tR\n" +
+	"externalId\x12.\n" +
+	"\x06recipe\x18\x02 \x01(\v2\x16.recipe.v1.RecipeInputR\x06recipe\"\x94\x01\n" +
+	"\x18BulkImportRecipesRequest\x128\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1c.recipe.v1.BulkImportOptionsH\x00R\aoptions\x123\n" +
+	"\x06recipe\x18\x02 \x01(\v2\x19.recipe.v1.PortableRecipeH\x00R\x06recipeB\t\n" +
+	"\apayload\"n\n" +
+	"\x11BulkImportOptions\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12'\n" +
+	"\x0fupdate_existing\x18\x03 \x01(\bR\x0eupdateExisting\"\xac\x01\n" +
+	"\x10BulkImportResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\trecipe_id\x18\x05 \x01(\tR\brecipeId\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\xd1\x01\n" +
+	"\x19BulkImportRecipesResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.recipe.v1.BulkImportResultR\aresults\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x05R\aupdated\x12\x18\n" +
+	"\askipped\x18\x04 \x01(\x05R\askipped\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12\x17\n" +
+	"\adry_run\x18\x06 \x01(\bR\x06dryRun\"/\n" +
+	"\x14ExportRecipesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"f\n" +
+	"\x12ScaleRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bservings\x18\x03 \x01(\x05R\bservings\"X\n" +
+	"\x13ScaleRecipeResponse\x12)\n" +
+	"\x06recipe\x18\x01 \x01(\v2\x11.recipe.v1.RecipeR\x06recipe\x12\x16\n" +
+	"\x06factor\x18\x02 \x01(\x01R\x06factor\"R\n" +
+	"\x1aListRecipeRevisionsRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"]\n" +
+	"\x1bListRecipeRevisionsResponse\x12>\n" +
+	"\trevisions\x18\x01 \x03(\v2 .recipe.v1.RecipeRevisionSummaryR\trevisions\"f\n" +
+	"\x15RecipeRevisionSummary\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"l\n" +
+	"\x18GetRecipeRevisionRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\"v\n" +
+	"\x0eRecipeRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x05R\brevision\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12)\n" +
+	"\x06recipe\x18\x03 \x01(\v2\x11.recipe.v1.RecipeR\x06recipe\"\x98\x01\n" +
+	"\x1aDiffRecipeRevisionsRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12#\n" +
+	"\rfrom_revision\x18\x03 \x01(\x05R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x04 \x01(\x05R\n" +
+	"toRevision\"\xfb\x01\n" +
+	"\n" +
+	"RecipeDiff\x12#\n" +
+	"\rfrom_revision\x18\x01 \x01(\x05R\ffromRevision\x12\x1f\n" +
+	"\vto_revision\x18\x02 \x01(\x05R\n" +
+	"toRevision\x12.\n" +
+	"\x06fields\x18\x03 \x03(\v2\x16.recipe.v1.FieldChangeR\x06fields\x12J\n" +
+	"\x10ingredient_lines\x18\x04 \x03(\v2\x1f.recipe.v1.IngredientLineChangeR\x0fingredientLines\x12+\n" +
+	"\x05steps\x18\x05 \x03(\v2\x15.recipe.v1.StepChangeR\x05steps\"G\n" +
+	"\vFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x88\x01\n" +
+	"\x14IngredientLineChange\x12\x16\n" +
+	"\x06change\x18\x01 \x01(\tR\x06change\x12-\n" +
+	"\x04from\x18\x02 \x01(\v2\x19.recipe.v1.IngredientLineR\x04from\x12)\n" +
+	"\x02to\x18\x03 \x01(\v2\x19.recipe.v1.IngredientLineR\x02to\"v\n" +
+	"\n" +
+	"StepChange\x12\x16\n" +
+	"\x06change\x18\x01 \x01(\tR\x06change\x12)\n" +
+	"\x04from\x18\x02 \x01(\v2\x15.recipe.v1.RecipeStepR\x04from\x12%\n" +
+	"\x02to\x18\x03 \x01(\v2\x15.recipe.v1.RecipeStepR\x02to\"p\n" +
+	"\x1cRestoreRecipeRevisionRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\"O\n" +
+	"\x1cListIngredientMatchesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"U\n" +
+	"\x1dListIngredientMatchesResponse\x124\n" +
+	"\amatches\x18\x01 \x03(\v2\x1a.recipe.v1.IngredientMatchR\amatches\"v\n" +
+	"\x1dResolveIngredientMatchRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\ringredient_id\x18\x02 \x01(\tR\fingredientId\x12\x17\n" +
+	"\afood_id\x18\x03 \x01(\tR\x06foodId\"e\n" +
+	"%GetIngredientDietaryAttributesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\ringredient_id\x18\x02 \x01(\tR\fingredientId\"\x85\x01\n" +
+	"%SetIngredientDietaryAttributesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\ringredient_id\x18\x02 \x01(\tR\fingredientId\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\tR\n" +
+	"attributes\"\xe9\x01\n" +
+	"\x1bIngredientDietaryAttributes\x128\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x18.recipe.v1.IngredientRefR\n" +
+	"ingredient\x12\x1e\n" +
+	"\n" +
+	"classified\x18\x02 \x01(\bR\n" +
+	"classified\x12\x1e\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\tR\n" +
+	"attributes\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\x121\n" +
+	"\x14reclassified_recipes\x18\x05 \x01(\x05R\x13reclassifiedRecipes\"\x83\x01\n" +
+	"\x16ListIngredientsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05query\x18\x02 \x01(\tR\x05query\x12\x1d\n" +
+	"\n" +
+	"page_index\x18\x03 \x01(\x05R\tpageIndex\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xd0\x01\n" +
+	"\x17ListIngredientsResponse\x127\n" +
+	"\vingredients\x18\x01 \x03(\v2\x15.recipe.v1.IngredientR\vingredients\x12\x1d\n" +
+	"\n" +
+	"page_index\x18\x02 \x01(\x05R\tpageIndex\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x05R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vtotal_pages\x18\x05 \x01(\x05R\n" +
+	"totalPages\"k\n" +
+	"\x17RenameIngredientRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\ringredient_id\x18\x02 \x01(\tR\fingredientId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"}\n" +
+	"\x1cSetIngredientCategoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12#\n" +
+	"\ringredient_id\x18\x02 \x01(\tR\fingredientId\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\":\n" +
+	"\x1fListIngredientCategoriesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"a\n" +
+	" ListIngredientCategoriesResponse\x12=\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1d.recipe.v1.IngredientCategoryR\n" +
+	"categories\"\x96\x01\n" +
+	"\x17MergeIngredientsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\x14source_ingredient_id\x18\x02 \x01(\tR\x12sourceIngredientId\x120\n" +
+	"\x14target_ingredient_id\x18\x03 \x01(\tR\x12targetIngredientId\"z\n" +
+	"\x18MergeIngredientsResponse\x125\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x15.recipe.v1.IngredientR\n" +
+	"ingredient\x12'\n" +
+	"\x0fupdated_recipes\x18\x02 \x01(\x05R\x0eupdatedRecipes\"w\n" +
+	"\x1fListDuplicateIngredientsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0emin_similarity\x18\x02 \x01(\x01R\rminSimilarity\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"b\n" +
+	" ListDuplicateIngredientsResponse\x12>\n" +
+	"\n" +
+	"duplicates\x18\x01 \x03(\v2\x1e.recipe.v1.IngredientDuplicateR\n" +
+	"duplicates\"\xa1\x01\n" +
+	"\x13IngredientDuplicate\x125\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x15.recipe.v1.IngredientR\n" +
+	"ingredient\x123\n" +
+	"\tduplicate\x18\x02 \x01(\v2\x15.recipe.v1.IngredientR\tduplicate\x12\x1e\n" +
+	"\n" +
+	"similarity\x18\x03 \x01(\x01R\n" +
+	"similarity\"\xc3\x02\n" +
+	"\n" +
+	"Ingredient\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x129\n" +
+	"\bcategory\x18\x04 \x01(\v2\x1d.recipe.v1.IngredientCategoryR\bcategory\x120\n" +
+	"\tallergies\x18\x05 \x03(\v2\x12.recipe.v1.AllergyR\tallergies\x12!\n" +
+	"\frecipe_count\x18\x06 \x01(\x05R\vrecipeCount\x12!\n" +
+	"\fsearch_score\x18\a \x01(\x01R\vsearchScore\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"]\n" +
+	"\x12IngredientCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rdisplay_order\x18\x03 \x01(\x05R\fdisplayOrder\"\x80\x01\n" +
+	"\x12ShareRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\"2\n" +
+	"\x17ListRecipeSharesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"J\n" +
+	"\x18ListRecipeSharesResponse\x12.\n" +
+	"\x06shares\x18\x01 \x03(\v2\x16.recipe.v1.RecipeShareR\x06shares\"\x7f\n" +
+	"\x18RevokeRecipeShareRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12-\n" +
+	"\x13shared_with_user_id\x18\x03 \x01(\tR\x10sharedWithUserId\"\xa1\x02\n" +
+	"\vRecipeShare\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x1f\n" +
+	"\vrecipe_name\x18\x02 \x01(\tR\n" +
+	"recipeName\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x1f\n" +
+	"\vowner_email\x18\x04 \x01(\tR\n" +
+	"ownerEmail\x12-\n" +
+	"\x13shared_with_user_id\x18\x05 \x01(\tR\x10sharedWithUserId\x12*\n" +
+	"\x11shared_with_email\x18\x06 \x01(\tR\x0fsharedWithEmail\x12\x1e\n" +
+	"\n" +
+	"permission\x18\a \x01(\tR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xdd\x02\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12&\n" +
+	"\x0fcover_image_url\x18\x05 \x01(\tR\rcoverImageUrl\x12!\n" +
+	"\frecipe_count\x18\x06 \x01(\x05R\vrecipeCount\x12/\n" +
+	"\x05items\x18\a \x03(\v2\x19.recipe.v1.CollectionItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"permission\x18\b \x01(\tR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06public\x18\v \x01(\bR\x06public\"\x87\x01\n" +
+	"\x0eCollectionItem\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x1f\n" +
+	"\vrecipe_name\x18\x02 \x01(\tR\n" +
+	"recipeName\x12\x1b\n" +
+	"\timage_url\x18\x03 \x01(\tR\bimageUrl\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"o\n" +
+	"\x0fCollectionInput\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12&\n" +
+	"\x0fcover_image_url\x18\x03 \x01(\tR\rcoverImageUrl\"1\n" +
+	"\x16ListCollectionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x17ListCollectionsResponse\x127\n" +
+	"\vcollections\x18\x01 \x03(\v2\x15.recipe.v1.CollectionR\vcollections\"T\n" +
+	"\x14GetCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"n\n" +
+	"\x17CreateCollectionRequest\x12:\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x1a.recipe.v1.CollectionInputR\n" +
+	"collection\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x93\x01\n" +
+	"\x17UpdateCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12:\n" +
+	"\n" +
+	"collection\x18\x02 \x01(\v2\x1a.recipe.v1.CollectionInputR\n" +
+	"collection\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"W\n" +
+	"\x17DeleteCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"t\n" +
+	"\x17CollectionRecipeRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"w\n" +
+	"\x18ReorderCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x1d\n" +
+	"\n" +
+	"recipe_ids\x18\x02 \x03(\tR\trecipeIds\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x8c\x01\n" +
+	"\x16ShareCollectionRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x04 \x01(\tR\n" +
+	"permission\"[\n" +
+	"\x1bListCollectionSharesRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"R\n" +
+	"\x1cListCollectionSharesResponse\x122\n" +
+	"\x06shares\x18\x01 \x03(\v2\x1a.recipe.v1.CollectionShareR\x06shares\"\x8b\x01\n" +
+	"\x1cRevokeCollectionShareRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12-\n" +
+	"\x13shared_with_user_id\x18\x03 \x01(\tR\x10sharedWithUserId\"\x9a\x02\n" +
+	"\fCookLogEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x1f\n" +
+	"\vrecipe_name\x18\x03 \x01(\tR\n" +
+	"recipeName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tcooked_on\x18\x05 \x01(\tR\bcookedOn\x12\x1a\n" +
+	"\bservings\x18\x06 \x01(\x05R\bservings\x12\x16\n" +
+	"\x06rating\x18\a \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"z\n" +
+	"\x11CookLogEntryInput\x12\x1b\n" +
+	"\tcooked_on\x18\x01 \x01(\tR\bcookedOn\x12\x1a\n" +
+	"\bservings\x18\x02 \x01(\x05R\bservings\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"z\n" +
+	"\x0eLogCookRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x122\n" +
+	"\x05entry\x18\x03 \x01(\v2\x1c.recipe.v1.CookLogEntryInputR\x05entry\"`\n" +
+	"\x12ListCookLogRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"z\n" +
+	"\x13ListCookLogResponse\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.recipe.v1.CookLogEntryR\aentries\x120\n" +
+	"\x05stats\x18\x02 \x01(\v2\x1a.recipe.v1.RecipeCookStatsR\x05stats\"\x83\x01\n" +
+	"\x19UpdateCookLogEntryRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x122\n" +
+	"\x05entry\x18\x03 \x01(\v2\x1c.recipe.v1.CookLogEntryInputR\x05entry\"O\n" +
+	"\x19DeleteCookLogEntryRequest\x12\x19\n" +
+	"\bentry_id\x18\x01 \x01(\tR\aentryId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xd7\x01\n" +
+	"\fSubstitution\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fingredient_name\x18\x02 \x01(\tR\x0eingredientName\x12'\n" +
+	"\x0fsubstitute_name\x18\x03 \x01(\tR\x0esubstituteName\x12\x14\n" +
+	"\x05ratio\x18\x04 \x01(\x01R\x05ratio\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x129\n" +
+	"\x0eavoids_allergy\x18\x06 \x01(\v2\x12.recipe.v1.AllergyR\ravoidsAllergy\"u\n" +
+	"\x1eListRecipeSubstitutionsRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"allergy_id\x18\x03 \x01(\tR\tallergyId\"g\n" +
+	"\x1fListRecipeSubstitutionsResponse\x12D\n" +
+	"\vingredients\x18\x01 \x03(\v2\".recipe.v1.IngredientSubstitutionsR\vingredients\"\x92\x01\n" +
+	"\x17IngredientSubstitutions\x128\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x18.recipe.v1.IngredientRefR\n" +
+	"ingredient\x12=\n" +
+	"\rsubstitutions\x18\x02 \x03(\v2\x17.recipe.v1.SubstitutionR\rsubstitutions\"\x8e\x01\n" +
+	"\x17SubstituteRecipeRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12)\n" +
+	"\x10substitution_ids\x18\x03 \x03(\tR\x0fsubstitutionIds\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\"\xaa\x01\n" +
+	"\x0eDietaryProfile\x120\n" +
+	"\tallergies\x18\x01 \x03(\v2\x12.recipe.v1.AllergyR\tallergies\x12\x14\n" +
+	"\x05diets\x18\x02 \x03(\tR\x05diets\x121\n" +
+	"\x14disliked_ingredients\x18\x03 \x03(\tR\x13dislikedIngredients\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"3\n" +
+	"\x18GetDietaryProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xa0\x01\n" +
+	"\x1bUpdateDietaryProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vallergy_ids\x18\x02 \x03(\tR\n" +
+	"allergyIds\x12\x14\n" +
+	"\x05diets\x18\x03 \x03(\tR\x05diets\x121\n" +
+	"\x14disliked_ingredients\x18\x04 \x03(\tR\x13dislikedIngredients\"/\n" +
+	"\x14ListAllergiesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"I\n" +
+	"\x15ListAllergiesResponse\x120\n" +
+	"\tallergies\x18\x01 \x03(\v2\x12.recipe.v1.AllergyR\tallergies\"\x8a\x04\n" +
+	"\vCookSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\trecipe_id\x18\x02 \x01(\tR\brecipeId\x12\x1f\n" +
+	"\vrecipe_name\x18\x03 \x01(\tR\n" +
+	"recipeName\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bservings\x18\x05 \x01(\x05R\bservings\x12!\n" +
+	"\fcurrent_step\x18\x06 \x01(\x05R\vcurrentStep\x12,\n" +
+	"\x06timers\x18\a \x03(\v2\x14.recipe.v1.CookTimerR\x06timers\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x18\n" +
+	"\aversion\x18\t \x01(\x05R\aversion\x12)\n" +
+	"\x11cook_log_entry_id\x18\n" +
+	" \x01(\tR\x0ecookLogEntryId\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\v \x01(\tR\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\x12!\n" +
+	"\fcompleted_at\x18\r \x01(\tR\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\tR\texpiresAt\x12\x1f\n" +
+	"\vserver_time\x18\x0f \x01(\tR\n" +
+	"serverTime\x12)\n" +
+	"\x06recipe\x18\x10 \x01(\v2\x11.recipe.v1.RecipeR\x06recipe\"\xc9\x01\n" +
+	"\tCookTimer\x12\x1d\n" +
+	"\n" +
+	"step_index\x18\x01 \x01(\x05R\tstepIndex\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x05R\x0fdurationSeconds\x12+\n" +
+	"\x11remaining_seconds\x18\x04 \x01(\x05R\x10remainingSeconds\x12\x18\n" +
+	"\arunning\x18\x05 \x01(\bR\arunning\x12\x17\n" +
+	"\aends_at\x18\x06 \x01(\tR\x06endsAt\"k\n" +
+	"\x17StartCookSessionRequest\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bservings\x18\x03 \x01(\x05R\bservings\"L\n" +
+	"\x12CookSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"2\n" +
+	"\x17ListCookSessionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"N\n" +
+	"\x18ListCookSessionsResponse\x122\n" +
+	"\bsessions\x18\x01 \x03(\v2\x16.recipe.v1.CookSessionR\bsessions\"\x89\x01\n" +
+	"\x1aMoveCookSessionStepRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12\x1d\n" +
+	"\n" +
+	"step_index\x18\x04 \x01(\x05R\tstepIndex\"i\n" +
+	"\x10CookTimerRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"step_index\x18\x03 \x01(\x05R\tstepIndex\"\x88\x01\n" +
+	"\x1aCompleteCookSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x122\n" +
+	"\x05entry\x18\x03 \x01(\v2\x1c.recipe.v1.CookLogEntryInputR\x05entry\"\xb5\x02\n" +
+	"\x0fCollectionShare\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12'\n" +
+	"\x0fcollection_name\x18\x02 \x01(\tR\x0ecollectionName\x12\x19\n" +
+	"\bowner_id\x18\x03 \x01(\tR\aownerId\x12\x1f\n" +
+	"\vowner_email\x18\x04 \x01(\tR\n" +
+	"ownerEmail\x12-\n" +
+	"\x13shared_with_user_id\x18\x05 \x01(\tR\x10sharedWithUserId\x12*\n" +
+	"\x11shared_with_email\x18\x06 \x01(\tR\x0fsharedWithEmail\x12\x1e\n" +
+	"\n" +
+	"permission\x18\a \x01(\tR\n" +
+	"permission\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xcb\x01\n" +
+	"\x0fIngredientMatch\x128\n" +
+	"\n" +
+	"ingredient\x18\x01 \x01(\v2\x18.recipe.v1.IngredientRefR\n" +
+	"ingredient\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12,\n" +
+	"\x04food\x18\x03 \x01(\v2\x18.recipe.v1.NutritionFoodR\x04food\x128\n" +
+	"\n" +
+	"candidates\x18\x04 \x03(\v2\x18.recipe.v1.NutritionFoodR\n" +
+	"candidates\"\x9e\x02\n" +
+	"\rNutritionFood\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x1b\n" +
+	"\tsource_id\x18\x03 \x01(\tR\bsourceId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcalories\x18\x05 \x01(\x01R\bcalories\x12\x1b\n" +
+	"\tprotein_g\x18\x06 \x01(\x01R\bproteinG\x12\x17\n" +
+	"\acarbs_g\x18\a \x01(\x01R\x06carbsG\x12\x13\n" +
+	"\x05fat_g\x18\b \x01(\x01R\x04fatG\x12\x17\n" +
+	"\afiber_g\x18\t \x01(\x01R\x06fiberG\x12\x17\n" +
+	"\asugar_g\x18\n" +
+	" \x01(\x01R\x06sugarG\x12\x1b\n" +
+	"\tsodium_mg\x18\v \x01(\x01R\bsodiumMg\"\xc4\a\n" +
 	"\x06Recipe\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
@@ -1568,7 +9383,28 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\x05steps\x18\x0e \x03(\v2\x15.recipe.v1.RecipeStepR\x05steps\x12\x12\n" +
 	"\x04tags\x18\x0f \x03(\tR\x04tags\x12\x1b\n" +
 	"\timage_url\x18\x10 \x01(\tR\bimageUrl\x128\n" +
-	"\tnutrition\x18\x11 \x01(\v2\x1a.recipe.v1.RecipeNutritionR\tnutrition\"\xa5\x05\n" +
+	"\tnutrition\x18\x11 \x01(\v2\x1a.recipe.v1.RecipeNutritionR\tnutrition\x12!\n" +
+	"\fsearch_score\x18\x12 \x01(\x01R\vsearchScore\x129\n" +
+	"\n" +
+	"cook_stats\x18\x13 \x01(\v2\x1a.recipe.v1.RecipeCookStatsR\tcookStats\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x14 \x01(\tR\tdeletedAt\x126\n" +
+	"\vforked_from\x18\x15 \x01(\v2\x15.recipe.v1.RecipeForkR\n" +
+	"forkedFrom\x120\n" +
+	"\tallergies\x18\x16 \x03(\v2\x12.recipe.v1.AllergyR\tallergies\x12\x1f\n" +
+	"\vdiet_labels\x18\x17 \x03(\tR\n" +
+	"dietLabels\"-\n" +
+	"\aAllergy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xc1\x01\n" +
+	"\n" +
+	"RecipeFork\x12\x1b\n" +
+	"\trecipe_id\x18\x01 \x01(\tR\brecipeId\x12\x1f\n" +
+	"\vrecipe_name\x18\x02 \x01(\tR\n" +
+	"recipeName\x12\x1a\n" +
+	"\brevision\x18\x03 \x01(\x05R\brevision\x12'\n" +
+	"\x0flatest_revision\x18\x04 \x01(\x05R\x0elatestRevision\x120\n" +
+	"\x14has_upstream_changes\x18\x05 \x01(\bR\x12hasUpstreamChanges\"\xa5\x05\n" +
 	"\vRecipeInput\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12*\n" +
@@ -1591,7 +9427,7 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\tnutrition\x18\x10 \x01(\v2\x1a.recipe.v1.RecipeNutritionR\tnutrition\"3\n" +
 	"\rIngredientRef\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"\x9c\x02\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\xee\x02\n" +
 	"\x0eIngredientLine\x128\n" +
 	"\n" +
 	"ingredient\x18\x01 \x01(\v2\x18.recipe.v1.IngredientRefR\n" +
@@ -1603,7 +9439,10 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"isOptional\x12\x12\n" +
 	"\x04note\x18\x06 \x01(\tR\x04note\x12\x1d\n" +
 	"\n" +
-	"sort_order\x18\a \x01(\x05R\tsortOrder\"\xb5\x02\n" +
+	"sort_order\x18\a \x01(\x05R\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"not_scaled\x18\b \x01(\bR\tnotScaled\x121\n" +
+	"\x14nutrition_unresolved\x18\t \x01(\bR\x13nutritionUnresolved\"\xb5\x02\n" +
 	"\x13IngredientLineInput\x12#\n" +
 	"\ringredient_id\x18\x01 \x01(\tR\fingredientId\x12'\n" +
 	"\x0fingredient_name\x18\x02 \x01(\tR\x0eingredientName\x12C\n" +
@@ -1631,7 +9470,7 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\x10duration_seconds\x18\x03 \x01(\v2\x1b.google.protobuf.Int32ValueR\x0fdurationSeconds\x12I\n" +
 	"\x11temperature_value\x18\x04 \x01(\v2\x1c.google.protobuf.DoubleValueR\x10temperatureValue\x12)\n" +
 	"\x10temperature_unit\x18\x05 \x01(\tR\x0ftemperatureUnit\x12\x1b\n" +
-	"\tmedia_url\x18\x06 \x01(\tR\bmediaUrl\"\x84\x02\n" +
+	"\tmedia_url\x18\x06 \x01(\tR\bmediaUrl\"\xa5\x02\n" +
 	"\x0fRecipeNutrition\x12%\n" +
 	"\x0ecalories_total\x18\x01 \x01(\x05R\rcaloriesTotal\x120\n" +
 	"\x14calories_per_serving\x18\x02 \x01(\x05R\x12caloriesPerServing\x12\x1b\n" +
@@ -1640,7 +9479,13 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\x05fat_g\x18\x05 \x01(\x01R\x04fatG\x12\x17\n" +
 	"\afiber_g\x18\x06 \x01(\x01R\x06fiberG\x12\x17\n" +
 	"\asugar_g\x18\a \x01(\x01R\x06sugarG\x12\x1b\n" +
-	"\tsodium_mg\x18\b \x01(\x01R\bsodiumMg\"-\n" +
+	"\tsodium_mg\x18\b \x01(\x01R\bsodiumMg\x12\x1f\n" +
+	"\vis_override\x18\t \x01(\bR\n" +
+	"isOverride\"\x9f\x01\n" +
+	"\x0fRecipeCookStats\x12!\n" +
+	"\ftimes_cooked\x18\x01 \x01(\x05R\vtimesCooked\x12$\n" +
+	"\x0elast_cooked_on\x18\x02 \x01(\tR\flastCookedOn\x12C\n" +
+	"\x0eaverage_rating\x18\x03 \x01(\v2\x1c.google.protobuf.DoubleValueR\raverageRating\"-\n" +
 	"\aCuisine\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"-\n" +
@@ -1650,16 +9495,131 @@ const file_recipe_v1_recipe_proto_rawDesc = "" +
 	"\bcuisines\x18\x01 \x03(\v2\x12.recipe.v1.CuisineR\bcuisines\"C\n" +
 	"\x14CreateCuisineRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId2\xd6\x04\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"b\n" +
+	"\x14UpdateCuisineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"cuisine_id\x18\x02 \x01(\tR\tcuisineId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"\x83\x01\n" +
+	"\x14DeleteCuisineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"cuisine_id\x18\x02 \x01(\tR\tcuisineId\x123\n" +
+	"\x16reassign_to_cuisine_id\x18\x03 \x01(\tR\x13reassignToCuisineId\"@\n" +
+	"\x15DeleteCuisineResponse\x12'\n" +
+	"\x0fupdated_recipes\x18\x01 \x01(\x05R\x0eupdatedRecipes\"\x87\x01\n" +
+	"\x14MergeCuisinesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x11source_cuisine_id\x18\x02 \x01(\tR\x0fsourceCuisineId\x12*\n" +
+	"\x11target_cuisine_id\x18\x03 \x01(\tR\x0ftargetCuisineId\"n\n" +
+	"\x15MergeCuisinesResponse\x12,\n" +
+	"\acuisine\x18\x01 \x01(\v2\x12.recipe.v1.CuisineR\acuisine\x12'\n" +
+	"\x0fupdated_recipes\x18\x02 \x01(\x05R\x0eupdatedRecipes\"R\n" +
+	"\x03Tag\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\frecipe_count\x18\x02 \x01(\x05R\vrecipeCount\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\"n\n" +
+	"\x0fListTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"6\n" +
+	"\x10ListTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.recipe.v1.TagR\x04tags\"Q\n" +
+	"\x10RenameTagRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"k\n" +
+	"\x10MergeTagsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vsource_tags\x18\x02 \x03(\tR\n" +
+	"sourceTags\x12\x1d\n" +
+	"\n" +
+	"target_tag\x18\x03 \x01(\tR\ttargetTag\"=\n" +
+	"\x10DeleteTagRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\"<\n" +
+	"\x11TagChangeResponse\x12'\n" +
+	"\x0fupdated_recipes\x18\x01 \x01(\x05R\x0eupdatedRecipes\"U\n" +
+	"\x12SetTagGroupRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03tag\x18\x02 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05group\x18\x03 \x01(\tR\x05group2\xb70\n" +
 	"\rRecipeService\x12;\n" +
 	"\tGetRecipe\x12\x1b.recipe.v1.GetRecipeRequest\x1a\x11.recipe.v1.Recipe\x12L\n" +
 	"\vListRecipes\x12\x1d.recipe.v1.ListRecipesRequest\x1a\x1e.recipe.v1.ListRecipesResponse\x12A\n" +
 	"\fCreateRecipe\x12\x1e.recipe.v1.CreateRecipeRequest\x1a\x11.recipe.v1.Recipe\x12A\n" +
 	"\fUpdateRecipe\x12\x1e.recipe.v1.UpdateRecipeRequest\x1a\x11.recipe.v1.Recipe\x12F\n" +
-	"\fDeleteRecipe\x12\x1e.recipe.v1.DeleteRecipeRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
-	"\x11GetSimilarRecipes\x12#.recipe.v1.GetSimilarRecipesRequest\x1a\x1e.recipe.v1.ListRecipesResponse\x12L\n" +
+	"\fDeleteRecipe\x12\x1e.recipe.v1.DeleteRecipeRequest\x1a\x16.google.protobuf.Empty\x12Z\n" +
+	"\x12ListDeletedRecipes\x12$.recipe.v1.ListDeletedRecipesRequest\x1a\x1e.recipe.v1.ListRecipesResponse\x12C\n" +
+	"\rRestoreRecipe\x12\x1f.recipe.v1.RestoreRecipeRequest\x1a\x11.recipe.v1.Recipe\x12D\n" +
+	"\vPurgeRecipe\x12\x1d.recipe.v1.PurgeRecipeRequest\x1a\x16.google.protobuf.Empty\x12G\n" +
+	"\x0fDuplicateRecipe\x12!.recipe.v1.DuplicateRecipeRequest\x1a\x11.recipe.v1.Recipe\x12M\n" +
+	"\x12PullUpstreamRecipe\x12$.recipe.v1.PullUpstreamRecipeRequest\x1a\x11.recipe.v1.Recipe\x12E\n" +
+	"\x0eSetRecipeImage\x12 .recipe.v1.SetRecipeImageRequest\x1a\x11.recipe.v1.Recipe\x12X\n" +
+	"\x11GetSimilarRecipes\x12#.recipe.v1.GetSimilarRecipesRequest\x1a\x1e.recipe.v1.ListRecipesResponse\x12O\n" +
+	"\fImportRecipe\x12\x1e.recipe.v1.ImportRecipeRequest\x1a\x1f.recipe.v1.ImportRecipeResponse\x12O\n" +
+	"\fExportRecipe\x12\x1e.recipe.v1.ExportRecipeRequest\x1a\x1f.recipe.v1.ExportRecipeResponse\x12V\n" +
+	"\x13ExportRecipeArchive\x12%.recipe.v1.ExportRecipeArchiveRequest\x1a\x16.recipe.v1.ExportChunk0\x01\x12`\n" +
+	"\x11BulkImportRecipes\x12#.recipe.v1.BulkImportRecipesRequest\x1a$.recipe.v1.BulkImportRecipesResponse(\x01\x12M\n" +
+	"\rExportRecipes\x12\x1f.recipe.v1.ExportRecipesRequest\x1a\x19.recipe.v1.PortableRecipe0\x01\x12L\n" +
+	"\vScaleRecipe\x12\x1d.recipe.v1.ScaleRecipeRequest\x1a\x1e.recipe.v1.ScaleRecipeResponse\x12d\n" +
+	"\x13ListRecipeRevisions\x12%.recipe.v1.ListRecipeRevisionsRequest\x1a&.recipe.v1.ListRecipeRevisionsResponse\x12S\n" +
+	"\x11GetRecipeRevision\x12#.recipe.v1.GetRecipeRevisionRequest\x1a\x19.recipe.v1.RecipeRevision\x12S\n" +
+	"\x13DiffRecipeRevisions\x12%.recipe.v1.DiffRecipeRevisionsRequest\x1a\x15.recipe.v1.RecipeDiff\x12S\n" +
+	"\x15RestoreRecipeRevision\x12'.recipe.v1.RestoreRecipeRevisionRequest\x1a\x11.recipe.v1.Recipe\x12j\n" +
+	"\x15ListIngredientMatches\x12'.recipe.v1.ListIngredientMatchesRequest\x1a(.recipe.v1.ListIngredientMatchesResponse\x12^\n" +
+	"\x16ResolveIngredientMatch\x12(.recipe.v1.ResolveIngredientMatchRequest\x1a\x1a.recipe.v1.IngredientMatch\x12z\n" +
+	"\x1eGetIngredientDietaryAttributes\x120.recipe.v1.GetIngredientDietaryAttributesRequest\x1a&.recipe.v1.IngredientDietaryAttributes\x12z\n" +
+	"\x1eSetIngredientDietaryAttributes\x120.recipe.v1.SetIngredientDietaryAttributesRequest\x1a&.recipe.v1.IngredientDietaryAttributes\x12X\n" +
+	"\x0fListIngredients\x12!.recipe.v1.ListIngredientsRequest\x1a\".recipe.v1.ListIngredientsResponse\x12M\n" +
+	"\x10RenameIngredient\x12\".recipe.v1.RenameIngredientRequest\x1a\x15.recipe.v1.Ingredient\x12W\n" +
+	"\x15SetIngredientCategory\x12'.recipe.v1.SetIngredientCategoryRequest\x1a\x15.recipe.v1.Ingredient\x12s\n" +
+	"\x18ListIngredientCategories\x12*.recipe.v1.ListIngredientCategoriesRequest\x1a+.recipe.v1.ListIngredientCategoriesResponse\x12[\n" +
+	"\x10MergeIngredients\x12\".recipe.v1.MergeIngredientsRequest\x1a#.recipe.v1.MergeIngredientsResponse\x12s\n" +
+	"\x18ListDuplicateIngredients\x12*.recipe.v1.ListDuplicateIngredientsRequest\x1a+.recipe.v1.ListDuplicateIngredientsResponse\x12D\n" +
+	"\vShareRecipe\x12\x1d.recipe.v1.ShareRecipeRequest\x1a\x16.recipe.v1.RecipeShare\x12[\n" +
+	"\x10ListSharedWithMe\x12\".recipe.v1.ListRecipeSharesRequest\x1a#.recipe.v1.ListRecipeSharesResponse\x12Y\n" +
+	"\x0eListSharedByMe\x12\".recipe.v1.ListRecipeSharesRequest\x1a#.recipe.v1.ListRecipeSharesResponse\x12P\n" +
+	"\x11RevokeRecipeShare\x12#.recipe.v1.RevokeRecipeShareRequest\x1a\x16.google.protobuf.Empty\x12X\n" +
+	"\x0fListCollections\x12!.recipe.v1.ListCollectionsRequest\x1a\".recipe.v1.ListCollectionsResponse\x12G\n" +
+	"\rGetCollection\x12\x1f.recipe.v1.GetCollectionRequest\x1a\x15.recipe.v1.Collection\x12M\n" +
+	"\x10CreateCollection\x12\".recipe.v1.CreateCollectionRequest\x1a\x15.recipe.v1.Collection\x12M\n" +
+	"\x10UpdateCollection\x12\".recipe.v1.UpdateCollectionRequest\x1a\x15.recipe.v1.Collection\x12N\n" +
+	"\x10DeleteCollection\x12\".recipe.v1.DeleteCollectionRequest\x1a\x16.google.protobuf.Empty\x12R\n" +
+	"\x15AddRecipeToCollection\x12\".recipe.v1.CollectionRecipeRequest\x1a\x15.recipe.v1.Collection\x12W\n" +
+	"\x1aRemoveRecipeFromCollection\x12\".recipe.v1.CollectionRecipeRequest\x1a\x15.recipe.v1.Collection\x12O\n" +
+	"\x11ReorderCollection\x12#.recipe.v1.ReorderCollectionRequest\x1a\x15.recipe.v1.Collection\x12P\n" +
+	"\x0fShareCollection\x12!.recipe.v1.ShareCollectionRequest\x1a\x1a.recipe.v1.CollectionShare\x12g\n" +
+	"\x14ListCollectionShares\x12&.recipe.v1.ListCollectionSharesRequest\x1a'.recipe.v1.ListCollectionSharesResponse\x12X\n" +
+	"\x15RevokeCollectionShare\x12'.recipe.v1.RevokeCollectionShareRequest\x1a\x16.google.protobuf.Empty\x12=\n" +
+	"\aLogCook\x12\x19.recipe.v1.LogCookRequest\x1a\x17.recipe.v1.CookLogEntry\x12L\n" +
+	"\vListCookLog\x12\x1d.recipe.v1.ListCookLogRequest\x1a\x1e.recipe.v1.ListCookLogResponse\x12S\n" +
+	"\x12UpdateCookLogEntry\x12$.recipe.v1.UpdateCookLogEntryRequest\x1a\x17.recipe.v1.CookLogEntry\x12R\n" +
+	"\x12DeleteCookLogEntry\x12$.recipe.v1.DeleteCookLogEntryRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x10StartCookSession\x12\".recipe.v1.StartCookSessionRequest\x1a\x16.recipe.v1.CookSession\x12G\n" +
+	"\x0eGetCookSession\x12\x1d.recipe.v1.CookSessionRequest\x1a\x16.recipe.v1.CookSession\x12[\n" +
+	"\x10ListCookSessions\x12\".recipe.v1.ListCookSessionsRequest\x1a#.recipe.v1.ListCookSessionsResponse\x12T\n" +
+	"\x13MoveCookSessionStep\x12%.recipe.v1.MoveCookSessionStepRequest\x1a\x16.recipe.v1.CookSession\x12E\n" +
+	"\x0eStartCookTimer\x12\x1b.recipe.v1.CookTimerRequest\x1a\x16.recipe.v1.CookSession\x12E\n" +
+	"\x0ePauseCookTimer\x12\x1b.recipe.v1.CookTimerRequest\x1a\x16.recipe.v1.CookSession\x12T\n" +
+	"\x13CompleteCookSession\x12%.recipe.v1.CompleteCookSessionRequest\x1a\x16.recipe.v1.CookSession\x12J\n" +
+	"\x11DeleteCookSession\x12\x1d.recipe.v1.CookSessionRequest\x1a\x16.google.protobuf.Empty\x12p\n" +
+	"\x17ListRecipeSubstitutions\x12).recipe.v1.ListRecipeSubstitutionsRequest\x1a*.recipe.v1.ListRecipeSubstitutionsResponse\x12I\n" +
+	"\x10SubstituteRecipe\x12\".recipe.v1.SubstituteRecipeRequest\x1a\x11.recipe.v1.Recipe\x12S\n" +
+	"\x11GetDietaryProfile\x12#.recipe.v1.GetDietaryProfileRequest\x1a\x19.recipe.v1.DietaryProfile\x12Y\n" +
+	"\x14UpdateDietaryProfile\x12&.recipe.v1.UpdateDietaryProfileRequest\x1a\x19.recipe.v1.DietaryProfile\x12R\n" +
+	"\rListAllergies\x12\x1f.recipe.v1.ListAllergiesRequest\x1a .recipe.v1.ListAllergiesResponse\x12L\n" +
 	"\vGetCuisines\x12\x1d.recipe.v1.GetCuisinesRequest\x1a\x1e.recipe.v1.GetCuisinesResponse\x12D\n" +
-	"\rCreateCuisine\x12\x1f.recipe.v1.CreateCuisineRequest\x1a\x12.recipe.v1.CuisineB2Z0github.com/platepilot/backend/internal/recipe/pbb\x06proto3"
+	"\rCreateCuisine\x12\x1f.recipe.v1.CreateCuisineRequest\x1a\x12.recipe.v1.Cuisine\x12D\n" +
+	"\rUpdateCuisine\x12\x1f.recipe.v1.UpdateCuisineRequest\x1a\x12.recipe.v1.Cuisine\x12R\n" +
+	"\rDeleteCuisine\x12\x1f.recipe.v1.DeleteCuisineRequest\x1a .recipe.v1.DeleteCuisineResponse\x12R\n" +
+	"\rMergeCuisines\x12\x1f.recipe.v1.MergeCuisinesRequest\x1a .recipe.v1.MergeCuisinesResponse\x12C\n" +
+	"\bListTags\x12\x1a.recipe.v1.ListTagsRequest\x1a\x1b.recipe.v1.ListTagsResponse\x12F\n" +
+	"\tRenameTag\x12\x1b.recipe.v1.RenameTagRequest\x1a\x1c.recipe.v1.TagChangeResponse\x12F\n" +
+	"\tMergeTags\x12\x1b.recipe.v1.MergeTagsRequest\x1a\x1c.recipe.v1.TagChangeResponse\x12F\n" +
+	"\tDeleteTag\x12\x1b.recipe.v1.DeleteTagRequest\x1a\x1c.recipe.v1.TagChangeResponse\x12<\n" +
+	"\vSetTagGroup\x12\x1d.recipe.v1.SetTagGroupRequest\x1a\x0e.recipe.v1.TagB2Z0github.com/platepilot/backend/internal/recipe/pbb\x06proto3"
 
 var (
 	file_recipe_v1_recipe_proto_rawDescOnce sync.Once
@@ -1673,74 +9633,377 @@ func file_recipe_v1_recipe_proto_rawDescGZIP() []byte {
 	return file_recipe_v1_recipe_proto_rawDescData
 }
 
-var file_recipe_v1_recipe_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_recipe_v1_recipe_proto_msgTypes = make([]protoimpl.MessageInfo, 134)
 var file_recipe_v1_recipe_proto_goTypes = []any{
-	(*GetRecipeRequest)(nil),         // 0: recipe.v1.GetRecipeRequest
-	(*ListRecipesRequest)(nil),       // 1: recipe.v1.ListRecipesRequest
-	(*ListRecipesResponse)(nil),      // 2: recipe.v1.ListRecipesResponse
-	(*CreateRecipeRequest)(nil),      // 3: recipe.v1.CreateRecipeRequest
-	(*UpdateRecipeRequest)(nil),      // 4: recipe.v1.UpdateRecipeRequest
-	(*DeleteRecipeRequest)(nil),      // 5: recipe.v1.DeleteRecipeRequest
-	(*GetSimilarRecipesRequest)(nil), // 6: recipe.v1.GetSimilarRecipesRequest
-	(*Recipe)(nil),                   // 7: recipe.v1.Recipe
-	(*RecipeInput)(nil),              // 8: recipe.v1.RecipeInput
-	(*IngredientRef)(nil),            // 9: recipe.v1.IngredientRef
-	(*IngredientLine)(nil),           // 10: recipe.v1.IngredientLine
-	(*IngredientLineInput)(nil),      // 11: recipe.v1.IngredientLineInput
-	(*RecipeStep)(nil),               // 12: recipe.v1.RecipeStep
-	(*RecipeStepInput)(nil),          // 13: recipe.v1.RecipeStepInput
-	(*RecipeNutrition)(nil),          // 14: recipe.v1.RecipeNutrition
-	(*Cuisine)(nil),                  // 15: recipe.v1.Cuisine
-	(*GetCuisinesRequest)(nil),       // 16: recipe.v1.GetCuisinesRequest
-	(*GetCuisinesResponse)(nil),      // 17: recipe.v1.GetCuisinesResponse
-	(*CreateCuisineRequest)(nil),     // 18: recipe.v1.CreateCuisineRequest
-	(*wrapperspb.DoubleValue)(nil),   // 19: google.protobuf.DoubleValue
-	(*wrapperspb.Int32Value)(nil),    // 20: google.protobuf.Int32Value
-	(*emptypb.Empty)(nil),            // 21: google.protobuf.Empty
+	(*GetRecipeRequest)(nil),                      // 0: recipe.v1.GetRecipeRequest
+	(*ListRecipesRequest)(nil),                    // 1: recipe.v1.ListRecipesRequest
+	(*ListRecipesResponse)(nil),                   // 2: recipe.v1.ListRecipesResponse
+	(*CreateRecipeRequest)(nil),                   // 3: recipe.v1.CreateRecipeRequest
+	(*UpdateRecipeRequest)(nil),                   // 4: recipe.v1.UpdateRecipeRequest
+	(*DeleteRecipeRequest)(nil),                   // 5: recipe.v1.DeleteRecipeRequest
+	(*ListDeletedRecipesRequest)(nil),             // 6: recipe.v1.ListDeletedRecipesRequest
+	(*RestoreRecipeRequest)(nil),                  // 7: recipe.v1.RestoreRecipeRequest
+	(*PurgeRecipeRequest)(nil),                    // 8: recipe.v1.PurgeRecipeRequest
+	(*SetRecipeImageRequest)(nil),                 // 9: recipe.v1.SetRecipeImageRequest
+	(*DuplicateRecipeRequest)(nil),                // 10: recipe.v1.DuplicateRecipeRequest
+	(*PullUpstreamRecipeRequest)(nil),             // 11: recipe.v1.PullUpstreamRecipeRequest
+	(*GetSimilarRecipesRequest)(nil),              // 12: recipe.v1.GetSimilarRecipesRequest
+	(*ImportRecipeRequest)(nil),                   // 13: recipe.v1.ImportRecipeRequest
+	(*ImportRecipeResponse)(nil),                  // 14: recipe.v1.ImportRecipeResponse
+	(*ExportRecipeRequest)(nil),                   // 15: recipe.v1.ExportRecipeRequest
+	(*ExportRecipeResponse)(nil),                  // 16: recipe.v1.ExportRecipeResponse
+	(*ExportRecipeArchiveRequest)(nil),            // 17: recipe.v1.ExportRecipeArchiveRequest
+	(*ExportChunk)(nil),                           // 18: recipe.v1.ExportChunk
+	(*PortableRecipe)(nil),                        // 19: recipe.v1.PortableRecipe
+	(*BulkImportRecipesRequest)(nil),              // 20: recipe.v1.BulkImportRecipesRequest
+	(*BulkImportOptions)(nil),                     // 21: recipe.v1.BulkImportOptions
+	(*BulkImportResult)(nil),                      // 22: recipe.v1.BulkImportResult
+	(*BulkImportRecipesResponse)(nil),             // 23: recipe.v1.BulkImportRecipesResponse
+	(*ExportRecipesRequest)(nil),                  // 24: recipe.v1.ExportRecipesRequest
+	(*ScaleRecipeRequest)(nil),                    // 25: recipe.v1.ScaleRecipeRequest
+	(*ScaleRecipeResponse)(nil),                   // 26: recipe.v1.ScaleRecipeResponse
+	(*ListRecipeRevisionsRequest)(nil),            // 27: recipe.v1.ListRecipeRevisionsRequest
+	(*ListRecipeRevisionsResponse)(nil),           // 28: recipe.v1.ListRecipeRevisionsResponse
+	(*RecipeRevisionSummary)(nil),                 // 29: recipe.v1.RecipeRevisionSummary
+	(*GetRecipeRevisionRequest)(nil),              // 30: recipe.v1.GetRecipeRevisionRequest
+	(*RecipeRevision)(nil),                        // 31: recipe.v1.RecipeRevision
+	(*DiffRecipeRevisionsRequest)(nil),            // 32: recipe.v1.DiffRecipeRevisionsRequest
+	(*RecipeDiff)(nil),                            // 33: recipe.v1.RecipeDiff
+	(*FieldChange)(nil),                           // 34: recipe.v1.FieldChange
+	(*IngredientLineChange)(nil),                  // 35: recipe.v1.IngredientLineChange
+	(*StepChange)(nil),                            // 36: recipe.v1.StepChange
+	(*RestoreRecipeRevisionRequest)(nil),          // 37: recipe.v1.RestoreRecipeRevisionRequest
+	(*ListIngredientMatchesRequest)(nil),          // 38: recipe.v1.ListIngredientMatchesRequest
+	(*ListIngredientMatchesResponse)(nil),         // 39: recipe.v1.ListIngredientMatchesResponse
+	(*ResolveIngredientMatchRequest)(nil),         // 40: recipe.v1.ResolveIngredientMatchRequest
+	(*GetIngredientDietaryAttributesRequest)(nil), // 41: recipe.v1.GetIngredientDietaryAttributesRequest
+	(*SetIngredientDietaryAttributesRequest)(nil), // 42: recipe.v1.SetIngredientDietaryAttributesRequest
+	(*IngredientDietaryAttributes)(nil),           // 43: recipe.v1.IngredientDietaryAttributes
+	(*ListIngredientsRequest)(nil),                // 44: recipe.v1.ListIngredientsRequest
+	(*ListIngredientsResponse)(nil),               // 45: recipe.v1.ListIngredientsResponse
+	(*RenameIngredientRequest)(nil),               // 46: recipe.v1.RenameIngredientRequest
+	(*SetIngredientCategoryRequest)(nil),          // 47: recipe.v1.SetIngredientCategoryRequest
+	(*ListIngredientCategoriesRequest)(nil),       // 48: recipe.v1.ListIngredientCategoriesRequest
+	(*ListIngredientCategoriesResponse)(nil),      // 49: recipe.v1.ListIngredientCategoriesResponse
+	(*MergeIngredientsRequest)(nil),               // 50: recipe.v1.MergeIngredientsRequest
+	(*MergeIngredientsResponse)(nil),              // 51: recipe.v1.MergeIngredientsResponse
+	(*ListDuplicateIngredientsRequest)(nil),       // 52: recipe.v1.ListDuplicateIngredientsRequest
+	(*ListDuplicateIngredientsResponse)(nil),      // 53: recipe.v1.ListDuplicateIngredientsResponse
+	(*IngredientDuplicate)(nil),                   // 54: recipe.v1.IngredientDuplicate
+	(*Ingredient)(nil),                            // 55: recipe.v1.Ingredient
+	(*IngredientCategory)(nil),                    // 56: recipe.v1.IngredientCategory
+	(*ShareRecipeRequest)(nil),                    // 57: recipe.v1.ShareRecipeRequest
+	(*ListRecipeSharesRequest)(nil),               // 58: recipe.v1.ListRecipeSharesRequest
+	(*ListRecipeSharesResponse)(nil),              // 59: recipe.v1.ListRecipeSharesResponse
+	(*RevokeRecipeShareRequest)(nil),              // 60: recipe.v1.RevokeRecipeShareRequest
+	(*RecipeShare)(nil),                           // 61: recipe.v1.RecipeShare
+	(*Collection)(nil),                            // 62: recipe.v1.Collection
+	(*CollectionItem)(nil),                        // 63: recipe.v1.CollectionItem
+	(*CollectionInput)(nil),                       // 64: recipe.v1.CollectionInput
+	(*ListCollectionsRequest)(nil),                // 65: recipe.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),               // 66: recipe.v1.ListCollectionsResponse
+	(*GetCollectionRequest)(nil),                  // 67: recipe.v1.GetCollectionRequest
+	(*CreateCollectionRequest)(nil),               // 68: recipe.v1.CreateCollectionRequest
+	(*UpdateCollectionRequest)(nil),               // 69: recipe.v1.UpdateCollectionRequest
+	(*DeleteCollectionRequest)(nil),               // 70: recipe.v1.DeleteCollectionRequest
+	(*CollectionRecipeRequest)(nil),               // 71: recipe.v1.CollectionRecipeRequest
+	(*ReorderCollectionRequest)(nil),              // 72: recipe.v1.ReorderCollectionRequest
+	(*ShareCollectionRequest)(nil),                // 73: recipe.v1.ShareCollectionRequest
+	(*ListCollectionSharesRequest)(nil),           // 74: recipe.v1.ListCollectionSharesRequest
+	(*ListCollectionSharesResponse)(nil),          // 75: recipe.v1.ListCollectionSharesResponse
+	(*RevokeCollectionShareRequest)(nil),          // 76: recipe.v1.RevokeCollectionShareRequest
+	(*CookLogEntry)(nil),                          // 77: recipe.v1.CookLogEntry
+	(*CookLogEntryInput)(nil),                     // 78: recipe.v1.CookLogEntryInput
+	(*LogCookRequest)(nil),                        // 79: recipe.v1.LogCookRequest
+	(*ListCookLogRequest)(nil),                    // 80: recipe.v1.ListCookLogRequest
+	(*ListCookLogResponse)(nil),                   // 81: recipe.v1.ListCookLogResponse
+	(*UpdateCookLogEntryRequest)(nil),             // 82: recipe.v1.UpdateCookLogEntryRequest
+	(*DeleteCookLogEntryRequest)(nil),             // 83: recipe.v1.DeleteCookLogEntryRequest
+	(*Substitution)(nil),                          // 84: recipe.v1.Substitution
+	(*ListRecipeSubstitutionsRequest)(nil),        // 85: recipe.v1.ListRecipeSubstitutionsRequest
+	(*ListRecipeSubstitutionsResponse)(nil),       // 86: recipe.v1.ListRecipeSubstitutionsResponse
+	(*IngredientSubstitutions)(nil),               // 87: recipe.v1.IngredientSubstitutions
+	(*SubstituteRecipeRequest)(nil),               // 88: recipe.v1.SubstituteRecipeRequest
+	(*DietaryProfile)(nil),                        // 89: recipe.v1.DietaryProfile
+	(*GetDietaryProfileRequest)(nil),              // 90: recipe.v1.GetDietaryProfileRequest
+	(*UpdateDietaryProfileRequest)(nil),           // 91: recipe.v1.UpdateDietaryProfileRequest
+	(*ListAllergiesRequest)(nil),                  // 92: recipe.v1.ListAllergiesRequest
+	(*ListAllergiesResponse)(nil),                 // 93: recipe.v1.ListAllergiesResponse
+	(*CookSession)(nil),                           // 94: recipe.v1.CookSession
+	(*CookTimer)(nil),                             // 95: recipe.v1.CookTimer
+	(*StartCookSessionRequest)(nil),               // 96: recipe.v1.StartCookSessionRequest
+	(*CookSessionRequest)(nil),                    // 97: recipe.v1.CookSessionRequest
+	(*ListCookSessionsRequest)(nil),               // 98: recipe.v1.ListCookSessionsRequest
+	(*ListCookSessionsResponse)(nil),              // 99: recipe.v1.ListCookSessionsResponse
+	(*MoveCookSessionStepRequest)(nil),            // 100: recipe.v1.MoveCookSessionStepRequest
+	(*CookTimerRequest)(nil),                      // 101: recipe.v1.CookTimerRequest
+	(*CompleteCookSessionRequest)(nil),            // 102: recipe.v1.CompleteCookSessionRequest
+	(*CollectionShare)(nil),                       // 103: recipe.v1.CollectionShare
+	(*IngredientMatch)(nil),                       // 104: recipe.v1.IngredientMatch
+	(*NutritionFood)(nil),                         // 105: recipe.v1.NutritionFood
+	(*Recipe)(nil),                                // 106: recipe.v1.Recipe
+	(*Allergy)(nil),                               // 107: recipe.v1.Allergy
+	(*RecipeFork)(nil),                            // 108: recipe.v1.RecipeFork
+	(*RecipeInput)(nil),                           // 109: recipe.v1.RecipeInput
+	(*IngredientRef)(nil),                         // 110: recipe.v1.IngredientRef
+	(*IngredientLine)(nil),                        // 111: recipe.v1.IngredientLine
+	(*IngredientLineInput)(nil),                   // 112: recipe.v1.IngredientLineInput
+	(*RecipeStep)(nil),                            // 113: recipe.v1.RecipeStep
+	(*RecipeStepInput)(nil),                       // 114: recipe.v1.RecipeStepInput
+	(*RecipeNutrition)(nil),                       // 115: recipe.v1.RecipeNutrition
+	(*RecipeCookStats)(nil),                       // 116: recipe.v1.RecipeCookStats
+	(*Cuisine)(nil),                               // 117: recipe.v1.Cuisine
+	(*GetCuisinesRequest)(nil),                    // 118: recipe.v1.GetCuisinesRequest
+	(*GetCuisinesResponse)(nil),                   // 119: recipe.v1.GetCuisinesResponse
+	(*CreateCuisineRequest)(nil),                  // 120: recipe.v1.CreateCuisineRequest
+	(*UpdateCuisineRequest)(nil),                  // 121: recipe.v1.UpdateCuisineRequest
+	(*DeleteCuisineRequest)(nil),                  // 122: recipe.v1.DeleteCuisineRequest
+	(*DeleteCuisineResponse)(nil),                 // 123: recipe.v1.DeleteCuisineResponse
+	(*MergeCuisinesRequest)(nil),                  // 124: recipe.v1.MergeCuisinesRequest
+	(*MergeCuisinesResponse)(nil),                 // 125: recipe.v1.MergeCuisinesResponse
+	(*Tag)(nil),                                   // 126: recipe.v1.Tag
+	(*ListTagsRequest)(nil),                       // 127: recipe.v1.ListTagsRequest
+	(*ListTagsResponse)(nil),                      // 128: recipe.v1.ListTagsResponse
+	(*RenameTagRequest)(nil),                      // 129: recipe.v1.RenameTagRequest
+	(*MergeTagsRequest)(nil),                      // 130: recipe.v1.MergeTagsRequest
+	(*DeleteTagRequest)(nil),                      // 131: recipe.v1.DeleteTagRequest
+	(*TagChangeResponse)(nil),                     // 132: recipe.v1.TagChangeResponse
+	(*SetTagGroupRequest)(nil),                    // 133: recipe.v1.SetTagGroupRequest
+	(*wrapperspb.DoubleValue)(nil),                // 134: google.protobuf.DoubleValue
+	(*wrapperspb.Int32Value)(nil),                 // 135: google.protobuf.Int32Value
+	(*emptypb.Empty)(nil),                         // 136: google.protobuf.Empty
 }
 var file_recipe_v1_recipe_proto_depIdxs = []int32{
-	7,  // 0: recipe.v1.ListRecipesResponse.recipes:type_name -> recipe.v1.Recipe
-	8,  // 1: recipe.v1.CreateRecipeRequest.recipe:type_name -> recipe.v1.RecipeInput
-	8,  // 2: recipe.v1.UpdateRecipeRequest.recipe:type_name -> recipe.v1.RecipeInput
-	19, // 3: recipe.v1.Recipe.yield_quantity:type_name -> google.protobuf.DoubleValue
-	9,  // 4: recipe.v1.Recipe.main_ingredient:type_name -> recipe.v1.IngredientRef
-	15, // 5: recipe.v1.Recipe.cuisine:type_name -> recipe.v1.Cuisine
-	10, // 6: recipe.v1.Recipe.ingredient_lines:type_name -> recipe.v1.IngredientLine
-	12, // 7: recipe.v1.Recipe.steps:type_name -> recipe.v1.RecipeStep
-	14, // 8: recipe.v1.Recipe.nutrition:type_name -> recipe.v1.RecipeNutrition
-	19, // 9: recipe.v1.RecipeInput.yield_quantity:type_name -> google.protobuf.DoubleValue
-	11, // 10: recipe.v1.RecipeInput.ingredient_lines:type_name -> recipe.v1.IngredientLineInput
-	13, // 11: recipe.v1.RecipeInput.steps:type_name -> recipe.v1.RecipeStepInput
-	14, // 12: recipe.v1.RecipeInput.nutrition:type_name -> recipe.v1.RecipeNutrition
-	9,  // 13: recipe.v1.IngredientLine.ingredient:type_name -> recipe.v1.IngredientRef
-	19, // 14: recipe.v1.IngredientLine.quantity_value:type_name -> google.protobuf.DoubleValue
-	19, // 15: recipe.v1.IngredientLineInput.quantity_value:type_name -> google.protobuf.DoubleValue
-	20, // 16: recipe.v1.RecipeStep.duration_seconds:type_name -> google.protobuf.Int32Value
-	19, // 17: recipe.v1.RecipeStep.temperature_value:type_name -> google.protobuf.DoubleValue
-	20, // 18: recipe.v1.RecipeStepInput.duration_seconds:type_name -> google.protobuf.Int32Value
-	19, // 19: recipe.v1.RecipeStepInput.temperature_value:type_name -> google.protobuf.DoubleValue
-	15, // 20: recipe.v1.GetCuisinesResponse.cuisines:type_name -> recipe.v1.Cuisine
-	0,  // 21: recipe.v1.RecipeService.GetRecipe:input_type -> recipe.v1.GetRecipeRequest
-	1,  // 22: recipe.v1.RecipeService.ListRecipes:input_type -> recipe.v1.ListRecipesRequest
-	3,  // 23: recipe.v1.RecipeService.CreateRecipe:input_type -> recipe.v1.CreateRecipeRequest
-	4,  // 24: recipe.v1.RecipeService.UpdateRecipe:input_type -> recipe.v1.UpdateRecipeRequest
-	5,  // 25: recipe.v1.RecipeService.DeleteRecipe:input_type -> recipe.v1.DeleteRecipeRequest
-	6,  // 26: recipe.v1.RecipeService.GetSimilarRecipes:input_type -> recipe.v1.GetSimilarRecipesRequest
-	16, // 27: recipe.v1.RecipeService.GetCuisines:input_type -> recipe.v1.GetCuisinesRequest
-	18, // 28: recipe.v1.RecipeService.CreateCuisine:input_type -> recipe.v1.CreateCuisineRequest
-	7,  // 29: recipe.v1.RecipeService.GetRecipe:output_type -> recipe.v1.Recipe
-	2,  // 30: recipe.v1.RecipeService.ListRecipes:output_type -> recipe.v1.ListRecipesResponse
-	7,  // 31: recipe.v1.RecipeService.CreateRecipe:output_type -> recipe.v1.Recipe
-	7,  // 32: recipe.v1.RecipeService.UpdateRecipe:output_type -> recipe.v1.Recipe
-	21, // 33: recipe.v1.RecipeService.DeleteRecipe:output_type -> google.protobuf.Empty
-	2,  // 34: recipe.v1.RecipeService.GetSimilarRecipes:output_type -> recipe.v1.ListRecipesResponse
-	17, // 35: recipe.v1.RecipeService.GetCuisines:output_type -> recipe.v1.GetCuisinesResponse
-	15, // 36: recipe.v1.RecipeService.CreateCuisine:output_type -> recipe.v1.Cuisine
-	29, // [29:37] is the sub-list for method output_type
-	21, // [21:29] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	134, // 0: recipe.v1.ListRecipesRequest.min_rating:type_name -> google.protobuf.DoubleValue
+	106, // 1: recipe.v1.ListRecipesResponse.recipes:type_name -> recipe.v1.Recipe
+	109, // 2: recipe.v1.CreateRecipeRequest.recipe:type_name -> recipe.v1.RecipeInput
+	109, // 3: recipe.v1.UpdateRecipeRequest.recipe:type_name -> recipe.v1.RecipeInput
+	109, // 4: recipe.v1.ImportRecipeResponse.draft:type_name -> recipe.v1.RecipeInput
+	106, // 5: recipe.v1.ImportRecipeResponse.recipe:type_name -> recipe.v1.Recipe
+	109, // 6: recipe.v1.PortableRecipe.recipe:type_name -> recipe.v1.RecipeInput
+	21,  // 7: recipe.v1.BulkImportRecipesRequest.options:type_name -> recipe.v1.BulkImportOptions
+	19,  // 8: recipe.v1.BulkImportRecipesRequest.recipe:type_name -> recipe.v1.PortableRecipe
+	22,  // 9: recipe.v1.BulkImportRecipesResponse.results:type_name -> recipe.v1.BulkImportResult
+	106, // 10: recipe.v1.ScaleRecipeResponse.recipe:type_name -> recipe.v1.Recipe
+	29,  // 11: recipe.v1.ListRecipeRevisionsResponse.revisions:type_name -> recipe.v1.RecipeRevisionSummary
+	106, // 12: recipe.v1.RecipeRevision.recipe:type_name -> recipe.v1.Recipe
+	34,  // 13: recipe.v1.RecipeDiff.fields:type_name -> recipe.v1.FieldChange
+	35,  // 14: recipe.v1.RecipeDiff.ingredient_lines:type_name -> recipe.v1.IngredientLineChange
+	36,  // 15: recipe.v1.RecipeDiff.steps:type_name -> recipe.v1.StepChange
+	111, // 16: recipe.v1.IngredientLineChange.from:type_name -> recipe.v1.IngredientLine
+	111, // 17: recipe.v1.IngredientLineChange.to:type_name -> recipe.v1.IngredientLine
+	113, // 18: recipe.v1.StepChange.from:type_name -> recipe.v1.RecipeStep
+	113, // 19: recipe.v1.StepChange.to:type_name -> recipe.v1.RecipeStep
+	104, // 20: recipe.v1.ListIngredientMatchesResponse.matches:type_name -> recipe.v1.IngredientMatch
+	110, // 21: recipe.v1.IngredientDietaryAttributes.ingredient:type_name -> recipe.v1.IngredientRef
+	55,  // 22: recipe.v1.ListIngredientsResponse.ingredients:type_name -> recipe.v1.Ingredient
+	56,  // 23: recipe.v1.ListIngredientCategoriesResponse.categories:type_name -> recipe.v1.IngredientCategory
+	55,  // 24: recipe.v1.MergeIngredientsResponse.ingredient:type_name -> recipe.v1.Ingredient
+	54,  // 25: recipe.v1.ListDuplicateIngredientsResponse.duplicates:type_name -> recipe.v1.IngredientDuplicate
+	55,  // 26: recipe.v1.IngredientDuplicate.ingredient:type_name -> recipe.v1.Ingredient
+	55,  // 27: recipe.v1.IngredientDuplicate.duplicate:type_name -> recipe.v1.Ingredient
+	56,  // 28: recipe.v1.Ingredient.category:type_name -> recipe.v1.IngredientCategory
+	107, // 29: recipe.v1.Ingredient.allergies:type_name -> recipe.v1.Allergy
+	61,  // 30: recipe.v1.ListRecipeSharesResponse.shares:type_name -> recipe.v1.RecipeShare
+	63,  // 31: recipe.v1.Collection.items:type_name -> recipe.v1.CollectionItem
+	62,  // 32: recipe.v1.ListCollectionsResponse.collections:type_name -> recipe.v1.Collection
+	64,  // 33: recipe.v1.CreateCollectionRequest.collection:type_name -> recipe.v1.CollectionInput
+	64,  // 34: recipe.v1.UpdateCollectionRequest.collection:type_name -> recipe.v1.CollectionInput
+	103, // 35: recipe.v1.ListCollectionSharesResponse.shares:type_name -> recipe.v1.CollectionShare
+	78,  // 36: recipe.v1.LogCookRequest.entry:type_name -> recipe.v1.CookLogEntryInput
+	77,  // 37: recipe.v1.ListCookLogResponse.entries:type_name -> recipe.v1.CookLogEntry
+	116, // 38: recipe.v1.ListCookLogResponse.stats:type_name -> recipe.v1.RecipeCookStats
+	78,  // 39: recipe.v1.UpdateCookLogEntryRequest.entry:type_name -> recipe.v1.CookLogEntryInput
+	107, // 40: recipe.v1.Substitution.avoids_allergy:type_name -> recipe.v1.Allergy
+	87,  // 41: recipe.v1.ListRecipeSubstitutionsResponse.ingredients:type_name -> recipe.v1.IngredientSubstitutions
+	110, // 42: recipe.v1.IngredientSubstitutions.ingredient:type_name -> recipe.v1.IngredientRef
+	84,  // 43: recipe.v1.IngredientSubstitutions.substitutions:type_name -> recipe.v1.Substitution
+	107, // 44: recipe.v1.DietaryProfile.allergies:type_name -> recipe.v1.Allergy
+	107, // 45: recipe.v1.ListAllergiesResponse.allergies:type_name -> recipe.v1.Allergy
+	95,  // 46: recipe.v1.CookSession.timers:type_name -> recipe.v1.CookTimer
+	106, // 47: recipe.v1.CookSession.recipe:type_name -> recipe.v1.Recipe
+	94,  // 48: recipe.v1.ListCookSessionsResponse.sessions:type_name -> recipe.v1.CookSession
+	78,  // 49: recipe.v1.CompleteCookSessionRequest.entry:type_name -> recipe.v1.CookLogEntryInput
+	110, // 50: recipe.v1.IngredientMatch.ingredient:type_name -> recipe.v1.IngredientRef
+	105, // 51: recipe.v1.IngredientMatch.food:type_name -> recipe.v1.NutritionFood
+	105, // 52: recipe.v1.IngredientMatch.candidates:type_name -> recipe.v1.NutritionFood
+	134, // 53: recipe.v1.Recipe.yield_quantity:type_name -> google.protobuf.DoubleValue
+	110, // 54: recipe.v1.Recipe.main_ingredient:type_name -> recipe.v1.IngredientRef
+	117, // 55: recipe.v1.Recipe.cuisine:type_name -> recipe.v1.Cuisine
+	111, // 56: recipe.v1.Recipe.ingredient_lines:type_name -> recipe.v1.IngredientLine
+	113, // 57: recipe.v1.Recipe.steps:type_name -> recipe.v1.RecipeStep
+	115, // 58: recipe.v1.Recipe.nutrition:type_name -> recipe.v1.RecipeNutrition
+	116, // 59: recipe.v1.Recipe.cook_stats:type_name -> recipe.v1.RecipeCookStats
+	108, // 60: recipe.v1.Recipe.forked_from:type_name -> recipe.v1.RecipeFork
+	107, // 61: recipe.v1.Recipe.allergies:type_name -> recipe.v1.Allergy
+	134, // 62: recipe.v1.RecipeInput.yield_quantity:type_name -> google.protobuf.DoubleValue
+	112, // 63: recipe.v1.RecipeInput.ingredient_lines:type_name -> recipe.v1.IngredientLineInput
+	114, // 64: recipe.v1.RecipeInput.steps:type_name -> recipe.v1.RecipeStepInput
+	115, // 65: recipe.v1.RecipeInput.nutrition:type_name -> recipe.v1.RecipeNutrition
+	110, // 66: recipe.v1.IngredientLine.ingredient:type_name -> recipe.v1.IngredientRef
+	134, // 67: recipe.v1.IngredientLine.quantity_value:type_name -> google.protobuf.DoubleValue
+	134, // 68: recipe.v1.IngredientLineInput.quantity_value:type_name -> google.protobuf.DoubleValue
+	135, // 69: recipe.v1.RecipeStep.duration_seconds:type_name -> google.protobuf.Int32Value
+	134, // 70: recipe.v1.RecipeStep.temperature_value:type_name -> google.protobuf.DoubleValue
+	135, // 71: recipe.v1.RecipeStepInput.duration_seconds:type_name -> google.protobuf.Int32Value
+	134, // 72: recipe.v1.RecipeStepInput.temperature_value:type_name -> google.protobuf.DoubleValue
+	134, // 73: recipe.v1.RecipeCookStats.average_rating:type_name -> google.protobuf.DoubleValue
+	117, // 74: recipe.v1.GetCuisinesResponse.cuisines:type_name -> recipe.v1.Cuisine
+	117, // 75: recipe.v1.MergeCuisinesResponse.cuisine:type_name -> recipe.v1.Cuisine
+	126, // 76: recipe.v1.ListTagsResponse.tags:type_name -> recipe.v1.Tag
+	0,   // 77: recipe.v1.RecipeService.GetRecipe:input_type -> recipe.v1.GetRecipeRequest
+	1,   // 78: recipe.v1.RecipeService.ListRecipes:input_type -> recipe.v1.ListRecipesRequest
+	3,   // 79: recipe.v1.RecipeService.CreateRecipe:input_type -> recipe.v1.CreateRecipeRequest
+	4,   // 80: recipe.v1.RecipeService.UpdateRecipe:input_type -> recipe.v1.UpdateRecipeRequest
+	5,   // 81: recipe.v1.RecipeService.DeleteRecipe:input_type -> recipe.v1.DeleteRecipeRequest
+	6,   // 82: recipe.v1.RecipeService.ListDeletedRecipes:input_type -> recipe.v1.ListDeletedRecipesRequest
+	7,   // 83: recipe.v1.RecipeService.RestoreRecipe:input_type -> recipe.v1.RestoreRecipeRequest
+	8,   // 84: recipe.v1.RecipeService.PurgeRecipe:input_type -> recipe.v1.PurgeRecipeRequest
+	10,  // 85: recipe.v1.RecipeService.DuplicateRecipe:input_type -> recipe.v1.DuplicateRecipeRequest
+	11,  // 86: recipe.v1.RecipeService.PullUpstreamRecipe:input_type -> recipe.v1.PullUpstreamRecipeRequest
+	9,   // 87: recipe.v1.RecipeService.SetRecipeImage:input_type -> recipe.v1.SetRecipeImageRequest
+	12,  // 88: recipe.v1.RecipeService.GetSimilarRecipes:input_type -> recipe.v1.GetSimilarRecipesRequest
+	13,  // 89: recipe.v1.RecipeService.ImportRecipe:input_type -> recipe.v1.ImportRecipeRequest
+	15,  // 90: recipe.v1.RecipeService.ExportRecipe:input_type -> recipe.v1.ExportRecipeRequest
+	17,  // 91: recipe.v1.RecipeService.ExportRecipeArchive:input_type -> recipe.v1.ExportRecipeArchiveRequest
+	20,  // 92: recipe.v1.RecipeService.BulkImportRecipes:input_type -> recipe.v1.BulkImportRecipesRequest
+	24,  // 93: recipe.v1.RecipeService.ExportRecipes:input_type -> recipe.v1.ExportRecipesRequest
+	25,  // 94: recipe.v1.RecipeService.ScaleRecipe:input_type -> recipe.v1.ScaleRecipeRequest
+	27,  // 95: recipe.v1.RecipeService.ListRecipeRevisions:input_type -> recipe.v1.ListRecipeRevisionsRequest
+	30,  // 96: recipe.v1.RecipeService.GetRecipeRevision:input_type -> recipe.v1.GetRecipeRevisionRequest
+	32,  // 97: recipe.v1.RecipeService.DiffRecipeRevisions:input_type -> recipe.v1.DiffRecipeRevisionsRequest
+	37,  // 98: recipe.v1.RecipeService.RestoreRecipeRevision:input_type -> recipe.v1.RestoreRecipeRevisionRequest
+	38,  // 99: recipe.v1.RecipeService.ListIngredientMatches:input_type -> recipe.v1.ListIngredientMatchesRequest
+	40,  // 100: recipe.v1.RecipeService.ResolveIngredientMatch:input_type -> recipe.v1.ResolveIngredientMatchRequest
+	41,  // 101: recipe.v1.RecipeService.GetIngredientDietaryAttributes:input_type -> recipe.v1.GetIngredientDietaryAttributesRequest
+	42,  // 102: recipe.v1.RecipeService.SetIngredientDietaryAttributes:input_type -> recipe.v1.SetIngredientDietaryAttributesRequest
+	44,  // 103: recipe.v1.RecipeService.ListIngredients:input_type -> recipe.v1.ListIngredientsRequest
+	46,  // 104: recipe.v1.RecipeService.RenameIngredient:input_type -> recipe.v1.RenameIngredientRequest
+	47,  // 105: recipe.v1.RecipeService.SetIngredientCategory:input_type -> recipe.v1.SetIngredientCategoryRequest
+	48,  // 106: recipe.v1.RecipeService.ListIngredientCategories:input_type -> recipe.v1.ListIngredientCategoriesRequest
+	50,  // 107: recipe.v1.RecipeService.MergeIngredients:input_type -> recipe.v1.MergeIngredientsRequest
+	52,  // 108: recipe.v1.RecipeService.ListDuplicateIngredients:input_type -> recipe.v1.ListDuplicateIngredientsRequest
+	57,  // 109: recipe.v1.RecipeService.ShareRecipe:input_type -> recipe.v1.ShareRecipeRequest
+	58,  // 110: recipe.v1.RecipeService.ListSharedWithMe:input_type -> recipe.v1.ListRecipeSharesRequest
+	58,  // 111: recipe.v1.RecipeService.ListSharedByMe:input_type -> recipe.v1.ListRecipeSharesRequest
+	60,  // 112: recipe.v1.RecipeService.RevokeRecipeShare:input_type -> recipe.v1.RevokeRecipeShareRequest
+	65,  // 113: recipe.v1.RecipeService.ListCollections:input_type -> recipe.v1.ListCollectionsRequest
+	67,  // 114: recipe.v1.RecipeService.GetCollection:input_type -> recipe.v1.GetCollectionRequest
+	68,  // 115: recipe.v1.RecipeService.CreateCollection:input_type -> recipe.v1.CreateCollectionRequest
+	69,  // 116: recipe.v1.RecipeService.UpdateCollection:input_type -> recipe.v1.UpdateCollectionRequest
+	70,  // 117: recipe.v1.RecipeService.DeleteCollection:input_type -> recipe.v1.DeleteCollectionRequest
+	71,  // 118: recipe.v1.RecipeService.AddRecipeToCollection:input_type -> recipe.v1.CollectionRecipeRequest
+	71,  // 119: recipe.v1.RecipeService.RemoveRecipeFromCollection:input_type -> recipe.v1.CollectionRecipeRequest
+	72,  // 120: recipe.v1.RecipeService.ReorderCollection:input_type -> recipe.v1.ReorderCollectionRequest
+	73,  // 121: recipe.v1.RecipeService.ShareCollection:input_type -> recipe.v1.ShareCollectionRequest
+	74,  // 122: recipe.v1.RecipeService.ListCollectionShares:input_type -> recipe.v1.ListCollectionSharesRequest
+	76,  // 123: recipe.v1.RecipeService.RevokeCollectionShare:input_type -> recipe.v1.RevokeCollectionShareRequest
+	79,  // 124: recipe.v1.RecipeService.LogCook:input_type -> recipe.v1.LogCookRequest
+	80,  // 125: recipe.v1.RecipeService.ListCookLog:input_type -> recipe.v1.ListCookLogRequest
+	82,  // 126: recipe.v1.RecipeService.UpdateCookLogEntry:input_type -> recipe.v1.UpdateCookLogEntryRequest
+	83,  // 127: recipe.v1.RecipeService.DeleteCookLogEntry:input_type -> recipe.v1.DeleteCookLogEntryRequest
+	96,  // 128: recipe.v1.RecipeService.StartCookSession:input_type -> recipe.v1.StartCookSessionRequest
+	97,  // 129: recipe.v1.RecipeService.GetCookSession:input_type -> recipe.v1.CookSessionRequest
+	98,  // 130: recipe.v1.RecipeService.ListCookSessions:input_type -> recipe.v1.ListCookSessionsRequest
+	100, // 131: recipe.v1.RecipeService.MoveCookSessionStep:input_type -> recipe.v1.MoveCookSessionStepRequest
+	101, // 132: recipe.v1.RecipeService.StartCookTimer:input_type -> recipe.v1.CookTimerRequest
+	101, // 133: recipe.v1.RecipeService.PauseCookTimer:input_type -> recipe.v1.CookTimerRequest
+	102, // 134: recipe.v1.RecipeService.CompleteCookSession:input_type -> recipe.v1.CompleteCookSessionRequest
+	97,  // 135: recipe.v1.RecipeService.DeleteCookSession:input_type -> recipe.v1.CookSessionRequest
+	85,  // 136: recipe.v1.RecipeService.ListRecipeSubstitutions:input_type -> recipe.v1.ListRecipeSubstitutionsRequest
+	88,  // 137: recipe.v1.RecipeService.SubstituteRecipe:input_type -> recipe.v1.SubstituteRecipeRequest
+	90,  // 138: recipe.v1.RecipeService.GetDietaryProfile:input_type -> recipe.v1.GetDietaryProfileRequest
+	91,  // 139: recipe.v1.RecipeService.UpdateDietaryProfile:input_type -> recipe.v1.UpdateDietaryProfileRequest
+	92,  // 140: recipe.v1.RecipeService.ListAllergies:input_type -> recipe.v1.ListAllergiesRequest
+	118, // 141: recipe.v1.RecipeService.GetCuisines:input_type -> recipe.v1.GetCuisinesRequest
+	120, // 142: recipe.v1.RecipeService.CreateCuisine:input_type -> recipe.v1.CreateCuisineRequest
+	121, // 143: recipe.v1.RecipeService.UpdateCuisine:input_type -> recipe.v1.UpdateCuisineRequest
+	122, // 144: recipe.v1.RecipeService.DeleteCuisine:input_type -> recipe.v1.DeleteCuisineRequest
+	124, // 145: recipe.v1.RecipeService.MergeCuisines:input_type -> recipe.v1.MergeCuisinesRequest
+	127, // 146: recipe.v1.RecipeService.ListTags:input_type -> recipe.v1.ListTagsRequest
+	129, // 147: recipe.v1.RecipeService.RenameTag:input_type -> recipe.v1.RenameTagRequest
+	130, // 148: recipe.v1.RecipeService.MergeTags:input_type -> recipe.v1.MergeTagsRequest
+	131, // 149: recipe.v1.RecipeService.DeleteTag:input_type -> recipe.v1.DeleteTagRequest
+	133, // 150: recipe.v1.RecipeService.SetTagGroup:input_type -> recipe.v1.SetTagGroupRequest
+	106, // 151: recipe.v1.RecipeService.GetRecipe:output_type -> recipe.v1.Recipe
+	2,   // 152: recipe.v1.RecipeService.ListRecipes:output_type -> recipe.v1.ListRecipesResponse
+	106, // 153: recipe.v1.RecipeService.CreateRecipe:output_type -> recipe.v1.Recipe
+	106, // 154: recipe.v1.RecipeService.UpdateRecipe:output_type -> recipe.v1.Recipe
+	136, // 155: recipe.v1.RecipeService.DeleteRecipe:output_type -> google.protobuf.Empty
+	2,   // 156: recipe.v1.RecipeService.ListDeletedRecipes:output_type -> recipe.v1.ListRecipesResponse
+	106, // 157: recipe.v1.RecipeService.RestoreRecipe:output_type -> recipe.v1.Recipe
+	136, // 158: recipe.v1.RecipeService.PurgeRecipe:output_type -> google.protobuf.Empty
+	106, // 159: recipe.v1.RecipeService.DuplicateRecipe:output_type -> recipe.v1.Recipe
+	106, // 160: recipe.v1.RecipeService.PullUpstreamRecipe:output_type -> recipe.v1.Recipe
+	106, // 161: recipe.v1.RecipeService.SetRecipeImage:output_type -> recipe.v1.Recipe
+	2,   // 162: recipe.v1.RecipeService.GetSimilarRecipes:output_type -> recipe.v1.ListRecipesResponse
+	14,  // 163: recipe.v1.RecipeService.ImportRecipe:output_type -> recipe.v1.ImportRecipeResponse
+	16,  // 164: recipe.v1.RecipeService.ExportRecipe:output_type -> recipe.v1.ExportRecipeResponse
+	18,  // 165: recipe.v1.RecipeService.ExportRecipeArchive:output_type -> recipe.v1.ExportChunk
+	23,  // 166: recipe.v1.RecipeService.BulkImportRecipes:output_type -> recipe.v1.BulkImportRecipesResponse
+	19,  // 167: recipe.v1.RecipeService.ExportRecipes:output_type -> recipe.v1.PortableRecipe
+	26,  // 168: recipe.v1.RecipeService.ScaleRecipe:output_type -> recipe.v1.ScaleRecipeResponse
+	28,  // 169: recipe.v1.RecipeService.ListRecipeRevisions:output_type -> recipe.v1.ListRecipeRevisionsResponse
+	31,  // 170: recipe.v1.RecipeService.GetRecipeRevision:output_type -> recipe.v1.RecipeRevision
+	33,  // 171: recipe.v1.RecipeService.DiffRecipeRevisions:output_type -> recipe.v1.RecipeDiff
+	106, // 172: recipe.v1.RecipeService.RestoreRecipeRevision:output_type -> recipe.v1.Recipe
+	39,  // 173: recipe.v1.RecipeService.ListIngredientMatches:output_type -> recipe.v1.ListIngredientMatchesResponse
+	104, // 174: recipe.v1.RecipeService.ResolveIngredientMatch:output_type -> recipe.v1.IngredientMatch
+	43,  // 175: recipe.v1.RecipeService.GetIngredientDietaryAttributes:output_type -> recipe.v1.IngredientDietaryAttributes
+	43,  // 176: recipe.v1.RecipeService.SetIngredientDietaryAttributes:output_type -> recipe.v1.IngredientDietaryAttributes
+	45,  // 177: recipe.v1.RecipeService.ListIngredients:output_type -> recipe.v1.ListIngredientsResponse
+	55,  // 178: recipe.v1.RecipeService.RenameIngredient:output_type -> recipe.v1.Ingredient
+	55,  // 179: recipe.v1.RecipeService.SetIngredientCategory:output_type -> recipe.v1.Ingredient
+	49,  // 180: recipe.v1.RecipeService.ListIngredientCategories:output_type -> recipe.v1.ListIngredientCategoriesResponse
+	51,  // 181: recipe.v1.RecipeService.MergeIngredients:output_type -> recipe.v1.MergeIngredientsResponse
+	53,  // 182: recipe.v1.RecipeService.ListDuplicateIngredients:output_type -> recipe.v1.ListDuplicateIngredientsResponse
+	61,  // 183: recipe.v1.RecipeService.ShareRecipe:output_type -> recipe.v1.RecipeShare
+	59,  // 184: recipe.v1.RecipeService.ListSharedWithMe:output_type -> recipe.v1.ListRecipeSharesResponse
+	59,  // 185: recipe.v1.RecipeService.ListSharedByMe:output_type -> recipe.v1.ListRecipeSharesResponse
+	136, // 186: recipe.v1.RecipeService.RevokeRecipeShare:output_type -> google.protobuf.Empty
+	66,  // 187: recipe.v1.RecipeService.ListCollections:output_type -> recipe.v1.ListCollectionsResponse
+	62,  // 188: recipe.v1.RecipeService.GetCollection:output_type -> recipe.v1.Collection
+	62,  // 189: recipe.v1.RecipeService.CreateCollection:output_type -> recipe.v1.Collection
+	62,  // 190: recipe.v1.RecipeService.UpdateCollection:output_type -> recipe.v1.Collection
+	136, // 191: recipe.v1.RecipeService.DeleteCollection:output_type -> google.protobuf.Empty
+	62,  // 192: recipe.v1.RecipeService.AddRecipeToCollection:output_type -> recipe.v1.Collection
+	62,  // 193: recipe.v1.RecipeService.RemoveRecipeFromCollection:output_type -> recipe.v1.Collection
+	62,  // 194: recipe.v1.RecipeService.ReorderCollection:output_type -> recipe.v1.Collection
+	103, // 195: recipe.v1.RecipeService.ShareCollection:output_type -> recipe.v1.CollectionShare
+	75,  // 196: recipe.v1.RecipeService.ListCollectionShares:output_type -> recipe.v1.ListCollectionSharesResponse
+	136, // 197: recipe.v1.RecipeService.RevokeCollectionShare:output_type -> google.protobuf.Empty
+	77,  // 198: recipe.v1.RecipeService.LogCook:output_type -> recipe.v1.CookLogEntry
+	81,  // 199: recipe.v1.RecipeService.ListCookLog:output_type -> recipe.v1.ListCookLogResponse
+	77,  // 200: recipe.v1.RecipeService.UpdateCookLogEntry:output_type -> recipe.v1.CookLogEntry
+	136, // 201: recipe.v1.RecipeService.DeleteCookLogEntry:output_type -> google.protobuf.Empty
+	94,  // 202: recipe.v1.RecipeService.StartCookSession:output_type -> recipe.v1.CookSession
+	94,  // 203: recipe.v1.RecipeService.GetCookSession:output_type -> recipe.v1.CookSession
+	99,  // 204: recipe.v1.RecipeService.ListCookSessions:output_type -> recipe.v1.ListCookSessionsResponse
+	94,  // 205: recipe.v1.RecipeService.MoveCookSessionStep:output_type -> recipe.v1.CookSession
+	94,  // 206: recipe.v1.RecipeService.StartCookTimer:output_type -> recipe.v1.CookSession
+	94,  // 207: recipe.v1.RecipeService.PauseCookTimer:output_type -> recipe.v1.CookSession
+	94,  // 208: recipe.v1.RecipeService.CompleteCookSession:output_type -> recipe.v1.CookSession
+	136, // 209: recipe.v1.RecipeService.DeleteCookSession:output_type -> google.protobuf.Empty
+	86,  // 210: recipe.v1.RecipeService.ListRecipeSubstitutions:output_type -> recipe.v1.ListRecipeSubstitutionsResponse
+	106, // 211: recipe.v1.RecipeService.SubstituteRecipe:output_type -> recipe.v1.Recipe
+	89,  // 212: recipe.v1.RecipeService.GetDietaryProfile:output_type -> recipe.v1.DietaryProfile
+	89,  // 213: recipe.v1.RecipeService.UpdateDietaryProfile:output_type -> recipe.v1.DietaryProfile
+	93,  // 214: recipe.v1.RecipeService.ListAllergies:output_type -> recipe.v1.ListAllergiesResponse
+	119, // 215: recipe.v1.RecipeService.GetCuisines:output_type -> recipe.v1.GetCuisinesResponse
+	117, // 216: recipe.v1.RecipeService.CreateCuisine:output_type -> recipe.v1.Cuisine
+	117, // 217: recipe.v1.RecipeService.UpdateCuisine:output_type -> recipe.v1.Cuisine
+	123, // 218: recipe.v1.RecipeService.DeleteCuisine:output_type -> recipe.v1.DeleteCuisineResponse
+	125, // 219: recipe.v1.RecipeService.MergeCuisines:output_type -> recipe.v1.MergeCuisinesResponse
+	128, // 220: recipe.v1.RecipeService.ListTags:output_type -> recipe.v1.ListTagsResponse
+	132, // 221: recipe.v1.RecipeService.RenameTag:output_type -> recipe.v1.TagChangeResponse
+	132, // 222: recipe.v1.RecipeService.MergeTags:output_type -> recipe.v1.TagChangeResponse
+	132, // 223: recipe.v1.RecipeService.DeleteTag:output_type -> recipe.v1.TagChangeResponse
+	126, // 224: recipe.v1.RecipeService.SetTagGroup:output_type -> recipe.v1.Tag
+	151, // [151:225] is the sub-list for method output_type
+	77,  // [77:151] is the sub-list for method input_type
+	77,  // [77:77] is the sub-list for extension type_name
+	77,  // [77:77] is the sub-list for extension extendee
+	0,   // [0:77] is the sub-list for field type_name
 }
 
 func init() { file_recipe_v1_recipe_proto_init() }
@@ -1748,13 +10011,17 @@ func file_recipe_v1_recipe_proto_init() {
 	if File_recipe_v1_recipe_proto != nil {
 		return
 	}
+	file_recipe_v1_recipe_proto_msgTypes[20].OneofWrappers = []any{
+		(*BulkImportRecipesRequest_Options)(nil),
+		(*BulkImportRecipesRequest_Recipe)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_recipe_v1_recipe_proto_rawDesc), len(file_recipe_v1_recipe_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   134,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
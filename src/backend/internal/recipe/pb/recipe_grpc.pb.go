@@ -20,14 +20,80 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RecipeService_GetRecipe_FullMethodName         = "/recipe.v1.RecipeService/GetRecipe"
-	RecipeService_ListRecipes_FullMethodName       = "/recipe.v1.RecipeService/ListRecipes"
-	RecipeService_CreateRecipe_FullMethodName      = "/recipe.v1.RecipeService/CreateRecipe"
-	RecipeService_UpdateRecipe_FullMethodName      = "/recipe.v1.RecipeService/UpdateRecipe"
-	RecipeService_DeleteRecipe_FullMethodName      = "/recipe.v1.RecipeService/DeleteRecipe"
-	RecipeService_GetSimilarRecipes_FullMethodName = "/recipe.v1.RecipeService/GetSimilarRecipes"
-	RecipeService_GetCuisines_FullMethodName       = "/recipe.v1.RecipeService/GetCuisines"
-	RecipeService_CreateCuisine_FullMethodName     = "/recipe.v1.RecipeService/CreateCuisine"
+	RecipeService_GetRecipe_FullMethodName                      = "/recipe.v1.RecipeService/GetRecipe"
+	RecipeService_ListRecipes_FullMethodName                    = "/recipe.v1.RecipeService/ListRecipes"
+	RecipeService_CreateRecipe_FullMethodName                   = "/recipe.v1.RecipeService/CreateRecipe"
+	RecipeService_UpdateRecipe_FullMethodName                   = "/recipe.v1.RecipeService/UpdateRecipe"
+	RecipeService_DeleteRecipe_FullMethodName                   = "/recipe.v1.RecipeService/DeleteRecipe"
+	RecipeService_ListDeletedRecipes_FullMethodName             = "/recipe.v1.RecipeService/ListDeletedRecipes"
+	RecipeService_RestoreRecipe_FullMethodName                  = "/recipe.v1.RecipeService/RestoreRecipe"
+	RecipeService_PurgeRecipe_FullMethodName                    = "/recipe.v1.RecipeService/PurgeRecipe"
+	RecipeService_DuplicateRecipe_FullMethodName                = "/recipe.v1.RecipeService/DuplicateRecipe"
+	RecipeService_PullUpstreamRecipe_FullMethodName             = "/recipe.v1.RecipeService/PullUpstreamRecipe"
+	RecipeService_SetRecipeImage_FullMethodName                 = "/recipe.v1.RecipeService/SetRecipeImage"
+	RecipeService_GetSimilarRecipes_FullMethodName              = "/recipe.v1.RecipeService/GetSimilarRecipes"
+	RecipeService_ImportRecipe_FullMethodName                   = "/recipe.v1.RecipeService/ImportRecipe"
+	RecipeService_ExportRecipe_FullMethodName                   = "/recipe.v1.RecipeService/ExportRecipe"
+	RecipeService_ExportRecipeArchive_FullMethodName            = "/recipe.v1.RecipeService/ExportRecipeArchive"
+	RecipeService_BulkImportRecipes_FullMethodName              = "/recipe.v1.RecipeService/BulkImportRecipes"
+	RecipeService_ExportRecipes_FullMethodName                  = "/recipe.v1.RecipeService/ExportRecipes"
+	RecipeService_ScaleRecipe_FullMethodName                    = "/recipe.v1.RecipeService/ScaleRecipe"
+	RecipeService_ListRecipeRevisions_FullMethodName            = "/recipe.v1.RecipeService/ListRecipeRevisions"
+	RecipeService_GetRecipeRevision_FullMethodName              = "/recipe.v1.RecipeService/GetRecipeRevision"
+	RecipeService_DiffRecipeRevisions_FullMethodName            = "/recipe.v1.RecipeService/DiffRecipeRevisions"
+	RecipeService_RestoreRecipeRevision_FullMethodName          = "/recipe.v1.RecipeService/RestoreRecipeRevision"
+	RecipeService_ListIngredientMatches_FullMethodName          = "/recipe.v1.RecipeService/ListIngredientMatches"
+	RecipeService_ResolveIngredientMatch_FullMethodName         = "/recipe.v1.RecipeService/ResolveIngredientMatch"
+	RecipeService_GetIngredientDietaryAttributes_FullMethodName = "/recipe.v1.RecipeService/GetIngredientDietaryAttributes"
+	RecipeService_SetIngredientDietaryAttributes_FullMethodName = "/recipe.v1.RecipeService/SetIngredientDietaryAttributes"
+	RecipeService_ListIngredients_FullMethodName                = "/recipe.v1.RecipeService/ListIngredients"
+	RecipeService_RenameIngredient_FullMethodName               = "/recipe.v1.RecipeService/RenameIngredient"
+	RecipeService_SetIngredientCategory_FullMethodName          = "/recipe.v1.RecipeService/SetIngredientCategory"
+	RecipeService_ListIngredientCategories_FullMethodName       = "/recipe.v1.RecipeService/ListIngredientCategories"
+	RecipeService_MergeIngredients_FullMethodName               = "/recipe.v1.RecipeService/MergeIngredients"
+	RecipeService_ListDuplicateIngredients_FullMethodName       = "/recipe.v1.RecipeService/ListDuplicateIngredients"
+	RecipeService_ShareRecipe_FullMethodName                    = "/recipe.v1.RecipeService/ShareRecipe"
+	RecipeService_ListSharedWithMe_FullMethodName               = "/recipe.v1.RecipeService/ListSharedWithMe"
+	RecipeService_ListSharedByMe_FullMethodName                 = "/recipe.v1.RecipeService/ListSharedByMe"
+	RecipeService_RevokeRecipeShare_FullMethodName              = "/recipe.v1.RecipeService/RevokeRecipeShare"
+	RecipeService_ListCollections_FullMethodName                = "/recipe.v1.RecipeService/ListCollections"
+	RecipeService_GetCollection_FullMethodName                  = "/recipe.v1.RecipeService/GetCollection"
+	RecipeService_CreateCollection_FullMethodName               = "/recipe.v1.RecipeService/CreateCollection"
+	RecipeService_UpdateCollection_FullMethodName               = "/recipe.v1.RecipeService/UpdateCollection"
+	RecipeService_DeleteCollection_FullMethodName               = "/recipe.v1.RecipeService/DeleteCollection"
+	RecipeService_AddRecipeToCollection_FullMethodName          = "/recipe.v1.RecipeService/AddRecipeToCollection"
+	RecipeService_RemoveRecipeFromCollection_FullMethodName     = "/recipe.v1.RecipeService/RemoveRecipeFromCollection"
+	RecipeService_ReorderCollection_FullMethodName              = "/recipe.v1.RecipeService/ReorderCollection"
+	RecipeService_ShareCollection_FullMethodName                = "/recipe.v1.RecipeService/ShareCollection"
+	RecipeService_ListCollectionShares_FullMethodName           = "/recipe.v1.RecipeService/ListCollectionShares"
+	RecipeService_RevokeCollectionShare_FullMethodName          = "/recipe.v1.RecipeService/RevokeCollectionShare"
+	RecipeService_LogCook_FullMethodName                        = "/recipe.v1.RecipeService/LogCook"
+	RecipeService_ListCookLog_FullMethodName                    = "/recipe.v1.RecipeService/ListCookLog"
+	RecipeService_UpdateCookLogEntry_FullMethodName             = "/recipe.v1.RecipeService/UpdateCookLogEntry"
+	RecipeService_DeleteCookLogEntry_FullMethodName             = "/recipe.v1.RecipeService/DeleteCookLogEntry"
+	RecipeService_StartCookSession_FullMethodName               = "/recipe.v1.RecipeService/StartCookSession"
+	RecipeService_GetCookSession_FullMethodName                 = "/recipe.v1.RecipeService/GetCookSession"
+	RecipeService_ListCookSessions_FullMethodName               = "/recipe.v1.RecipeService/ListCookSessions"
+	RecipeService_MoveCookSessionStep_FullMethodName            = "/recipe.v1.RecipeService/MoveCookSessionStep"
+	RecipeService_StartCookTimer_FullMethodName                 = "/recipe.v1.RecipeService/StartCookTimer"
+	RecipeService_PauseCookTimer_FullMethodName                 = "/recipe.v1.RecipeService/PauseCookTimer"
+	RecipeService_CompleteCookSession_FullMethodName            = "/recipe.v1.RecipeService/CompleteCookSession"
+	RecipeService_DeleteCookSession_FullMethodName              = "/recipe.v1.RecipeService/DeleteCookSession"
+	RecipeService_ListRecipeSubstitutions_FullMethodName        = "/recipe.v1.RecipeService/ListRecipeSubstitutions"
+	RecipeService_SubstituteRecipe_FullMethodName               = "/recipe.v1.RecipeService/SubstituteRecipe"
+	RecipeService_GetDietaryProfile_FullMethodName              = "/recipe.v1.RecipeService/GetDietaryProfile"
+	RecipeService_UpdateDietaryProfile_FullMethodName           = "/recipe.v1.RecipeService/UpdateDietaryProfile"
+	RecipeService_ListAllergies_FullMethodName                  = "/recipe.v1.RecipeService/ListAllergies"
+	RecipeService_GetCuisines_FullMethodName                    = "/recipe.v1.RecipeService/GetCuisines"
+	RecipeService_CreateCuisine_FullMethodName                  = "/recipe.v1.RecipeService/CreateCuisine"
+	RecipeService_UpdateCuisine_FullMethodName                  = "/recipe.v1.RecipeService/UpdateCuisine"
+	RecipeService_DeleteCuisine_FullMethodName                  = "/recipe.v1.RecipeService/DeleteCuisine"
+	RecipeService_MergeCuisines_FullMethodName                  = "/recipe.v1.RecipeService/MergeCuisines"
+	RecipeService_ListTags_FullMethodName                       = "/recipe.v1.RecipeService/ListTags"
+	RecipeService_RenameTag_FullMethodName                      = "/recipe.v1.RecipeService/RenameTag"
+	RecipeService_MergeTags_FullMethodName                      = "/recipe.v1.RecipeService/MergeTags"
+	RecipeService_DeleteTag_FullMethodName                      = "/recipe.v1.RecipeService/DeleteTag"
+	RecipeService_SetTagGroup_FullMethodName                    = "/recipe.v1.RecipeService/SetTagGroup"
 )
 
 // RecipeServiceClient is the client API for RecipeService service.
@@ -41,9 +107,75 @@ type RecipeServiceClient interface {
 	CreateRecipe(ctx context.Context, in *CreateRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
 	UpdateRecipe(ctx context.Context, in *UpdateRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
 	DeleteRecipe(ctx context.Context, in *DeleteRecipeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDeletedRecipes(ctx context.Context, in *ListDeletedRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error)
+	RestoreRecipe(ctx context.Context, in *RestoreRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	PurgeRecipe(ctx context.Context, in *PurgeRecipeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DuplicateRecipe(ctx context.Context, in *DuplicateRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	PullUpstreamRecipe(ctx context.Context, in *PullUpstreamRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	SetRecipeImage(ctx context.Context, in *SetRecipeImageRequest, opts ...grpc.CallOption) (*Recipe, error)
 	GetSimilarRecipes(ctx context.Context, in *GetSimilarRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error)
+	ImportRecipe(ctx context.Context, in *ImportRecipeRequest, opts ...grpc.CallOption) (*ImportRecipeResponse, error)
+	ExportRecipe(ctx context.Context, in *ExportRecipeRequest, opts ...grpc.CallOption) (*ExportRecipeResponse, error)
+	ExportRecipeArchive(ctx context.Context, in *ExportRecipeArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	BulkImportRecipes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BulkImportRecipesRequest, BulkImportRecipesResponse], error)
+	ExportRecipes(ctx context.Context, in *ExportRecipesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PortableRecipe], error)
+	ScaleRecipe(ctx context.Context, in *ScaleRecipeRequest, opts ...grpc.CallOption) (*ScaleRecipeResponse, error)
+	ListRecipeRevisions(ctx context.Context, in *ListRecipeRevisionsRequest, opts ...grpc.CallOption) (*ListRecipeRevisionsResponse, error)
+	GetRecipeRevision(ctx context.Context, in *GetRecipeRevisionRequest, opts ...grpc.CallOption) (*RecipeRevision, error)
+	DiffRecipeRevisions(ctx context.Context, in *DiffRecipeRevisionsRequest, opts ...grpc.CallOption) (*RecipeDiff, error)
+	RestoreRecipeRevision(ctx context.Context, in *RestoreRecipeRevisionRequest, opts ...grpc.CallOption) (*Recipe, error)
+	ListIngredientMatches(ctx context.Context, in *ListIngredientMatchesRequest, opts ...grpc.CallOption) (*ListIngredientMatchesResponse, error)
+	ResolveIngredientMatch(ctx context.Context, in *ResolveIngredientMatchRequest, opts ...grpc.CallOption) (*IngredientMatch, error)
+	GetIngredientDietaryAttributes(ctx context.Context, in *GetIngredientDietaryAttributesRequest, opts ...grpc.CallOption) (*IngredientDietaryAttributes, error)
+	SetIngredientDietaryAttributes(ctx context.Context, in *SetIngredientDietaryAttributesRequest, opts ...grpc.CallOption) (*IngredientDietaryAttributes, error)
+	ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error)
+	RenameIngredient(ctx context.Context, in *RenameIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error)
+	SetIngredientCategory(ctx context.Context, in *SetIngredientCategoryRequest, opts ...grpc.CallOption) (*Ingredient, error)
+	ListIngredientCategories(ctx context.Context, in *ListIngredientCategoriesRequest, opts ...grpc.CallOption) (*ListIngredientCategoriesResponse, error)
+	MergeIngredients(ctx context.Context, in *MergeIngredientsRequest, opts ...grpc.CallOption) (*MergeIngredientsResponse, error)
+	ListDuplicateIngredients(ctx context.Context, in *ListDuplicateIngredientsRequest, opts ...grpc.CallOption) (*ListDuplicateIngredientsResponse, error)
+	ShareRecipe(ctx context.Context, in *ShareRecipeRequest, opts ...grpc.CallOption) (*RecipeShare, error)
+	ListSharedWithMe(ctx context.Context, in *ListRecipeSharesRequest, opts ...grpc.CallOption) (*ListRecipeSharesResponse, error)
+	ListSharedByMe(ctx context.Context, in *ListRecipeSharesRequest, opts ...grpc.CallOption) (*ListRecipeSharesResponse, error)
+	RevokeRecipeShare(ctx context.Context, in *RevokeRecipeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddRecipeToCollection(ctx context.Context, in *CollectionRecipeRequest, opts ...grpc.CallOption) (*Collection, error)
+	RemoveRecipeFromCollection(ctx context.Context, in *CollectionRecipeRequest, opts ...grpc.CallOption) (*Collection, error)
+	ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	ShareCollection(ctx context.Context, in *ShareCollectionRequest, opts ...grpc.CallOption) (*CollectionShare, error)
+	ListCollectionShares(ctx context.Context, in *ListCollectionSharesRequest, opts ...grpc.CallOption) (*ListCollectionSharesResponse, error)
+	RevokeCollectionShare(ctx context.Context, in *RevokeCollectionShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LogCook(ctx context.Context, in *LogCookRequest, opts ...grpc.CallOption) (*CookLogEntry, error)
+	ListCookLog(ctx context.Context, in *ListCookLogRequest, opts ...grpc.CallOption) (*ListCookLogResponse, error)
+	UpdateCookLogEntry(ctx context.Context, in *UpdateCookLogEntryRequest, opts ...grpc.CallOption) (*CookLogEntry, error)
+	DeleteCookLogEntry(ctx context.Context, in *DeleteCookLogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	StartCookSession(ctx context.Context, in *StartCookSessionRequest, opts ...grpc.CallOption) (*CookSession, error)
+	GetCookSession(ctx context.Context, in *CookSessionRequest, opts ...grpc.CallOption) (*CookSession, error)
+	ListCookSessions(ctx context.Context, in *ListCookSessionsRequest, opts ...grpc.CallOption) (*ListCookSessionsResponse, error)
+	MoveCookSessionStep(ctx context.Context, in *MoveCookSessionStepRequest, opts ...grpc.CallOption) (*CookSession, error)
+	StartCookTimer(ctx context.Context, in *CookTimerRequest, opts ...grpc.CallOption) (*CookSession, error)
+	PauseCookTimer(ctx context.Context, in *CookTimerRequest, opts ...grpc.CallOption) (*CookSession, error)
+	CompleteCookSession(ctx context.Context, in *CompleteCookSessionRequest, opts ...grpc.CallOption) (*CookSession, error)
+	DeleteCookSession(ctx context.Context, in *CookSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRecipeSubstitutions(ctx context.Context, in *ListRecipeSubstitutionsRequest, opts ...grpc.CallOption) (*ListRecipeSubstitutionsResponse, error)
+	SubstituteRecipe(ctx context.Context, in *SubstituteRecipeRequest, opts ...grpc.CallOption) (*Recipe, error)
+	GetDietaryProfile(ctx context.Context, in *GetDietaryProfileRequest, opts ...grpc.CallOption) (*DietaryProfile, error)
+	UpdateDietaryProfile(ctx context.Context, in *UpdateDietaryProfileRequest, opts ...grpc.CallOption) (*DietaryProfile, error)
+	ListAllergies(ctx context.Context, in *ListAllergiesRequest, opts ...grpc.CallOption) (*ListAllergiesResponse, error)
 	GetCuisines(ctx context.Context, in *GetCuisinesRequest, opts ...grpc.CallOption) (*GetCuisinesResponse, error)
 	CreateCuisine(ctx context.Context, in *CreateCuisineRequest, opts ...grpc.CallOption) (*Cuisine, error)
+	UpdateCuisine(ctx context.Context, in *UpdateCuisineRequest, opts ...grpc.CallOption) (*Cuisine, error)
+	DeleteCuisine(ctx context.Context, in *DeleteCuisineRequest, opts ...grpc.CallOption) (*DeleteCuisineResponse, error)
+	MergeCuisines(ctx context.Context, in *MergeCuisinesRequest, opts ...grpc.CallOption) (*MergeCuisinesResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*TagChangeResponse, error)
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*TagChangeResponse, error)
+	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*TagChangeResponse, error)
+	SetTagGroup(ctx context.Context, in *SetTagGroupRequest, opts ...grpc.CallOption) (*Tag, error)
 }
 
 type recipeServiceClient struct {
@@ -104,6 +236,66 @@ func (c *recipeServiceClient) DeleteRecipe(ctx context.Context, in *DeleteRecipe
 	return out, nil
 }
 
+func (c *recipeServiceClient) ListDeletedRecipes(ctx context.Context, in *ListDeletedRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListDeletedRecipes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RestoreRecipe(ctx context.Context, in *RestoreRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_RestoreRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) PurgeRecipe(ctx context.Context, in *PurgeRecipeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecipeService_PurgeRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) DuplicateRecipe(ctx context.Context, in *DuplicateRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_DuplicateRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) PullUpstreamRecipe(ctx context.Context, in *PullUpstreamRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_PullUpstreamRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) SetRecipeImage(ctx context.Context, in *SetRecipeImageRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_SetRecipeImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *recipeServiceClient) GetSimilarRecipes(ctx context.Context, in *GetSimilarRecipesRequest, opts ...grpc.CallOption) (*ListRecipesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListRecipesResponse)
@@ -114,235 +306,2283 @@ func (c *recipeServiceClient) GetSimilarRecipes(ctx context.Context, in *GetSimi
 	return out, nil
 }
 
-func (c *recipeServiceClient) GetCuisines(ctx context.Context, in *GetCuisinesRequest, opts ...grpc.CallOption) (*GetCuisinesResponse, error) {
+func (c *recipeServiceClient) ImportRecipe(ctx context.Context, in *ImportRecipeRequest, opts ...grpc.CallOption) (*ImportRecipeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCuisinesResponse)
-	err := c.cc.Invoke(ctx, RecipeService_GetCuisines_FullMethodName, in, out, cOpts...)
+	out := new(ImportRecipeResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ImportRecipe_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *recipeServiceClient) CreateCuisine(ctx context.Context, in *CreateCuisineRequest, opts ...grpc.CallOption) (*Cuisine, error) {
+func (c *recipeServiceClient) ExportRecipe(ctx context.Context, in *ExportRecipeRequest, opts ...grpc.CallOption) (*ExportRecipeResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Cuisine)
-	err := c.cc.Invoke(ctx, RecipeService_CreateCuisine_FullMethodName, in, out, cOpts...)
+	out := new(ExportRecipeResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ExportRecipe_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RecipeServiceServer is the server API for RecipeService service.
-// All implementations must embed UnimplementedRecipeServiceServer
-// for forward compatibility.
-//
-// Service definition for recipe management
-type RecipeServiceServer interface {
-	GetRecipe(context.Context, *GetRecipeRequest) (*Recipe, error)
-	ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error)
-	CreateRecipe(context.Context, *CreateRecipeRequest) (*Recipe, error)
-	UpdateRecipe(context.Context, *UpdateRecipeRequest) (*Recipe, error)
-	DeleteRecipe(context.Context, *DeleteRecipeRequest) (*emptypb.Empty, error)
-	GetSimilarRecipes(context.Context, *GetSimilarRecipesRequest) (*ListRecipesResponse, error)
-	GetCuisines(context.Context, *GetCuisinesRequest) (*GetCuisinesResponse, error)
-	CreateCuisine(context.Context, *CreateCuisineRequest) (*Cuisine, error)
-	mustEmbedUnimplementedRecipeServiceServer()
+func (c *recipeServiceClient) ExportRecipeArchive(ctx context.Context, in *ExportRecipeArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecipeService_ServiceDesc.Streams[0], RecipeService_ExportRecipeArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRecipeArchiveRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// UnimplementedRecipeServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedRecipeServiceServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ExportRecipeArchiveClient = grpc.ServerStreamingClient[ExportChunk]
 
-func (UnimplementedRecipeServiceServer) GetRecipe(context.Context, *GetRecipeRequest) (*Recipe, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetRecipe not implemented")
+func (c *recipeServiceClient) BulkImportRecipes(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[BulkImportRecipesRequest, BulkImportRecipesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecipeService_ServiceDesc.Streams[1], RecipeService_BulkImportRecipes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[BulkImportRecipesRequest, BulkImportRecipesResponse]{ClientStream: stream}
+	return x, nil
 }
-func (UnimplementedRecipeServiceServer) ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListRecipes not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_BulkImportRecipesClient = grpc.ClientStreamingClient[BulkImportRecipesRequest, BulkImportRecipesResponse]
+
+func (c *recipeServiceClient) ExportRecipes(ctx context.Context, in *ExportRecipesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PortableRecipe], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RecipeService_ServiceDesc.Streams[2], RecipeService_ExportRecipes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportRecipesRequest, PortableRecipe]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedRecipeServiceServer) CreateRecipe(context.Context, *CreateRecipeRequest) (*Recipe, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateRecipe not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ExportRecipesClient = grpc.ServerStreamingClient[PortableRecipe]
+
+func (c *recipeServiceClient) ScaleRecipe(ctx context.Context, in *ScaleRecipeRequest, opts ...grpc.CallOption) (*ScaleRecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaleRecipeResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ScaleRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRecipeServiceServer) UpdateRecipe(context.Context, *UpdateRecipeRequest) (*Recipe, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateRecipe not implemented")
+
+func (c *recipeServiceClient) ListRecipeRevisions(ctx context.Context, in *ListRecipeRevisionsRequest, opts ...grpc.CallOption) (*ListRecipeRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeRevisionsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListRecipeRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRecipeServiceServer) DeleteRecipe(context.Context, *DeleteRecipeRequest) (*emptypb.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteRecipe not implemented")
+
+func (c *recipeServiceClient) GetRecipeRevision(ctx context.Context, in *GetRecipeRevisionRequest, opts ...grpc.CallOption) (*RecipeRevision, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeRevision)
+	err := c.cc.Invoke(ctx, RecipeService_GetRecipeRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRecipeServiceServer) GetSimilarRecipes(context.Context, *GetSimilarRecipesRequest) (*ListRecipesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetSimilarRecipes not implemented")
+
+func (c *recipeServiceClient) DiffRecipeRevisions(ctx context.Context, in *DiffRecipeRevisionsRequest, opts ...grpc.CallOption) (*RecipeDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeDiff)
+	err := c.cc.Invoke(ctx, RecipeService_DiffRecipeRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RestoreRecipeRevision(ctx context.Context, in *RestoreRecipeRevisionRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_RestoreRecipeRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListIngredientMatches(ctx context.Context, in *ListIngredientMatchesRequest, opts ...grpc.CallOption) (*ListIngredientMatchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIngredientMatchesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListIngredientMatches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ResolveIngredientMatch(ctx context.Context, in *ResolveIngredientMatchRequest, opts ...grpc.CallOption) (*IngredientMatch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngredientMatch)
+	err := c.cc.Invoke(ctx, RecipeService_ResolveIngredientMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) GetIngredientDietaryAttributes(ctx context.Context, in *GetIngredientDietaryAttributesRequest, opts ...grpc.CallOption) (*IngredientDietaryAttributes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngredientDietaryAttributes)
+	err := c.cc.Invoke(ctx, RecipeService_GetIngredientDietaryAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) SetIngredientDietaryAttributes(ctx context.Context, in *SetIngredientDietaryAttributesRequest, opts ...grpc.CallOption) (*IngredientDietaryAttributes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngredientDietaryAttributes)
+	err := c.cc.Invoke(ctx, RecipeService_SetIngredientDietaryAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListIngredients(ctx context.Context, in *ListIngredientsRequest, opts ...grpc.CallOption) (*ListIngredientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIngredientsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RenameIngredient(ctx context.Context, in *RenameIngredientRequest, opts ...grpc.CallOption) (*Ingredient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ingredient)
+	err := c.cc.Invoke(ctx, RecipeService_RenameIngredient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) SetIngredientCategory(ctx context.Context, in *SetIngredientCategoryRequest, opts ...grpc.CallOption) (*Ingredient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Ingredient)
+	err := c.cc.Invoke(ctx, RecipeService_SetIngredientCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListIngredientCategories(ctx context.Context, in *ListIngredientCategoriesRequest, opts ...grpc.CallOption) (*ListIngredientCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIngredientCategoriesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListIngredientCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) MergeIngredients(ctx context.Context, in *MergeIngredientsRequest, opts ...grpc.CallOption) (*MergeIngredientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeIngredientsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_MergeIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListDuplicateIngredients(ctx context.Context, in *ListDuplicateIngredientsRequest, opts ...grpc.CallOption) (*ListDuplicateIngredientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDuplicateIngredientsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListDuplicateIngredients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ShareRecipe(ctx context.Context, in *ShareRecipeRequest, opts ...grpc.CallOption) (*RecipeShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecipeShare)
+	err := c.cc.Invoke(ctx, RecipeService_ShareRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListSharedWithMe(ctx context.Context, in *ListRecipeSharesRequest, opts ...grpc.CallOption) (*ListRecipeSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeSharesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListSharedWithMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListSharedByMe(ctx context.Context, in *ListRecipeSharesRequest, opts ...grpc.CallOption) (*ListRecipeSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeSharesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListSharedByMe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RevokeRecipeShare(ctx context.Context, in *RevokeRecipeShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecipeService_RevokeRecipeShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, RecipeService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, RecipeService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, RecipeService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecipeService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) AddRecipeToCollection(ctx context.Context, in *CollectionRecipeRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, RecipeService_AddRecipeToCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RemoveRecipeFromCollection(ctx context.Context, in *CollectionRecipeRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, RecipeService_RemoveRecipeFromCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ReorderCollection(ctx context.Context, in *ReorderCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, RecipeService_ReorderCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ShareCollection(ctx context.Context, in *ShareCollectionRequest, opts ...grpc.CallOption) (*CollectionShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionShare)
+	err := c.cc.Invoke(ctx, RecipeService_ShareCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListCollectionShares(ctx context.Context, in *ListCollectionSharesRequest, opts ...grpc.CallOption) (*ListCollectionSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionSharesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListCollectionShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RevokeCollectionShare(ctx context.Context, in *RevokeCollectionShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecipeService_RevokeCollectionShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) LogCook(ctx context.Context, in *LogCookRequest, opts ...grpc.CallOption) (*CookLogEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookLogEntry)
+	err := c.cc.Invoke(ctx, RecipeService_LogCook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListCookLog(ctx context.Context, in *ListCookLogRequest, opts ...grpc.CallOption) (*ListCookLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCookLogResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListCookLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) UpdateCookLogEntry(ctx context.Context, in *UpdateCookLogEntryRequest, opts ...grpc.CallOption) (*CookLogEntry, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookLogEntry)
+	err := c.cc.Invoke(ctx, RecipeService_UpdateCookLogEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) DeleteCookLogEntry(ctx context.Context, in *DeleteCookLogEntryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecipeService_DeleteCookLogEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) StartCookSession(ctx context.Context, in *StartCookSessionRequest, opts ...grpc.CallOption) (*CookSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookSession)
+	err := c.cc.Invoke(ctx, RecipeService_StartCookSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) GetCookSession(ctx context.Context, in *CookSessionRequest, opts ...grpc.CallOption) (*CookSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookSession)
+	err := c.cc.Invoke(ctx, RecipeService_GetCookSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListCookSessions(ctx context.Context, in *ListCookSessionsRequest, opts ...grpc.CallOption) (*ListCookSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCookSessionsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListCookSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) MoveCookSessionStep(ctx context.Context, in *MoveCookSessionStepRequest, opts ...grpc.CallOption) (*CookSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookSession)
+	err := c.cc.Invoke(ctx, RecipeService_MoveCookSessionStep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) StartCookTimer(ctx context.Context, in *CookTimerRequest, opts ...grpc.CallOption) (*CookSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookSession)
+	err := c.cc.Invoke(ctx, RecipeService_StartCookTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) PauseCookTimer(ctx context.Context, in *CookTimerRequest, opts ...grpc.CallOption) (*CookSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookSession)
+	err := c.cc.Invoke(ctx, RecipeService_PauseCookTimer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) CompleteCookSession(ctx context.Context, in *CompleteCookSessionRequest, opts ...grpc.CallOption) (*CookSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CookSession)
+	err := c.cc.Invoke(ctx, RecipeService_CompleteCookSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) DeleteCookSession(ctx context.Context, in *CookSessionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecipeService_DeleteCookSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListRecipeSubstitutions(ctx context.Context, in *ListRecipeSubstitutionsRequest, opts ...grpc.CallOption) (*ListRecipeSubstitutionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecipeSubstitutionsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListRecipeSubstitutions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) SubstituteRecipe(ctx context.Context, in *SubstituteRecipeRequest, opts ...grpc.CallOption) (*Recipe, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, RecipeService_SubstituteRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) GetDietaryProfile(ctx context.Context, in *GetDietaryProfileRequest, opts ...grpc.CallOption) (*DietaryProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DietaryProfile)
+	err := c.cc.Invoke(ctx, RecipeService_GetDietaryProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) UpdateDietaryProfile(ctx context.Context, in *UpdateDietaryProfileRequest, opts ...grpc.CallOption) (*DietaryProfile, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DietaryProfile)
+	err := c.cc.Invoke(ctx, RecipeService_UpdateDietaryProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListAllergies(ctx context.Context, in *ListAllergiesRequest, opts ...grpc.CallOption) (*ListAllergiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllergiesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListAllergies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) GetCuisines(ctx context.Context, in *GetCuisinesRequest, opts ...grpc.CallOption) (*GetCuisinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCuisinesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_GetCuisines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) CreateCuisine(ctx context.Context, in *CreateCuisineRequest, opts ...grpc.CallOption) (*Cuisine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cuisine)
+	err := c.cc.Invoke(ctx, RecipeService_CreateCuisine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) UpdateCuisine(ctx context.Context, in *UpdateCuisineRequest, opts ...grpc.CallOption) (*Cuisine, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cuisine)
+	err := c.cc.Invoke(ctx, RecipeService_UpdateCuisine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) DeleteCuisine(ctx context.Context, in *DeleteCuisineRequest, opts ...grpc.CallOption) (*DeleteCuisineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCuisineResponse)
+	err := c.cc.Invoke(ctx, RecipeService_DeleteCuisine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) MergeCuisines(ctx context.Context, in *MergeCuisinesRequest, opts ...grpc.CallOption) (*MergeCuisinesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCuisinesResponse)
+	err := c.cc.Invoke(ctx, RecipeService_MergeCuisines_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, RecipeService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*TagChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagChangeResponse)
+	err := c.cc.Invoke(ctx, RecipeService_RenameTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*TagChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagChangeResponse)
+	err := c.cc.Invoke(ctx, RecipeService_MergeTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*TagChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagChangeResponse)
+	err := c.cc.Invoke(ctx, RecipeService_DeleteTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recipeServiceClient) SetTagGroup(ctx context.Context, in *SetTagGroupRequest, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, RecipeService_SetTagGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecipeServiceServer is the server API for RecipeService service.
+// All implementations must embed UnimplementedRecipeServiceServer
+// for forward compatibility.
+//
+// Service definition for recipe management
+type RecipeServiceServer interface {
+	GetRecipe(context.Context, *GetRecipeRequest) (*Recipe, error)
+	ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error)
+	CreateRecipe(context.Context, *CreateRecipeRequest) (*Recipe, error)
+	UpdateRecipe(context.Context, *UpdateRecipeRequest) (*Recipe, error)
+	DeleteRecipe(context.Context, *DeleteRecipeRequest) (*emptypb.Empty, error)
+	ListDeletedRecipes(context.Context, *ListDeletedRecipesRequest) (*ListRecipesResponse, error)
+	RestoreRecipe(context.Context, *RestoreRecipeRequest) (*Recipe, error)
+	PurgeRecipe(context.Context, *PurgeRecipeRequest) (*emptypb.Empty, error)
+	DuplicateRecipe(context.Context, *DuplicateRecipeRequest) (*Recipe, error)
+	PullUpstreamRecipe(context.Context, *PullUpstreamRecipeRequest) (*Recipe, error)
+	SetRecipeImage(context.Context, *SetRecipeImageRequest) (*Recipe, error)
+	GetSimilarRecipes(context.Context, *GetSimilarRecipesRequest) (*ListRecipesResponse, error)
+	ImportRecipe(context.Context, *ImportRecipeRequest) (*ImportRecipeResponse, error)
+	ExportRecipe(context.Context, *ExportRecipeRequest) (*ExportRecipeResponse, error)
+	ExportRecipeArchive(*ExportRecipeArchiveRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	BulkImportRecipes(grpc.ClientStreamingServer[BulkImportRecipesRequest, BulkImportRecipesResponse]) error
+	ExportRecipes(*ExportRecipesRequest, grpc.ServerStreamingServer[PortableRecipe]) error
+	ScaleRecipe(context.Context, *ScaleRecipeRequest) (*ScaleRecipeResponse, error)
+	ListRecipeRevisions(context.Context, *ListRecipeRevisionsRequest) (*ListRecipeRevisionsResponse, error)
+	GetRecipeRevision(context.Context, *GetRecipeRevisionRequest) (*RecipeRevision, error)
+	DiffRecipeRevisions(context.Context, *DiffRecipeRevisionsRequest) (*RecipeDiff, error)
+	RestoreRecipeRevision(context.Context, *RestoreRecipeRevisionRequest) (*Recipe, error)
+	ListIngredientMatches(context.Context, *ListIngredientMatchesRequest) (*ListIngredientMatchesResponse, error)
+	ResolveIngredientMatch(context.Context, *ResolveIngredientMatchRequest) (*IngredientMatch, error)
+	GetIngredientDietaryAttributes(context.Context, *GetIngredientDietaryAttributesRequest) (*IngredientDietaryAttributes, error)
+	SetIngredientDietaryAttributes(context.Context, *SetIngredientDietaryAttributesRequest) (*IngredientDietaryAttributes, error)
+	ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error)
+	RenameIngredient(context.Context, *RenameIngredientRequest) (*Ingredient, error)
+	SetIngredientCategory(context.Context, *SetIngredientCategoryRequest) (*Ingredient, error)
+	ListIngredientCategories(context.Context, *ListIngredientCategoriesRequest) (*ListIngredientCategoriesResponse, error)
+	MergeIngredients(context.Context, *MergeIngredientsRequest) (*MergeIngredientsResponse, error)
+	ListDuplicateIngredients(context.Context, *ListDuplicateIngredientsRequest) (*ListDuplicateIngredientsResponse, error)
+	ShareRecipe(context.Context, *ShareRecipeRequest) (*RecipeShare, error)
+	ListSharedWithMe(context.Context, *ListRecipeSharesRequest) (*ListRecipeSharesResponse, error)
+	ListSharedByMe(context.Context, *ListRecipeSharesRequest) (*ListRecipeSharesResponse, error)
+	RevokeRecipeShare(context.Context, *RevokeRecipeShareRequest) (*emptypb.Empty, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
+	CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error)
+	AddRecipeToCollection(context.Context, *CollectionRecipeRequest) (*Collection, error)
+	RemoveRecipeFromCollection(context.Context, *CollectionRecipeRequest) (*Collection, error)
+	ReorderCollection(context.Context, *ReorderCollectionRequest) (*Collection, error)
+	ShareCollection(context.Context, *ShareCollectionRequest) (*CollectionShare, error)
+	ListCollectionShares(context.Context, *ListCollectionSharesRequest) (*ListCollectionSharesResponse, error)
+	RevokeCollectionShare(context.Context, *RevokeCollectionShareRequest) (*emptypb.Empty, error)
+	LogCook(context.Context, *LogCookRequest) (*CookLogEntry, error)
+	ListCookLog(context.Context, *ListCookLogRequest) (*ListCookLogResponse, error)
+	UpdateCookLogEntry(context.Context, *UpdateCookLogEntryRequest) (*CookLogEntry, error)
+	DeleteCookLogEntry(context.Context, *DeleteCookLogEntryRequest) (*emptypb.Empty, error)
+	StartCookSession(context.Context, *StartCookSessionRequest) (*CookSession, error)
+	GetCookSession(context.Context, *CookSessionRequest) (*CookSession, error)
+	ListCookSessions(context.Context, *ListCookSessionsRequest) (*ListCookSessionsResponse, error)
+	MoveCookSessionStep(context.Context, *MoveCookSessionStepRequest) (*CookSession, error)
+	StartCookTimer(context.Context, *CookTimerRequest) (*CookSession, error)
+	PauseCookTimer(context.Context, *CookTimerRequest) (*CookSession, error)
+	CompleteCookSession(context.Context, *CompleteCookSessionRequest) (*CookSession, error)
+	DeleteCookSession(context.Context, *CookSessionRequest) (*emptypb.Empty, error)
+	ListRecipeSubstitutions(context.Context, *ListRecipeSubstitutionsRequest) (*ListRecipeSubstitutionsResponse, error)
+	SubstituteRecipe(context.Context, *SubstituteRecipeRequest) (*Recipe, error)
+	GetDietaryProfile(context.Context, *GetDietaryProfileRequest) (*DietaryProfile, error)
+	UpdateDietaryProfile(context.Context, *UpdateDietaryProfileRequest) (*DietaryProfile, error)
+	ListAllergies(context.Context, *ListAllergiesRequest) (*ListAllergiesResponse, error)
+	GetCuisines(context.Context, *GetCuisinesRequest) (*GetCuisinesResponse, error)
+	CreateCuisine(context.Context, *CreateCuisineRequest) (*Cuisine, error)
+	UpdateCuisine(context.Context, *UpdateCuisineRequest) (*Cuisine, error)
+	DeleteCuisine(context.Context, *DeleteCuisineRequest) (*DeleteCuisineResponse, error)
+	MergeCuisines(context.Context, *MergeCuisinesRequest) (*MergeCuisinesResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	RenameTag(context.Context, *RenameTagRequest) (*TagChangeResponse, error)
+	MergeTags(context.Context, *MergeTagsRequest) (*TagChangeResponse, error)
+	DeleteTag(context.Context, *DeleteTagRequest) (*TagChangeResponse, error)
+	SetTagGroup(context.Context, *SetTagGroupRequest) (*Tag, error)
+	mustEmbedUnimplementedRecipeServiceServer()
+}
+
+// UnimplementedRecipeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRecipeServiceServer struct{}
+
+func (UnimplementedRecipeServiceServer) GetRecipe(context.Context, *GetRecipeRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListRecipes(context.Context, *ListRecipesRequest) (*ListRecipesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) CreateRecipe(context.Context, *CreateRecipeRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) UpdateRecipe(context.Context, *UpdateRecipeRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) DeleteRecipe(context.Context, *DeleteRecipeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListDeletedRecipes(context.Context, *ListDeletedRecipesRequest) (*ListRecipesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) RestoreRecipe(context.Context, *RestoreRecipeRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) PurgeRecipe(context.Context, *PurgeRecipeRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method PurgeRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) DuplicateRecipe(context.Context, *DuplicateRecipeRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method DuplicateRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) PullUpstreamRecipe(context.Context, *PullUpstreamRecipeRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method PullUpstreamRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) SetRecipeImage(context.Context, *SetRecipeImageRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRecipeImage not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetSimilarRecipes(context.Context, *GetSimilarRecipesRequest) (*ListRecipesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSimilarRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) ImportRecipe(context.Context, *ImportRecipeRequest) (*ImportRecipeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ExportRecipe(context.Context, *ExportRecipeRequest) (*ExportRecipeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ExportRecipeArchive(*ExportRecipeArchiveRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportRecipeArchive not implemented")
+}
+func (UnimplementedRecipeServiceServer) BulkImportRecipes(grpc.ClientStreamingServer[BulkImportRecipesRequest, BulkImportRecipesResponse]) error {
+	return status.Error(codes.Unimplemented, "method BulkImportRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) ExportRecipes(*ExportRecipesRequest, grpc.ServerStreamingServer[PortableRecipe]) error {
+	return status.Error(codes.Unimplemented, "method ExportRecipes not implemented")
+}
+func (UnimplementedRecipeServiceServer) ScaleRecipe(context.Context, *ScaleRecipeRequest) (*ScaleRecipeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScaleRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListRecipeRevisions(context.Context, *ListRecipeRevisionsRequest) (*ListRecipeRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecipeRevisions not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetRecipeRevision(context.Context, *GetRecipeRevisionRequest) (*RecipeRevision, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRecipeRevision not implemented")
+}
+func (UnimplementedRecipeServiceServer) DiffRecipeRevisions(context.Context, *DiffRecipeRevisionsRequest) (*RecipeDiff, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffRecipeRevisions not implemented")
+}
+func (UnimplementedRecipeServiceServer) RestoreRecipeRevision(context.Context, *RestoreRecipeRevisionRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreRecipeRevision not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListIngredientMatches(context.Context, *ListIngredientMatchesRequest) (*ListIngredientMatchesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIngredientMatches not implemented")
+}
+func (UnimplementedRecipeServiceServer) ResolveIngredientMatch(context.Context, *ResolveIngredientMatchRequest) (*IngredientMatch, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveIngredientMatch not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetIngredientDietaryAttributes(context.Context, *GetIngredientDietaryAttributesRequest) (*IngredientDietaryAttributes, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetIngredientDietaryAttributes not implemented")
+}
+func (UnimplementedRecipeServiceServer) SetIngredientDietaryAttributes(context.Context, *SetIngredientDietaryAttributesRequest) (*IngredientDietaryAttributes, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetIngredientDietaryAttributes not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListIngredients(context.Context, *ListIngredientsRequest) (*ListIngredientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIngredients not implemented")
+}
+func (UnimplementedRecipeServiceServer) RenameIngredient(context.Context, *RenameIngredientRequest) (*Ingredient, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameIngredient not implemented")
+}
+func (UnimplementedRecipeServiceServer) SetIngredientCategory(context.Context, *SetIngredientCategoryRequest) (*Ingredient, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetIngredientCategory not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListIngredientCategories(context.Context, *ListIngredientCategoriesRequest) (*ListIngredientCategoriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIngredientCategories not implemented")
+}
+func (UnimplementedRecipeServiceServer) MergeIngredients(context.Context, *MergeIngredientsRequest) (*MergeIngredientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeIngredients not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListDuplicateIngredients(context.Context, *ListDuplicateIngredientsRequest) (*ListDuplicateIngredientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDuplicateIngredients not implemented")
+}
+func (UnimplementedRecipeServiceServer) ShareRecipe(context.Context, *ShareRecipeRequest) (*RecipeShare, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListSharedWithMe(context.Context, *ListRecipeSharesRequest) (*ListRecipeSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSharedWithMe not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListSharedByMe(context.Context, *ListRecipeSharesRequest) (*ListRecipeSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSharedByMe not implemented")
+}
+func (UnimplementedRecipeServiceServer) RevokeRecipeShare(context.Context, *RevokeRecipeShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeRecipeShare not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) AddRecipeToCollection(context.Context, *CollectionRecipeRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddRecipeToCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) RemoveRecipeFromCollection(context.Context, *CollectionRecipeRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveRecipeFromCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) ReorderCollection(context.Context, *ReorderCollectionRequest) (*Collection, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReorderCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) ShareCollection(context.Context, *ShareCollectionRequest) (*CollectionShare, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareCollection not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListCollectionShares(context.Context, *ListCollectionSharesRequest) (*ListCollectionSharesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCollectionShares not implemented")
+}
+func (UnimplementedRecipeServiceServer) RevokeCollectionShare(context.Context, *RevokeCollectionShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeCollectionShare not implemented")
+}
+func (UnimplementedRecipeServiceServer) LogCook(context.Context, *LogCookRequest) (*CookLogEntry, error) {
+	return nil, status.Error(codes.Unimplemented, "method LogCook not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListCookLog(context.Context, *ListCookLogRequest) (*ListCookLogResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCookLog not implemented")
+}
+func (UnimplementedRecipeServiceServer) UpdateCookLogEntry(context.Context, *UpdateCookLogEntryRequest) (*CookLogEntry, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCookLogEntry not implemented")
+}
+func (UnimplementedRecipeServiceServer) DeleteCookLogEntry(context.Context, *DeleteCookLogEntryRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCookLogEntry not implemented")
+}
+func (UnimplementedRecipeServiceServer) StartCookSession(context.Context, *StartCookSessionRequest) (*CookSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartCookSession not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetCookSession(context.Context, *CookSessionRequest) (*CookSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCookSession not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListCookSessions(context.Context, *ListCookSessionsRequest) (*ListCookSessionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCookSessions not implemented")
+}
+func (UnimplementedRecipeServiceServer) MoveCookSessionStep(context.Context, *MoveCookSessionStepRequest) (*CookSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveCookSessionStep not implemented")
+}
+func (UnimplementedRecipeServiceServer) StartCookTimer(context.Context, *CookTimerRequest) (*CookSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartCookTimer not implemented")
+}
+func (UnimplementedRecipeServiceServer) PauseCookTimer(context.Context, *CookTimerRequest) (*CookSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseCookTimer not implemented")
+}
+func (UnimplementedRecipeServiceServer) CompleteCookSession(context.Context, *CompleteCookSessionRequest) (*CookSession, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteCookSession not implemented")
+}
+func (UnimplementedRecipeServiceServer) DeleteCookSession(context.Context, *CookSessionRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCookSession not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListRecipeSubstitutions(context.Context, *ListRecipeSubstitutionsRequest) (*ListRecipeSubstitutionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRecipeSubstitutions not implemented")
+}
+func (UnimplementedRecipeServiceServer) SubstituteRecipe(context.Context, *SubstituteRecipeRequest) (*Recipe, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubstituteRecipe not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetDietaryProfile(context.Context, *GetDietaryProfileRequest) (*DietaryProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDietaryProfile not implemented")
+}
+func (UnimplementedRecipeServiceServer) UpdateDietaryProfile(context.Context, *UpdateDietaryProfileRequest) (*DietaryProfile, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateDietaryProfile not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListAllergies(context.Context, *ListAllergiesRequest) (*ListAllergiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListAllergies not implemented")
+}
+func (UnimplementedRecipeServiceServer) GetCuisines(context.Context, *GetCuisinesRequest) (*GetCuisinesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCuisines not implemented")
+}
+func (UnimplementedRecipeServiceServer) CreateCuisine(context.Context, *CreateCuisineRequest) (*Cuisine, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCuisine not implemented")
+}
+func (UnimplementedRecipeServiceServer) UpdateCuisine(context.Context, *UpdateCuisineRequest) (*Cuisine, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCuisine not implemented")
+}
+func (UnimplementedRecipeServiceServer) DeleteCuisine(context.Context, *DeleteCuisineRequest) (*DeleteCuisineResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCuisine not implemented")
+}
+func (UnimplementedRecipeServiceServer) MergeCuisines(context.Context, *MergeCuisinesRequest) (*MergeCuisinesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeCuisines not implemented")
+}
+func (UnimplementedRecipeServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedRecipeServiceServer) RenameTag(context.Context, *RenameTagRequest) (*TagChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameTag not implemented")
+}
+func (UnimplementedRecipeServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*TagChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergeTags not implemented")
+}
+func (UnimplementedRecipeServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*TagChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteTag not implemented")
+}
+func (UnimplementedRecipeServiceServer) SetTagGroup(context.Context, *SetTagGroupRequest) (*Tag, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetTagGroup not implemented")
+}
+func (UnimplementedRecipeServiceServer) mustEmbedUnimplementedRecipeServiceServer() {}
+func (UnimplementedRecipeServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRecipeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecipeServiceServer will
+// result in compilation errors.
+type UnsafeRecipeServiceServer interface {
+	mustEmbedUnimplementedRecipeServiceServer()
+}
+
+func RegisterRecipeServiceServer(s grpc.ServiceRegistrar, srv RecipeServiceServer) {
+	// If the following call panics, it indicates UnimplementedRecipeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RecipeService_ServiceDesc, srv)
+}
+
+func _RecipeService_GetRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetRecipe(ctx, req.(*GetRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListRecipes(ctx, req.(*ListRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_CreateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).CreateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_CreateRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).CreateRecipe(ctx, req.(*CreateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_UpdateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).UpdateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_UpdateRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).UpdateRecipe(ctx, req.(*UpdateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_DeleteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).DeleteRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_DeleteRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).DeleteRecipe(ctx, req.(*DeleteRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListDeletedRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListDeletedRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListDeletedRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListDeletedRecipes(ctx, req.(*ListDeletedRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_RestoreRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).RestoreRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_RestoreRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).RestoreRecipe(ctx, req.(*RestoreRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_PurgeRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).PurgeRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_PurgeRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).PurgeRecipe(ctx, req.(*PurgeRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_DuplicateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).DuplicateRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_DuplicateRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).DuplicateRecipe(ctx, req.(*DuplicateRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_PullUpstreamRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullUpstreamRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).PullUpstreamRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_PullUpstreamRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).PullUpstreamRecipe(ctx, req.(*PullUpstreamRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_SetRecipeImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecipeImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).SetRecipeImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_SetRecipeImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).SetRecipeImage(ctx, req.(*SetRecipeImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetSimilarRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSimilarRecipesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetSimilarRecipes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetSimilarRecipes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetSimilarRecipes(ctx, req.(*GetSimilarRecipesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ImportRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ImportRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ImportRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ImportRecipe(ctx, req.(*ImportRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ExportRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ExportRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ExportRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ExportRecipe(ctx, req.(*ExportRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ExportRecipeArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRecipeArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecipeServiceServer).ExportRecipeArchive(m, &grpc.GenericServerStream[ExportRecipeArchiveRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ExportRecipeArchiveServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _RecipeService_BulkImportRecipes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RecipeServiceServer).BulkImportRecipes(&grpc.GenericServerStream[BulkImportRecipesRequest, BulkImportRecipesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_BulkImportRecipesServer = grpc.ClientStreamingServer[BulkImportRecipesRequest, BulkImportRecipesResponse]
+
+func _RecipeService_ExportRecipes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRecipesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RecipeServiceServer).ExportRecipes(m, &grpc.GenericServerStream[ExportRecipesRequest, PortableRecipe]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RecipeService_ExportRecipesServer = grpc.ServerStreamingServer[PortableRecipe]
+
+func _RecipeService_ScaleRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ScaleRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ScaleRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ScaleRecipe(ctx, req.(*ScaleRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListRecipeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListRecipeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListRecipeRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListRecipeRevisions(ctx, req.(*ListRecipeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetRecipeRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetRecipeRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetRecipeRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetRecipeRevision(ctx, req.(*GetRecipeRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_DiffRecipeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRecipeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).DiffRecipeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_DiffRecipeRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).DiffRecipeRevisions(ctx, req.(*DiffRecipeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_RestoreRecipeRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRecipeRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).RestoreRecipeRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_RestoreRecipeRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).RestoreRecipeRevision(ctx, req.(*RestoreRecipeRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListIngredientMatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngredientMatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListIngredientMatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListIngredientMatches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListIngredientMatches(ctx, req.(*ListIngredientMatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ResolveIngredientMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIngredientMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ResolveIngredientMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ResolveIngredientMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ResolveIngredientMatch(ctx, req.(*ResolveIngredientMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetIngredientDietaryAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIngredientDietaryAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetIngredientDietaryAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetIngredientDietaryAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetIngredientDietaryAttributes(ctx, req.(*GetIngredientDietaryAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_SetIngredientDietaryAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIngredientDietaryAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).SetIngredientDietaryAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_SetIngredientDietaryAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).SetIngredientDietaryAttributes(ctx, req.(*SetIngredientDietaryAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngredientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListIngredients(ctx, req.(*ListIngredientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_RenameIngredient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameIngredientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).RenameIngredient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_RenameIngredient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).RenameIngredient(ctx, req.(*RenameIngredientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_SetIngredientCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIngredientCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).SetIngredientCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_SetIngredientCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).SetIngredientCategory(ctx, req.(*SetIngredientCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListIngredientCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngredientCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListIngredientCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListIngredientCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListIngredientCategories(ctx, req.(*ListIngredientCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_MergeIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeIngredientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).MergeIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_MergeIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).MergeIngredients(ctx, req.(*MergeIngredientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListDuplicateIngredients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDuplicateIngredientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListDuplicateIngredients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListDuplicateIngredients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListDuplicateIngredients(ctx, req.(*ListDuplicateIngredientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ShareRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ShareRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ShareRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ShareRecipe(ctx, req.(*ShareRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListSharedWithMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListSharedWithMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListSharedWithMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListSharedWithMe(ctx, req.(*ListRecipeSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListSharedByMe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListSharedByMe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListSharedByMe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListSharedByMe(ctx, req.(*ListRecipeSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_RevokeRecipeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRecipeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).RevokeRecipeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_RevokeRecipeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).RevokeRecipeShare(ctx, req.(*RevokeRecipeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_AddRecipeToCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).AddRecipeToCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_AddRecipeToCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).AddRecipeToCollection(ctx, req.(*CollectionRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_RemoveRecipeFromCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).RemoveRecipeFromCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_RemoveRecipeFromCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).RemoveRecipeFromCollection(ctx, req.(*CollectionRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ReorderCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ReorderCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ReorderCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ReorderCollection(ctx, req.(*ReorderCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ShareCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ShareCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ShareCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ShareCollection(ctx, req.(*ShareCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListCollectionShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListCollectionShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListCollectionShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListCollectionShares(ctx, req.(*ListCollectionSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_RevokeCollectionShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeCollectionShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).RevokeCollectionShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_RevokeCollectionShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).RevokeCollectionShare(ctx, req.(*RevokeCollectionShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_LogCook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogCookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).LogCook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_LogCook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).LogCook(ctx, req.(*LogCookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListCookLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCookLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListCookLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListCookLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListCookLog(ctx, req.(*ListCookLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_UpdateCookLogEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCookLogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).UpdateCookLogEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_UpdateCookLogEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).UpdateCookLogEntry(ctx, req.(*UpdateCookLogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_DeleteCookLogEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCookLogEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).DeleteCookLogEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_DeleteCookLogEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).DeleteCookLogEntry(ctx, req.(*DeleteCookLogEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_StartCookSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartCookSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).StartCookSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_StartCookSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).StartCookSession(ctx, req.(*StartCookSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetCookSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CookSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetCookSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetCookSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetCookSession(ctx, req.(*CookSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListCookSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCookSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListCookSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListCookSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListCookSessions(ctx, req.(*ListCookSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_MoveCookSessionStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCookSessionStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).MoveCookSessionStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_MoveCookSessionStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).MoveCookSessionStep(ctx, req.(*MoveCookSessionStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_StartCookTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CookTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).StartCookTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_StartCookTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).StartCookTimer(ctx, req.(*CookTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_PauseCookTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CookTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).PauseCookTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_PauseCookTimer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).PauseCookTimer(ctx, req.(*CookTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_CompleteCookSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCookSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).CompleteCookSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_CompleteCookSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).CompleteCookSession(ctx, req.(*CompleteCookSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_DeleteCookSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CookSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).DeleteCookSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_DeleteCookSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).DeleteCookSession(ctx, req.(*CookSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_ListRecipeSubstitutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecipeSubstitutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListRecipeSubstitutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListRecipeSubstitutions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListRecipeSubstitutions(ctx, req.(*ListRecipeSubstitutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_SubstituteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubstituteRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).SubstituteRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_SubstituteRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).SubstituteRecipe(ctx, req.(*SubstituteRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecipeService_GetDietaryProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDietaryProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetDietaryProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetDietaryProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetDietaryProfile(ctx, req.(*GetDietaryProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRecipeServiceServer) GetCuisines(context.Context, *GetCuisinesRequest) (*GetCuisinesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetCuisines not implemented")
+
+func _RecipeService_UpdateDietaryProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDietaryProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).UpdateDietaryProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_UpdateDietaryProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).UpdateDietaryProfile(ctx, req.(*UpdateDietaryProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRecipeServiceServer) CreateCuisine(context.Context, *CreateCuisineRequest) (*Cuisine, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateCuisine not implemented")
+
+func _RecipeService_ListAllergies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllergiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).ListAllergies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_ListAllergies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).ListAllergies(ctx, req.(*ListAllergiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedRecipeServiceServer) mustEmbedUnimplementedRecipeServiceServer() {}
-func (UnimplementedRecipeServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeRecipeServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RecipeServiceServer will
-// result in compilation errors.
-type UnsafeRecipeServiceServer interface {
-	mustEmbedUnimplementedRecipeServiceServer()
+func _RecipeService_GetCuisines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCuisinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).GetCuisines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_GetCuisines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).GetCuisines(ctx, req.(*GetCuisinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRecipeServiceServer(s grpc.ServiceRegistrar, srv RecipeServiceServer) {
-	// If the following call panics, it indicates UnimplementedRecipeServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _RecipeService_CreateCuisine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCuisineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&RecipeService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(RecipeServiceServer).CreateCuisine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecipeService_CreateCuisine_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecipeServiceServer).CreateCuisine(ctx, req.(*CreateCuisineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_GetRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRecipeRequest)
+func _RecipeService_UpdateCuisine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCuisineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).GetRecipe(ctx, in)
+		return srv.(RecipeServiceServer).UpdateCuisine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_GetRecipe_FullMethodName,
+		FullMethod: RecipeService_UpdateCuisine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).GetRecipe(ctx, req.(*GetRecipeRequest))
+		return srv.(RecipeServiceServer).UpdateCuisine(ctx, req.(*UpdateCuisineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_ListRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRecipesRequest)
+func _RecipeService_DeleteCuisine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCuisineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).ListRecipes(ctx, in)
+		return srv.(RecipeServiceServer).DeleteCuisine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_ListRecipes_FullMethodName,
+		FullMethod: RecipeService_DeleteCuisine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).ListRecipes(ctx, req.(*ListRecipesRequest))
+		return srv.(RecipeServiceServer).DeleteCuisine(ctx, req.(*DeleteCuisineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_CreateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRecipeRequest)
+func _RecipeService_MergeCuisines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCuisinesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).CreateRecipe(ctx, in)
+		return srv.(RecipeServiceServer).MergeCuisines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_CreateRecipe_FullMethodName,
+		FullMethod: RecipeService_MergeCuisines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).CreateRecipe(ctx, req.(*CreateRecipeRequest))
+		return srv.(RecipeServiceServer).MergeCuisines(ctx, req.(*MergeCuisinesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_UpdateRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRecipeRequest)
+func _RecipeService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).UpdateRecipe(ctx, in)
+		return srv.(RecipeServiceServer).ListTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_UpdateRecipe_FullMethodName,
+		FullMethod: RecipeService_ListTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).UpdateRecipe(ctx, req.(*UpdateRecipeRequest))
+		return srv.(RecipeServiceServer).ListTags(ctx, req.(*ListTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_DeleteRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRecipeRequest)
+func _RecipeService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).DeleteRecipe(ctx, in)
+		return srv.(RecipeServiceServer).RenameTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_DeleteRecipe_FullMethodName,
+		FullMethod: RecipeService_RenameTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).DeleteRecipe(ctx, req.(*DeleteRecipeRequest))
+		return srv.(RecipeServiceServer).RenameTag(ctx, req.(*RenameTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_GetSimilarRecipes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSimilarRecipesRequest)
+func _RecipeService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).GetSimilarRecipes(ctx, in)
+		return srv.(RecipeServiceServer).MergeTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_GetSimilarRecipes_FullMethodName,
+		FullMethod: RecipeService_MergeTags_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).GetSimilarRecipes(ctx, req.(*GetSimilarRecipesRequest))
+		return srv.(RecipeServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_GetCuisines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCuisinesRequest)
+func _RecipeService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTagRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).GetCuisines(ctx, in)
+		return srv.(RecipeServiceServer).DeleteTag(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_GetCuisines_FullMethodName,
+		FullMethod: RecipeService_DeleteTag_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).GetCuisines(ctx, req.(*GetCuisinesRequest))
+		return srv.(RecipeServiceServer).DeleteTag(ctx, req.(*DeleteTagRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RecipeService_CreateCuisine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCuisineRequest)
+func _RecipeService_SetTagGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTagGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RecipeServiceServer).CreateCuisine(ctx, in)
+		return srv.(RecipeServiceServer).SetTagGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: RecipeService_CreateCuisine_FullMethodName,
+		FullMethod: RecipeService_SetTagGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RecipeServiceServer).CreateCuisine(ctx, req.(*CreateCuisineRequest))
+		return srv.(RecipeServiceServer).SetTagGroup(ctx, req.(*SetTagGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -374,10 +2614,230 @@ var RecipeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRecipe",
 			Handler:    _RecipeService_DeleteRecipe_Handler,
 		},
+		{
+			MethodName: "ListDeletedRecipes",
+			Handler:    _RecipeService_ListDeletedRecipes_Handler,
+		},
+		{
+			MethodName: "RestoreRecipe",
+			Handler:    _RecipeService_RestoreRecipe_Handler,
+		},
+		{
+			MethodName: "PurgeRecipe",
+			Handler:    _RecipeService_PurgeRecipe_Handler,
+		},
+		{
+			MethodName: "DuplicateRecipe",
+			Handler:    _RecipeService_DuplicateRecipe_Handler,
+		},
+		{
+			MethodName: "PullUpstreamRecipe",
+			Handler:    _RecipeService_PullUpstreamRecipe_Handler,
+		},
+		{
+			MethodName: "SetRecipeImage",
+			Handler:    _RecipeService_SetRecipeImage_Handler,
+		},
 		{
 			MethodName: "GetSimilarRecipes",
 			Handler:    _RecipeService_GetSimilarRecipes_Handler,
 		},
+		{
+			MethodName: "ImportRecipe",
+			Handler:    _RecipeService_ImportRecipe_Handler,
+		},
+		{
+			MethodName: "ExportRecipe",
+			Handler:    _RecipeService_ExportRecipe_Handler,
+		},
+		{
+			MethodName: "ScaleRecipe",
+			Handler:    _RecipeService_ScaleRecipe_Handler,
+		},
+		{
+			MethodName: "ListRecipeRevisions",
+			Handler:    _RecipeService_ListRecipeRevisions_Handler,
+		},
+		{
+			MethodName: "GetRecipeRevision",
+			Handler:    _RecipeService_GetRecipeRevision_Handler,
+		},
+		{
+			MethodName: "DiffRecipeRevisions",
+			Handler:    _RecipeService_DiffRecipeRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreRecipeRevision",
+			Handler:    _RecipeService_RestoreRecipeRevision_Handler,
+		},
+		{
+			MethodName: "ListIngredientMatches",
+			Handler:    _RecipeService_ListIngredientMatches_Handler,
+		},
+		{
+			MethodName: "ResolveIngredientMatch",
+			Handler:    _RecipeService_ResolveIngredientMatch_Handler,
+		},
+		{
+			MethodName: "GetIngredientDietaryAttributes",
+			Handler:    _RecipeService_GetIngredientDietaryAttributes_Handler,
+		},
+		{
+			MethodName: "SetIngredientDietaryAttributes",
+			Handler:    _RecipeService_SetIngredientDietaryAttributes_Handler,
+		},
+		{
+			MethodName: "ListIngredients",
+			Handler:    _RecipeService_ListIngredients_Handler,
+		},
+		{
+			MethodName: "RenameIngredient",
+			Handler:    _RecipeService_RenameIngredient_Handler,
+		},
+		{
+			MethodName: "SetIngredientCategory",
+			Handler:    _RecipeService_SetIngredientCategory_Handler,
+		},
+		{
+			MethodName: "ListIngredientCategories",
+			Handler:    _RecipeService_ListIngredientCategories_Handler,
+		},
+		{
+			MethodName: "MergeIngredients",
+			Handler:    _RecipeService_MergeIngredients_Handler,
+		},
+		{
+			MethodName: "ListDuplicateIngredients",
+			Handler:    _RecipeService_ListDuplicateIngredients_Handler,
+		},
+		{
+			MethodName: "ShareRecipe",
+			Handler:    _RecipeService_ShareRecipe_Handler,
+		},
+		{
+			MethodName: "ListSharedWithMe",
+			Handler:    _RecipeService_ListSharedWithMe_Handler,
+		},
+		{
+			MethodName: "ListSharedByMe",
+			Handler:    _RecipeService_ListSharedByMe_Handler,
+		},
+		{
+			MethodName: "RevokeRecipeShare",
+			Handler:    _RecipeService_RevokeRecipeShare_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _RecipeService_ListCollections_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _RecipeService_GetCollection_Handler,
+		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _RecipeService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _RecipeService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _RecipeService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "AddRecipeToCollection",
+			Handler:    _RecipeService_AddRecipeToCollection_Handler,
+		},
+		{
+			MethodName: "RemoveRecipeFromCollection",
+			Handler:    _RecipeService_RemoveRecipeFromCollection_Handler,
+		},
+		{
+			MethodName: "ReorderCollection",
+			Handler:    _RecipeService_ReorderCollection_Handler,
+		},
+		{
+			MethodName: "ShareCollection",
+			Handler:    _RecipeService_ShareCollection_Handler,
+		},
+		{
+			MethodName: "ListCollectionShares",
+			Handler:    _RecipeService_ListCollectionShares_Handler,
+		},
+		{
+			MethodName: "RevokeCollectionShare",
+			Handler:    _RecipeService_RevokeCollectionShare_Handler,
+		},
+		{
+			MethodName: "LogCook",
+			Handler:    _RecipeService_LogCook_Handler,
+		},
+		{
+			MethodName: "ListCookLog",
+			Handler:    _RecipeService_ListCookLog_Handler,
+		},
+		{
+			MethodName: "UpdateCookLogEntry",
+			Handler:    _RecipeService_UpdateCookLogEntry_Handler,
+		},
+		{
+			MethodName: "DeleteCookLogEntry",
+			Handler:    _RecipeService_DeleteCookLogEntry_Handler,
+		},
+		{
+			MethodName: "StartCookSession",
+			Handler:    _RecipeService_StartCookSession_Handler,
+		},
+		{
+			MethodName: "GetCookSession",
+			Handler:    _RecipeService_GetCookSession_Handler,
+		},
+		{
+			MethodName: "ListCookSessions",
+			Handler:    _RecipeService_ListCookSessions_Handler,
+		},
+		{
+			MethodName: "MoveCookSessionStep",
+			Handler:    _RecipeService_MoveCookSessionStep_Handler,
+		},
+		{
+			MethodName: "StartCookTimer",
+			Handler:    _RecipeService_StartCookTimer_Handler,
+		},
+		{
+			MethodName: "PauseCookTimer",
+			Handler:    _RecipeService_PauseCookTimer_Handler,
+		},
+		{
+			MethodName: "CompleteCookSession",
+			Handler:    _RecipeService_CompleteCookSession_Handler,
+		},
+		{
+			MethodName: "DeleteCookSession",
+			Handler:    _RecipeService_DeleteCookSession_Handler,
+		},
+		{
+			MethodName: "ListRecipeSubstitutions",
+			Handler:    _RecipeService_ListRecipeSubstitutions_Handler,
+		},
+		{
+			MethodName: "SubstituteRecipe",
+			Handler:    _RecipeService_SubstituteRecipe_Handler,
+		},
+		{
+			MethodName: "GetDietaryProfile",
+			Handler:    _RecipeService_GetDietaryProfile_Handler,
+		},
+		{
+			MethodName: "UpdateDietaryProfile",
+			Handler:    _RecipeService_UpdateDietaryProfile_Handler,
+		},
+		{
+			MethodName: "ListAllergies",
+			Handler:    _RecipeService_ListAllergies_Handler,
+		},
 		{
 			MethodName: "GetCuisines",
 			Handler:    _RecipeService_GetCuisines_Handler,
@@ -386,7 +2846,55 @@ var RecipeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateCuisine",
 			Handler:    _RecipeService_CreateCuisine_Handler,
 		},
+		{
+			MethodName: "UpdateCuisine",
+			Handler:    _RecipeService_UpdateCuisine_Handler,
+		},
+		{
+			MethodName: "DeleteCuisine",
+			Handler:    _RecipeService_DeleteCuisine_Handler,
+		},
+		{
+			MethodName: "MergeCuisines",
+			Handler:    _RecipeService_MergeCuisines_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _RecipeService_ListTags_Handler,
+		},
+		{
+			MethodName: "RenameTag",
+			Handler:    _RecipeService_RenameTag_Handler,
+		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _RecipeService_MergeTags_Handler,
+		},
+		{
+			MethodName: "DeleteTag",
+			Handler:    _RecipeService_DeleteTag_Handler,
+		},
+		{
+			MethodName: "SetTagGroup",
+			Handler:    _RecipeService_SetTagGroup_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportRecipeArchive",
+			Handler:       _RecipeService_ExportRecipeArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BulkImportRecipes",
+			Handler:       _RecipeService_BulkImportRecipes_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportRecipes",
+			Handler:       _RecipeService_ExportRecipes_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "recipe/v1/recipe.proto",
 }
@@ -0,0 +1,544 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+var (
+	ErrCollectionNotFound      = errors.New("collection not found")
+	ErrCollectionNotEditable   = errors.New("collection is shared read-only")
+	ErrCollectionItemNotFound  = errors.New("recipe is not in the collection")
+	ErrCollectionOrderMismatch = errors.New("order must list every recipe in the collection once")
+)
+
+// collectionQuery selects collections visible to the user in $1 together with
+// the permission of a share, which is NULL for the owner. Public collections
+// are read-only for everyone else.
+const collectionQuery = `
+	SELECT
+		c.id, c.user_id, c.name, c.description, c.cover_image_url, c.is_public,
+		c.created_at, c.updated_at,
+		(
+			SELECT COUNT(*)
+			FROM recipe_collection_items rci
+			JOIN recipes cr ON cr.id = rci.recipe_id
+			WHERE rci.collection_id = c.id AND cr.deleted_at IS NULL
+		),
+		COALESCE(cs.permission, CASE WHEN c.user_id <> $1 THEN 'read' END)
+	FROM recipe_collections c
+	LEFT JOIN collection_shares cs ON cs.collection_id = c.id AND cs.shared_with_user_id = $1
+	WHERE (c.user_id = $1 OR cs.shared_with_user_id IS NOT NULL OR c.is_public)
+`
+
+// ListCollections returns the user's collections, the collections shared
+// with them and the public ones, ordered by name.
+func (r *Repository) ListCollections(ctx context.Context, userID uuid.UUID) ([]domain.Collection, error) {
+	rows, err := r.pool.Query(ctx, collectionQuery+` ORDER BY c.name, c.created_at`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query collections: %w", err)
+	}
+	defer rows.Close()
+
+	var collections []domain.Collection
+	for rows.Next() {
+		collection, err := scanCollection(rows)
+		if err != nil {
+			return nil, err
+		}
+		collections = append(collections, *collection)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate collections: %w", err)
+	}
+
+	return collections, nil
+}
+
+// GetCollection returns a collection with its recipes in order.
+func (r *Repository) GetCollection(ctx context.Context, userID, id uuid.UUID) (*domain.Collection, error) {
+	collection, err := scanCollection(r.pool.QueryRow(ctx, collectionQuery+` AND c.id = $2`, userID, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCollectionNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT rci.recipe_id, r.name, r.image_url, rci.position, rci.added_at
+		FROM recipe_collection_items rci
+		JOIN recipes r ON r.id = rci.recipe_id
+		WHERE rci.collection_id = $1 AND `+activeClause("r")+`
+		ORDER BY rci.position, rci.added_at
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query collection items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var item domain.CollectionItem
+		var imageURL *string
+		if err := rows.Scan(&item.RecipeID, &item.RecipeName, &imageURL, &item.Position, &item.AddedAt); err != nil {
+			return nil, fmt.Errorf("scan collection item: %w", err)
+		}
+		if imageURL != nil {
+			item.ImageURL = *imageURL
+		}
+		collection.Items = append(collection.Items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate collection items: %w", err)
+	}
+
+	return collection, nil
+}
+
+// CreateCollection creates an empty collection.
+func (r *Repository) CreateCollection(ctx context.Context, collection *domain.Collection) error {
+	if collection.ID == uuid.Nil {
+		collection.ID = uuid.New()
+	}
+
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO recipe_collections (id, user_id, name, description, cover_image_url)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, updated_at
+	`, collection.ID, collection.UserID, collection.Name,
+		nullableText(collection.Description), nullableText(collection.CoverImageURL),
+	).Scan(&collection.CreatedAt, &collection.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("insert collection: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateCollection changes the name, description and cover image of a
+// collection the user owns or may edit.
+func (r *Repository) UpdateCollection(ctx context.Context, userID uuid.UUID, collection *domain.Collection) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockEditableCollection(ctx, tx, userID, collection.ID); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE recipe_collections
+		SET name = $2, description = $3, cover_image_url = $4
+		WHERE id = $1
+	`, collection.ID, collection.Name,
+		nullableText(collection.Description), nullableText(collection.CoverImageURL),
+	)
+	if err != nil {
+		return fmt.Errorf("update collection: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetCollectionPublic makes a collection the user owns readable by every user,
+// or private again.
+func (r *Repository) SetCollectionPublic(ctx context.Context, userID, id uuid.UUID, public bool) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE recipe_collections SET is_public = $3 WHERE id = $1 AND user_id = $2
+	`, id, userID, public)
+	if err != nil {
+		return fmt.Errorf("update collection visibility: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+// DeleteCollection deletes a collection the user owns. The recipes in it are
+// kept.
+func (r *Repository) DeleteCollection(ctx context.Context, userID, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM recipe_collections WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete collection: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+// AddCollectionRecipe appends a recipe to a collection. Only recipes of the
+// collection's owner can be added, since sharing the collection shares its
+// recipes. Adding a recipe that is already in the collection does nothing.
+func (r *Repository) AddCollectionRecipe(ctx context.Context, userID, collectionID, recipeID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ownerID, err := lockEditableCollection(ctx, tx, userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM recipes r WHERE r.id = $1 AND r.user_id = $2 AND `+activeClause("r")+`)
+	`, recipeID, ownerID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("query recipe: %w", err)
+	}
+	if !exists {
+		return ErrRecipeNotFound
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO recipe_collection_items (collection_id, recipe_id, position)
+		SELECT $1, $2, COALESCE(MAX(position), 0) + 1
+		FROM recipe_collection_items
+		WHERE collection_id = $1
+		ON CONFLICT (collection_id, recipe_id) DO NOTHING
+	`, collectionID, recipeID)
+	if err != nil {
+		return fmt.Errorf("insert collection item: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveCollectionRecipe removes a recipe from a collection.
+func (r *Repository) RemoveCollectionRecipe(ctx context.Context, userID, collectionID, recipeID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockEditableCollection(ctx, tx, userID, collectionID); err != nil {
+		return err
+	}
+
+	result, err := tx.Exec(ctx, `
+		DELETE FROM recipe_collection_items
+		WHERE collection_id = $1 AND recipe_id = $2
+	`, collectionID, recipeID)
+	if err != nil {
+		return fmt.Errorf("delete collection item: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrCollectionItemNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListRecipeCollectionIDs returns the IDs of the collections a recipe is in.
+func (r *Repository) ListRecipeCollectionIDs(ctx context.Context, recipeID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT collection_id FROM recipe_collection_items WHERE recipe_id = $1 ORDER BY collection_id
+	`, recipeID)
+	if err != nil {
+		return nil, fmt.Errorf("query recipe collections: %w", err)
+	}
+
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("collect recipe collections: %w", err)
+	}
+
+	return ids, nil
+}
+
+// ReorderCollection puts the recipes of a collection in the given order,
+// which must list every recipe in the collection exactly once.
+func (r *Repository) ReorderCollection(ctx context.Context, userID, collectionID uuid.UUID, recipeIDs []uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := lockEditableCollection(ctx, tx, userID, collectionID); err != nil {
+		return err
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT rci.recipe_id
+		FROM recipe_collection_items rci
+		JOIN recipes r ON r.id = rci.recipe_id
+		WHERE rci.collection_id = $1 AND `+activeClause("r")+`
+	`, collectionID)
+	if err != nil {
+		return fmt.Errorf("query collection items: %w", err)
+	}
+	current, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return fmt.Errorf("scan collection items: %w", err)
+	}
+
+	if !sameRecipeSet(current, recipeIDs) {
+		return ErrCollectionOrderMismatch
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE recipe_collection_items rci
+		SET position = o.position
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(recipe_id, position)
+		WHERE rci.collection_id = $1 AND rci.recipe_id = o.recipe_id
+	`, collectionID, recipeIDs)
+	if err != nil {
+		return fmt.Errorf("reorder collection items: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Collection sharing
+
+// ShareCollection shares a collection the user owns, and the recipes in it,
+// with the user registered under email. Sharing again with the same user
+// changes the permission.
+func (r *Repository) ShareCollection(ctx context.Context, ownerID, collectionID uuid.UUID, email string, permission domain.SharePermission) (*domain.CollectionShare, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM recipe_collections WHERE id = $1 AND user_id = $2)
+	`, collectionID, ownerID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("query collection: %w", err)
+	}
+	if !exists {
+		return nil, ErrCollectionNotFound
+	}
+
+	var sharedWithUserID uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT id FROM users WHERE lower(email) = lower($1)`, email).Scan(&sharedWithUserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("query user: %w", err)
+	}
+	if sharedWithUserID == ownerID {
+		return nil, ErrShareWithOwner
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO collection_shares (collection_id, shared_with_user_id, permission)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (collection_id, shared_with_user_id) DO UPDATE SET
+			permission = EXCLUDED.permission
+	`, collectionID, sharedWithUserID, string(permission))
+	if err != nil {
+		return nil, fmt.Errorf("save collection share: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	shares, err := r.queryCollectionShares(ctx, `cs.collection_id = $1 AND cs.shared_with_user_id = $2`, collectionID, sharedWithUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, ErrShareNotFound
+	}
+	return &shares[0], nil
+}
+
+// ListCollectionShares returns who a collection the user owns is shared with.
+func (r *Repository) ListCollectionShares(ctx context.Context, ownerID, collectionID uuid.UUID) ([]domain.CollectionShare, error) {
+	var exists bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM recipe_collections WHERE id = $1 AND user_id = $2)
+	`, collectionID, ownerID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("query collection: %w", err)
+	}
+	if !exists {
+		return nil, ErrCollectionNotFound
+	}
+
+	return r.queryCollectionShares(ctx, `cs.collection_id = $1`, collectionID)
+}
+
+// RevokeCollectionShare removes another user's access to a collection the
+// user owns.
+func (r *Repository) RevokeCollectionShare(ctx context.Context, ownerID, collectionID, sharedWithUserID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM collection_shares cs
+		USING recipe_collections c
+		WHERE cs.collection_id = c.id
+		  AND cs.collection_id = $1
+		  AND cs.shared_with_user_id = $2
+		  AND c.user_id = $3
+	`, collectionID, sharedWithUserID, ownerID)
+	if err != nil {
+		return fmt.Errorf("delete collection share: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrShareNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) queryCollectionShares(ctx context.Context, condition string, args ...any) ([]domain.CollectionShare, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			cs.collection_id, c.name, c.user_id, owner.email,
+			cs.shared_with_user_id, recipient.email,
+			cs.permission, cs.created_at
+		FROM collection_shares cs
+		JOIN recipe_collections c ON c.id = cs.collection_id
+		JOIN users owner ON owner.id = c.user_id
+		JOIN users recipient ON recipient.id = cs.shared_with_user_id
+		WHERE `+condition+`
+		ORDER BY cs.created_at DESC, recipient.email
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query collection shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []domain.CollectionShare
+	for rows.Next() {
+		var share domain.CollectionShare
+		var permission string
+		if err := rows.Scan(
+			&share.CollectionID, &share.CollectionName, &share.OwnerID, &share.OwnerEmail,
+			&share.SharedWithUserID, &share.SharedWithEmail,
+			&permission, &share.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan collection share: %w", err)
+		}
+		share.Permission = domain.SharePermission(permission)
+		shares = append(shares, share)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate collection shares: %w", err)
+	}
+
+	return shares, nil
+}
+
+// lockEditableCollection checks that the user owns the collection or may edit
+// it, locks it so concurrent changes number its items in turn, and returns its
+// owner.
+func lockEditableCollection(ctx context.Context, tx pgx.Tx, userID, collectionID uuid.UUID) (uuid.UUID, error) {
+	var ownerID uuid.UUID
+	var permission *string
+	err := tx.QueryRow(ctx, `
+		SELECT c.user_id, cs.permission
+		FROM recipe_collections c
+		LEFT JOIN collection_shares cs ON cs.collection_id = c.id AND cs.shared_with_user_id = $2
+		WHERE c.id = $1
+		  AND (c.user_id = $2 OR cs.shared_with_user_id IS NOT NULL OR c.is_public)
+		FOR UPDATE OF c
+	`, collectionID, userID).Scan(&ownerID, &permission)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrCollectionNotFound
+		}
+		return uuid.Nil, fmt.Errorf("query collection: %w", err)
+	}
+
+	if ownerID != userID && (permission == nil || domain.SharePermission(*permission) != domain.SharePermissionEdit) {
+		return uuid.Nil, ErrCollectionNotEditable
+	}
+	return ownerID, nil
+}
+
+func scanCollection(row pgx.Row) (*domain.Collection, error) {
+	var collection domain.Collection
+	var description, coverImageURL, permission *string
+	err := row.Scan(
+		&collection.ID, &collection.UserID, &collection.Name, &description, &coverImageURL, &collection.Public,
+		&collection.CreatedAt, &collection.UpdatedAt,
+		&collection.RecipeCount, &permission,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan collection: %w", err)
+	}
+
+	if description != nil {
+		collection.Description = *description
+	}
+	if coverImageURL != nil {
+		collection.CoverImageURL = *coverImageURL
+	}
+	if permission != nil {
+		collection.Permission = domain.SharePermission(*permission)
+	}
+
+	return &collection, nil
+}
+
+func sameRecipeSet(current, order []uuid.UUID) bool {
+	if len(current) != len(order) {
+		return false
+	}
+
+	remaining := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		remaining[id] = true
+	}
+	for _, id := range order {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
+
+func nullableText(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+var (
+	ErrCookLogEntryNotFound = errors.New("cook log entry not found")
+)
+
+// LogCook records that the user cooked a recipe they have access to.
+func (r *Repository) LogCook(ctx context.Context, entry *domain.CookLogEntry) error {
+	return insertCookLogEntry(ctx, r.pool, entry)
+}
+
+// queryRower is implemented by both the pool and transactions.
+type queryRower interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func insertCookLogEntry(ctx context.Context, q queryRower, entry *domain.CookLogEntry) error {
+	var recipeName string
+	err := q.QueryRow(ctx, `
+		SELECT r.name FROM recipes r
+		WHERE r.id = $1 AND `+activeClause("r")+` AND `+accessClause("r", 2)+`
+	`, entry.RecipeID, entry.UserID).Scan(&recipeName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRecipeNotFound
+		}
+		return fmt.Errorf("query recipe: %w", err)
+	}
+
+	if entry.ID == uuid.Nil {
+		entry.ID = uuid.New()
+	}
+
+	err = q.QueryRow(ctx, `
+		INSERT INTO recipe_cook_log (id, recipe_id, user_id, cooked_on, servings, rating, notes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at, updated_at
+	`,
+		entry.ID, entry.RecipeID, entry.UserID, entry.CookedOn,
+		nullableServings(entry.Servings), entry.Rating, nullableText(entry.Notes),
+	).Scan(&entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("insert cook log entry: %w", err)
+	}
+
+	entry.RecipeName = recipeName
+	return nil
+}
+
+// ListCookLog returns the user's cook log, most recent first. When recipeID
+// is set, only entries for that recipe are returned.
+func (r *Repository) ListCookLog(ctx context.Context, userID uuid.UUID, recipeID *uuid.UUID, limit int) ([]domain.CookLogEntry, error) {
+	args := []any{userID}
+	condition := ""
+	if recipeID != nil {
+		args = append(args, *recipeID)
+		condition = " AND cl.recipe_id = $2"
+	}
+	args = append(args, limit)
+
+	return r.queryCookLog(ctx, `cl.user_id = $1`+condition+fmt.Sprintf(" ORDER BY cl.cooked_on DESC, cl.created_at DESC LIMIT $%d", len(args)), args...)
+}
+
+// UpdateCookLogEntry changes the date, servings, rating and notes of one of
+// the user's cook log entries. RecipeID is filled in from the stored entry.
+func (r *Repository) UpdateCookLogEntry(ctx context.Context, entry *domain.CookLogEntry) error {
+	err := r.pool.QueryRow(ctx, `
+		UPDATE recipe_cook_log SET
+			cooked_on = $3,
+			servings = $4,
+			rating = $5,
+			notes = $6
+		WHERE id = $1 AND user_id = $2
+		RETURNING recipe_id, created_at, updated_at
+	`,
+		entry.ID, entry.UserID, entry.CookedOn,
+		nullableServings(entry.Servings), entry.Rating, nullableText(entry.Notes),
+	).Scan(&entry.RecipeID, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCookLogEntryNotFound
+		}
+		return fmt.Errorf("update cook log entry: %w", err)
+	}
+
+	if err := r.pool.QueryRow(ctx, `SELECT name FROM recipes WHERE id = $1`, entry.RecipeID).Scan(&entry.RecipeName); err != nil {
+		return fmt.Errorf("query recipe name: %w", err)
+	}
+	return nil
+}
+
+// DeleteCookLogEntry deletes one of the user's cook log entries and returns
+// the recipe it was for.
+func (r *Repository) DeleteCookLogEntry(ctx context.Context, userID, id uuid.UUID) (uuid.UUID, error) {
+	var recipeID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		DELETE FROM recipe_cook_log WHERE id = $1 AND user_id = $2
+		RETURNING recipe_id
+	`, id, userID).Scan(&recipeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrCookLogEntryNotFound
+		}
+		return uuid.Nil, fmt.Errorf("delete cook log entry: %w", err)
+	}
+	return recipeID, nil
+}
+
+// GetCookStats aggregates the user's cook log for a recipe.
+func (r *Repository) GetCookStats(ctx context.Context, userID, recipeID uuid.UUID) (*domain.RecipeCookStats, error) {
+	var stats domain.RecipeCookStats
+	err := r.pool.QueryRow(ctx, `
+		SELECT times_cooked, last_cooked_on, average_rating
+		FROM recipe_cook_stats
+		WHERE recipe_id = $1 AND user_id = $2
+	`, recipeID, userID).Scan(&stats.TimesCooked, &stats.LastCookedOn, &stats.AverageRating)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &stats, nil
+		}
+		return nil, fmt.Errorf("query cook stats: %w", err)
+	}
+	return &stats, nil
+}
+
+func (r *Repository) queryCookLog(ctx context.Context, condition string, args ...any) ([]domain.CookLogEntry, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			cl.id, cl.recipe_id, r.name, cl.user_id,
+			cl.cooked_on, cl.servings, cl.rating, cl.notes,
+			cl.created_at, cl.updated_at
+		FROM recipe_cook_log cl
+		JOIN recipes r ON r.id = cl.recipe_id
+		WHERE `+activeClause("r")+`
+		  AND `+condition, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query cook log: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []domain.CookLogEntry
+	for rows.Next() {
+		var entry domain.CookLogEntry
+		var servings *int
+		var notes *string
+		if err := rows.Scan(
+			&entry.ID, &entry.RecipeID, &entry.RecipeName, &entry.UserID,
+			&entry.CookedOn, &servings, &entry.Rating, &notes,
+			&entry.CreatedAt, &entry.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan cook log entry: %w", err)
+		}
+		if servings != nil {
+			entry.Servings = *servings
+		}
+		if notes != nil {
+			entry.Notes = *notes
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate cook log: %w", err)
+	}
+
+	return entries, nil
+}
+
+func nullableServings(servings int) *int {
+	if servings <= 0 {
+		return nil
+	}
+	return &servings
+}
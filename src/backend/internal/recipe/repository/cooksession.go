@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+var (
+	ErrCookSessionNotFound = errors.New("cook session not found")
+	// ErrCookSessionExists is returned when the user already cooks the recipe
+	// in an active session.
+	ErrCookSessionExists = errors.New("recipe already has an active cook session")
+	// ErrCookSessionConflict is returned when a session was changed, completed
+	// or expired after it was read.
+	ErrCookSessionConflict = errors.New("cook session was changed concurrently")
+)
+
+// timerRecord is the JSON form of a cook timer in cook_sessions.timers.
+type timerRecord struct {
+	StepIndex        int        `json:"stepIndex"`
+	Name             string     `json:"name"`
+	DurationSeconds  int        `json:"durationSeconds"`
+	RemainingSeconds int        `json:"remainingSeconds"`
+	StartedAt        *time.Time `json:"startedAt,omitempty"`
+}
+
+const cookSessionSelect = `
+	SELECT
+		cs.id, cs.user_id, cs.recipe_id, r.name,
+		cs.servings, cs.current_step, cs.timers, cs.version, cs.cook_log_entry_id,
+		cs.started_at, cs.updated_at, cs.completed_at, cs.expires_at
+	FROM cook_sessions cs
+	JOIN recipes r ON r.id = cs.recipe_id
+`
+
+// CreateCookSession stores a new session. Sessions that already expired are
+// removed first, so an expired session never blocks a new one.
+func (r *Repository) CreateCookSession(ctx context.Context, session *domain.CookSession) error {
+	timers, err := marshalTimers(session.Timers)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM cook_sessions WHERE expires_at <= NOW()`); err != nil {
+		return fmt.Errorf("delete expired cook sessions: %w", err)
+	}
+
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO cook_sessions (id, user_id, recipe_id, servings, current_step, timers, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (user_id, recipe_id) WHERE completed_at IS NULL DO NOTHING
+		RETURNING version, started_at, updated_at
+	`,
+		session.ID, session.UserID, session.RecipeID, session.Servings,
+		session.CurrentStep, timers, session.ExpiresAt,
+	).Scan(&session.Version, &session.StartedAt, &session.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCookSessionExists
+		}
+		return fmt.Errorf("insert cook session: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetCookSession returns one of the user's sessions unless it expired.
+func (r *Repository) GetCookSession(ctx context.Context, userID, id uuid.UUID) (*domain.CookSession, error) {
+	session, err := scanCookSession(r.pool.QueryRow(ctx, cookSessionSelect+`
+		WHERE cs.id = $1 AND cs.user_id = $2 AND cs.expires_at > NOW()
+	`, id, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCookSessionNotFound
+		}
+		return nil, fmt.Errorf("query cook session: %w", err)
+	}
+	return session, nil
+}
+
+// GetActiveCookSession returns the user's active session for a recipe.
+func (r *Repository) GetActiveCookSession(ctx context.Context, userID, recipeID uuid.UUID) (*domain.CookSession, error) {
+	session, err := scanCookSession(r.pool.QueryRow(ctx, cookSessionSelect+`
+		WHERE cs.user_id = $1 AND cs.recipe_id = $2
+		  AND cs.completed_at IS NULL AND cs.expires_at > NOW()
+	`, userID, recipeID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCookSessionNotFound
+		}
+		return nil, fmt.Errorf("query cook session: %w", err)
+	}
+	return session, nil
+}
+
+// ListCookSessions returns the user's active sessions, most recently used
+// first.
+func (r *Repository) ListCookSessions(ctx context.Context, userID uuid.UUID) ([]domain.CookSession, error) {
+	rows, err := r.pool.Query(ctx, cookSessionSelect+`
+		WHERE cs.user_id = $1 AND cs.completed_at IS NULL AND cs.expires_at > NOW()
+		ORDER BY cs.updated_at DESC
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query cook sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []domain.CookSession
+	for rows.Next() {
+		session, err := scanCookSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan cook session: %w", err)
+		}
+		sessions = append(sessions, *session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate cook sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// UpdateCookSession saves the step, timers and expiry of an active session.
+// It fails with ErrCookSessionConflict when the session changed since it was
+// read at session.Version.
+func (r *Repository) UpdateCookSession(ctx context.Context, session *domain.CookSession) error {
+	timers, err := marshalTimers(session.Timers)
+	if err != nil {
+		return err
+	}
+
+	err = r.pool.QueryRow(ctx, `
+		UPDATE cook_sessions SET
+			current_step = $4,
+			timers = $5,
+			expires_at = $6,
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND version = $3
+		  AND completed_at IS NULL AND expires_at > NOW()
+		RETURNING version, updated_at
+	`,
+		session.ID, session.UserID, session.Version,
+		session.CurrentStep, timers, session.ExpiresAt,
+	).Scan(&session.Version, &session.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCookSessionConflict
+		}
+		return fmt.Errorf("update cook session: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteCookSession logs the cook and marks the session completed in one
+// transaction, so a session is logged at most once even when two devices
+// complete it.
+func (r *Repository) CompleteCookSession(ctx context.Context, session *domain.CookSession, entry *domain.CookLogEntry) error {
+	timers, err := marshalTimers(session.Timers)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := insertCookLogEntry(ctx, tx, entry); err != nil {
+		return err
+	}
+
+	err = tx.QueryRow(ctx, `
+		UPDATE cook_sessions SET
+			timers = $4,
+			expires_at = $5,
+			cook_log_entry_id = $6,
+			completed_at = NOW(),
+			version = version + 1,
+			updated_at = NOW()
+		WHERE id = $1 AND user_id = $2 AND version = $3
+		  AND completed_at IS NULL AND expires_at > NOW()
+		RETURNING version, updated_at, completed_at
+	`,
+		session.ID, session.UserID, session.Version,
+		timers, session.ExpiresAt, entry.ID,
+	).Scan(&session.Version, &session.UpdatedAt, &session.CompletedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCookSessionConflict
+		}
+		return fmt.Errorf("complete cook session: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	session.CookLogEntryID = &entry.ID
+	return nil
+}
+
+// DeleteCookSession deletes one of the user's sessions.
+func (r *Repository) DeleteCookSession(ctx context.Context, userID, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM cook_sessions WHERE id = $1 AND user_id = $2 AND expires_at > NOW()
+	`, id, userID)
+	if err != nil {
+		return fmt.Errorf("delete cook session: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrCookSessionNotFound
+	}
+
+	return nil
+}
+
+func scanCookSession(row pgx.Row) (*domain.CookSession, error) {
+	var session domain.CookSession
+	var timers []byte
+	err := row.Scan(
+		&session.ID, &session.UserID, &session.RecipeID, &session.RecipeName,
+		&session.Servings, &session.CurrentStep, &timers, &session.Version, &session.CookLogEntryID,
+		&session.StartedAt, &session.UpdatedAt, &session.CompletedAt, &session.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []timerRecord
+	if err := json.Unmarshal(timers, &records); err != nil {
+		return nil, fmt.Errorf("decode cook timers: %w", err)
+	}
+	for _, record := range records {
+		session.Timers = append(session.Timers, domain.CookTimer{
+			StepIndex:        record.StepIndex,
+			Name:             record.Name,
+			DurationSeconds:  record.DurationSeconds,
+			RemainingSeconds: record.RemainingSeconds,
+			StartedAt:        record.StartedAt,
+		})
+	}
+
+	return &session, nil
+}
+
+func marshalTimers(timers []domain.CookTimer) ([]byte, error) {
+	records := make([]timerRecord, len(timers))
+	for i, timer := range timers {
+		records[i] = timerRecord{
+			StepIndex:        timer.StepIndex,
+			Name:             timer.Name,
+			DurationSeconds:  timer.DurationSeconds,
+			RemainingSeconds: timer.RemainingSeconds,
+			StartedAt:        timer.StartedAt,
+		}
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("encode cook timers: %w", err)
+	}
+	return data, nil
+}
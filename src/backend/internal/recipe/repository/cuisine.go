@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	// ErrCuisineNameTaken is returned when renaming a cuisine to the name of
+	// another cuisine of the same user; those should be merged.
+	ErrCuisineNameTaken = errors.New("cuisine name is already taken")
+	// ErrCuisineInUse is returned when deleting a cuisine that recipes still
+	// use without naming a cuisine to reassign them to.
+	ErrCuisineInUse = errors.New("cuisine is used by recipes")
+	// ErrCuisineMergeSelf is returned when reassigning a cuisine's recipes to
+	// the cuisine itself.
+	ErrCuisineMergeSelf = errors.New("cuisine cannot be merged into itself")
+)
+
+// RenameCuisine renames one of the user's cuisines and returns the active
+// recipes using it.
+func (r *Repository) RenameCuisine(ctx context.Context, userID, id uuid.UUID, name string) ([]uuid.UUID, error) {
+	result, err := r.pool.Exec(ctx, `UPDATE cuisines SET name = $3 WHERE id = $1 AND user_id = $2`, id, userID, name)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return nil, ErrCuisineNameTaken
+		}
+		return nil, fmt.Errorf("rename cuisine: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return nil, ErrCuisineNotFound
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT id FROM recipes
+		WHERE cuisine_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query recipes using cuisine: %w", err)
+	}
+	defer rows.Close()
+
+	var recipeIDs []uuid.UUID
+	for rows.Next() {
+		var recipeID uuid.UUID
+		if err := rows.Scan(&recipeID); err != nil {
+			return nil, fmt.Errorf("scan recipe id: %w", err)
+		}
+		recipeIDs = append(recipeIDs, recipeID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate recipes using cuisine: %w", err)
+	}
+
+	return recipeIDs, nil
+}
+
+// DeleteCuisine deletes one of the user's cuisines in one transaction. Recipes
+// using it, including those in the trash, are moved to reassignTo first; it
+// may only be nil when no recipe uses the cuisine. It returns the active
+// recipes that were moved.
+func (r *Repository) DeleteCuisine(ctx context.Context, userID, id uuid.UUID, reassignTo *uuid.UUID) ([]uuid.UUID, error) {
+	if reassignTo != nil && *reassignTo == id {
+		return nil, ErrCuisineMergeSelf
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	cuisineIDs := []uuid.UUID{id}
+	if reassignTo != nil {
+		cuisineIDs = append(cuisineIDs, *reassignTo)
+	}
+	var found int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM (
+			SELECT id FROM cuisines WHERE id = ANY($1) AND user_id = $2 FOR UPDATE
+		) locked
+	`, cuisineIDs, userID).Scan(&found)
+	if err != nil {
+		return nil, fmt.Errorf("lock cuisines: %w", err)
+	}
+	if found != len(cuisineIDs) {
+		return nil, ErrCuisineNotFound
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, deleted_at IS NULL FROM recipes
+		WHERE cuisine_id = $1
+		ORDER BY created_at
+		FOR UPDATE
+	`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query recipes using cuisine: %w", err)
+	}
+	var used bool
+	var activeIDs []uuid.UUID
+	for rows.Next() {
+		var recipeID uuid.UUID
+		var active bool
+		if err := rows.Scan(&recipeID, &active); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan recipe id: %w", err)
+		}
+		used = true
+		if active {
+			activeIDs = append(activeIDs, recipeID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate recipes using cuisine: %w", err)
+	}
+
+	if used {
+		if reassignTo == nil {
+			return nil, ErrCuisineInUse
+		}
+		_, err = tx.Exec(ctx, `UPDATE recipes SET cuisine_id = $2 WHERE cuisine_id = $1`, id, *reassignTo)
+		if err != nil {
+			return nil, fmt.Errorf("reassign recipes: %w", err)
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM cuisines WHERE id = $1`, id); err != nil {
+		return nil, fmt.Errorf("delete cuisine: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return activeIDs, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// GetIngredientDietaryAttributes retrieves the dietary attributes of the given
+// ingredients, keyed by ingredient ID. Unclassified ingredients are omitted.
+func (r *Repository) GetIngredientDietaryAttributes(ctx context.Context, ingredientIDs []uuid.UUID) (map[uuid.UUID]domain.IngredientDietaryAttributes, error) {
+	attributes := make(map[uuid.UUID]domain.IngredientDietaryAttributes)
+	if len(ingredientIDs) == 0 {
+		return attributes, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT ingredient_id, attributes, updated_at
+		FROM ingredient_dietary_attributes
+		WHERE ingredient_id = ANY($1)
+	`, ingredientIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query ingredient dietary attributes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record domain.IngredientDietaryAttributes
+		var values []string
+		if err := rows.Scan(&record.IngredientID, &values, &record.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan ingredient dietary attributes: %w", err)
+		}
+		for _, value := range values {
+			record.Attributes = append(record.Attributes, domain.IngredientAttribute(value))
+		}
+		attributes[record.IngredientID] = record
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ingredient dietary attributes: %w", err)
+	}
+
+	return attributes, nil
+}
+
+// SaveIngredientDietaryAttributes replaces the dietary attributes of an
+// ingredient, marking it classified.
+func (r *Repository) SaveIngredientDietaryAttributes(ctx context.Context, record *domain.IngredientDietaryAttributes) error {
+	values := make([]string, len(record.Attributes))
+	for i, attribute := range record.Attributes {
+		values[i] = string(attribute)
+	}
+
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO ingredient_dietary_attributes (ingredient_id, attributes)
+		VALUES ($1, $2)
+		ON CONFLICT (ingredient_id) DO UPDATE SET attributes = EXCLUDED.attributes
+		RETURNING updated_at
+	`, record.IngredientID, values).Scan(&record.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("upsert ingredient dietary attributes: %w", err)
+	}
+
+	return nil
+}
+
+// ListUnclassifiedIngredients returns ingredients of all users, ordered by ID
+// and after the given ID, that have no dietary attributes.
+func (r *Repository) ListUnclassifiedIngredients(ctx context.Context, after uuid.UUID, limit int) ([]domain.Ingredient, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT i.id, i.user_id, i.name, i.description, i.created_at, i.updated_at
+		FROM ingredients i
+		WHERE i.id > $1
+		  AND NOT EXISTS (SELECT 1 FROM ingredient_dietary_attributes a WHERE a.ingredient_id = i.id)
+		ORDER BY i.id
+		LIMIT $2
+	`, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query unclassified ingredients: %w", err)
+	}
+	defer rows.Close()
+
+	var ingredients []domain.Ingredient
+	for rows.Next() {
+		var ingredient domain.Ingredient
+		if err := rows.Scan(
+			&ingredient.ID, &ingredient.UserID, &ingredient.Name, &ingredient.Description,
+			&ingredient.CreatedAt, &ingredient.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan ingredient: %w", err)
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ingredients: %w", err)
+	}
+
+	return ingredients, nil
+}
+
+// ListRecipeIDsUsingIngredient returns the active recipes that use an
+// ingredient as their main ingredient or on any line.
+func (r *Repository) ListRecipeIDsUsingIngredient(ctx context.Context, ingredientID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT r.id FROM recipes r
+		WHERE r.deleted_at IS NULL
+		  AND (
+			r.main_ingredient_id = $1 OR EXISTS (
+				SELECT 1 FROM recipe_ingredient_lines ril
+				WHERE ril.recipe_id = r.id AND ril.ingredient_id = $1
+			)
+		  )
+		ORDER BY r.created_at
+	`, ingredientID)
+	if err != nil {
+		return nil, fmt.Errorf("query recipes using ingredient: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan recipe id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate recipes using ingredient: %w", err)
+	}
+
+	return ids, nil
+}
+
+// UpdateRecipeDietLabels stores the diet labels of a recipe and whether it is
+// classified without recording a revision, since they follow from its
+// ingredients rather than an edit.
+func (r *Repository) UpdateRecipeDietLabels(ctx context.Context, recipeID uuid.UUID, labels []domain.DietLabel, classified bool) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE recipes SET diet_labels = $2, diet_classified = $3 WHERE id = $1 AND deleted_at IS NULL
+	`, recipeID, dietLabelStrings(labels), classified)
+	if err != nil {
+		return fmt.Errorf("update recipe diet labels: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrRecipeNotFound
+	}
+	return nil
+}
+
+func dietLabelStrings(labels []domain.DietLabel) []string {
+	values := make([]string, len(labels))
+	for i, label := range labels {
+		values[i] = string(label)
+	}
+	return values
+}
+
+func toDietLabels(values []string) []domain.DietLabel {
+	var labels []domain.DietLabel
+	for _, value := range values {
+		labels = append(labels, domain.DietLabel(value))
+	}
+	return labels
+}
@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// GetDietaryProfile retrieves a user's dietary profile. Users who never saved
+// one get an empty profile.
+func (r *Repository) GetDietaryProfile(ctx context.Context, userID uuid.UUID) (*domain.DietaryProfile, error) {
+	profile := &domain.DietaryProfile{UserID: userID}
+
+	var diets []string
+	err := r.pool.QueryRow(ctx, `
+		SELECT diets, disliked_ingredients, updated_at
+		FROM dietary_profiles
+		WHERE user_id = $1
+	`, userID).Scan(&diets, &profile.DislikedIngredients, &profile.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return profile, nil
+		}
+		return nil, fmt.Errorf("query dietary profile: %w", err)
+	}
+	for _, diet := range diets {
+		profile.Diets = append(profile.Diets, domain.Diet(diet))
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT a.id, a.name, a.created_at
+		FROM dietary_profile_allergies dpa
+		JOIN allergies a ON a.id = dpa.allergy_id
+		WHERE dpa.user_id = $1
+		ORDER BY a.name
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query dietary profile allergies: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var allergy domain.Allergy
+		if err := rows.Scan(&allergy.ID, &allergy.Name, &allergy.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan allergy: %w", err)
+		}
+		profile.Allergies = append(profile.Allergies, allergy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate dietary profile allergies: %w", err)
+	}
+
+	return profile, nil
+}
+
+// SaveDietaryProfile replaces a user's dietary profile.
+func (r *Repository) SaveDietaryProfile(ctx context.Context, profile *domain.DietaryProfile) error {
+	diets := make([]string, len(profile.Diets))
+	for i, diet := range profile.Diets {
+		diets[i] = string(diet)
+	}
+	disliked := profile.DislikedIngredients
+	if disliked == nil {
+		disliked = []string{}
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO dietary_profiles (user_id, diets, disliked_ingredients)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET
+			diets = EXCLUDED.diets,
+			disliked_ingredients = EXCLUDED.disliked_ingredients,
+			updated_at = NOW()
+		RETURNING updated_at
+	`, profile.UserID, diets, disliked).Scan(&profile.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("upsert dietary profile: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM dietary_profile_allergies WHERE user_id = $1`, profile.UserID); err != nil {
+		return fmt.Errorf("delete dietary profile allergies: %w", err)
+	}
+	for _, allergy := range profile.Allergies {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO dietary_profile_allergies (user_id, allergy_id) VALUES ($1, $2)
+		`, profile.UserID, allergy.ID)
+		if err != nil {
+			return fmt.Errorf("insert dietary profile allergy: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListAllergies retrieves all allergies ordered by name.
+func (r *Repository) ListAllergies(ctx context.Context) ([]domain.Allergy, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, name, created_at FROM allergies ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("query allergies: %w", err)
+	}
+	defer rows.Close()
+
+	var allergies []domain.Allergy
+	for rows.Next() {
+		var allergy domain.Allergy
+		if err := rows.Scan(&allergy.ID, &allergy.Name, &allergy.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan allergy: %w", err)
+		}
+		allergies = append(allergies, allergy)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate allergies: %w", err)
+	}
+
+	return allergies, nil
+}
+
+// dietaryProfileConditions renders WHERE conditions on the recipes alias "r"
+// that leave out recipes conflicting with the profile, appending their
+// parameters to args. Allergies count on any ingredient; dislikes only on the
+// main ingredient and required lines, since optional ones can be left out.
+func dietaryProfileConditions(profile *domain.DietaryProfile, args []any) (string, []any) {
+	var sb strings.Builder
+	argPos := len(args) + 1
+
+	if len(profile.Allergies) > 0 {
+		sb.WriteString(fmt.Sprintf(`
+			AND NOT EXISTS (
+				SELECT 1 FROM ingredient_allergies ia
+				WHERE ia.allergy_id = ANY($%d)
+				  AND (
+					ia.ingredient_id = r.main_ingredient_id OR ia.ingredient_id IN (
+						SELECT ril.ingredient_id FROM recipe_ingredient_lines ril
+						WHERE ril.recipe_id = r.id
+					)
+				  )
+			)
+		`, argPos))
+		args = append(args, profile.AllergyIDs())
+		argPos++
+	}
+
+	if len(profile.Diets) > 0 {
+		labels := make([]string, len(profile.Diets))
+		for i, diet := range profile.Diets {
+			labels[i] = string(diet.Label())
+		}
+		// Unclassified recipes are unknown rather than unfit, so they stay in
+		sb.WriteString(fmt.Sprintf(" AND (NOT r.diet_classified OR r.diet_labels @> $%d)", argPos))
+		args = append(args, labels)
+		argPos++
+	}
+
+	if len(profile.DislikedIngredients) > 0 {
+		names := make([]string, len(profile.DislikedIngredients))
+		for i, name := range profile.DislikedIngredients {
+			names[i] = strings.ToLower(name)
+		}
+		sb.WriteString(fmt.Sprintf(`
+			AND NOT EXISTS (
+				SELECT 1 FROM ingredients i
+				WHERE LOWER(i.name) = ANY($%d)
+				  AND (
+					i.id = r.main_ingredient_id OR i.id IN (
+						SELECT ril.ingredient_id FROM recipe_ingredient_lines ril
+						WHERE ril.recipe_id = r.id AND NOT ril.is_optional
+					)
+				  )
+			)
+		`, argPos))
+		args = append(args, names)
+	}
+
+	return sb.String(), args
+}
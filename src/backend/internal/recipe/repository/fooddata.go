@@ -0,0 +1,484 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/fooddata"
+)
+
+var ErrNutritionFoodNotFound = errors.New("nutrition food not found")
+
+// Reference foods store nutrition per 100 g.
+const (
+	foodServingSize = 100
+	foodServingUnit = "g"
+)
+
+// Nutrition reference import
+
+// GetImportProgress returns how far an import of a nutrition file got.
+func (r *Repository) GetImportProgress(ctx context.Context, key fooddata.ImportKey) (fooddata.ImportProgress, error) {
+	var progress fooddata.ImportProgress
+	var completedAt *time.Time
+
+	err := r.pool.QueryRow(ctx, `
+		SELECT rows_done, completed_at
+		FROM nutrition_imports
+		WHERE source = $1 AND file_name = $2 AND file_size = $3
+	`, key.Source, key.FileName, key.FileSize).Scan(&progress.RowsDone, &completedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return progress, nil
+		}
+		return progress, fmt.Errorf("query import progress: %w", err)
+	}
+
+	progress.Completed = completedAt != nil
+	return progress, nil
+}
+
+// SaveFoods upserts a batch of reference foods with their synonyms, refreshes
+// ingredient nutrition linked to them and records the import progress.
+func (r *Repository) SaveFoods(ctx context.Context, key fooddata.ImportKey, foods []domain.NutritionFood, rowsDone int64) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	foodIDs := make([]uuid.UUID, 0, len(foods))
+	for _, food := range foods {
+		var foodID uuid.UUID
+		err := tx.QueryRow(ctx, `
+			INSERT INTO nutrition_foods (
+				source, source_id, name, normalized_name,
+				calories, protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
+			) VALUES (
+				$1, $2, $3, $4,
+				$5, $6, $7, $8, $9, $10, $11
+			)
+			ON CONFLICT (source, source_id) DO UPDATE SET
+				name = EXCLUDED.name,
+				normalized_name = EXCLUDED.normalized_name,
+				calories = EXCLUDED.calories,
+				protein_g = EXCLUDED.protein_g,
+				carbs_g = EXCLUDED.carbs_g,
+				fat_g = EXCLUDED.fat_g,
+				fiber_g = EXCLUDED.fiber_g,
+				sugar_g = EXCLUDED.sugar_g,
+				sodium_mg = EXCLUDED.sodium_mg
+			RETURNING id
+		`, food.Source, food.SourceID, food.Name, fooddata.Normalize(food.Name),
+			food.Calories, food.ProteinG, food.CarbsG, food.FatG, food.FiberG, food.SugarG, food.SodiumMg,
+		).Scan(&foodID)
+		if err != nil {
+			return fmt.Errorf("upsert nutrition food %s: %w", food.SourceID, err)
+		}
+		foodIDs = append(foodIDs, foodID)
+
+		_, err = tx.Exec(ctx, `DELETE FROM nutrition_food_synonyms WHERE food_id = $1`, foodID)
+		if err != nil {
+			return fmt.Errorf("delete food synonyms: %w", err)
+		}
+		for _, synonym := range fooddata.Synonyms(food.Name, food.Synonyms) {
+			_, err = tx.Exec(ctx, `INSERT INTO nutrition_food_synonyms (food_id, synonym) VALUES ($1, $2)`, foodID, synonym)
+			if err != nil {
+				return fmt.Errorf("insert food synonym: %w", err)
+			}
+		}
+	}
+
+	if len(foodIDs) > 0 {
+		_, err = tx.Exec(ctx, `
+			UPDATE ingredient_nutrition n SET
+				calories = ROUND(f.calories),
+				protein_g = f.protein_g,
+				carbs_g = f.carbs_g,
+				fat_g = f.fat_g,
+				fiber_g = f.fiber_g,
+				sugar_g = f.sugar_g,
+				sodium_mg = f.sodium_mg
+			FROM nutrition_foods f
+			WHERE n.food_id = f.id AND f.id = ANY($1)
+		`, foodIDs)
+		if err != nil {
+			return fmt.Errorf("refresh linked ingredient nutrition: %w", err)
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO nutrition_imports (source, file_name, file_size, rows_done)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (source, file_name, file_size) DO UPDATE SET
+			rows_done = EXCLUDED.rows_done,
+			updated_at = NOW()
+	`, key.Source, key.FileName, key.FileSize, rowsDone)
+	if err != nil {
+		return fmt.Errorf("save import progress: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteImport marks an import of a nutrition file as finished.
+func (r *Repository) CompleteImport(ctx context.Context, key fooddata.ImportKey) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO nutrition_imports (source, file_name, file_size, completed_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (source, file_name, file_size) DO UPDATE SET
+			completed_at = NOW(),
+			updated_at = NOW()
+	`, key.Source, key.FileName, key.FileSize)
+	if err != nil {
+		return fmt.Errorf("complete import: %w", err)
+	}
+	return nil
+}
+
+// Ingredient matching
+
+// ListIngredientsToMatch returns ingredients of all users, ordered by ID and
+// after the given ID, that have no match yet or matched nothing before.
+func (r *Repository) ListIngredientsToMatch(ctx context.Context, after uuid.UUID, limit int) ([]domain.Ingredient, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT i.id, i.user_id, i.name, i.description, i.created_at, i.updated_at
+		FROM ingredients i
+		LEFT JOIN ingredient_food_matches m ON m.ingredient_id = i.id
+		WHERE i.id > $1
+		  AND (m.ingredient_id IS NULL OR m.status = 'unmatched')
+		ORDER BY i.id
+		LIMIT $2
+	`, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query ingredients to match: %w", err)
+	}
+	defer rows.Close()
+
+	var ingredients []domain.Ingredient
+	for rows.Next() {
+		var ingredient domain.Ingredient
+		if err := rows.Scan(
+			&ingredient.ID, &ingredient.UserID, &ingredient.Name, &ingredient.Description,
+			&ingredient.CreatedAt, &ingredient.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan ingredient: %w", err)
+		}
+		ingredients = append(ingredients, ingredient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ingredients: %w", err)
+	}
+
+	return ingredients, nil
+}
+
+// FindFoodCandidates returns the reference foods whose normalized name or a
+// synonym equals normalizedName, full-name matches first.
+func (r *Repository) FindFoodCandidates(ctx context.Context, normalizedName string, limit int) ([]fooddata.FoodCandidate, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT f.id, f.normalized_name = $1 AS exact
+		FROM nutrition_foods f
+		WHERE f.id IN (
+			SELECT id FROM nutrition_foods WHERE normalized_name = $1
+			UNION
+			SELECT food_id FROM nutrition_food_synonyms WHERE synonym = $1
+		)
+		ORDER BY exact DESC, length(f.name), f.name
+		LIMIT $2
+	`, normalizedName, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query food candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []fooddata.FoodCandidate
+	for rows.Next() {
+		var candidate fooddata.FoodCandidate
+		if err := rows.Scan(&candidate.FoodID, &candidate.Exact); err != nil {
+			return nil, fmt.Errorf("scan food candidate: %w", err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate food candidates: %w", err)
+	}
+
+	return candidates, nil
+}
+
+// SaveMatch stores an automatic match result. Matches confirmed or rejected by
+// a user are left alone, as is ingredient nutrition that was entered by hand.
+func (r *Repository) SaveMatch(ctx context.Context, ingredientID uuid.UUID, status domain.FoodMatchStatus, foodID *uuid.UUID, candidates []uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if candidates == nil {
+		candidates = []uuid.UUID{}
+	}
+
+	result, err := tx.Exec(ctx, `
+		INSERT INTO ingredient_food_matches (ingredient_id, status, food_id, candidate_food_ids)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (ingredient_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			food_id = EXCLUDED.food_id,
+			candidate_food_ids = EXCLUDED.candidate_food_ids,
+			updated_at = NOW()
+		WHERE ingredient_food_matches.status NOT IN ('confirmed', 'rejected')
+	`, ingredientID, string(status), foodID, candidates)
+	if err != nil {
+		return fmt.Errorf("save ingredient match: %w", err)
+	}
+
+	if result.RowsAffected() > 0 && foodID != nil {
+		if err := linkIngredientNutrition(ctx, tx, ingredientID, *foodID, false); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListIngredientMatches returns the user's ingredient matches with the given
+// status, with their linked food and candidates.
+func (r *Repository) ListIngredientMatches(ctx context.Context, userID uuid.UUID, status domain.FoodMatchStatus) ([]domain.IngredientFoodMatch, error) {
+	return r.queryIngredientMatches(ctx, `i.user_id = $1 AND m.status = $2`, userID, string(status))
+}
+
+// ResolveIngredientMatch records a user's decision for an ingredient: the
+// chosen food, whose nutrition is copied to the ingredient, or none when
+// foodID is nil.
+func (r *Repository) ResolveIngredientMatch(ctx context.Context, userID, ingredientID uuid.UUID, foodID *uuid.UUID) (*domain.IngredientFoodMatch, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM ingredients WHERE id = $1 AND user_id = $2)`, ingredientID, userID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("query ingredient: %w", err)
+	}
+	if !exists {
+		return nil, ErrIngredientNotFound
+	}
+
+	status := domain.FoodMatchRejected
+	if foodID != nil {
+		status = domain.FoodMatchConfirmed
+		err = tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM nutrition_foods WHERE id = $1)`, *foodID).Scan(&exists)
+		if err != nil {
+			return nil, fmt.Errorf("query nutrition food: %w", err)
+		}
+		if !exists {
+			return nil, ErrNutritionFoodNotFound
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO ingredient_food_matches (ingredient_id, status, food_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (ingredient_id) DO UPDATE SET
+			status = EXCLUDED.status,
+			food_id = EXCLUDED.food_id,
+			updated_at = NOW()
+	`, ingredientID, string(status), foodID)
+	if err != nil {
+		return nil, fmt.Errorf("save ingredient match: %w", err)
+	}
+
+	if foodID != nil {
+		if err := linkIngredientNutrition(ctx, tx, ingredientID, *foodID, true); err != nil {
+			return nil, err
+		}
+	} else {
+		_, err = tx.Exec(ctx, `DELETE FROM ingredient_nutrition WHERE ingredient_id = $1 AND food_id IS NOT NULL`, ingredientID)
+		if err != nil {
+			return nil, fmt.Errorf("unlink ingredient nutrition: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	matches, err := r.queryIngredientMatches(ctx, `i.id = $1`, ingredientID)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, ErrIngredientNotFound
+	}
+	return &matches[0], nil
+}
+
+// linkIngredientNutrition copies the nutrition of a reference food to an
+// ingredient. Unless overwrite is set, nutrition entered by hand is kept.
+func linkIngredientNutrition(ctx context.Context, tx pgx.Tx, ingredientID, foodID uuid.UUID, overwrite bool) error {
+	query := `
+		INSERT INTO ingredient_nutrition (
+			ingredient_id, food_id, serving_size_value, serving_unit,
+			calories, protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
+		)
+		SELECT
+			$1, f.id, $3, $4,
+			ROUND(f.calories), f.protein_g, f.carbs_g, f.fat_g, f.fiber_g, f.sugar_g, f.sodium_mg
+		FROM nutrition_foods f
+		WHERE f.id = $2
+		ON CONFLICT (ingredient_id) DO UPDATE SET
+			food_id = EXCLUDED.food_id,
+			serving_size_value = EXCLUDED.serving_size_value,
+			serving_unit = EXCLUDED.serving_unit,
+			calories = EXCLUDED.calories,
+			protein_g = EXCLUDED.protein_g,
+			carbs_g = EXCLUDED.carbs_g,
+			fat_g = EXCLUDED.fat_g,
+			fiber_g = EXCLUDED.fiber_g,
+			sugar_g = EXCLUDED.sugar_g,
+			sodium_mg = EXCLUDED.sodium_mg
+	`
+	if !overwrite {
+		query += ` WHERE ingredient_nutrition.food_id IS NOT NULL`
+	}
+
+	if _, err := tx.Exec(ctx, query, ingredientID, foodID, foodServingSize, foodServingUnit); err != nil {
+		return fmt.Errorf("link ingredient nutrition: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) queryIngredientMatches(ctx context.Context, condition string, args ...any) ([]domain.IngredientFoodMatch, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			i.id, i.user_id, i.name, i.description, i.created_at, i.updated_at,
+			m.status, m.food_id, m.candidate_food_ids, m.updated_at
+		FROM ingredient_food_matches m
+		JOIN ingredients i ON i.id = m.ingredient_id
+		WHERE `+condition+`
+		ORDER BY i.name
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query ingredient matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []domain.IngredientFoodMatch
+	var foodIDs []uuid.UUID
+	var linked []*uuid.UUID
+	var candidates [][]uuid.UUID
+	for rows.Next() {
+		var match domain.IngredientFoodMatch
+		var status string
+		var foodID *uuid.UUID
+		var candidateIDs []uuid.UUID
+
+		if err := rows.Scan(
+			&match.Ingredient.ID, &match.Ingredient.UserID, &match.Ingredient.Name, &match.Ingredient.Description,
+			&match.Ingredient.CreatedAt, &match.Ingredient.UpdatedAt,
+			&status, &foodID, &candidateIDs, &match.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan ingredient match: %w", err)
+		}
+		match.Status = domain.FoodMatchStatus(status)
+
+		if foodID != nil {
+			foodIDs = append(foodIDs, *foodID)
+		}
+		foodIDs = append(foodIDs, candidateIDs...)
+		matches = append(matches, match)
+		linked = append(linked, foodID)
+		candidates = append(candidates, candidateIDs)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ingredient matches: %w", err)
+	}
+
+	foods, err := r.getNutritionFoods(ctx, foodIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range matches {
+		if linked[i] != nil {
+			if food, ok := foods[*linked[i]]; ok {
+				matches[i].Food = &food
+			}
+		}
+		for _, id := range candidates[i] {
+			if food, ok := foods[id]; ok {
+				matches[i].Candidates = append(matches[i].Candidates, food)
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+func (r *Repository) getNutritionFoods(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]domain.NutritionFood, error) {
+	foods := make(map[uuid.UUID]domain.NutritionFood)
+	if len(ids) == 0 {
+		return foods, nil
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			id, source, source_id, name,
+			calories, protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
+		FROM nutrition_foods
+		WHERE id = ANY($1)
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query nutrition foods: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var food domain.NutritionFood
+		var calories, protein, carbs, fat, fiber, sugar, sodium pgtype.Numeric
+
+		if err := rows.Scan(
+			&food.ID, &food.Source, &food.SourceID, &food.Name,
+			&calories, &protein, &carbs, &fat, &fiber, &sugar, &sodium,
+		); err != nil {
+			return nil, fmt.Errorf("scan nutrition food: %w", err)
+		}
+
+		food.Calories = numericToFloat(calories)
+		food.ProteinG = numericToFloat(protein)
+		food.CarbsG = numericToFloat(carbs)
+		food.FatG = numericToFloat(fat)
+		food.FiberG = numericToFloat(fiber)
+		food.SugarG = numericToFloat(sugar)
+		food.SodiumMg = numericToFloat(sodium)
+		foods[food.ID] = food
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate nutrition foods: %w", err)
+	}
+
+	return foods, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/dietary"
+)
+
+// SyncForkRevision records that a duplicated recipe now matches the given
+// revision of its upstream recipe.
+func (r *Repository) SyncForkRevision(ctx context.Context, recipeID uuid.UUID, revision int) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE recipes SET forked_from_revision = $2
+		WHERE id = $1 AND forked_from_recipe_id IS NOT NULL
+	`, recipeID, revision)
+	if err != nil {
+		return fmt.Errorf("update fork revision: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrRecipeNotFound
+	}
+
+	return nil
+}
+
+// setForkedFrom links a new recipe to the recipe it was duplicated from, at
+// the upstream's current revision.
+func setForkedFrom(ctx context.Context, tx pgx.Tx, recipe *domain.Recipe) error {
+	err := tx.QueryRow(ctx, `
+		UPDATE recipes SET
+			forked_from_recipe_id = $2,
+			forked_from_revision = (
+				SELECT COALESCE(MAX(revision), 0) FROM recipe_revisions WHERE recipe_id = $2
+			)
+		WHERE id = $1
+		RETURNING forked_from_revision
+	`, recipe.ID, recipe.ForkedFrom.RecipeID).Scan(&recipe.ForkedFrom.Revision)
+	if err != nil {
+		return fmt.Errorf("link forked recipe: %w", err)
+	}
+	recipe.ForkedFrom.LatestRevision = recipe.ForkedFrom.Revision
+	return nil
+}
+
+// CopyIngredient returns the owner's ingredient named like source. When the
+// owner has none, it is created with the allergies, nutrition facts and
+// dietary attributes of source, so a copied recipe keeps what it contains.
+func (r *Repository) CopyIngredient(ctx context.Context, ownerID uuid.UUID, source *domain.Ingredient) (*domain.Ingredient, error) {
+	ingredient, err := r.GetIngredientByName(ctx, ownerID, source.Name)
+	if err == nil {
+		return ingredient, nil
+	}
+	if !errors.Is(err, ErrIngredientNotFound) {
+		return nil, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	id := uuid.New()
+	result, err := tx.Exec(ctx, `
+		INSERT INTO ingredients (id, user_id, name, description, category_id, density_g_per_ml)
+		SELECT $1, $2, $3, description, category_id, density_g_per_ml
+		FROM ingredients
+		WHERE id = $4
+	`, id, ownerID, source.Name, source.ID)
+	if err != nil {
+		return nil, fmt.Errorf("insert ingredient: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		// The source is gone; there is nothing to copy.
+		return r.GetOrCreateIngredient(ctx, ownerID, source.Name)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO ingredient_allergies (ingredient_id, allergy_id)
+		SELECT $2, allergy_id FROM ingredient_allergies WHERE ingredient_id = $1
+	`, source.ID, id)
+	if err != nil {
+		return nil, fmt.Errorf("copy ingredient allergies: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO ingredient_nutrition (
+			ingredient_id, food_id, serving_size_value, serving_unit,
+			calories, protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
+		)
+		SELECT
+			$2, food_id, serving_size_value, serving_unit,
+			calories, protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
+		FROM ingredient_nutrition
+		WHERE ingredient_id = $1
+	`, source.ID, id)
+	if err != nil {
+		return nil, fmt.Errorf("copy ingredient nutrition: %w", err)
+	}
+
+	result, err = tx.Exec(ctx, `
+		INSERT INTO ingredient_dietary_attributes (ingredient_id, attributes)
+		SELECT $2, attributes FROM ingredient_dietary_attributes WHERE ingredient_id = $1
+	`, source.ID, id)
+	if err != nil {
+		return nil, fmt.Errorf("copy ingredient dietary attributes: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		// An unclassified source is classified like a new ingredient.
+		catalog, err := dietary.Bundled()
+		if err != nil {
+			return nil, err
+		}
+		if attributes, ok := catalog.Lookup(source.Name); ok {
+			values := make([]string, len(attributes))
+			for i, attribute := range attributes {
+				values[i] = string(attribute)
+			}
+			_, err = tx.Exec(ctx, `
+				INSERT INTO ingredient_dietary_attributes (ingredient_id, attributes) VALUES ($1, $2)
+			`, id, values)
+			if err != nil {
+				return nil, fmt.Errorf("insert ingredient dietary attributes: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return r.GetIngredientByID(ctx, ownerID, id)
+}
+
+// getRecipeFork loads the upstream link of a recipe owned by ownerID, or nil
+// when it was not duplicated or its upstream is no longer available to the
+// owner: purged, in the trash, or no longer shared with them.
+func (r *Repository) getRecipeFork(ctx context.Context, ownerID, recipeID uuid.UUID) (*domain.RecipeFork, error) {
+	var fork domain.RecipeFork
+	err := r.pool.QueryRow(ctx, `
+		SELECT
+			up.id, up.name, COALESCE(r.forked_from_revision, 0),
+			COALESCE((SELECT MAX(rr.revision) FROM recipe_revisions rr WHERE rr.recipe_id = up.id), 0)
+		FROM recipes r
+		JOIN recipes up ON up.id = r.forked_from_recipe_id
+		WHERE r.id = $1 AND `+accessClause("up", 2)+` AND `+activeClause("up")+`
+	`, recipeID, ownerID).Scan(&fork.RecipeID, &fork.RecipeName, &fork.Revision, &fork.LatestRevision)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query recipe fork: %w", err)
+	}
+	return &fork, nil
+}
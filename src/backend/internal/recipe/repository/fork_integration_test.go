@@ -0,0 +1,88 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+func TestGetByID_UpstreamDeleted_DropsForkLink(t *testing.T) {
+	ctx, repo, pool := givenRepository(t)
+	userID := givenUser(t, ctx, repo, pool)
+	pasta := givenIngredient(t, ctx, repo, userID, "pasta")
+	upstream := givenRecipe(t, ctx, repo, userID, "Lasagna", pasta)
+	duplicate := &domain.Recipe{
+		UserID:         userID,
+		Name:           "My Lasagna",
+		Servings:       4,
+		MainIngredient: pasta,
+		Cuisine:        upstream.Cuisine,
+		ForkedFrom:     &domain.RecipeFork{RecipeID: upstream.ID},
+	}
+	if err := repo.Create(ctx, duplicate); err != nil {
+		t.Fatalf("create duplicate: %v", err)
+	}
+
+	linked, err := repo.GetByID(ctx, userID, duplicate.ID)
+	if err != nil {
+		t.Fatalf("get duplicate: %v", err)
+	}
+	if linked.ForkedFrom == nil || linked.ForkedFrom.RecipeID != upstream.ID {
+		t.Fatalf("expected the duplicate to link to its upstream, got %+v", linked.ForkedFrom)
+	}
+
+	if err := repo.Delete(ctx, userID, upstream.ID); err != nil {
+		t.Fatalf("delete upstream: %v", err)
+	}
+
+	unlinked, err := repo.GetByID(ctx, userID, duplicate.ID)
+	if err != nil {
+		t.Fatalf("get duplicate: %v", err)
+	}
+	if unlinked.ForkedFrom != nil {
+		t.Fatalf("expected no fork link to a deleted upstream, got %+v", unlinked.ForkedFrom)
+	}
+}
+
+func TestCopyIngredient_OtherOwner_CopiesAllergiesAndDietaryAttributes(t *testing.T) {
+	ctx, repo, pool := givenRepository(t)
+	ownerID := givenUser(t, ctx, repo, pool)
+	friendID := givenUser(t, ctx, repo, pool)
+	var allergyID uuid.UUID
+	if err := pool.QueryRow(ctx, `INSERT INTO allergies (name) VALUES ('Integration dairy') RETURNING id`).Scan(&allergyID); err != nil {
+		t.Fatalf("create allergy: %v", err)
+	}
+	t.Cleanup(func() { pool.Exec(context.Background(), `DELETE FROM allergies WHERE id = $1`, allergyID) })
+	ricotta := givenIngredient(t, ctx, repo, ownerID, "ricotta")
+	if err := repo.AddIngredientAllergy(ctx, ricotta.ID, allergyID); err != nil {
+		t.Fatalf("add ingredient allergy: %v", err)
+	}
+	record := &domain.IngredientDietaryAttributes{IngredientID: ricotta.ID, Attributes: []domain.IngredientAttribute{domain.IngredientAttributeDairy}}
+	if err := repo.SaveIngredientDietaryAttributes(ctx, record); err != nil {
+		t.Fatalf("save dietary attributes: %v", err)
+	}
+
+	copied, err := repo.CopyIngredient(ctx, friendID, ricotta)
+
+	if err != nil {
+		t.Fatalf("copy ingredient: %v", err)
+	}
+	if copied.ID == ricotta.ID || copied.UserID != friendID {
+		t.Fatalf("expected a new ingredient of the friend, got %+v", copied)
+	}
+	if len(copied.Allergies) != 1 || copied.Allergies[0].ID != allergyID {
+		t.Fatalf("expected the allergy to be copied, got %+v", copied.Allergies)
+	}
+	attributes, err := repo.GetIngredientDietaryAttributes(ctx, []uuid.UUID{copied.ID})
+	if err != nil {
+		t.Fatalf("get dietary attributes: %v", err)
+	}
+	if !attributes[copied.ID].Has(domain.IngredientAttributeDairy) {
+		t.Fatalf("expected the dietary attributes to be copied, got %+v", attributes[copied.ID])
+	}
+}
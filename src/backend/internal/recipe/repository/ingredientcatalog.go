@@ -0,0 +1,423 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+var (
+	// ErrIngredientNameTaken is returned when renaming an ingredient to the
+	// name of another ingredient of the same user; those should be merged.
+	ErrIngredientNameTaken = errors.New("ingredient name is already taken")
+	// ErrIngredientCategoryNotFound is returned for unknown category IDs.
+	ErrIngredientCategoryNotFound = errors.New("ingredient category not found")
+	// ErrIngredientMergeSelf is returned when merging an ingredient into itself.
+	ErrIngredientMergeSelf = errors.New("ingredient cannot be merged into itself")
+)
+
+// uniqueViolation is the Postgres error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+// catalogColumns selects an ingredient with its category and the number of
+// active recipes using it.
+func catalogColumns(ingredient, category string) string {
+	return fmt.Sprintf(`
+		%[1]s.id, %[1]s.user_id, %[1]s.name, %[1]s.description, %[1]s.created_at, %[1]s.updated_at,
+		%[2]s.id, %[2]s.name, %[2]s.display_order,
+		(
+			SELECT COUNT(*) FROM recipes r
+			WHERE r.deleted_at IS NULL
+			  AND (
+				r.main_ingredient_id = %[1]s.id OR EXISTS (
+					SELECT 1 FROM recipe_ingredient_lines ril
+					WHERE ril.recipe_id = r.id AND ril.ingredient_id = %[1]s.id
+				)
+			  )
+		)`, ingredient, category)
+}
+
+// ingredientSearchCondition matches ingredient names against a search query,
+// either as a substring or by trigram similarity so that misspellings and
+// plural forms still match.
+const ingredientSearchCondition = `(
+	strpos(lower(i.name), lower($2)) > 0
+	OR lower(i.name) % lower($2)
+	OR lower($2) <% lower(i.name)
+)`
+
+// ingredientSearchScore ranks ingredient names by how well they match a
+// search query.
+const ingredientSearchScore = `GREATEST(
+	similarity(lower(i.name), lower($2)),
+	word_similarity(lower($2), lower(i.name))
+)`
+
+// ListIngredients retrieves the user's ingredients ordered by name, or by how
+// well they match the query when one is given.
+func (r *Repository) ListIngredients(ctx context.Context, userID uuid.UUID, query string, limit, offset int) ([]domain.Ingredient, error) {
+	var rows pgx.Rows
+	var err error
+	if query == "" {
+		rows, err = r.pool.Query(ctx, `
+			SELECT `+catalogColumns("i", "ic")+`, 0::float8
+			FROM ingredients i
+			LEFT JOIN ingredient_categories ic ON ic.id = i.category_id
+			WHERE i.user_id = $1
+			ORDER BY lower(i.name), i.id
+			LIMIT $2 OFFSET $3
+		`, userID, limit, offset)
+	} else {
+		rows, err = r.pool.Query(ctx, `
+			SELECT `+catalogColumns("i", "ic")+`, `+ingredientSearchScore+` AS score
+			FROM ingredients i
+			LEFT JOIN ingredient_categories ic ON ic.id = i.category_id
+			WHERE i.user_id = $1 AND `+ingredientSearchCondition+`
+			ORDER BY score DESC, lower(i.name), i.id
+			LIMIT $3 OFFSET $4
+		`, userID, query, limit, offset)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query ingredients: %w", err)
+	}
+	defer rows.Close()
+
+	var ingredients []domain.Ingredient
+	for rows.Next() {
+		var row catalogIngredientRow
+		var score float64
+		if err := rows.Scan(append(row.dest(), &score)...); err != nil {
+			return nil, fmt.Errorf("scan ingredient: %w", err)
+		}
+		ingredient := row.toDomain()
+		ingredient.SearchScore = score
+		ingredients = append(ingredients, ingredient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ingredients: %w", err)
+	}
+
+	return ingredients, nil
+}
+
+// CountIngredients counts the user's ingredients matching the query.
+func (r *Repository) CountIngredients(ctx context.Context, userID uuid.UUID, query string) (int64, error) {
+	var count int64
+	var err error
+	if query == "" {
+		err = r.pool.QueryRow(ctx, `SELECT COUNT(*) FROM ingredients i WHERE i.user_id = $1`, userID).Scan(&count)
+	} else {
+		err = r.pool.QueryRow(ctx, `
+			SELECT COUNT(*) FROM ingredients i
+			WHERE i.user_id = $1 AND `+ingredientSearchCondition, userID, query).Scan(&count)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("count ingredients: %w", err)
+	}
+	return count, nil
+}
+
+// RenameIngredient renames one of the user's ingredients and refreshes the
+// search documents of the recipes using it.
+func (r *Repository) RenameIngredient(ctx context.Context, userID, id uuid.UUID, name string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, `UPDATE ingredients SET name = $3 WHERE id = $1 AND user_id = $2`, id, userID, name)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrIngredientNameTaken
+		}
+		return fmt.Errorf("rename ingredient: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrIngredientNotFound
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE recipes SET search_document = recipe_search_document(id)
+		WHERE id IN (SELECT recipe_id FROM recipe_ingredient_lines WHERE ingredient_id = $1)
+	`, id)
+	if err != nil {
+		return fmt.Errorf("refresh search documents: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// SetIngredientCategory files one of the user's ingredients under a category,
+// or clears its category when categoryID is nil.
+func (r *Repository) SetIngredientCategory(ctx context.Context, userID, id uuid.UUID, categoryID *uuid.UUID) error {
+	if categoryID != nil {
+		var exists bool
+		err := r.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM ingredient_categories WHERE id = $1)`, *categoryID).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("query ingredient category: %w", err)
+		}
+		if !exists {
+			return ErrIngredientCategoryNotFound
+		}
+	}
+
+	result, err := r.pool.Exec(ctx, `UPDATE ingredients SET category_id = $3 WHERE id = $1 AND user_id = $2`, id, userID, categoryID)
+	if err != nil {
+		return fmt.Errorf("set ingredient category: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		return ErrIngredientNotFound
+	}
+	return nil
+}
+
+// ListIngredientCategories retrieves the categories ingredients can be filed
+// under, in display order.
+func (r *Repository) ListIngredientCategories(ctx context.Context) ([]domain.IngredientCategory, error) {
+	rows, err := r.pool.Query(ctx, `SELECT id, name, display_order, created_at FROM ingredient_categories ORDER BY display_order, name`)
+	if err != nil {
+		return nil, fmt.Errorf("query ingredient categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []domain.IngredientCategory
+	for rows.Next() {
+		var category domain.IngredientCategory
+		if err := rows.Scan(&category.ID, &category.Name, &category.DisplayOrder, &category.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan ingredient category: %w", err)
+		}
+		categories = append(categories, category)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ingredient categories: %w", err)
+	}
+
+	return categories, nil
+}
+
+// MergeIngredients replaces every use of the source ingredient with the target
+// and deletes the source, in one transaction. Recipe lines, main ingredients
+// and shopping list items are rewritten, allergies are combined, and
+// nutrition, food matches, dietary attributes, the category and the
+// description carry over where the target has none. Revision snapshots are
+// immutable and keep the source; restoring one resolves it by name. It returns
+// the active recipes that used the source.
+func (r *Repository) MergeIngredients(ctx context.Context, userID, sourceID, targetID uuid.UUID) ([]uuid.UUID, error) {
+	if sourceID == targetID {
+		return nil, ErrIngredientMergeSelf
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var found int
+	err = tx.QueryRow(ctx, `
+		SELECT COUNT(*) FROM (
+			SELECT id FROM ingredients WHERE id = ANY($1) AND user_id = $2 FOR UPDATE
+		) locked
+	`, []uuid.UUID{sourceID, targetID}, userID).Scan(&found)
+	if err != nil {
+		return nil, fmt.Errorf("lock ingredients: %w", err)
+	}
+	if found != 2 {
+		return nil, ErrIngredientNotFound
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT r.id, r.deleted_at IS NULL FROM recipes r
+		WHERE r.main_ingredient_id = $1 OR EXISTS (
+			SELECT 1 FROM recipe_ingredient_lines ril
+			WHERE ril.recipe_id = r.id AND ril.ingredient_id = $1
+		)
+		ORDER BY r.created_at
+	`, sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("query recipes using ingredient: %w", err)
+	}
+	var recipeIDs, activeIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		var active bool
+		if err := rows.Scan(&id, &active); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("scan recipe id: %w", err)
+		}
+		recipeIDs = append(recipeIDs, id)
+		if active {
+			activeIDs = append(activeIDs, id)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate recipes using ingredient: %w", err)
+	}
+
+	statements := []struct {
+		action string
+		sql    string
+	}{
+		{"rewrite ingredient lines", `UPDATE recipe_ingredient_lines SET ingredient_id = $2 WHERE ingredient_id = $1`},
+		{"rewrite main ingredients", `UPDATE recipes SET main_ingredient_id = $2 WHERE main_ingredient_id = $1`},
+		{"rewrite shopping list items", `UPDATE shopping_list_items SET ingredient_id = $2 WHERE ingredient_id = $1`},
+		{"merge allergies", `
+			INSERT INTO ingredient_allergies (ingredient_id, allergy_id)
+			SELECT $2, allergy_id FROM ingredient_allergies WHERE ingredient_id = $1
+			ON CONFLICT DO NOTHING
+		`},
+		{"move nutrition", `
+			UPDATE ingredient_nutrition SET ingredient_id = $2
+			WHERE ingredient_id = $1
+			  AND NOT EXISTS (SELECT 1 FROM ingredient_nutrition WHERE ingredient_id = $2)
+		`},
+		{"move food match", `
+			UPDATE ingredient_food_matches SET ingredient_id = $2
+			WHERE ingredient_id = $1
+			  AND NOT EXISTS (SELECT 1 FROM ingredient_food_matches WHERE ingredient_id = $2)
+		`},
+		{"move dietary attributes", `
+			UPDATE ingredient_dietary_attributes SET ingredient_id = $2
+			WHERE ingredient_id = $1
+			  AND NOT EXISTS (SELECT 1 FROM ingredient_dietary_attributes WHERE ingredient_id = $2)
+		`},
+		{"merge ingredient details", `
+			UPDATE ingredients t SET
+				category_id = COALESCE(t.category_id, s.category_id),
+				density_g_per_ml = COALESCE(t.density_g_per_ml, s.density_g_per_ml),
+				description = CASE WHEN t.description = '' THEN s.description ELSE t.description END
+			FROM ingredients s
+			WHERE s.id = $1 AND t.id = $2
+		`},
+		{"delete ingredient", `DELETE FROM ingredients WHERE id = $1`},
+	}
+	for _, statement := range statements {
+		if _, err := tx.Exec(ctx, statement.sql, sourceID, targetID); err != nil {
+			return nil, fmt.Errorf("%s: %w", statement.action, err)
+		}
+	}
+
+	if len(recipeIDs) > 0 {
+		_, err = tx.Exec(ctx, `UPDATE recipes SET search_document = recipe_search_document(id) WHERE id = ANY($1)`, recipeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("refresh search documents: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return activeIDs, nil
+}
+
+// ListDuplicateIngredients pairs up the user's ingredients whose names are at
+// least minSimilarity alike, most similar first. Each pair is reported once,
+// with the ingredient used by more recipes first since that is usually the one
+// to keep.
+func (r *Repository) ListDuplicateIngredients(ctx context.Context, userID uuid.UUID, minSimilarity float64, limit int) ([]domain.IngredientDuplicate, error) {
+	rows, err := r.pool.Query(ctx, `
+		WITH pairs AS (
+			SELECT a.id AS first_id, b.id AS second_id, GREATEST(
+				similarity(lower(a.name), lower(b.name)),
+				word_similarity(lower(a.name), lower(b.name)),
+				word_similarity(lower(b.name), lower(a.name))
+			) AS score
+			FROM ingredients a
+			JOIN ingredients b ON b.user_id = a.user_id AND a.id < b.id
+			WHERE a.user_id = $1
+			  AND (
+				lower(a.name) % lower(b.name)
+				OR lower(a.name) <% lower(b.name)
+				OR lower(b.name) <% lower(a.name)
+			  )
+		)
+		SELECT `+catalogColumns("a", "ac")+`, `+catalogColumns("b", "bc")+`, p.score
+		FROM pairs p
+		JOIN ingredients a ON a.id = p.first_id
+		LEFT JOIN ingredient_categories ac ON ac.id = a.category_id
+		JOIN ingredients b ON b.id = p.second_id
+		LEFT JOIN ingredient_categories bc ON bc.id = b.category_id
+		WHERE p.score >= $2
+		ORDER BY p.score DESC, lower(a.name), lower(b.name)
+		LIMIT $3
+	`, userID, minSimilarity, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query duplicate ingredients: %w", err)
+	}
+	defer rows.Close()
+
+	var duplicates []domain.IngredientDuplicate
+	for rows.Next() {
+		var first, second catalogIngredientRow
+		var score float64
+		dest := append(first.dest(), second.dest()...)
+		if err := rows.Scan(append(dest, &score)...); err != nil {
+			return nil, fmt.Errorf("scan duplicate ingredients: %w", err)
+		}
+
+		duplicate := domain.IngredientDuplicate{
+			Ingredient: first.toDomain(),
+			Duplicate:  second.toDomain(),
+			Similarity: score,
+		}
+		if duplicate.Duplicate.RecipeCount > duplicate.Ingredient.RecipeCount {
+			duplicate.Ingredient, duplicate.Duplicate = duplicate.Duplicate, duplicate.Ingredient
+		}
+		duplicates = append(duplicates, duplicate)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate duplicate ingredients: %w", err)
+	}
+
+	return duplicates, nil
+}
+
+// catalogIngredientRow receives the columns of catalogColumns.
+type catalogIngredientRow struct {
+	ingredient    domain.Ingredient
+	categoryID    *uuid.UUID
+	categoryName  *string
+	categoryOrder *int
+	recipeCount   int64
+}
+
+func (row *catalogIngredientRow) dest() []any {
+	return []any{
+		&row.ingredient.ID, &row.ingredient.UserID, &row.ingredient.Name, &row.ingredient.Description,
+		&row.ingredient.CreatedAt, &row.ingredient.UpdatedAt,
+		&row.categoryID, &row.categoryName, &row.categoryOrder,
+		&row.recipeCount,
+	}
+}
+
+func (row *catalogIngredientRow) toDomain() domain.Ingredient {
+	ingredient := row.ingredient
+	ingredient.RecipeCount = int(row.recipeCount)
+	if row.categoryID != nil {
+		ingredient.Category = &domain.IngredientCategory{ID: *row.categoryID}
+		if row.categoryName != nil {
+			ingredient.Category.Name = *row.categoryName
+		}
+		if row.categoryOrder != nil {
+			ingredient.Category.DisplayOrder = *row.categoryOrder
+		}
+	}
+	return ingredient
+}
@@ -0,0 +1,38 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"testing"
+)
+
+func TestMergeIngredients_KeepsRevisionSnapshots(t *testing.T) {
+	ctx, repo, pool := givenRepository(t)
+	userID := givenUser(t, ctx, repo, pool)
+	tomato := givenIngredient(t, ctx, repo, userID, "tomato")
+	tomatoes := givenIngredient(t, ctx, repo, userID, "tomatoes")
+	recipe := givenRecipe(t, ctx, repo, userID, "Passata", tomatoes)
+
+	recipeIDs, err := repo.MergeIngredients(ctx, userID, tomatoes.ID, tomato.ID)
+
+	if err != nil {
+		t.Fatalf("merge ingredients: %v", err)
+	}
+	if len(recipeIDs) != 1 || recipeIDs[0] != recipe.ID {
+		t.Fatalf("expected the recipe to be reported, got %v", recipeIDs)
+	}
+	merged, err := repo.GetByID(ctx, userID, recipe.ID)
+	if err != nil {
+		t.Fatalf("get recipe: %v", err)
+	}
+	if merged.IngredientLines[0].Ingredient.ID != tomato.ID || merged.MainIngredient.ID != tomato.ID {
+		t.Fatal("expected the recipe to use the target ingredient")
+	}
+	revision, err := repo.GetRecipeRevision(ctx, userID, recipe.ID, 1)
+	if err != nil {
+		t.Fatalf("get revision: %v", err)
+	}
+	if line := revision.Recipe.IngredientLines[0].Ingredient; line.ID != tomatoes.ID || line.Name != "tomatoes" {
+		t.Fatalf("expected the revision to keep the merged ingredient, got %+v", line)
+	}
+}
@@ -0,0 +1,92 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/repository"
+)
+
+// These tests run against a migrated recipe database named by RECIPE_DB_URL,
+// as `make migrate-up test-integration` sets up. Every test works in a user of
+// its own, which is deleted again with everything it owns.
+
+func givenRepository(t *testing.T) (context.Context, *repository.Repository, *pgxpool.Pool) {
+	t.Helper()
+
+	url := os.Getenv("RECIPE_DB_URL")
+	if url == "" {
+		t.Skip("RECIPE_DB_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("connect to recipe database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	return ctx, repository.NewRepository(pool), pool
+}
+
+func givenUser(t *testing.T, ctx context.Context, repo *repository.Repository, pool *pgxpool.Pool) uuid.UUID {
+	t.Helper()
+
+	user := &domain.User{Email: uuid.NewString() + "@example.com", DisplayName: "Integration"}
+	if err := repo.CreateUser(ctx, user); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	t.Cleanup(func() {
+		// Everything the user owns goes with them.
+		if _, err := pool.Exec(context.Background(), `DELETE FROM users WHERE id = $1`, user.ID); err != nil {
+			t.Errorf("delete user: %v", err)
+		}
+	})
+
+	return user.ID
+}
+
+func givenIngredient(t *testing.T, ctx context.Context, repo *repository.Repository, userID uuid.UUID, name string) *domain.Ingredient {
+	t.Helper()
+
+	ingredient, err := repo.GetOrCreateIngredient(ctx, userID, name)
+	if err != nil {
+		t.Fatalf("create ingredient %s: %v", name, err)
+	}
+	return ingredient
+}
+
+func givenRecipe(t *testing.T, ctx context.Context, repo *repository.Repository, userID uuid.UUID, name string, ingredients ...*domain.Ingredient) *domain.Recipe {
+	t.Helper()
+
+	cuisine, err := repo.GetOrCreateCuisine(ctx, userID, "Italian")
+	if err != nil {
+		t.Fatalf("create cuisine: %v", err)
+	}
+
+	recipe := &domain.Recipe{
+		UserID:         userID,
+		Name:           name,
+		Servings:       4,
+		MainIngredient: ingredients[0],
+		Cuisine:        cuisine,
+	}
+	for i, ingredient := range ingredients {
+		recipe.IngredientLines = append(recipe.IngredientLines, domain.RecipeIngredientLine{
+			Ingredient: *ingredient,
+			SortOrder:  i + 1,
+		})
+	}
+	if err := repo.Create(ctx, recipe); err != nil {
+		t.Fatalf("create recipe %s: %v", name, err)
+	}
+
+	return recipe
+}
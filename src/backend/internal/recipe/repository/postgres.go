@@ -13,6 +13,7 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/pgvector/pgvector-go"
 	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/dietary"
 )
 
 var (
@@ -33,8 +34,21 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 	return &Repository{pool: pool}
 }
 
+// accessClause matches recipes the user owns, that were shared with them, or
+// that are in a collection shared with them or public.
 func accessClause(alias string, userParam int) string {
-	return fmt.Sprintf("(%s.user_id = $%d OR EXISTS (SELECT 1 FROM recipe_shares rs WHERE rs.recipe_id = %s.id AND rs.shared_with_user_id = $%d))", alias, userParam, alias, userParam)
+	return fmt.Sprintf(`(%[1]s.user_id = $%[2]d
+		OR EXISTS (SELECT 1 FROM recipe_shares rs WHERE rs.recipe_id = %[1]s.id AND rs.shared_with_user_id = $%[2]d)
+		OR EXISTS (
+			SELECT 1 FROM recipe_collection_items rci
+			JOIN collection_shares cs ON cs.collection_id = rci.collection_id
+			WHERE rci.recipe_id = %[1]s.id AND cs.shared_with_user_id = $%[2]d
+		)
+		OR EXISTS (
+			SELECT 1 FROM recipe_collection_items rci
+			JOIN recipe_collections pc ON pc.id = rci.collection_id
+			WHERE rci.recipe_id = %[1]s.id AND pc.is_public
+		))`, alias, userParam)
 }
 
 func activeClause(alias string) string {
@@ -48,16 +62,19 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*domain
 			r.id, r.user_id, r.name, r.description,
 			r.prep_time_minutes, r.cook_time_minutes, r.total_time_minutes,
 			r.servings, r.yield_quantity, r.yield_unit,
-			r.image_url, r.tags, r.search_vector,
+			r.image_url, r.tags, r.diet_labels, r.diet_classified, r.search_vector,
 			r.created_at, r.updated_at, r.deleted_at,
 			c.id, c.user_id, c.name, c.created_at,
 			mi.id, mi.user_id, mi.name, mi.description, mi.created_at, mi.updated_at,
 			rn.calories_total, rn.calories_per_serving,
-			rn.protein_g, rn.carbs_g, rn.fat_g, rn.fiber_g, rn.sugar_g, rn.sodium_mg
+			rn.protein_g, rn.carbs_g, rn.fat_g, rn.fiber_g, rn.sugar_g, rn.sodium_mg,
+			COALESCE(rn.is_override, FALSE),
+			COALESCE(st.times_cooked, 0), st.last_cooked_on, st.average_rating
 		FROM recipes r
 		JOIN cuisines c ON r.cuisine_id = c.id
 		JOIN ingredients mi ON r.main_ingredient_id = mi.id
 		LEFT JOIN recipe_nutrition rn ON rn.recipe_id = r.id
+		LEFT JOIN recipe_cook_stats st ON st.recipe_id = r.id AND st.user_id = $2
 		WHERE r.id = $1
 		  AND ` + activeClause("r") + `
 		  AND ` + accessClause("r", 2) + `
@@ -69,6 +86,7 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*domain
 	var searchVector pgvector.Vector
 	var imageURL *string
 	var tags []string
+	var dietLabels []string
 	var yieldUnit *string
 	var yieldQuantity pgtype.Numeric
 	var deletedAt *time.Time
@@ -80,17 +98,20 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*domain
 	var sodium pgtype.Numeric
 	var caloriesTotal int
 	var caloriesPerServing int
+	var isOverride bool
 
 	err := r.pool.QueryRow(ctx, query, id, userID).Scan(
 		&recipe.ID, &recipe.UserID, &recipe.Name, &recipe.Description,
 		&recipe.PrepTimeMinutes, &recipe.CookTimeMinutes, &recipe.TotalTimeMinutes,
 		&recipe.Servings, &yieldQuantity, &yieldUnit,
-		&imageURL, &tags, &searchVector,
+		&imageURL, &tags, &dietLabels, &recipe.DietClassified, &searchVector,
 		&recipe.CreatedAt, &recipe.UpdatedAt, &deletedAt,
 		&cuisine.ID, &cuisine.UserID, &cuisine.Name, &cuisine.CreatedAt,
 		&mainIngredient.ID, &mainIngredient.UserID, &mainIngredient.Name, &mainIngredient.Description, &mainIngredient.CreatedAt, &mainIngredient.UpdatedAt,
 		&caloriesTotal, &caloriesPerServing,
 		&protein, &carbs, &fat, &fiber, &sugar, &sodium,
+		&isOverride,
+		&recipe.CookStats.TimesCooked, &recipe.CookStats.LastCookedOn, &recipe.CookStats.AverageRating,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -114,6 +135,7 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*domain
 	}
 	recipe.YieldQuantity = yieldPtr
 	recipe.Tags = tags
+	recipe.DietLabels = toDietLabels(dietLabels)
 	recipe.DeletedAt = deletedAt
 	recipe.Nutrition = domain.RecipeNutrition{
 		CaloriesTotal:      caloriesTotal,
@@ -124,6 +146,7 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*domain
 		FiberG:             numericToFloat(fiber),
 		SugarG:             numericToFloat(sugar),
 		SodiumMg:           numericToFloat(sodium),
+		IsOverride:         isOverride,
 	}
 
 	lines, err := r.getRecipeIngredientLines(ctx, id)
@@ -138,77 +161,80 @@ func (r *Repository) GetByID(ctx context.Context, userID, id uuid.UUID) (*domain
 	}
 	recipe.Steps = steps
 
+	fork, err := r.getRecipeFork(ctx, recipe.UserID, id)
+	if err != nil {
+		return nil, fmt.Errorf("load fork: %w", err)
+	}
+	recipe.ForkedFrom = fork
+
 	return &recipe, nil
 }
 
-// GetAll retrieves all recipes with pagination
+// List retrieves recipes with pagination and optional filters. When the filter
+// carries a search query, results are ordered by their hybrid search score.
+// Column sorts and the default newest-first order break ties by recipe ID so
+// that filter.After can continue a listing with keyset pagination.
 func (r *Repository) List(ctx context.Context, userID uuid.UUID, filter domain.RecipeFilter, limit, offset int) ([]domain.Recipe, error) {
-	var sb strings.Builder
 	args := []any{userID}
-	argPos := 2
+	conditions, score, args := recipeFilterConditions(filter, args)
+	argPos := len(args) + 1
 
+	var sb strings.Builder
 	sb.WriteString(`
 		SELECT
 			r.id, r.user_id, r.name, r.description,
 			r.prep_time_minutes, r.cook_time_minutes, r.total_time_minutes,
 			r.servings, r.yield_quantity, r.yield_unit,
-			r.image_url, r.tags, r.search_vector,
+			r.image_url, r.tags, r.diet_labels, r.diet_classified, r.search_vector,
 			r.created_at, r.updated_at, r.deleted_at,
 			c.id, c.user_id, c.name, c.created_at,
 			mi.id, mi.user_id, mi.name, mi.description, mi.created_at, mi.updated_at,
 			rn.calories_total, rn.calories_per_serving,
-			rn.protein_g, rn.carbs_g, rn.fat_g, rn.fiber_g, rn.sugar_g, rn.sodium_mg
+			rn.protein_g, rn.carbs_g, rn.fat_g, rn.fiber_g, rn.sugar_g, rn.sodium_mg,
+			COALESCE(rn.is_override, FALSE),
+			COALESCE(st.times_cooked, 0), st.last_cooked_on, st.average_rating,
+			` + score + ` AS score
 		FROM recipes r
 		JOIN cuisines c ON r.cuisine_id = c.id
 		JOIN ingredients mi ON r.main_ingredient_id = mi.id
 		LEFT JOIN recipe_nutrition rn ON rn.recipe_id = r.id
+		` + cookStatsJoin("r", 1) + `
 		WHERE ` + activeClause("r") + `
 		  AND ` + accessClause("r", 1) + `
 	`)
+	sb.WriteString(conditions)
 
-	if filter.CuisineID != nil {
-		sb.WriteString(fmt.Sprintf(" AND r.cuisine_id = $%d", argPos))
-		args = append(args, *filter.CuisineID)
-		argPos++
-	}
-
-	if filter.IngredientID != nil {
-		sb.WriteString(fmt.Sprintf(`
-			AND (
-				r.main_ingredient_id = $%d OR EXISTS (
-					SELECT 1 FROM recipe_ingredient_lines ril
-					WHERE ril.recipe_id = r.id AND ril.ingredient_id = $%d
-				)
-			)
-		`, argPos, argPos))
-		args = append(args, *filter.IngredientID)
-		argPos++
+	sort, order, keyset := filter.KeysetSort()
+	if filter.After != nil && !keyset {
+		return nil, fmt.Errorf("list recipes: a cursor needs a column sort")
 	}
 
-	if filter.AllergyID != nil {
+	switch {
+	case keyset:
+		key := recipeSortKeys[sort]
+		if filter.After != nil {
+			var condition string
+			condition, args = key.after(filter.After, order, args)
+			argPos = len(args) + 1
+			sb.WriteString(" AND " + condition)
+		}
+		sb.WriteString(" ORDER BY " + key.orderBy(order))
+	case filter.Sort != domain.RecipeSortNewest:
+		sb.WriteString(" ORDER BY " + recipeSortClause(filter.Sort))
+	case filter.Query != "":
+		sb.WriteString(" ORDER BY score DESC, r.created_at DESC")
+	case filter.CollectionID != nil:
+		// Keep the order the user gave the collection.
 		sb.WriteString(fmt.Sprintf(`
-			AND NOT EXISTS (
-				SELECT 1 FROM ingredient_allergies ia
-				WHERE ia.ingredient_id = r.main_ingredient_id AND ia.allergy_id = $%d
-			)
-			AND NOT EXISTS (
-				SELECT 1
-				FROM recipe_ingredient_lines ril
-				JOIN ingredient_allergies ia ON ril.ingredient_id = ia.ingredient_id
-				WHERE ril.recipe_id = r.id AND ia.allergy_id = $%d
-			)
-		`, argPos, argPos))
-		args = append(args, *filter.AllergyID)
+			ORDER BY (
+				SELECT rci.position FROM recipe_collection_items rci
+				WHERE rci.recipe_id = r.id AND rci.collection_id = $%d
+			), r.created_at DESC
+		`, argPos))
+		args = append(args, *filter.CollectionID)
 		argPos++
 	}
-
-	if len(filter.Tags) > 0 {
-		sb.WriteString(fmt.Sprintf(" AND r.tags @> $%d", argPos))
-		args = append(args, filter.Tags)
-		argPos++
-	}
-
-	sb.WriteString(fmt.Sprintf(" ORDER BY r.created_at DESC LIMIT $%d OFFSET $%d", argPos, argPos+1))
+	sb.WriteString(fmt.Sprintf(" LIMIT $%d OFFSET $%d", argPos, argPos+1))
 	args = append(args, limit, offset)
 
 	rows, err := r.pool.Query(ctx, sb.String(), args...)
@@ -243,13 +269,15 @@ func (r *Repository) Create(ctx context.Context, recipe *domain.Recipe) error {
 			prep_time_minutes, cook_time_minutes, total_time_minutes,
 			servings, yield_quantity, yield_unit,
 			main_ingredient_id, cuisine_id,
-			image_url, tags, search_vector
+			image_url, tags, diet_labels, diet_classified, search_vector,
+			external_id
 		) VALUES (
 			$1, $2, $3, $4,
 			$5, $6, $7,
 			$8, $9, $10,
 			$11, $12,
-			$13, $14, $15
+			$13, $14, $15, $16, $17,
+			$18
 		)
 	`
 
@@ -268,12 +296,18 @@ func (r *Repository) Create(ctx context.Context, recipe *domain.Recipe) error {
 		tags = []string{}
 	}
 
+	var externalID *string
+	if recipe.ExternalID != "" {
+		externalID = &recipe.ExternalID
+	}
+
 	_, err = tx.Exec(ctx, query,
 		recipe.ID, recipe.UserID, recipe.Name, recipe.Description,
 		recipe.PrepTimeMinutes, recipe.CookTimeMinutes, recipe.TotalTimeMinutes,
 		recipe.Servings, recipe.YieldQuantity, yieldUnit,
 		recipe.MainIngredient.ID, recipe.Cuisine.ID,
-		imageURL, tags, recipe.SearchVector,
+		imageURL, tags, dietLabelStrings(recipe.DietLabels), recipe.DietClassified, recipe.SearchVector,
+		externalID,
 	)
 	if err != nil {
 		return fmt.Errorf("insert recipe: %w", err)
@@ -282,14 +316,17 @@ func (r *Repository) Create(ctx context.Context, recipe *domain.Recipe) error {
 	_, err = tx.Exec(ctx, `
 		INSERT INTO recipe_nutrition (
 			recipe_id, calories_total, calories_per_serving,
-			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
+			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg,
+			is_override
 		) VALUES (
 			$1, $2, $3,
-			$4, $5, $6, $7, $8, $9
+			$4, $5, $6, $7, $8, $9,
+			$10
 		)
 	`, recipe.ID, recipe.Nutrition.CaloriesTotal, recipe.Nutrition.CaloriesPerServing,
 		recipe.Nutrition.ProteinG, recipe.Nutrition.CarbsG, recipe.Nutrition.FatG,
 		recipe.Nutrition.FiberG, recipe.Nutrition.SugarG, recipe.Nutrition.SodiumMg,
+		recipe.Nutrition.IsOverride,
 	)
 	if err != nil {
 		return fmt.Errorf("insert recipe nutrition: %w", err)
@@ -304,15 +341,18 @@ func (r *Repository) Create(ctx context.Context, recipe *domain.Recipe) error {
 			INSERT INTO recipe_ingredient_lines (
 				id, recipe_id, ingredient_id,
 				quantity_value, quantity_text, unit,
-				is_optional, note, sort_order
+				is_optional, note, sort_order,
+				nutrition_unresolved
 			) VALUES (
 				$1, $2, $3,
 				$4, $5, $6,
-				$7, $8, $9
+				$7, $8, $9,
+				$10
 			)
 		`, lineID, recipe.ID, line.Ingredient.ID,
 			line.QuantityValue, line.QuantityText, line.Unit,
 			line.IsOptional, line.Note, line.SortOrder,
+			line.NutritionUnresolved,
 		)
 		if err != nil {
 			return fmt.Errorf("insert recipe ingredient line: %w", err)
@@ -340,6 +380,20 @@ func (r *Repository) Create(ctx context.Context, recipe *domain.Recipe) error {
 		}
 	}
 
+	if err := refreshSearchDocument(ctx, tx, recipe.ID); err != nil {
+		return err
+	}
+
+	if err := insertRevision(ctx, tx, recipe); err != nil {
+		return err
+	}
+
+	if recipe.ForkedFrom != nil {
+		if err := setForkedFrom(ctx, tx, recipe); err != nil {
+			return err
+		}
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
 	}
@@ -355,14 +409,18 @@ func (r *Repository) Update(ctx context.Context, recipe *domain.Recipe) error {
 	}
 	defer tx.Rollback(ctx)
 
+	if err := r.ensureBaselineRevision(ctx, tx, recipe); err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE recipes SET
 			name = $2, description = $3,
 			prep_time_minutes = $4, cook_time_minutes = $5, total_time_minutes = $6,
 			servings = $7, yield_quantity = $8, yield_unit = $9,
 			main_ingredient_id = $10, cuisine_id = $11,
-			image_url = $12, tags = $13, search_vector = $14
-		WHERE id = $1 AND user_id = $15 AND deleted_at IS NULL
+			image_url = $12, tags = $13, diet_labels = $14, diet_classified = $15, search_vector = $16
+		WHERE id = $1 AND user_id = $17 AND deleted_at IS NULL
 	`
 
 	var imageURL *string
@@ -389,7 +447,7 @@ func (r *Repository) Update(ctx context.Context, recipe *domain.Recipe) error {
 		recipe.PrepTimeMinutes, recipe.CookTimeMinutes, recipe.TotalTimeMinutes,
 		recipe.Servings, recipe.YieldQuantity, yieldUnit,
 		recipe.MainIngredient.ID, recipe.Cuisine.ID,
-		imageURL, tags, recipe.SearchVector,
+		imageURL, tags, dietLabelStrings(recipe.DietLabels), recipe.DietClassified, recipe.SearchVector,
 		recipe.UserID,
 	)
 	if err != nil {
@@ -403,10 +461,12 @@ func (r *Repository) Update(ctx context.Context, recipe *domain.Recipe) error {
 	_, err = tx.Exec(ctx, `
 		INSERT INTO recipe_nutrition (
 			recipe_id, calories_total, calories_per_serving,
-			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg
+			protein_g, carbs_g, fat_g, fiber_g, sugar_g, sodium_mg,
+			is_override
 		) VALUES (
 			$1, $2, $3,
-			$4, $5, $6, $7, $8, $9
+			$4, $5, $6, $7, $8, $9,
+			$10
 		)
 		ON CONFLICT (recipe_id) DO UPDATE SET
 			calories_total = EXCLUDED.calories_total,
@@ -417,10 +477,12 @@ func (r *Repository) Update(ctx context.Context, recipe *domain.Recipe) error {
 			fiber_g = EXCLUDED.fiber_g,
 			sugar_g = EXCLUDED.sugar_g,
 			sodium_mg = EXCLUDED.sodium_mg,
+			is_override = EXCLUDED.is_override,
 			updated_at = NOW()
 	`, recipe.ID, recipe.Nutrition.CaloriesTotal, recipe.Nutrition.CaloriesPerServing,
 		recipe.Nutrition.ProteinG, recipe.Nutrition.CarbsG, recipe.Nutrition.FatG,
 		recipe.Nutrition.FiberG, recipe.Nutrition.SugarG, recipe.Nutrition.SodiumMg,
+		recipe.Nutrition.IsOverride,
 	)
 	if err != nil {
 		return fmt.Errorf("upsert recipe nutrition: %w", err)
@@ -441,15 +503,18 @@ func (r *Repository) Update(ctx context.Context, recipe *domain.Recipe) error {
 			INSERT INTO recipe_ingredient_lines (
 				id, recipe_id, ingredient_id,
 				quantity_value, quantity_text, unit,
-				is_optional, note, sort_order
+				is_optional, note, sort_order,
+				nutrition_unresolved
 			) VALUES (
 				$1, $2, $3,
 				$4, $5, $6,
-				$7, $8, $9
+				$7, $8, $9,
+				$10
 			)
 		`, lineID, recipe.ID, line.Ingredient.ID,
 			line.QuantityValue, line.QuantityText, line.Unit,
 			line.IsOptional, line.Note, line.SortOrder,
+			line.NutritionUnresolved,
 		)
 		if err != nil {
 			return fmt.Errorf("insert recipe ingredient line: %w", err)
@@ -482,6 +547,14 @@ func (r *Repository) Update(ctx context.Context, recipe *domain.Recipe) error {
 		}
 	}
 
+	if err := refreshSearchDocument(ctx, tx, recipe.ID); err != nil {
+		return err
+	}
+
+	if err := insertRevision(ctx, tx, recipe); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
 	}
@@ -509,8 +582,10 @@ func (r *Repository) Delete(ctx context.Context, userID, id uuid.UUID) error {
 
 // Query operations
 
-// GetSimilar retrieves recipes similar to a given recipe using vector similarity
-func (r *Repository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID, limit int) ([]domain.Recipe, error) {
+// GetSimilar retrieves recipes similar to a given recipe using vector
+// similarity, leaving out recipes conflicting with the dietary profile when one
+// is given.
+func (r *Repository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID, profile *domain.DietaryProfile, limit int) ([]domain.Recipe, error) {
 	// First get the vector for the target recipe
 	var targetVector pgvector.Vector
 	err := r.pool.QueryRow(ctx,
@@ -529,24 +604,36 @@ func (r *Repository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID,
 			r.id, r.user_id, r.name, r.description,
 			r.prep_time_minutes, r.cook_time_minutes, r.total_time_minutes,
 			r.servings, r.yield_quantity, r.yield_unit,
-			r.image_url, r.tags, r.search_vector,
+			r.image_url, r.tags, r.diet_labels, r.diet_classified, r.search_vector,
 			r.created_at, r.updated_at, r.deleted_at,
 			c.id, c.user_id, c.name, c.created_at,
 			mi.id, mi.user_id, mi.name, mi.description, mi.created_at, mi.updated_at,
 			rn.calories_total, rn.calories_per_serving,
-			rn.protein_g, rn.carbs_g, rn.fat_g, rn.fiber_g, rn.sugar_g, rn.sodium_mg
+			rn.protein_g, rn.carbs_g, rn.fat_g, rn.fiber_g, rn.sugar_g, rn.sodium_mg,
+			COALESCE(rn.is_override, FALSE),
+			COALESCE(st.times_cooked, 0), st.last_cooked_on, st.average_rating,
+			1 - (r.search_vector <=> $3) AS score
 		FROM recipes r
 		JOIN cuisines c ON r.cuisine_id = c.id
 		JOIN ingredients mi ON r.main_ingredient_id = mi.id
 		LEFT JOIN recipe_nutrition rn ON rn.recipe_id = r.id
+		` + cookStatsJoin("r", 2) + `
 		WHERE r.id != $1
 		  AND ` + activeClause("r") + `
 		  AND ` + accessClause("r", 2) + `
+	`
+	args := []any{recipeID, userID, targetVector, limit}
+	if !profile.IsEmpty() {
+		var conditions string
+		conditions, args = dietaryProfileConditions(profile, args)
+		query += conditions
+	}
+	query += `
 		ORDER BY r.search_vector <=> $3
 		LIMIT $4
 	`
 
-	rows, err := r.pool.Query(ctx, query, recipeID, userID, targetVector, limit)
+	rows, err := r.pool.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query similar recipes: %w", err)
 	}
@@ -555,20 +642,119 @@ func (r *Repository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID,
 	return r.scanRecipes(ctx, rows)
 }
 
-// GetByCuisine retrieves recipes by cuisine ID
-// Count returns the total number of recipes
+// Count returns the total number of recipes matching the filter
 func (r *Repository) Count(ctx context.Context, userID uuid.UUID, filter domain.RecipeFilter) (int64, error) {
-	var sb strings.Builder
 	args := []any{userID}
-	argPos := 2
+	conditions, _, args := recipeFilterConditions(filter, args)
 
-	sb.WriteString(`SELECT COUNT(*) FROM recipes r WHERE ` + activeClause("r") + ` AND ` + accessClause("r", 1))
+	query := `SELECT COUNT(*) FROM recipes r ` + cookStatsJoin("r", 1) + ` WHERE ` + activeClause("r") + ` AND ` + accessClause("r", 1) + conditions
+
+	var count int64
+	err := r.pool.QueryRow(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count recipes: %w", err)
+	}
+	return count, nil
+}
+
+// Hybrid search tuning. The score blends full-text rank (normalized to 0..1)
+// with cosine similarity of the query embedding; recipes that do not match the
+// text query are still returned when they are semantically close enough.
+const (
+	searchTextWeight            = 0.6
+	searchSemanticWeight        = 0.4
+	searchMinSemanticSimilarity = 0.35
+)
+
+// cookStatsJoin joins the cook log aggregates of the listing user as "st".
+func cookStatsJoin(alias string, userParam int) string {
+	return fmt.Sprintf("LEFT JOIN recipe_cook_stats st ON st.recipe_id = %s.id AND st.user_id = $%d", alias, userParam)
+}
+
+// recipeSortClause renders the ORDER BY expressions for a cook log sort.
+// Recipes without cook history sort last, except for least_recent where they
+// come first since they have not been cooked for the longest time.
+func recipeSortClause(sort domain.RecipeSort) string {
+	switch sort {
+	case domain.RecipeSortLastCooked:
+		return "st.last_cooked_on DESC NULLS LAST, r.created_at DESC"
+	case domain.RecipeSortMostCooked:
+		return "COALESCE(st.times_cooked, 0) DESC, st.last_cooked_on DESC NULLS LAST, r.created_at DESC"
+	case domain.RecipeSortTopRated:
+		return "st.average_rating DESC NULLS LAST, COALESCE(st.times_cooked, 0) DESC, r.created_at DESC"
+	case domain.RecipeSortLeastRecent:
+		return "st.last_cooked_on ASC NULLS FIRST, r.created_at DESC"
+	default:
+		return "r.created_at DESC"
+	}
+}
+
+// recipeSortKey is the expression a column sort orders recipes by.
+type recipeSortKey struct {
+	column string
+	// param renders a cursor key parameter as the type of column.
+	param string
+	// nullable columns sort their NULLs last in either direction.
+	nullable bool
+}
+
+var recipeSortKeys = map[domain.RecipeSort]recipeSortKey{
+	domain.RecipeSortName:      {column: "lower(r.name)", param: "lower($%d::text)"},
+	domain.RecipeSortCreatedAt: {column: "r.created_at", param: "$%d::timestamptz"},
+	domain.RecipeSortUpdatedAt: {column: "r.updated_at", param: "$%d::timestamptz"},
+	domain.RecipeSortTotalTime: {column: "r.total_time_minutes", param: "$%d::int"},
+	domain.RecipeSortCalories:  {column: "COALESCE(rn.calories_per_serving, 0)", param: "$%d::int"},
+	domain.RecipeSortRating:    {column: "st.average_rating", param: "$%d::float8", nullable: true},
+}
+
+// orderBy renders the ORDER BY expressions of the sort, with the recipe ID as
+// the tiebreaker.
+func (k recipeSortKey) orderBy(order domain.SortOrder) string {
+	direction := "ASC"
+	if order == domain.SortDescending {
+		direction = "DESC"
+	}
+	clause := fmt.Sprintf("%s %s, r.id %s", k.column, direction, direction)
+	if k.nullable {
+		clause = fmt.Sprintf("(%s IS NULL), %s", k.column, clause)
+	}
+	return clause
+}
+
+// after renders the condition selecting the recipes ordered after the cursor,
+// appending its parameters to args.
+func (k recipeSortKey) after(cursor *domain.RecipeCursor, order domain.SortOrder, args []any) (string, []any) {
+	op := ">"
+	if order == domain.SortDescending {
+		op = "<"
+	}
+	argPos := len(args) + 1
+	if cursor.KeyNull {
+		// Only recipes without a key follow one without a key.
+		return fmt.Sprintf("(%s IS NULL AND r.id %s $%d)", k.column, op, argPos), append(args, cursor.ID)
+	}
+
+	condition := fmt.Sprintf("(%s, r.id) %s (%s, $%d)", k.column, op, fmt.Sprintf(k.param, argPos), argPos+1)
+	if k.nullable {
+		condition = fmt.Sprintf("(%s IS NULL OR %s)", k.column, condition)
+	}
+	return condition, append(args, cursor.Key, cursor.ID)
+}
+
+// recipeFilterConditions renders the filter as additional WHERE conditions on
+// the recipes alias "r", appending its parameters to args. It also returns the
+// search score expression, which is a constant 0 when no query is set.
+func recipeFilterConditions(filter domain.RecipeFilter, args []any) (string, string, []any) {
+	var sb strings.Builder
+	argPos := len(args) + 1
+	score := "0::float8"
 
 	if filter.CuisineID != nil {
 		sb.WriteString(fmt.Sprintf(" AND r.cuisine_id = $%d", argPos))
 		args = append(args, *filter.CuisineID)
 		argPos++
 	}
+
 	if filter.IngredientID != nil {
 		sb.WriteString(fmt.Sprintf(`
 			AND (
@@ -581,6 +767,7 @@ func (r *Repository) Count(ctx context.Context, userID uuid.UUID, filter domain.
 		args = append(args, *filter.IngredientID)
 		argPos++
 	}
+
 	if filter.AllergyID != nil {
 		sb.WriteString(fmt.Sprintf(`
 			AND NOT EXISTS (
@@ -597,18 +784,75 @@ func (r *Repository) Count(ctx context.Context, userID uuid.UUID, filter domain.
 		args = append(args, *filter.AllergyID)
 		argPos++
 	}
+
+	if filter.CollectionID != nil {
+		sb.WriteString(fmt.Sprintf(`
+			AND EXISTS (
+				SELECT 1 FROM recipe_collection_items rci
+				WHERE rci.recipe_id = r.id AND rci.collection_id = $%d
+			)
+		`, argPos))
+		args = append(args, *filter.CollectionID)
+		argPos++
+	}
+
+	if filter.MinRating != nil {
+		sb.WriteString(fmt.Sprintf(" AND st.average_rating >= $%d", argPos))
+		args = append(args, *filter.MinRating)
+		argPos++
+	}
+
+	if filter.CookedSince != nil {
+		sb.WriteString(fmt.Sprintf(" AND st.last_cooked_on >= $%d", argPos))
+		args = append(args, *filter.CookedSince)
+		argPos++
+	}
+
+	if filter.NotCookedSince != nil {
+		sb.WriteString(fmt.Sprintf(" AND (st.last_cooked_on IS NULL OR st.last_cooked_on < $%d)", argPos))
+		args = append(args, *filter.NotCookedSince)
+		argPos++
+	}
+
 	if len(filter.Tags) > 0 {
 		sb.WriteString(fmt.Sprintf(" AND r.tags @> $%d", argPos))
 		args = append(args, filter.Tags)
 		argPos++
 	}
 
-	var count int64
-	err := r.pool.QueryRow(ctx, sb.String(), args...).Scan(&count)
-	if err != nil {
-		return 0, fmt.Errorf("count recipes: %w", err)
+	if len(filter.DietLabels) > 0 {
+		sb.WriteString(fmt.Sprintf(" AND r.diet_labels @> $%d", argPos))
+		args = append(args, dietLabelStrings(filter.DietLabels))
+		argPos++
 	}
-	return count, nil
+
+	if !filter.DietaryProfile.IsEmpty() {
+		var conditions string
+		conditions, args = dietaryProfileConditions(filter.DietaryProfile, args)
+		sb.WriteString(conditions)
+		argPos = len(args) + 1
+	}
+
+	if filter.Query != "" {
+		textQuery := fmt.Sprintf("websearch_to_tsquery('simple', $%d)", argPos)
+		args = append(args, filter.Query)
+		argPos++
+
+		textRank := fmt.Sprintf("ts_rank_cd(r.search_document, %s, 32)", textQuery)
+		if filter.QueryVector != nil {
+			similarity := fmt.Sprintf("COALESCE(1 - (r.search_vector <=> $%d), 0)", argPos)
+			args = append(args, *filter.QueryVector)
+			argPos++
+
+			sb.WriteString(fmt.Sprintf(" AND (r.search_document @@ %s OR %s >= %v)", textQuery, similarity, searchMinSemanticSimilarity))
+			score = fmt.Sprintf("(%v * %s + %v * %s)", searchTextWeight, textRank, searchSemanticWeight, similarity)
+		} else {
+			sb.WriteString(fmt.Sprintf(" AND r.search_document @@ %s", textQuery))
+			score = textRank
+		}
+	}
+
+	return sb.String(), score, args
 }
 
 // User operations
@@ -675,11 +919,21 @@ func (r *Repository) CreateUserCredentials(ctx context.Context, userID uuid.UUID
 
 // GetIngredientByID retrieves an ingredient by ID
 func (r *Repository) GetIngredientByID(ctx context.Context, userID, id uuid.UUID) (*domain.Ingredient, error) {
-	query := `SELECT id, user_id, name, description, created_at, updated_at FROM ingredients WHERE id = $1 AND user_id = $2`
+	query := `
+		SELECT i.id, i.user_id, i.name, i.description, i.created_at, i.updated_at,
+		       ic.id, ic.name, ic.display_order
+		FROM ingredients i
+		LEFT JOIN ingredient_categories ic ON ic.id = i.category_id
+		WHERE i.id = $1 AND i.user_id = $2
+	`
 
 	var ingredient domain.Ingredient
+	var categoryID *uuid.UUID
+	var categoryName *string
+	var categoryOrder *int
 	err := r.pool.QueryRow(ctx, query, id, userID).Scan(
 		&ingredient.ID, &ingredient.UserID, &ingredient.Name, &ingredient.Description, &ingredient.CreatedAt, &ingredient.UpdatedAt,
+		&categoryID, &categoryName, &categoryOrder,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -687,6 +941,9 @@ func (r *Repository) GetIngredientByID(ctx context.Context, userID, id uuid.UUID
 		}
 		return nil, fmt.Errorf("query ingredient: %w", err)
 	}
+	if categoryID != nil && categoryName != nil && categoryOrder != nil {
+		ingredient.Category = &domain.IngredientCategory{ID: *categoryID, Name: *categoryName, DisplayOrder: *categoryOrder}
+	}
 
 	// Load allergies for this ingredient
 	allergies, err := r.getIngredientAllergies(ctx, id)
@@ -758,9 +1015,75 @@ func (r *Repository) GetOrCreateIngredient(ctx context.Context, userID uuid.UUID
 		return nil, err
 	}
 
+	// Classify it right away when the bundled catalog knows what it contains
+	catalog, err := dietary.Bundled()
+	if err != nil {
+		return nil, err
+	}
+	if attributes, ok := catalog.Lookup(name); ok {
+		record := &domain.IngredientDietaryAttributes{IngredientID: ingredient.ID, Attributes: attributes}
+		if err := r.SaveIngredientDietaryAttributes(ctx, record); err != nil {
+			return nil, err
+		}
+	}
+
 	return ingredient, nil
 }
 
+// GetIngredientNutrition retrieves the nutrition facts of the given
+// ingredients, keyed by ingredient ID. Ingredients without facts are omitted.
+func (r *Repository) GetIngredientNutrition(ctx context.Context, ingredientIDs []uuid.UUID) (map[uuid.UUID]domain.IngredientNutrition, error) {
+	facts := make(map[uuid.UUID]domain.IngredientNutrition)
+	if len(ingredientIDs) == 0 {
+		return facts, nil
+	}
+
+	query := `
+		SELECT
+			n.ingredient_id, n.serving_size_value, n.serving_unit, n.calories,
+			n.protein_g, n.carbs_g, n.fat_g, n.fiber_g, n.sugar_g, n.sodium_mg,
+			i.density_g_per_ml
+		FROM ingredient_nutrition n
+		JOIN ingredients i ON i.id = n.ingredient_id
+		WHERE n.ingredient_id = ANY($1)
+	`
+
+	rows, err := r.pool.Query(ctx, query, ingredientIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query ingredient nutrition: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fact domain.IngredientNutrition
+		var servingSize, protein, carbs, fat, fiber, sugar, sodium, density pgtype.Numeric
+
+		if err := rows.Scan(
+			&fact.IngredientID, &servingSize, &fact.ServingUnit, &fact.Calories,
+			&protein, &carbs, &fat, &fiber, &sugar, &sodium,
+			&density,
+		); err != nil {
+			return nil, fmt.Errorf("scan ingredient nutrition: %w", err)
+		}
+
+		fact.ServingSizeValue = numericToFloat(servingSize)
+		fact.ProteinG = numericToFloat(protein)
+		fact.CarbsG = numericToFloat(carbs)
+		fact.FatG = numericToFloat(fat)
+		fact.FiberG = numericToFloat(fiber)
+		fact.SugarG = numericToFloat(sugar)
+		fact.SodiumMg = numericToFloat(sodium)
+		fact.GramsPerML = numericToFloat(density)
+		facts[fact.IngredientID] = fact
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ingredient nutrition: %w", err)
+	}
+
+	return facts, nil
+}
+
 // Cuisine operations
 
 // GetCuisineByID retrieves a cuisine by ID for a user.
@@ -995,7 +1318,7 @@ func (r *Repository) getRecipeIngredientLines(ctx context.Context, recipeID uuid
 		SELECT
 			ril.id, ril.ingredient_id,
 			ril.quantity_value, ril.quantity_text, ril.unit,
-			ril.is_optional, ril.note, ril.sort_order,
+			ril.is_optional, ril.note, ril.sort_order, ril.nutrition_unresolved,
 			i.user_id, i.name, i.description, i.created_at, i.updated_at
 		FROM recipe_ingredient_lines ril
 		JOIN ingredients i ON i.id = ril.ingredient_id
@@ -1018,7 +1341,7 @@ func (r *Repository) getRecipeIngredientLines(ctx context.Context, recipeID uuid
 		if err := rows.Scan(
 			&line.ID, &ingredient.ID,
 			&quantityValue, &line.QuantityText, &line.Unit,
-			&line.IsOptional, &line.Note, &line.SortOrder,
+			&line.IsOptional, &line.Note, &line.SortOrder, &line.NutritionUnresolved,
 			&ingredient.UserID, &ingredient.Name, &ingredient.Description, &ingredient.CreatedAt, &ingredient.UpdatedAt,
 		); err != nil {
 			return nil, fmt.Errorf("scan ingredient line: %w", err)
@@ -1047,6 +1370,16 @@ func (r *Repository) getRecipeIngredientLines(ctx context.Context, recipeID uuid
 	return lines, nil
 }
 
+// refreshSearchDocument rebuilds the full-text search document of a recipe from
+// its current name, description, ingredient lines and steps.
+func refreshSearchDocument(ctx context.Context, tx pgx.Tx, recipeID uuid.UUID) error {
+	_, err := tx.Exec(ctx, `UPDATE recipes SET search_document = recipe_search_document(id) WHERE id = $1`, recipeID)
+	if err != nil {
+		return fmt.Errorf("refresh search document: %w", err)
+	}
+	return nil
+}
+
 func (r *Repository) getRecipeSteps(ctx context.Context, recipeID uuid.UUID) ([]domain.RecipeStep, error) {
 	query := `
 		SELECT id, step_index, instruction, duration_seconds, temperature_value, temperature_unit, media_url
@@ -1105,6 +1438,7 @@ func (r *Repository) scanRecipes(ctx context.Context, rows pgx.Rows) ([]domain.R
 		var searchVector pgvector.Vector
 		var imageURL *string
 		var tags []string
+		var dietLabels []string
 		var yieldUnit *string
 		var yieldQuantity pgtype.Numeric
 		var deletedAt *time.Time
@@ -1116,17 +1450,21 @@ func (r *Repository) scanRecipes(ctx context.Context, rows pgx.Rows) ([]domain.R
 		var sodium pgtype.Numeric
 		var caloriesTotal int
 		var caloriesPerServing int
+		var isOverride bool
 
 		err := rows.Scan(
 			&recipe.ID, &recipe.UserID, &recipe.Name, &recipe.Description,
 			&recipe.PrepTimeMinutes, &recipe.CookTimeMinutes, &recipe.TotalTimeMinutes,
 			&recipe.Servings, &yieldQuantity, &yieldUnit,
-			&imageURL, &tags, &searchVector,
+			&imageURL, &tags, &dietLabels, &recipe.DietClassified, &searchVector,
 			&recipe.CreatedAt, &recipe.UpdatedAt, &deletedAt,
 			&cuisine.ID, &cuisine.UserID, &cuisine.Name, &cuisine.CreatedAt,
 			&mainIngredient.ID, &mainIngredient.UserID, &mainIngredient.Name, &mainIngredient.Description, &mainIngredient.CreatedAt, &mainIngredient.UpdatedAt,
 			&caloriesTotal, &caloriesPerServing,
 			&protein, &carbs, &fat, &fiber, &sugar, &sodium,
+			&isOverride,
+			&recipe.CookStats.TimesCooked, &recipe.CookStats.LastCookedOn, &recipe.CookStats.AverageRating,
+			&recipe.SearchScore,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan recipe: %w", err)
@@ -1147,6 +1485,7 @@ func (r *Repository) scanRecipes(ctx context.Context, rows pgx.Rows) ([]domain.R
 		}
 		recipe.YieldQuantity = yieldPtr
 		recipe.Tags = tags
+		recipe.DietLabels = toDietLabels(dietLabels)
 		recipe.DeletedAt = deletedAt
 		recipe.Nutrition = domain.RecipeNutrition{
 			CaloriesTotal:      caloriesTotal,
@@ -1157,6 +1496,7 @@ func (r *Repository) scanRecipes(ctx context.Context, rows pgx.Rows) ([]domain.R
 			FiberG:             numericToFloat(fiber),
 			SugarG:             numericToFloat(sugar),
 			SodiumMg:           numericToFloat(sodium),
+			IsOverride:         isOverride,
 		}
 
 		recipes = append(recipes, recipe)
@@ -1178,6 +1518,12 @@ func (r *Repository) scanRecipes(ctx context.Context, rows pgx.Rows) ([]domain.R
 			return nil, err
 		}
 		recipes[i].Steps = steps
+
+		fork, err := r.getRecipeFork(ctx, recipes[i].UserID, recipes[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		recipes[i].ForkedFrom = fork
 	}
 
 	return recipes, nil
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// FindImportedRecipe returns the user's recipe a bulk import item refers to:
+// the one imported earlier under the same external ID or, failing that, an
+// active recipe with the same name. A recipe in the trash only matches by
+// external ID, and trashed reports it. It returns ErrRecipeNotFound when no
+// recipe matches.
+func (r *Repository) FindImportedRecipe(ctx context.Context, userID uuid.UUID, externalID, name string) (uuid.UUID, bool, error) {
+	var id uuid.UUID
+	var trashed bool
+	err := r.pool.QueryRow(ctx, `
+		SELECT id, deleted_at IS NOT NULL
+		FROM recipes
+		WHERE user_id = $1
+		  AND (
+			(external_id = $2 AND $2 <> '')
+			OR (deleted_at IS NULL AND lower(name) = lower($3))
+		  )
+		ORDER BY COALESCE(external_id = $2, FALSE) DESC, created_at
+		LIMIT 1
+	`, userID, externalID, name).Scan(&id, &trashed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, false, ErrRecipeNotFound
+		}
+		return uuid.Nil, false, fmt.Errorf("find imported recipe: %w", err)
+	}
+	return id, trashed, nil
+}
@@ -0,0 +1,344 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+var ErrRevisionNotFound = errors.New("recipe revision not found")
+
+// recipeSnapshot is the JSON stored for a revision. It is decoupled from
+// domain.Recipe so that stored revisions stay readable as the domain evolves.
+type recipeSnapshot struct {
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	PrepTimeMinutes  int               `json:"prepTimeMinutes"`
+	CookTimeMinutes  int               `json:"cookTimeMinutes"`
+	TotalTimeMinutes int               `json:"totalTimeMinutes"`
+	Servings         int               `json:"servings"`
+	YieldQuantity    *float64          `json:"yieldQuantity,omitempty"`
+	YieldUnit        string            `json:"yieldUnit,omitempty"`
+	MainIngredient   *snapshotRef      `json:"mainIngredient,omitempty"`
+	Cuisine          *snapshotRef      `json:"cuisine,omitempty"`
+	IngredientLines  []lineSnapshot    `json:"ingredientLines"`
+	Steps            []stepSnapshot    `json:"steps"`
+	Tags             []string          `json:"tags,omitempty"`
+	ImageURL         string            `json:"imageUrl,omitempty"`
+	Nutrition        nutritionSnapshot `json:"nutrition"`
+}
+
+type snapshotRef struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+type lineSnapshot struct {
+	Ingredient    snapshotRef `json:"ingredient"`
+	QuantityValue *float64    `json:"quantityValue,omitempty"`
+	QuantityText  string      `json:"quantityText,omitempty"`
+	Unit          string      `json:"unit,omitempty"`
+	IsOptional    bool        `json:"isOptional,omitempty"`
+	Note          string      `json:"note,omitempty"`
+	SortOrder     int         `json:"sortOrder"`
+}
+
+type stepSnapshot struct {
+	StepIndex        int      `json:"stepIndex"`
+	Instruction      string   `json:"instruction"`
+	DurationSeconds  *int     `json:"durationSeconds,omitempty"`
+	TemperatureValue *float64 `json:"temperatureValue,omitempty"`
+	TemperatureUnit  string   `json:"temperatureUnit,omitempty"`
+	MediaURL         string   `json:"mediaUrl,omitempty"`
+}
+
+type nutritionSnapshot struct {
+	CaloriesTotal      int     `json:"caloriesTotal"`
+	CaloriesPerServing int     `json:"caloriesPerServing"`
+	ProteinG           float64 `json:"proteinG"`
+	CarbsG             float64 `json:"carbsG"`
+	FatG               float64 `json:"fatG"`
+	FiberG             float64 `json:"fiberG"`
+	SugarG             float64 `json:"sugarG"`
+	SodiumMg           float64 `json:"sodiumMg"`
+	IsOverride         bool    `json:"isOverride,omitempty"`
+}
+
+// ListRecipeRevisions returns the revisions of a recipe, newest first.
+func (r *Repository) ListRecipeRevisions(ctx context.Context, userID, recipeID uuid.UUID) ([]domain.RecipeRevision, error) {
+	ownerID, err := r.getRevisionOwner(ctx, userID, recipeID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT revision, snapshot, created_at
+		FROM recipe_revisions
+		WHERE recipe_id = $1
+		ORDER BY revision DESC
+	`, recipeID)
+	if err != nil {
+		return nil, fmt.Errorf("query recipe revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []domain.RecipeRevision
+	for rows.Next() {
+		revision, err := scanRevision(rows, recipeID, ownerID)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, *revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate recipe revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetRecipeRevision returns one revision of a recipe.
+func (r *Repository) GetRecipeRevision(ctx context.Context, userID, recipeID uuid.UUID, number int) (*domain.RecipeRevision, error) {
+	ownerID, err := r.getRevisionOwner(ctx, userID, recipeID)
+	if err != nil {
+		return nil, err
+	}
+
+	row := r.pool.QueryRow(ctx, `
+		SELECT revision, snapshot, created_at
+		FROM recipe_revisions
+		WHERE recipe_id = $1 AND revision = $2
+	`, recipeID, number)
+
+	revision, err := scanRevision(row, recipeID, ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRevisionNotFound
+		}
+		return nil, err
+	}
+	return revision, nil
+}
+
+// getRevisionOwner checks that the user can read the recipe and returns its
+// owner.
+func (r *Repository) getRevisionOwner(ctx context.Context, userID, recipeID uuid.UUID) (uuid.UUID, error) {
+	var ownerID uuid.UUID
+	err := r.pool.QueryRow(ctx, `
+		SELECT r.user_id
+		FROM recipes r
+		WHERE r.id = $1
+		  AND `+activeClause("r")+`
+		  AND `+accessClause("r", 2)+`
+	`, recipeID, userID).Scan(&ownerID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrRecipeNotFound
+		}
+		return uuid.Nil, fmt.Errorf("query recipe: %w", err)
+	}
+	return ownerID, nil
+}
+
+// ensureBaselineRevision snapshots a recipe as it is before its first
+// update, so that recipes created before revisions were recorded can still be
+// restored to their original state.
+func (r *Repository) ensureBaselineRevision(ctx context.Context, tx pgx.Tx, recipe *domain.Recipe) error {
+	// Lock the recipe so concurrent updates number their revisions in turn.
+	var exists bool
+	err := tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM recipe_revisions rv WHERE rv.recipe_id = r.id)
+		FROM recipes r
+		WHERE r.id = $1
+		FOR UPDATE
+	`, recipe.ID).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("query recipe revisions: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	current, err := r.GetByID(ctx, recipe.UserID, recipe.ID)
+	if err != nil {
+		if errors.Is(err, ErrRecipeNotFound) {
+			return nil
+		}
+		return fmt.Errorf("load recipe for baseline revision: %w", err)
+	}
+	return insertRevision(ctx, tx, current)
+}
+
+// insertRevision appends a snapshot of the recipe as its next revision.
+func insertRevision(ctx context.Context, tx pgx.Tx, recipe *domain.Recipe) error {
+	snapshot, err := json.Marshal(toSnapshot(recipe))
+	if err != nil {
+		return fmt.Errorf("encode recipe snapshot: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO recipe_revisions (recipe_id, revision, snapshot)
+		SELECT $1, COALESCE(MAX(revision), 0) + 1, $2
+		FROM recipe_revisions
+		WHERE recipe_id = $1
+	`, recipe.ID, snapshot)
+	if err != nil {
+		return fmt.Errorf("insert recipe revision: %w", err)
+	}
+	return nil
+}
+
+func scanRevision(row pgx.Row, recipeID, ownerID uuid.UUID) (*domain.RecipeRevision, error) {
+	var revision domain.RecipeRevision
+	var data []byte
+
+	if err := row.Scan(&revision.Revision, &data, &revision.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scan recipe revision: %w", err)
+	}
+
+	var snapshot recipeSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("decode recipe snapshot %d: %w", revision.Revision, err)
+	}
+
+	revision.RecipeID = recipeID
+	revision.Recipe = fromSnapshot(snapshot)
+	revision.Recipe.ID = recipeID
+	revision.Recipe.UserID = ownerID
+	revision.Recipe.CreatedAt = revision.CreatedAt
+	revision.Recipe.UpdatedAt = revision.CreatedAt
+	return &revision, nil
+}
+
+func toSnapshot(recipe *domain.Recipe) recipeSnapshot {
+	snapshot := recipeSnapshot{
+		Name:             recipe.Name,
+		Description:      recipe.Description,
+		PrepTimeMinutes:  recipe.PrepTimeMinutes,
+		CookTimeMinutes:  recipe.CookTimeMinutes,
+		TotalTimeMinutes: recipe.TotalTimeMinutes,
+		Servings:         recipe.Servings,
+		YieldQuantity:    recipe.YieldQuantity,
+		YieldUnit:        recipe.YieldUnit,
+		IngredientLines:  make([]lineSnapshot, len(recipe.IngredientLines)),
+		Steps:            make([]stepSnapshot, len(recipe.Steps)),
+		Tags:             recipe.Tags,
+		ImageURL:         recipe.ImageURL,
+		Nutrition: nutritionSnapshot{
+			CaloriesTotal:      recipe.Nutrition.CaloriesTotal,
+			CaloriesPerServing: recipe.Nutrition.CaloriesPerServing,
+			ProteinG:           recipe.Nutrition.ProteinG,
+			CarbsG:             recipe.Nutrition.CarbsG,
+			FatG:               recipe.Nutrition.FatG,
+			FiberG:             recipe.Nutrition.FiberG,
+			SugarG:             recipe.Nutrition.SugarG,
+			SodiumMg:           recipe.Nutrition.SodiumMg,
+			IsOverride:         recipe.Nutrition.IsOverride,
+		},
+	}
+
+	if recipe.MainIngredient != nil {
+		snapshot.MainIngredient = &snapshotRef{ID: recipe.MainIngredient.ID, Name: recipe.MainIngredient.Name}
+	}
+	if recipe.Cuisine != nil {
+		snapshot.Cuisine = &snapshotRef{ID: recipe.Cuisine.ID, Name: recipe.Cuisine.Name}
+	}
+
+	for i, line := range recipe.IngredientLines {
+		snapshot.IngredientLines[i] = lineSnapshot{
+			Ingredient:    snapshotRef{ID: line.Ingredient.ID, Name: line.Ingredient.Name},
+			QuantityValue: line.QuantityValue,
+			QuantityText:  line.QuantityText,
+			Unit:          line.Unit,
+			IsOptional:    line.IsOptional,
+			Note:          line.Note,
+			SortOrder:     line.SortOrder,
+		}
+	}
+
+	for i, step := range recipe.Steps {
+		snapshot.Steps[i] = stepSnapshot{
+			StepIndex:        step.StepIndex,
+			Instruction:      step.Instruction,
+			DurationSeconds:  step.DurationSeconds,
+			TemperatureValue: step.TemperatureValue,
+			TemperatureUnit:  step.TemperatureUnit,
+			MediaURL:         step.MediaURL,
+		}
+	}
+
+	return snapshot
+}
+
+func fromSnapshot(snapshot recipeSnapshot) domain.Recipe {
+	recipe := domain.Recipe{
+		Name:             snapshot.Name,
+		Description:      snapshot.Description,
+		PrepTimeMinutes:  snapshot.PrepTimeMinutes,
+		CookTimeMinutes:  snapshot.CookTimeMinutes,
+		TotalTimeMinutes: snapshot.TotalTimeMinutes,
+		Servings:         snapshot.Servings,
+		YieldQuantity:    snapshot.YieldQuantity,
+		YieldUnit:        snapshot.YieldUnit,
+		IngredientLines:  make([]domain.RecipeIngredientLine, len(snapshot.IngredientLines)),
+		Steps:            make([]domain.RecipeStep, len(snapshot.Steps)),
+		Tags:             snapshot.Tags,
+		ImageURL:         snapshot.ImageURL,
+		Nutrition: domain.RecipeNutrition{
+			CaloriesTotal:      snapshot.Nutrition.CaloriesTotal,
+			CaloriesPerServing: snapshot.Nutrition.CaloriesPerServing,
+			ProteinG:           snapshot.Nutrition.ProteinG,
+			CarbsG:             snapshot.Nutrition.CarbsG,
+			FatG:               snapshot.Nutrition.FatG,
+			FiberG:             snapshot.Nutrition.FiberG,
+			SugarG:             snapshot.Nutrition.SugarG,
+			SodiumMg:           snapshot.Nutrition.SodiumMg,
+			IsOverride:         snapshot.Nutrition.IsOverride,
+		},
+	}
+
+	if ref := snapshot.MainIngredient; ref != nil {
+		recipe.MainIngredient = &domain.Ingredient{ID: ref.ID, Name: ref.Name}
+	}
+	if ref := snapshot.Cuisine; ref != nil {
+		recipe.Cuisine = &domain.Cuisine{ID: ref.ID, Name: ref.Name}
+	}
+
+	for i, line := range snapshot.IngredientLines {
+		recipe.IngredientLines[i] = domain.RecipeIngredientLine{
+			Ingredient:    domain.Ingredient{ID: line.Ingredient.ID, Name: line.Ingredient.Name},
+			QuantityValue: line.QuantityValue,
+			QuantityText:  line.QuantityText,
+			Unit:          line.Unit,
+			IsOptional:    line.IsOptional,
+			Note:          line.Note,
+			SortOrder:     line.SortOrder,
+		}
+	}
+
+	for i, step := range snapshot.Steps {
+		recipe.Steps[i] = domain.RecipeStep{
+			StepIndex:        step.StepIndex,
+			Instruction:      step.Instruction,
+			DurationSeconds:  step.DurationSeconds,
+			TemperatureValue: step.TemperatureValue,
+			TemperatureUnit:  step.TemperatureUnit,
+			MediaURL:         step.MediaURL,
+		}
+	}
+
+	return recipe
+}
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// SeedRecipe is a recipe the seeder imported and the hash of the seed data it
+// was imported from.
+type SeedRecipe struct {
+	RecipeID    uuid.UUID
+	ContentHash string
+}
+
+// ListSeedRecipes returns the recipes seeded into the user's library, keyed by
+// their external ID. Recipes purged since are gone from the result.
+func (r *Repository) ListSeedRecipes(ctx context.Context, userID uuid.UUID) (map[string]SeedRecipe, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT external_id, recipe_id, content_hash
+		FROM seed_recipes
+		WHERE user_id = $1
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("query seed recipes: %w", err)
+	}
+	defer rows.Close()
+
+	seeded := make(map[string]SeedRecipe)
+	for rows.Next() {
+		var externalID string
+		var recipe SeedRecipe
+		if err := rows.Scan(&externalID, &recipe.RecipeID, &recipe.ContentHash); err != nil {
+			return nil, fmt.Errorf("scan seed recipe: %w", err)
+		}
+		seeded[externalID] = recipe
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate seed recipes: %w", err)
+	}
+
+	return seeded, nil
+}
+
+// SaveSeedRecipe records the seed data hash a recipe was imported from.
+func (r *Repository) SaveSeedRecipe(ctx context.Context, userID uuid.UUID, externalID string, recipe SeedRecipe) error {
+	_, err := r.pool.Exec(ctx, `
+		INSERT INTO seed_recipes (user_id, external_id, recipe_id, content_hash)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id, external_id) DO UPDATE SET
+			recipe_id = EXCLUDED.recipe_id,
+			content_hash = EXCLUDED.content_hash,
+			seeded_at = NOW()
+	`, userID, externalID, recipe.RecipeID, recipe.ContentHash)
+	if err != nil {
+		return fmt.Errorf("save seed recipe: %w", err)
+	}
+	return nil
+}
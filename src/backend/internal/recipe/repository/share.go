@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+var (
+	ErrShareNotFound     = errors.New("recipe share not found")
+	ErrShareWithOwner    = errors.New("recipe cannot be shared with its owner")
+	ErrRecipeNotEditable = errors.New("recipe is shared read-only")
+)
+
+// ShareRecipe shares a recipe the user owns with the user registered under
+// email. Sharing again with the same user changes the permission.
+func (r *Repository) ShareRecipe(ctx context.Context, ownerID, recipeID uuid.UUID, email string, permission domain.SharePermission) (*domain.RecipeShare, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var exists bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM recipes r WHERE r.id = $1 AND r.user_id = $2 AND `+activeClause("r")+`)
+	`, recipeID, ownerID).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("query recipe: %w", err)
+	}
+	if !exists {
+		return nil, ErrRecipeNotFound
+	}
+
+	var sharedWithUserID uuid.UUID
+	err = tx.QueryRow(ctx, `SELECT id FROM users WHERE lower(email) = lower($1)`, email).Scan(&sharedWithUserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("query user: %w", err)
+	}
+	if sharedWithUserID == ownerID {
+		return nil, ErrShareWithOwner
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO recipe_shares (recipe_id, shared_with_user_id, permission)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (recipe_id, shared_with_user_id) DO UPDATE SET
+			permission = EXCLUDED.permission
+	`, recipeID, sharedWithUserID, string(permission))
+	if err != nil {
+		return nil, fmt.Errorf("save recipe share: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	shares, err := r.queryShares(ctx, `rs.recipe_id = $1 AND rs.shared_with_user_id = $2`, recipeID, sharedWithUserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, ErrShareNotFound
+	}
+	return &shares[0], nil
+}
+
+// ListSharedWithMe returns the shares of other users' recipes with the user.
+func (r *Repository) ListSharedWithMe(ctx context.Context, userID uuid.UUID) ([]domain.RecipeShare, error) {
+	return r.queryShares(ctx, `rs.shared_with_user_id = $1`, userID)
+}
+
+// ListSharedByMe returns the shares of the user's recipes with other users.
+func (r *Repository) ListSharedByMe(ctx context.Context, ownerID uuid.UUID) ([]domain.RecipeShare, error) {
+	return r.queryShares(ctx, `r.user_id = $1`, ownerID)
+}
+
+// RevokeShare removes another user's access to a recipe the user owns.
+func (r *Repository) RevokeShare(ctx context.Context, ownerID, recipeID, sharedWithUserID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `
+		DELETE FROM recipe_shares rs
+		USING recipes r
+		WHERE rs.recipe_id = r.id
+		  AND rs.recipe_id = $1
+		  AND rs.shared_with_user_id = $2
+		  AND r.user_id = $3
+	`, recipeID, sharedWithUserID, ownerID)
+	if err != nil {
+		return fmt.Errorf("delete recipe share: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrShareNotFound
+	}
+
+	return nil
+}
+
+// GetEditableRecipeOwner checks that the user owns the recipe or was given
+// permission to edit it, and returns its owner. Edits made through a share
+// are saved on the owner's recipe.
+func (r *Repository) GetEditableRecipeOwner(ctx context.Context, userID, recipeID uuid.UUID) (uuid.UUID, error) {
+	var ownerID uuid.UUID
+	var permission *string
+	err := r.pool.QueryRow(ctx, `
+		SELECT r.user_id, rs.permission
+		FROM recipes r
+		LEFT JOIN recipe_shares rs ON rs.recipe_id = r.id AND rs.shared_with_user_id = $2
+		WHERE r.id = $1
+		  AND `+activeClause("r")+`
+		  AND `+accessClause("r", 2)+`
+	`, recipeID, userID).Scan(&ownerID, &permission)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrRecipeNotFound
+		}
+		return uuid.Nil, fmt.Errorf("query recipe: %w", err)
+	}
+
+	if ownerID != userID && (permission == nil || domain.SharePermission(*permission) != domain.SharePermissionEdit) {
+		return uuid.Nil, ErrRecipeNotEditable
+	}
+	return ownerID, nil
+}
+
+func (r *Repository) queryShares(ctx context.Context, condition string, args ...any) ([]domain.RecipeShare, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			rs.recipe_id, r.name, r.user_id, owner.email,
+			rs.shared_with_user_id, recipient.email,
+			rs.permission, rs.created_at
+		FROM recipe_shares rs
+		JOIN recipes r ON r.id = rs.recipe_id
+		JOIN users owner ON owner.id = r.user_id
+		JOIN users recipient ON recipient.id = rs.shared_with_user_id
+		WHERE `+activeClause("r")+`
+		  AND `+condition+`
+		ORDER BY rs.created_at DESC, r.name
+	`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query recipe shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []domain.RecipeShare
+	for rows.Next() {
+		var share domain.RecipeShare
+		var permission string
+		if err := rows.Scan(
+			&share.RecipeID, &share.RecipeName, &share.OwnerID, &share.OwnerEmail,
+			&share.SharedWithUserID, &share.SharedWithEmail,
+			&permission, &share.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan recipe share: %w", err)
+		}
+		share.Permission = domain.SharePermission(permission)
+		shares = append(shares, share)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate recipe shares: %w", err)
+	}
+
+	return shares, nil
+}
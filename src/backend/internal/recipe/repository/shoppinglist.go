@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/common/units"
 )
 
 var (
@@ -319,32 +321,35 @@ func (r *ShoppingListRepository) DeleteItem(ctx context.Context, userID, itemID
 	return nil
 }
 
-// GetAggregatedIngredients retrieves and aggregates ingredients from multiple recipes
+// GetAggregatedIngredients retrieves and aggregates ingredients from multiple recipes.
+// Quantities of the same ingredient are summed across compatible units (200 g and
+// 0.5 kg become 700 g; volume joins mass when the ingredient has a density), while
+// unknown units are kept as written.
 func (r *ShoppingListRepository) GetAggregatedIngredients(ctx context.Context, userID uuid.UUID, recipeIDs []uuid.UUID) ([]domain.AggregatedIngredient, error) {
 	if len(recipeIDs) == 0 {
 		return nil, nil
 	}
 
-	// Build query to get all ingredients from the specified recipes
-	// with their quantities and units from recipe_ingredients
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
 			i.id as ingredient_id,
 			i.name as ingredient_name,
+			i.density_g_per_ml,
 			ic.id as category_id,
 			ic.name as category_name,
-			ri.quantity,
-			ri.unit,
+			ril.quantity_value,
+			NULLIF(ril.unit, ''),
 			r.id as recipe_id,
 			r.name as recipe_name
-		FROM recipe_ingredients ri
-		JOIN ingredients i ON ri.ingredient_id = i.id
-		JOIN recipes r ON ri.recipe_id = r.id
+		FROM recipe_ingredient_lines ril
+		JOIN ingredients i ON ril.ingredient_id = i.id
+		JOIN recipes r ON ril.recipe_id = r.id
 		LEFT JOIN ingredient_categories ic ON i.category_id = ic.id
-		WHERE ri.recipe_id = ANY($1)
-		  AND (r.user_id = $2 OR EXISTS (SELECT 1 FROM recipe_shares rs WHERE rs.recipe_id = r.id AND rs.shared_with_user_id = $2))
-		ORDER BY ic.display_order NULLS LAST, i.name
-	`
+		WHERE ril.recipe_id = ANY($1)
+		  AND %s
+		  AND %s
+		ORDER BY ic.display_order NULLS LAST, i.name, r.name, ril.sort_order
+	`, accessClause("r", 2), activeClause("r"))
 
 	rows, err := r.pool.Query(ctx, query, recipeIDs, userID)
 	if err != nil {
@@ -352,17 +357,19 @@ func (r *ShoppingListRepository) GetAggregatedIngredients(ctx context.Context, u
 	}
 	defer rows.Close()
 
-	// Aggregate ingredients by ID and unit
-	type ingredientKey struct {
-		ID   uuid.UUID
-		Unit string
+	// Aggregate ingredients by ID, summing quantities per compatible unit
+	type aggregation struct {
+		ingredient   *domain.AggregatedIngredient
+		total        *units.Total
+		unquantified []*string
 	}
-	aggregated := make(map[ingredientKey]*domain.AggregatedIngredient)
-	ingredientOrder := []ingredientKey{}
+	aggregated := make(map[uuid.UUID]*aggregation)
+	ingredientOrder := []uuid.UUID{}
 
 	for rows.Next() {
 		var ingredientID uuid.UUID
 		var ingredientName string
+		var density *float64
 		var categoryID *uuid.UUID
 		var categoryName *string
 		var quantity *float64
@@ -371,64 +378,48 @@ func (r *ShoppingListRepository) GetAggregatedIngredients(ctx context.Context, u
 		var recipeName string
 
 		err := rows.Scan(
-			&ingredientID, &ingredientName, &categoryID, &categoryName,
+			&ingredientID, &ingredientName, &density, &categoryID, &categoryName,
 			&quantity, &unit, &recipeID, &recipeName,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("scan ingredient: %w", err)
 		}
 
-		unitStr := ""
-		if unit != nil {
-			unitStr = *unit
+		agg, exists := aggregated[ingredientID]
+		if !exists {
+			gramsPerML := 0.0
+			if density != nil {
+				gramsPerML = *density
+			}
+			agg = &aggregation{
+				ingredient: &domain.AggregatedIngredient{
+					IngredientID:   ingredientID,
+					IngredientName: ingredientName,
+					CategoryID:     categoryID,
+					CategoryName:   categoryName,
+				},
+				total: units.NewTotal(gramsPerML),
+			}
+			aggregated[ingredientID] = agg
+			ingredientOrder = append(ingredientOrder, ingredientID)
 		}
-		key := ingredientKey{ID: ingredientID, Unit: unitStr}
-
-		if agg, exists := aggregated[key]; exists {
-			// Add to existing aggregation
-			if quantity != nil {
-				found := false
-				for i, qu := range agg.Quantities {
-					// Same unit, add quantities
-					if (qu.Unit == nil && unit == nil) || (qu.Unit != nil && unit != nil && *qu.Unit == *unit) {
-						if qu.Quantity != nil && quantity != nil {
-							newQty := *qu.Quantity + *quantity
-							agg.Quantities[i].Quantity = &newQty
-						} else if quantity != nil {
-							agg.Quantities[i].Quantity = quantity
-						}
-						found = true
-						break
-					}
-				}
-				if !found {
-					agg.Quantities = append(agg.Quantities, domain.QuantityUnit{Quantity: quantity, Unit: unit})
-				}
+
+		if quantity != nil {
+			unitStr := ""
+			if unit != nil {
+				unitStr = *unit
 			}
-			agg.RecipeSources = append(agg.RecipeSources, domain.RecipeSource{
-				RecipeID:   recipeID,
-				RecipeName: recipeName,
-				Quantity:   quantity,
-				Unit:       unit,
-			})
+			agg.total.Add(*quantity, unitStr)
 		} else {
-			// New aggregation
-			agg := &domain.AggregatedIngredient{
-				IngredientID:   ingredientID,
-				IngredientName: ingredientName,
-				CategoryID:     categoryID,
-				CategoryName:   categoryName,
-				Quantities:     []domain.QuantityUnit{{Quantity: quantity, Unit: unit}},
-				RecipeSources: []domain.RecipeSource{{
-					RecipeID:   recipeID,
-					RecipeName: recipeName,
-					Quantity:   quantity,
-					Unit:       unit,
-				}},
-			}
-			aggregated[key] = agg
-			ingredientOrder = append(ingredientOrder, key)
+			agg.unquantified = append(agg.unquantified, unit)
 		}
+
+		agg.ingredient.RecipeSources = append(agg.ingredient.RecipeSources, domain.RecipeSource{
+			RecipeID:   recipeID,
+			RecipeName: recipeName,
+			Quantity:   quantity,
+			Unit:       unit,
+		})
 	}
 
 	if err := rows.Err(); err != nil {
@@ -437,8 +428,21 @@ func (r *ShoppingListRepository) GetAggregatedIngredients(ctx context.Context, u
 
 	// Convert map to slice maintaining order
 	result := make([]domain.AggregatedIngredient, 0, len(aggregated))
-	for _, key := range ingredientOrder {
-		result = append(result, *aggregated[key])
+	for _, id := range ingredientOrder {
+		agg := aggregated[id]
+		for _, q := range agg.total.Quantities() {
+			value := math.Round(q.Value*1000) / 1000
+			var unit *string
+			if q.Unit != "" {
+				unit = &q.Unit
+			}
+			agg.ingredient.Quantities = append(agg.ingredient.Quantities, domain.QuantityUnit{Quantity: &value, Unit: unit})
+		}
+		// Lines without an amount ("to taste") only show up when nothing else did
+		if len(agg.ingredient.Quantities) == 0 {
+			agg.ingredient.Quantities = []domain.QuantityUnit{{Unit: agg.unquantified[0]}}
+		}
+		result = append(result, *agg.ingredient)
 	}
 
 	return result, nil
@@ -497,7 +501,7 @@ func (r *ShoppingListRepository) getListItems(ctx context.Context, listID uuid.U
 			sli.id, sli.shopping_list_id, sli.ingredient_id, sli.custom_name,
 			sli.quantity, sli.unit, sli.checked, sli.notes, sli.is_custom,
 			sli.created_at, sli.updated_at,
-			i.id, i.name, i.quantity,
+			i.id, i.name,
 			ic.id, ic.name, ic.display_order
 		FROM shopping_list_items sli
 		LEFT JOIN ingredients i ON sli.ingredient_id = i.id
@@ -516,14 +520,14 @@ func (r *ShoppingListRepository) getListItems(ctx context.Context, listID uuid.U
 	for rows.Next() {
 		var item domain.ShoppingListItem
 		var ingredientID, catID *uuid.UUID
-		var ingredientName, ingredientQty, catName *string
+		var ingredientName, catName *string
 		var catOrder *int
 
 		err := rows.Scan(
 			&item.ID, &item.ShoppingListID, &item.IngredientID, &item.CustomName,
 			&item.Quantity, &item.Unit, &item.Checked, &item.Notes, &item.IsCustom,
 			&item.CreatedAt, &item.UpdatedAt,
-			&ingredientID, &ingredientName, &ingredientQty,
+			&ingredientID, &ingredientName,
 			&catID, &catName, &catOrder,
 		)
 		if err != nil {
@@ -531,14 +535,9 @@ func (r *ShoppingListRepository) getListItems(ctx context.Context, listID uuid.U
 		}
 
 		if ingredientID != nil && ingredientName != nil {
-			qty := ""
-			if ingredientQty != nil {
-				qty = *ingredientQty
-			}
 			item.Ingredient = &domain.Ingredient{
-				ID:       *ingredientID,
-				Name:     *ingredientName,
-				Quantity: qty,
+				ID:   *ingredientID,
+				Name: *ingredientName,
 			}
 		}
 
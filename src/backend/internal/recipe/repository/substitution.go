@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/substitution"
+)
+
+const substitutionSelect = `
+	SELECT s.id, s.ingredient_name, s.substitute_name, s.ratio, s.notes, a.id, a.name,
+	       ARRAY(SELECT c.id FROM allergies c WHERE c.id = ANY(s.contains_allergy_ids) ORDER BY c.name),
+	       ARRAY(SELECT c.name FROM allergies c WHERE c.id = ANY(s.contains_allergy_ids) ORDER BY c.name)
+	FROM ingredient_substitutions s
+	LEFT JOIN allergies a ON a.id = s.avoids_allergy_id
+`
+
+// SaveSubstitutions upserts catalog substitutions by ingredient and
+// substitute. The allergies they avoid or contain are created when missing.
+func (r *Repository) SaveSubstitutions(ctx context.Context, substitutions []domain.Substitution) error {
+	allergyIDs := make(map[string]uuid.UUID)
+	for _, sub := range substitutions {
+		names := make([]string, 0, len(sub.ContainsAllergies)+1)
+		if sub.AvoidsAllergy != nil {
+			names = append(names, sub.AvoidsAllergy.Name)
+		}
+		for _, allergy := range sub.ContainsAllergies {
+			names = append(names, allergy.Name)
+		}
+		for _, name := range names {
+			if _, ok := allergyIDs[name]; ok {
+				continue
+			}
+			allergy, err := r.GetOrCreateAllergy(ctx, name)
+			if err != nil {
+				return fmt.Errorf("get or create allergy %s: %w", name, err)
+			}
+			allergyIDs[name] = allergy.ID
+		}
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, sub := range substitutions {
+		var allergyID *uuid.UUID
+		if sub.AvoidsAllergy != nil {
+			id := allergyIDs[sub.AvoidsAllergy.Name]
+			allergyID = &id
+		}
+		containsIDs := make([]uuid.UUID, len(sub.ContainsAllergies))
+		for i, allergy := range sub.ContainsAllergies {
+			containsIDs[i] = allergyIDs[allergy.Name]
+		}
+		_, err := tx.Exec(ctx, `
+			INSERT INTO ingredient_substitutions
+				(ingredient_key, ingredient_name, substitute_name, ratio, notes, avoids_allergy_id, contains_allergy_ids)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			ON CONFLICT (ingredient_key, substitute_name) DO UPDATE SET
+				ingredient_name = EXCLUDED.ingredient_name,
+				ratio = EXCLUDED.ratio,
+				notes = EXCLUDED.notes,
+				avoids_allergy_id = EXCLUDED.avoids_allergy_id,
+				contains_allergy_ids = EXCLUDED.contains_allergy_ids,
+				updated_at = NOW()
+		`, substitution.Key(sub.IngredientName), sub.IngredientName, sub.SubstituteName, sub.Ratio, sub.Notes, allergyID, containsIDs)
+		if err != nil {
+			return fmt.Errorf("upsert substitution %s for %s: %w", sub.SubstituteName, sub.IngredientName, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ListSubstitutions returns the substitutions for ingredients with the given
+// names, optionally only those avoiding an allergy.
+func (r *Repository) ListSubstitutions(ctx context.Context, ingredientNames []string, allergyID *uuid.UUID) ([]domain.Substitution, error) {
+	keys := make([]string, len(ingredientNames))
+	for i, name := range ingredientNames {
+		keys[i] = substitution.Key(name)
+	}
+
+	rows, err := r.pool.Query(ctx, substitutionSelect+`
+		WHERE s.ingredient_key = ANY($1)
+		  AND ($2::uuid IS NULL OR s.avoids_allergy_id = $2)
+		ORDER BY s.ingredient_key, s.substitute_name
+	`, keys, allergyID)
+	if err != nil {
+		return nil, fmt.Errorf("query substitutions: %w", err)
+	}
+
+	return collectSubstitutions(rows)
+}
+
+// GetSubstitutions returns the substitutions with the given IDs; unknown IDs
+// are skipped.
+func (r *Repository) GetSubstitutions(ctx context.Context, ids []uuid.UUID) ([]domain.Substitution, error) {
+	rows, err := r.pool.Query(ctx, substitutionSelect+`
+		WHERE s.id = ANY($1)
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query substitutions: %w", err)
+	}
+
+	return collectSubstitutions(rows)
+}
+
+func collectSubstitutions(rows pgx.Rows) ([]domain.Substitution, error) {
+	defer rows.Close()
+
+	var substitutions []domain.Substitution
+	for rows.Next() {
+		var sub domain.Substitution
+		var allergyID *uuid.UUID
+		var allergyName *string
+		var containsIDs []uuid.UUID
+		var containsNames []string
+		if err := rows.Scan(
+			&sub.ID, &sub.IngredientName, &sub.SubstituteName, &sub.Ratio, &sub.Notes,
+			&allergyID, &allergyName, &containsIDs, &containsNames,
+		); err != nil {
+			return nil, fmt.Errorf("scan substitution: %w", err)
+		}
+		if allergyID != nil {
+			sub.AvoidsAllergy = &domain.Allergy{ID: *allergyID, Name: *allergyName}
+		}
+		for i, id := range containsIDs {
+			sub.ContainsAllergies = append(sub.ContainsAllergies, domain.Allergy{ID: id, Name: containsNames[i]})
+		}
+		substitutions = append(substitutions, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate substitutions: %w", err)
+	}
+
+	return substitutions, nil
+}
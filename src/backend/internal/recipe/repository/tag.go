@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+var (
+	// ErrTagNotFound is returned when none of the user's recipes carry a tag.
+	ErrTagNotFound = errors.New("tag not found")
+	// ErrTagNameTaken is returned when renaming a tag to another tag already
+	// in use; those should be merged.
+	ErrTagNameTaken = errors.New("tag name is already taken")
+	// ErrTagMergeSelf is returned when merging a tag into itself.
+	ErrTagMergeSelf = errors.New("tag cannot be merged into itself")
+)
+
+// ListTags returns the tags on the user's active recipes with the number of
+// recipes carrying each, most used first. An empty prefix or group matches
+// every tag.
+func (r *Repository) ListTags(ctx context.Context, userID uuid.UUID, prefix string, group domain.TagGroup, limit int) ([]domain.Tag, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT t.tag, COALESCE(g.tag_group, ''), COUNT(DISTINCT r.id)
+		FROM recipes r
+		CROSS JOIN LATERAL unnest(r.tags) AS t(tag)
+		LEFT JOIN tag_groups g ON g.user_id = r.user_id AND g.tag = t.tag
+		WHERE r.user_id = $1 AND `+activeClause("r")+`
+		  AND ($2 = '' OR starts_with(t.tag, $2))
+		  AND ($3 = '' OR g.tag_group = $3)
+		GROUP BY t.tag, g.tag_group
+		ORDER BY COUNT(DISTINCT r.id) DESC, t.tag
+		LIMIT $4
+	`, userID, prefix, string(group), limit)
+	if err != nil {
+		return nil, fmt.Errorf("query tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []domain.Tag
+	for rows.Next() {
+		var tag domain.Tag
+		var tagGroup string
+		if err := rows.Scan(&tag.Name, &tagGroup, &tag.RecipeCount); err != nil {
+			return nil, fmt.Errorf("scan tag: %w", err)
+		}
+		tag.Group = domain.TagGroup(tagGroup)
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// RenameTag renames a tag on every recipe of the user, including those in the
+// trash, and returns the active recipes that changed. The tag keeps its group.
+func (r *Repository) RenameTag(ctx context.Context, userID uuid.UUID, tag, name string) ([]uuid.UUID, error) {
+	if tag == name {
+		return nil, ErrTagMergeSelf
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var taken bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (SELECT 1 FROM recipes WHERE user_id = $1 AND tags @> ARRAY[$2::text])
+	`, userID, name).Scan(&taken)
+	if err != nil {
+		return nil, fmt.Errorf("check tag name: %w", err)
+	}
+	if taken {
+		return nil, ErrTagNameTaken
+	}
+
+	// A group left behind by a tag no recipe carries any more would shadow
+	// the group of the renamed tag.
+	if _, err := tx.Exec(ctx, `DELETE FROM tag_groups WHERE user_id = $1 AND tag = $2`, userID, name); err != nil {
+		return nil, fmt.Errorf("delete stale tag group: %w", err)
+	}
+
+	recipeIDs, err := replaceTags(ctx, tx, userID, []string{tag}, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return recipeIDs, nil
+}
+
+// MergeTags replaces the source tags with the target on every recipe of the
+// user, including those in the trash, and returns the active recipes that
+// changed. The target keeps its group or, when it has none, takes the group
+// of the first grouped source.
+func (r *Repository) MergeTags(ctx context.Context, userID uuid.UUID, sources []string, target string) ([]uuid.UUID, error) {
+	if slices.Contains(sources, target) {
+		return nil, ErrTagMergeSelf
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	recipeIDs, err := replaceTags(ctx, tx, userID, sources, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return recipeIDs, nil
+}
+
+// replaceTags swaps the source tags for the target on the user's recipes,
+// keeping the order of the remaining tags and dropping duplicates, and moves
+// the group of the sources to the target. It returns the active recipes that
+// changed, or ErrTagNotFound when no recipe carries a source tag.
+func replaceTags(ctx context.Context, tx pgx.Tx, userID uuid.UUID, sources []string, target string) ([]uuid.UUID, error) {
+	rows, err := tx.Query(ctx, `
+		WITH updated AS (
+			UPDATE recipes r SET tags = ARRAY(
+				SELECT d.tag FROM (
+					SELECT DISTINCT ON (m.tag) m.tag, t.ord
+					FROM unnest(r.tags) WITH ORDINALITY AS t(tag, ord)
+					CROSS JOIN LATERAL (
+						SELECT CASE WHEN t.tag = ANY($2::text[]) THEN $3::text ELSE t.tag END AS tag
+					) m
+					ORDER BY m.tag, t.ord
+				) d
+				ORDER BY d.ord
+			)
+			WHERE r.user_id = $1 AND r.tags && $2::text[]
+			RETURNING r.id, r.created_at, r.deleted_at
+		)
+		SELECT id, deleted_at IS NULL FROM updated
+		ORDER BY created_at
+	`, userID, sources, target)
+	if err != nil {
+		return nil, fmt.Errorf("replace tags: %w", err)
+	}
+	recipeIDs, err := collectActiveRecipeIDs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO tag_groups (user_id, tag, tag_group)
+		SELECT user_id, $3, tag_group FROM tag_groups
+		WHERE user_id = $1 AND tag = ANY($2::text[])
+		ORDER BY array_position($2::text[], tag)
+		LIMIT 1
+		ON CONFLICT (user_id, tag) DO NOTHING
+	`, userID, sources, target)
+	if err != nil {
+		return nil, fmt.Errorf("move tag group: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM tag_groups WHERE user_id = $1 AND tag = ANY($2::text[])`, userID, sources)
+	if err != nil {
+		return nil, fmt.Errorf("delete source tag groups: %w", err)
+	}
+
+	return recipeIDs, nil
+}
+
+// DeleteTag removes a tag from every recipe of the user, including those in
+// the trash, and returns the active recipes that changed.
+func (r *Repository) DeleteTag(ctx context.Context, userID uuid.UUID, tag string) ([]uuid.UUID, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `
+		WITH updated AS (
+			UPDATE recipes SET tags = array_remove(tags, $2::text)
+			WHERE user_id = $1 AND tags @> ARRAY[$2::text]
+			RETURNING id, created_at, deleted_at
+		)
+		SELECT id, deleted_at IS NULL FROM updated
+		ORDER BY created_at
+	`, userID, tag)
+	if err != nil {
+		return nil, fmt.Errorf("remove tag: %w", err)
+	}
+	recipeIDs, err := collectActiveRecipeIDs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM tag_groups WHERE user_id = $1 AND tag = $2`, userID, tag); err != nil {
+		return nil, fmt.Errorf("delete tag group: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return recipeIDs, nil
+}
+
+// SetTagGroup files a tag in use on the user's recipes under a group, or
+// ungroups it when group is empty, and returns the tag.
+func (r *Repository) SetTagGroup(ctx context.Context, userID uuid.UUID, tag string, group domain.TagGroup) (*domain.Tag, error) {
+	var active, total int
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FILTER (WHERE deleted_at IS NULL), COUNT(*)
+		FROM recipes
+		WHERE user_id = $1 AND tags @> ARRAY[$2::text]
+	`, userID, tag).Scan(&active, &total)
+	if err != nil {
+		return nil, fmt.Errorf("count tagged recipes: %w", err)
+	}
+	if total == 0 {
+		return nil, ErrTagNotFound
+	}
+
+	if group == "" {
+		_, err = r.pool.Exec(ctx, `DELETE FROM tag_groups WHERE user_id = $1 AND tag = $2`, userID, tag)
+	} else {
+		_, err = r.pool.Exec(ctx, `
+			INSERT INTO tag_groups (user_id, tag, tag_group)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (user_id, tag) DO UPDATE SET tag_group = EXCLUDED.tag_group
+		`, userID, tag, string(group))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("save tag group: %w", err)
+	}
+
+	return &domain.Tag{Name: tag, Group: group, RecipeCount: active}, nil
+}
+
+// collectActiveRecipeIDs reads the (id, active) rows of retagged recipes and
+// returns the active ones. It returns ErrTagNotFound when no recipe changed.
+func collectActiveRecipeIDs(rows pgx.Rows) ([]uuid.UUID, error) {
+	defer rows.Close()
+
+	var found bool
+	var recipeIDs []uuid.UUID
+	for rows.Next() {
+		var recipeID uuid.UUID
+		var active bool
+		if err := rows.Scan(&recipeID, &active); err != nil {
+			return nil, fmt.Errorf("scan recipe id: %w", err)
+		}
+		found = true
+		if active {
+			recipeIDs = append(recipeIDs, recipeID)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate retagged recipes: %w", err)
+	}
+	if !found {
+		return nil, ErrTagNotFound
+	}
+
+	return recipeIDs, nil
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/trash"
+)
+
+// ListDeletedRecipes returns the user's soft-deleted recipes, most recently
+// deleted first. Only the owner sees a recipe in the trash.
+func (r *Repository) ListDeletedRecipes(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.Recipe, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT
+			r.id, r.user_id, r.name, r.description,
+			r.prep_time_minutes, r.cook_time_minutes, r.total_time_minutes,
+			r.servings, r.yield_quantity, r.yield_unit,
+			r.image_url, r.tags, r.diet_labels, r.diet_classified, r.search_vector,
+			r.created_at, r.updated_at, r.deleted_at,
+			c.id, c.user_id, c.name, c.created_at,
+			mi.id, mi.user_id, mi.name, mi.description, mi.created_at, mi.updated_at,
+			rn.calories_total, rn.calories_per_serving,
+			rn.protein_g, rn.carbs_g, rn.fat_g, rn.fiber_g, rn.sugar_g, rn.sodium_mg,
+			COALESCE(rn.is_override, FALSE),
+			COALESCE(st.times_cooked, 0), st.last_cooked_on, st.average_rating,
+			0::float8 AS score
+		FROM recipes r
+		JOIN cuisines c ON r.cuisine_id = c.id
+		JOIN ingredients mi ON r.main_ingredient_id = mi.id
+		LEFT JOIN recipe_nutrition rn ON rn.recipe_id = r.id
+		`+cookStatsJoin("r", 1)+`
+		WHERE r.deleted_at IS NOT NULL
+		  AND r.user_id = $1
+		ORDER BY r.deleted_at DESC
+		LIMIT $2 OFFSET $3
+	`, userID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("query deleted recipes: %w", err)
+	}
+	defer rows.Close()
+
+	return r.scanRecipes(ctx, rows)
+}
+
+// CountDeletedRecipes returns the number of recipes in the user's trash.
+func (r *Repository) CountDeletedRecipes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM recipes WHERE deleted_at IS NOT NULL AND user_id = $1
+	`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("count deleted recipes: %w", err)
+	}
+	return count, nil
+}
+
+// RestoreRecipe moves a recipe out of the user's trash.
+func (r *Repository) RestoreRecipe(ctx context.Context, userID, id uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `
+		UPDATE recipes
+		SET deleted_at = NULL
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL
+	`, id, userID)
+	if err != nil {
+		return fmt.Errorf("restore recipe: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return ErrRecipeNotFound
+	}
+
+	return nil
+}
+
+// PurgeRecipe permanently deletes a recipe from the user's trash and returns
+// the image URLs nothing references anymore. Recipes that were not deleted
+// first cannot be purged.
+func (r *Repository) PurgeRecipe(ctx context.Context, userID, id uuid.UUID) ([]string, error) {
+	purged, err := r.purgeRecipes(ctx, `id = $1 AND user_id = $2 AND deleted_at IS NOT NULL`, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("purge recipe: %w", err)
+	}
+
+	if purged.Recipes == 0 {
+		return nil, ErrRecipeNotFound
+	}
+
+	return purged.OrphanedMedia, nil
+}
+
+// PurgeDeletedRecipes permanently deletes every recipe that was moved to the
+// trash before the given time.
+func (r *Repository) PurgeDeletedRecipes(ctx context.Context, deletedBefore time.Time) (*trash.Purged, error) {
+	purged, err := r.purgeRecipes(ctx, `deleted_at IS NOT NULL AND deleted_at < $1`, deletedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("purge deleted recipes: %w", err)
+	}
+	return purged, nil
+}
+
+// purgeRecipes deletes the recipes matching the condition. The image and step
+// media URLs of the deleted recipes and their revisions are reported as
+// orphaned unless a remaining recipe, revision or collection still uses them;
+// duplicated recipes share their original's images.
+func (r *Repository) purgeRecipes(ctx context.Context, condition string, args ...any) (*trash.Purged, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT id FROM recipes WHERE `+condition+` FOR UPDATE`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query purged recipes: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("scan purged recipes: %w", err)
+	}
+
+	purged := &trash.Purged{Recipes: int64(len(ids))}
+	if len(ids) == 0 {
+		return purged, nil
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT DISTINCT url FROM (
+			SELECT image_url AS url FROM recipes WHERE id = ANY($1)
+			UNION ALL
+			SELECT media_url FROM recipe_steps WHERE recipe_id = ANY($1)
+			UNION ALL
+			SELECT snapshot->>'imageUrl' FROM recipe_revisions WHERE recipe_id = ANY($1)
+			UNION ALL
+			SELECT step->>'mediaUrl'
+			FROM recipe_revisions rr
+			CROSS JOIN LATERAL jsonb_array_elements(
+				CASE WHEN jsonb_typeof(rr.snapshot->'steps') = 'array'
+					THEN rr.snapshot->'steps' ELSE '[]'::jsonb END
+			) AS step
+			WHERE rr.recipe_id = ANY($1)
+		) urls
+		WHERE url IS NOT NULL AND url <> ''
+	`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query recipe media: %w", err)
+	}
+	candidates, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("scan recipe media: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM recipes WHERE id = ANY($1)`, ids); err != nil {
+		return nil, fmt.Errorf("delete recipes: %w", err)
+	}
+
+	if len(candidates) > 0 {
+		rows, err = tx.Query(ctx, `
+			SELECT c.url FROM unnest($1::text[]) AS c(url)
+			WHERE NOT EXISTS (SELECT 1 FROM recipes WHERE image_url = c.url)
+			  AND NOT EXISTS (SELECT 1 FROM recipe_steps WHERE media_url = c.url)
+			  AND NOT EXISTS (SELECT 1 FROM recipe_collections WHERE cover_image_url = c.url)
+			  AND NOT EXISTS (
+				SELECT 1 FROM recipe_revisions
+				WHERE strpos(snapshot::text, to_jsonb(c.url)::text) > 0
+			  )
+		`, candidates)
+		if err != nil {
+			return nil, fmt.Errorf("query orphaned media: %w", err)
+		}
+		purged.OrphanedMedia, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		if err != nil {
+			return nil, fmt.Errorf("scan orphaned media: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return purged, nil
+}
+
+// ListRecipeShares returns the shares of a recipe the user owns.
+func (r *Repository) ListRecipeShares(ctx context.Context, ownerID, recipeID uuid.UUID) ([]domain.RecipeShare, error) {
+	return r.queryShares(ctx, `r.user_id = $1 AND rs.recipe_id = $2`, ownerID, recipeID)
+}
+
+// ListRecipeCookStats returns the cook log aggregates of every user who has
+// cooked a recipe, keyed by user.
+func (r *Repository) ListRecipeCookStats(ctx context.Context, recipeID uuid.UUID) (map[uuid.UUID]domain.RecipeCookStats, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT user_id, times_cooked, last_cooked_on, average_rating
+		FROM recipe_cook_stats
+		WHERE recipe_id = $1
+	`, recipeID)
+	if err != nil {
+		return nil, fmt.Errorf("query cook stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make(map[uuid.UUID]domain.RecipeCookStats)
+	for rows.Next() {
+		var userID uuid.UUID
+		var s domain.RecipeCookStats
+		if err := rows.Scan(&userID, &s.TimesCooked, &s.LastCookedOn, &s.AverageRating); err != nil {
+			return nil, fmt.Errorf("scan cook stats: %w", err)
+		}
+		stats[userID] = s
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate cook stats: %w", err)
+	}
+
+	return stats, nil
+}
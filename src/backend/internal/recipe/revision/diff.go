@@ -0,0 +1,193 @@
+// Package revision compares recipe revisions.
+package revision
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+)
+
+// ChangeKind describes how an ingredient line or step changed.
+type ChangeKind string
+
+const (
+	Added    ChangeKind = "added"
+	Removed  ChangeKind = "removed"
+	Modified ChangeKind = "modified"
+)
+
+// FieldChange is a scalar recipe field whose value changed, rendered as text.
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// LineChange is an added, removed or modified ingredient line. From is nil
+// for added lines and To is nil for removed ones.
+type LineChange struct {
+	Kind ChangeKind
+	From *domain.RecipeIngredientLine
+	To   *domain.RecipeIngredientLine
+}
+
+// StepChange is an added, removed or modified step. From is nil for added
+// steps and To is nil for removed ones.
+type StepChange struct {
+	Kind ChangeKind
+	From *domain.RecipeStep
+	To   *domain.RecipeStep
+}
+
+// Diff lists the changes between two versions of a recipe.
+type Diff struct {
+	Fields          []FieldChange
+	IngredientLines []LineChange
+	Steps           []StepChange
+}
+
+// Empty reports whether the two versions are the same.
+func (d Diff) Empty() bool {
+	return len(d.Fields) == 0 && len(d.IngredientLines) == 0 && len(d.Steps) == 0
+}
+
+// Compare returns the changes that turn from into to. Ingredient lines are
+// paired by ingredient, so reordering lines is not reported as a change, and
+// steps are paired by position.
+func Compare(from, to *domain.Recipe) Diff {
+	return Diff{
+		Fields:          compareFields(from, to),
+		IngredientLines: compareLines(from.IngredientLines, to.IngredientLines),
+		Steps:           compareSteps(from.Steps, to.Steps),
+	}
+}
+
+func compareFields(from, to *domain.Recipe) []FieldChange {
+	var changes []FieldChange
+	add := func(field, a, b string) {
+		if a != b {
+			changes = append(changes, FieldChange{Field: field, From: a, To: b})
+		}
+	}
+
+	add("name", from.Name, to.Name)
+	add("description", from.Description, to.Description)
+	add("prep_time_minutes", strconv.Itoa(from.PrepTimeMinutes), strconv.Itoa(to.PrepTimeMinutes))
+	add("cook_time_minutes", strconv.Itoa(from.CookTimeMinutes), strconv.Itoa(to.CookTimeMinutes))
+	add("total_time_minutes", strconv.Itoa(from.TotalTimeMinutes), strconv.Itoa(to.TotalTimeMinutes))
+	add("servings", strconv.Itoa(from.Servings), strconv.Itoa(to.Servings))
+	add("yield", formatYield(from), formatYield(to))
+	add("main_ingredient", ingredientName(from.MainIngredient), ingredientName(to.MainIngredient))
+	add("cuisine", cuisineName(from.Cuisine), cuisineName(to.Cuisine))
+	add("tags", strings.Join(from.Tags, ", "), strings.Join(to.Tags, ", "))
+	add("image_url", from.ImageURL, to.ImageURL)
+	add("calories_total", strconv.Itoa(from.Nutrition.CaloriesTotal), strconv.Itoa(to.Nutrition.CaloriesTotal))
+
+	return changes
+}
+
+func compareLines(from, to []domain.RecipeIngredientLine) []LineChange {
+	from, to = sortedLines(from), sortedLines(to)
+
+	// Pair lines of the same ingredient in order of appearance.
+	unmatched := make(map[uuid.UUID][]int)
+	for i, line := range from {
+		unmatched[line.Ingredient.ID] = append(unmatched[line.Ingredient.ID], i)
+	}
+
+	var changes []LineChange
+	paired := make([]bool, len(from))
+	for i := range to {
+		candidates := unmatched[to[i].Ingredient.ID]
+		if len(candidates) == 0 {
+			changes = append(changes, LineChange{Kind: Added, To: &to[i]})
+			continue
+		}
+		j := candidates[0]
+		unmatched[to[i].Ingredient.ID] = candidates[1:]
+		paired[j] = true
+
+		if from[j].DisplayText() != to[i].DisplayText() {
+			changes = append(changes, LineChange{Kind: Modified, From: &from[j], To: &to[i]})
+		}
+	}
+
+	for j := range from {
+		if !paired[j] {
+			changes = append(changes, LineChange{Kind: Removed, From: &from[j]})
+		}
+	}
+
+	return changes
+}
+
+func compareSteps(from, to []domain.RecipeStep) []StepChange {
+	from, to = sortedSteps(from), sortedSteps(to)
+
+	var changes []StepChange
+	for i := 0; i < len(from) || i < len(to); i++ {
+		switch {
+		case i >= len(from):
+			changes = append(changes, StepChange{Kind: Added, To: &to[i]})
+		case i >= len(to):
+			changes = append(changes, StepChange{Kind: Removed, From: &from[i]})
+		case !sameStep(from[i], to[i]):
+			changes = append(changes, StepChange{Kind: Modified, From: &from[i], To: &to[i]})
+		}
+	}
+
+	return changes
+}
+
+func sameStep(a, b domain.RecipeStep) bool {
+	return a.Instruction == b.Instruction &&
+		a.TemperatureUnit == b.TemperatureUnit &&
+		a.MediaURL == b.MediaURL &&
+		equalPtr(a.DurationSeconds, b.DurationSeconds) &&
+		equalPtr(a.TemperatureValue, b.TemperatureValue)
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sortedLines(lines []domain.RecipeIngredientLine) []domain.RecipeIngredientLine {
+	sorted := append([]domain.RecipeIngredientLine(nil), lines...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SortOrder < sorted[j].SortOrder })
+	return sorted
+}
+
+func sortedSteps(steps []domain.RecipeStep) []domain.RecipeStep {
+	sorted := append([]domain.RecipeStep(nil), steps...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].StepIndex < sorted[j].StepIndex })
+	return sorted
+}
+
+func formatYield(r *domain.Recipe) string {
+	if r.YieldQuantity == nil {
+		return r.YieldUnit
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s", strconv.FormatFloat(*r.YieldQuantity, 'f', -1, 64), r.YieldUnit))
+}
+
+func ingredientName(ingredient *domain.Ingredient) string {
+	if ingredient == nil {
+		return ""
+	}
+	return ingredient.Name
+}
+
+func cuisineName(cuisine *domain.Cuisine) string {
+	if cuisine == nil {
+		return ""
+	}
+	return cuisine.Name
+}
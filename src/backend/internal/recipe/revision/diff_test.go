@@ -0,0 +1,141 @@
+package revision_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/revision"
+)
+
+func TestCompare_SameRecipe_IsEmpty(t *testing.T) {
+	recipe := givenRecipe("Lasagna", line("pasta", 250, "g", 1))
+
+	diff := revision.Compare(recipe, recipe)
+
+	if !diff.Empty() {
+		t.Fatalf("expected no changes, got %+v", diff)
+	}
+}
+
+func TestCompare_ReportsFieldChanges(t *testing.T) {
+	from := givenRecipe("Lasagna")
+	to := givenRecipe("Quick Lasagna")
+	to.Servings = 6
+	to.Tags = []string{"weeknight"}
+
+	diff := revision.Compare(from, to)
+
+	want := map[string][2]string{
+		"name":     {"Lasagna", "Quick Lasagna"},
+		"servings": {"4", "6"},
+		"tags":     {"", "weeknight"},
+	}
+	if len(diff.Fields) != len(want) {
+		t.Fatalf("expected %d field changes, got %+v", len(want), diff.Fields)
+	}
+	for _, change := range diff.Fields {
+		values, ok := want[change.Field]
+		if !ok || change.From != values[0] || change.To != values[1] {
+			t.Errorf("unexpected change %+v", change)
+		}
+	}
+}
+
+func TestCompare_PairsLinesByIngredient(t *testing.T) {
+	pasta := line("pasta", 250, "g", 1)
+	ricotta := line("ricotta", 500, "g", 2)
+	basil := line("basil", 5, "leaves", 3)
+	from := givenRecipe("Lasagna", pasta, ricotta, basil)
+
+	// Reorder, change the ricotta amount, drop the basil and add spinach.
+	ricotta2 := ricotta
+	ricotta2.SortOrder = 1
+	ricotta2.QuantityValue = ptr(400.0)
+	pasta2 := pasta
+	pasta2.SortOrder = 2
+	spinach := line("spinach", 200, "g", 3)
+	to := givenRecipe("Lasagna", ricotta2, pasta2, spinach)
+
+	diff := revision.Compare(from, to)
+
+	kinds := make(map[string]revision.ChangeKind)
+	for _, change := range diff.IngredientLines {
+		l := change.To
+		if l == nil {
+			l = change.From
+		}
+		kinds[l.Ingredient.Name] = change.Kind
+	}
+	want := map[string]revision.ChangeKind{
+		"ricotta": revision.Modified,
+		"spinach": revision.Added,
+		"basil":   revision.Removed,
+	}
+	if len(kinds) != len(want) {
+		t.Fatalf("expected %v, got %v", want, kinds)
+	}
+	for name, kind := range want {
+		if kinds[name] != kind {
+			t.Errorf("%s: expected %s, got %s", name, kind, kinds[name])
+		}
+	}
+}
+
+func TestCompare_ComparesStepsByPosition(t *testing.T) {
+	from := givenRecipe("Lasagna")
+	from.Steps = []domain.RecipeStep{
+		{StepIndex: 1, Instruction: "Boil the sheets."},
+		{StepIndex: 2, Instruction: "Layer and bake.", DurationSeconds: ptr(2400)},
+	}
+	to := givenRecipe("Lasagna")
+	to.Steps = []domain.RecipeStep{
+		{StepIndex: 1, Instruction: "Boil the sheets."},
+		{StepIndex: 2, Instruction: "Layer and bake.", DurationSeconds: ptr(1800)},
+		{StepIndex: 3, Instruction: "Rest for 10 minutes."},
+	}
+
+	diff := revision.Compare(from, to)
+
+	if len(diff.Steps) != 2 {
+		t.Fatalf("expected 2 step changes, got %+v", diff.Steps)
+	}
+	if diff.Steps[0].Kind != revision.Modified || *diff.Steps[0].To.DurationSeconds != 1800 {
+		t.Errorf("expected step 2 modified, got %+v", diff.Steps[0])
+	}
+	if diff.Steps[1].Kind != revision.Added || diff.Steps[1].From != nil {
+		t.Errorf("expected step 3 added, got %+v", diff.Steps[1])
+	}
+}
+
+// Helpers
+
+var ingredientIDs = map[string]uuid.UUID{}
+
+func givenRecipe(name string, lines ...domain.RecipeIngredientLine) *domain.Recipe {
+	return &domain.Recipe{
+		Name:            name,
+		Servings:        4,
+		Cuisine:         &domain.Cuisine{Name: "Italian"},
+		IngredientLines: lines,
+	}
+}
+
+func line(name string, quantity float64, unit string, order int) domain.RecipeIngredientLine {
+	id, ok := ingredientIDs[name]
+	if !ok {
+		id = uuid.New()
+		ingredientIDs[name] = id
+	}
+	return domain.RecipeIngredientLine{
+		Ingredient:    domain.Ingredient{ID: id, Name: name},
+		QuantityValue: ptr(quantity),
+		Unit:          unit,
+		SortOrder:     order,
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,179 @@
+// Package scaler resizes recipes to a different number of servings and rounds
+// the resulting amounts to values a cook can actually measure.
+package scaler
+
+import (
+	"errors"
+	"math"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/common/units"
+)
+
+var (
+	// ErrInvalidServings is returned when the requested servings are not positive.
+	ErrInvalidServings = errors.New("servings must be positive")
+	// ErrNoServings is returned when the recipe does not say how many it serves.
+	ErrNoServings = errors.New("recipe has no servings to scale from")
+)
+
+// Result is a recipe scaled to a new number of servings.
+type Result struct {
+	Recipe *domain.Recipe
+	Factor float64
+	// NotScaled holds the indexes of ingredient lines whose amount is free
+	// text, such as "2-3" or "a handful", and was left as written.
+	NotScaled []int
+}
+
+// IsNotScaled reports whether the ingredient line at index was left as written.
+func (r *Result) IsNotScaled(index int) bool {
+	for _, i := range r.NotScaled {
+		if i == index {
+			return true
+		}
+	}
+	return false
+}
+
+// Scale returns a copy of recipe for the given number of servings. Ingredient
+// quantities, the yield and the nutrition total are scaled and rounded to
+// kitchen-friendly amounts; per-serving nutrition is unchanged. The original
+// recipe is not modified.
+func Scale(recipe *domain.Recipe, servings int) (*Result, error) {
+	if servings <= 0 {
+		return nil, ErrInvalidServings
+	}
+	if recipe.Servings <= 0 {
+		return nil, ErrNoServings
+	}
+
+	factor := float64(servings) / float64(recipe.Servings)
+	scaled := *recipe
+	scaled.Servings = servings
+	scaled.IngredientLines = make([]domain.RecipeIngredientLine, len(recipe.IngredientLines))
+	result := &Result{Recipe: &scaled, Factor: factor}
+
+	for i, line := range recipe.IngredientLines {
+		if line.QuantityValue != nil {
+			value, unit := *line.QuantityValue, line.Unit
+			if factor != 1 {
+				value, unit = Round(value*factor, unit)
+			}
+			line.QuantityValue, line.Unit = &value, unit
+		} else if line.QuantityText != "" && factor != 1 {
+			result.NotScaled = append(result.NotScaled, i)
+		}
+		scaled.IngredientLines[i] = line
+	}
+
+	if recipe.YieldQuantity != nil && factor != 1 {
+		quantity, unit := Round(*recipe.YieldQuantity*factor, recipe.YieldUnit)
+		scaled.YieldQuantity, scaled.YieldUnit = &quantity, unit
+	}
+
+	if recipe.Nutrition.CaloriesPerServing > 0 {
+		scaled.Nutrition.CaloriesTotal = recipe.Nutrition.CaloriesPerServing * servings
+	} else {
+		scaled.Nutrition.CaloriesTotal = int(math.Round(float64(recipe.Nutrition.CaloriesTotal) * factor))
+	}
+
+	return result, nil
+}
+
+// ladderUnits are converted up or down to the most readable unit of their
+// system; other known units such as "dl" or "pint" keep the unit the author
+// chose.
+var ladderUnits = map[string]bool{
+	"mg": true, "g": true, "kg": true,
+	"ml": true, "l": true,
+	"tsp": true, "tbsp": true, "cup": true,
+	"oz": true, "lb": true,
+}
+
+// Round converts a scaled amount to the most readable unit of its system
+// (48 tsp becomes 1 cup, 1500 g becomes 1.5 kg) and rounds it to a value
+// that can be measured: quarter teaspoons, common cup fractions, the nearest
+// 5 g or ml, and whole items for counted ingredients. Unknown units are
+// kept as written.
+func Round(value float64, unit string) (float64, string) {
+	u, known := units.Lookup(unit)
+	if known && ladderUnits[u.Name] {
+		humanized := units.Humanize(units.Quantity{Value: value, Unit: unit})
+		if humanized.Unit != u.Name {
+			value, unit = humanized.Value, humanized.Unit
+		}
+		u, _ = units.Lookup(unit)
+	}
+
+	if !known {
+		if unit == "" {
+			// Counted items such as eggs are whole.
+			return roundTo(value, 1), unit
+		}
+		return roundGeneric(value), unit
+	}
+	if u.Dimension == units.DimensionCount {
+		return roundTo(value, 1), unit
+	}
+
+	switch u.Name {
+	case "tsp":
+		if value < 1 {
+			return roundTo(value, 0.125), unit
+		}
+		return roundTo(value, 0.25), unit
+	case "tbsp":
+		return roundTo(value, 0.5), unit
+	case "cup":
+		return roundCupFraction(value), unit
+	case "g", "ml", "mg":
+		if value < 10 {
+			return roundTo(value, 1), unit
+		}
+		return roundTo(value, 5), unit
+	case "kg", "l":
+		return roundTo(value, 0.05), unit
+	case "oz", "lb":
+		return roundTo(value, 0.25), unit
+	default:
+		return roundGeneric(value), unit
+	}
+}
+
+// roundTo rounds value to the nearest multiple of step, never rounding a
+// positive amount down to zero.
+func roundTo(value, step float64) float64 {
+	rounded := math.Round(value/step) * step
+	if rounded == 0 && value > 0 {
+		return step
+	}
+	// Drop floating point noise such as 0.15000000000000002.
+	return math.Round(rounded*1000) / 1000
+}
+
+func roundGeneric(value float64) float64 {
+	if value < 10 {
+		return roundTo(value, 0.25)
+	}
+	return roundTo(value, 1)
+}
+
+var cupFractions = []float64{0, 0.25, 1.0 / 3, 0.5, 2.0 / 3, 0.75, 1}
+
+// roundCupFraction rounds to whole cups plus the nearest quarter or third.
+func roundCupFraction(value float64) float64 {
+	whole := math.Floor(value)
+	fraction := value - whole
+	best := cupFractions[0]
+	for _, f := range cupFractions[1:] {
+		if math.Abs(fraction-f) < math.Abs(fraction-best) {
+			best = f
+		}
+	}
+	rounded := whole + best
+	if rounded == 0 && value > 0 {
+		return 0.25
+	}
+	return rounded
+}
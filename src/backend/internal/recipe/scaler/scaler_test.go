@@ -0,0 +1,141 @@
+package scaler_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/scaler"
+)
+
+func TestRound_KitchenFriendlyAmounts(t *testing.T) {
+	cases := []struct {
+		value    float64
+		unit     string
+		want     float64
+		wantUnit string
+	}{
+		{48, "tsp", 1, "cup"},
+		{0.3, "tsp", 0.25, "tsp"},
+		{0.1, "tsp", 0.125, "tsp"},
+		{1.6, "tsp", 1.5, "tsp"},
+		{4.5, "tsp", 1.5, "tbsp"},
+		{0.5, "tbsp", 1.5, "tsp"},
+		{0.73, "cups", 0.75, "cups"},
+		{0.7, "cup", 2.0 / 3, "cup"},
+		{1.3, "cup", 1 + 1.0/3, "cup"},
+		{0.1, "cup", 1.5, "tbsp"},
+		{333, "g", 335, "g"},
+		{1500, "g", 1.5, "kg"},
+		{1.23, "kg", 1.25, "kg"},
+		{3.2, "g", 3, "g"},
+		{750, "ml", 750, "ml"},
+		{1.5, "dl", 1.5, "dl"},
+		{20, "oz", 1.25, "lb"},
+		{1.5, "", 2, ""},
+		{0.4, "", 1, ""},
+		{2.6, "cloves", 3, "cloves"},
+		{1.3, "snuf", 1.25, "snuf"},
+	}
+
+	for _, tc := range cases {
+		got, gotUnit := scaler.Round(tc.value, tc.unit)
+		if gotUnit != tc.wantUnit || math.Abs(got-tc.want) > 1e-9 {
+			t.Fatalf("Round(%v, %q): expected %v %q, got %v %q", tc.value, tc.unit, tc.want, tc.wantUnit, got, gotUnit)
+		}
+	}
+}
+
+func TestScale_ScalesLinesYieldAndNutrition(t *testing.T) {
+	recipe := givenRecipe()
+
+	result, err := scaler.Scale(recipe, 6)
+
+	thenNoError(t, err)
+	if result.Factor != 3 {
+		t.Fatalf("expected factor 3, got %v", result.Factor)
+	}
+	scaled := result.Recipe
+	if scaled.Servings != 6 {
+		t.Fatalf("expected 6 servings, got %d", scaled.Servings)
+	}
+	thenLine(t, scaled.IngredientLines[0], 600, "g")
+	thenLine(t, scaled.IngredientLines[1], 1, "cup")
+	thenLine(t, scaled.IngredientLines[2], 5, "")
+	if scaled.IngredientLines[3].QuantityText != "2-3" || scaled.IngredientLines[3].QuantityValue != nil {
+		t.Fatalf("expected free-text line to be left as written, got %+v", scaled.IngredientLines[3])
+	}
+	if len(result.NotScaled) != 1 || !result.IsNotScaled(3) {
+		t.Fatalf("expected line 3 to be flagged as not scaled, got %v", result.NotScaled)
+	}
+	if *scaled.YieldQuantity != 3 || scaled.YieldUnit != "loaves" {
+		t.Fatalf("expected yield 3 loaves, got %v %s", *scaled.YieldQuantity, scaled.YieldUnit)
+	}
+	if scaled.Nutrition.CaloriesTotal != 1800 || scaled.Nutrition.CaloriesPerServing != 300 {
+		t.Fatalf("unexpected nutrition %+v", scaled.Nutrition)
+	}
+
+	// The original recipe is untouched.
+	if *recipe.IngredientLines[0].QuantityValue != 200 || recipe.Servings != 2 || *recipe.YieldQuantity != 1 {
+		t.Fatalf("expected original recipe to be unchanged, got %+v", recipe)
+	}
+}
+
+func TestScale_SameServings_KeepsQuantities(t *testing.T) {
+	result, err := scaler.Scale(givenRecipe(), 2)
+
+	thenNoError(t, err)
+	thenLine(t, result.Recipe.IngredientLines[2], 1.5, "")
+	if len(result.NotScaled) != 0 {
+		t.Fatalf("expected no flagged lines, got %v", result.NotScaled)
+	}
+}
+
+func TestScale_InvalidInput(t *testing.T) {
+	if _, err := scaler.Scale(givenRecipe(), 0); !errors.Is(err, scaler.ErrInvalidServings) {
+		t.Fatalf("expected ErrInvalidServings, got %v", err)
+	}
+
+	recipe := givenRecipe()
+	recipe.Servings = 0
+	if _, err := scaler.Scale(recipe, 4); !errors.Is(err, scaler.ErrNoServings) {
+		t.Fatalf("expected ErrNoServings, got %v", err)
+	}
+}
+
+// Helpers
+
+func givenRecipe() *domain.Recipe {
+	flour, milk, eggs, yield := 200.0, 16.0, 1.5, 1.0
+	return &domain.Recipe{
+		Name:          "Bread",
+		Servings:      2,
+		YieldQuantity: &yield,
+		YieldUnit:     "loaves",
+		IngredientLines: []domain.RecipeIngredientLine{
+			{Ingredient: domain.Ingredient{Name: "flour"}, QuantityValue: &flour, Unit: "g"},
+			{Ingredient: domain.Ingredient{Name: "milk"}, QuantityValue: &milk, Unit: "tsp"},
+			{Ingredient: domain.Ingredient{Name: "eggs"}, QuantityValue: &eggs},
+			{Ingredient: domain.Ingredient{Name: "garlic"}, QuantityText: "2-3", Unit: ""},
+		},
+		Nutrition: domain.RecipeNutrition{
+			CaloriesTotal:      600,
+			CaloriesPerServing: 300,
+		},
+	}
+}
+
+func thenNoError(t *testing.T, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func thenLine(t *testing.T, line domain.RecipeIngredientLine, value float64, unit string) {
+	t.Helper()
+	if line.QuantityValue == nil || math.Abs(*line.QuantityValue-value) > 1e-9 || line.Unit != unit {
+		t.Fatalf("expected %v %q for %s, got %+v", value, unit, line.Ingredient.Name, line)
+	}
+}
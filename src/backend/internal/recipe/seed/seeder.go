@@ -2,6 +2,8 @@ package seed
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,180 +14,335 @@ import (
 	"unicode"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/platepilot/backend/internal/common/auth"
 	"github.com/platepilot/backend/internal/common/domain"
-	"github.com/platepilot/backend/internal/common/vector"
-	"github.com/platepilot/backend/internal/recipe/events"
+	"github.com/platepilot/backend/internal/recipe/handler"
+	"github.com/platepilot/backend/internal/recipe/ingredientparser"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
 	"github.com/platepilot/backend/internal/recipe/repository"
 )
 
-// Seeder handles database seeding from JSON files
+// seedFormatVersion is part of every content hash. Bump it when the way seed
+// data becomes recipes changes, so the next run re-imports every recipe.
+const seedFormatVersion = "2"
+
+// libraryCollectionName names the public collection a seed library is
+// published as.
+const libraryCollectionName = "PlatePilot Library"
+
+// Seeder handles database seeding from JSON files. Recipes go through the
+// recipe service's bulk import, like any other imported library, and the
+// seeder remembers a hash of each recipe's seed data so that re-runs only
+// create or update the recipes that changed.
 type Seeder struct {
-	repo      *repository.Repository
-	vectorGen vector.Generator
-	publisher *events.Publisher
-	logger    *slog.Logger
+	repo     *repository.Repository
+	importer *handler.GRPCHandler
+	logger   *slog.Logger
+}
+
+// Options controls where a seed file is imported.
+type Options struct {
+	// UserEmail is the registered user whose library receives the recipes.
+	// When empty, the seed user from PLATEPILOT_SEED_USER_EMAIL is used and
+	// created if needed.
+	UserEmail string
+	// Share publishes the seeded recipes as a public collection every user
+	// can read, including users who register later.
+	Share bool
+}
+
+// Summary reports what a seed run changed. Recipes are listed by name.
+type Summary struct {
+	Created []string
+	Updated []string
+	// Skipped recipes match a recipe the seeder did not create, or one in
+	// the trash, and were left alone.
+	Skipped   []string
+	Failed    []string
+	Unchanged int
+	// Published reports whether the recipes were published as the library.
+	Published bool
 }
 
 // NewSeeder creates a new database seeder
-func NewSeeder(repo *repository.Repository, vectorGen vector.Generator, publisher *events.Publisher, logger *slog.Logger) *Seeder {
+func NewSeeder(repo *repository.Repository, importer *handler.GRPCHandler, logger *slog.Logger) *Seeder {
 	return &Seeder{
-		repo:      repo,
-		vectorGen: vectorGen,
-		publisher: publisher,
-		logger:    logger,
+		repo:     repo,
+		importer: importer,
+		logger:   logger,
 	}
 }
 
 // SeedFromFile seeds the database from a JSON file
-func (s *Seeder) SeedFromFile(ctx context.Context, filePath string) error {
-	seedUser, err := s.ensureSeedUser(ctx)
+func (s *Seeder) SeedFromFile(ctx context.Context, filePath string, options Options) (*Summary, error) {
+	seedUser, err := s.targetUser(ctx, options.UserEmail)
 	if err != nil {
-		return fmt.Errorf("ensure seed user: %w", err)
-	}
-
-	// Check if already seeded
-	count, err := s.repo.Count(ctx, seedUser.ID, domain.RecipeFilter{})
-	if err != nil {
-		return fmt.Errorf("check recipe count: %w", err)
-	}
-
-	if count > 0 {
-		s.logger.Info("database already seeded", "recipeCount", count)
-		return nil
+		return nil, fmt.Errorf("ensure seed user: %w", err)
 	}
 
 	// Read JSON file
 	data, err := os.ReadFile(filePath)
 	if err != nil {
-		return fmt.Errorf("read seed file: %w", err)
+		return nil, fmt.Errorf("read seed file: %w", err)
 	}
 
 	var seedData SeedData
 	if err := json.Unmarshal(data, &seedData); err != nil {
-		return fmt.Errorf("unmarshal seed data: %w", err)
+		return nil, fmt.Errorf("unmarshal seed data: %w", err)
+	}
+
+	seeded, err := s.repo.ListSeedRecipes(ctx, seedUser.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list seed recipes: %w", err)
 	}
 
-	s.logger.Info("seeding database", "recipeCount", len(seedData.Recipes))
+	s.logger.Info("seeding database", "recipeCount", len(seedData.Recipes), "userId", seedUser.ID)
 
-	// Track already created entities to avoid duplicates
-	createdIngredients := make(map[string]*domain.Ingredient)
-	createdCuisines := make(map[string]*domain.Cuisine)
+	// Recipes seeded before are updated in place; new ones are only created
+	// when the library has no recipe of that name, so a user's own recipes
+	// are never overwritten.
+	creates := s.importer.NewRecipeImport(seedUser.ID, handler.ImportOptions{})
+	updates := s.importer.NewRecipeImport(seedUser.ID, handler.ImportOptions{UpdateExisting: true})
 
+	summary := &Summary{}
+	var recipeIDs []uuid.UUID
 	for _, recipeData := range seedData.Recipes {
-		if err := s.seedRecipe(ctx, seedUser.ID, recipeData, createdIngredients, createdCuisines); err != nil {
+		item := toPortableRecipe(recipeData)
+		hash, err := contentHash(recipeData)
+		if err != nil {
+			return nil, fmt.Errorf("hash seed recipe %s: %w", recipeData.Name, err)
+		}
+
+		previous, ok := seeded[item.GetExternalId()]
+		if ok && previous.ContentHash == hash {
+			summary.Unchanged++
+			recipeIDs = append(recipeIDs, previous.RecipeID)
+			continue
+		}
+
+		imp := creates
+		if ok || s.seededBefore(ctx, seedUser.ID, recipeData.ID, item.GetExternalId()) {
+			imp = updates
+		}
+		result := imp.Import(ctx, item)
+
+		switch result.GetStatus() {
+		case handler.ImportStatusCreated:
+			summary.Created = append(summary.Created, recipeData.Name)
+		case handler.ImportStatusUpdated:
+			summary.Updated = append(summary.Updated, recipeData.Name)
+		case handler.ImportStatusSkipped:
+			summary.Skipped = append(summary.Skipped, recipeData.Name)
+			continue
+		default:
+			// Continue with other recipes
 			s.logger.Error("failed to seed recipe",
-				"error", err,
+				"error", result.GetMessage(),
 				"recipeName", recipeData.Name,
 			)
-			// Continue with other recipes
+			summary.Failed = append(summary.Failed, recipeData.Name)
 			continue
 		}
+
+		recipeID, err := uuid.Parse(result.GetRecipeId())
+		if err != nil {
+			return nil, fmt.Errorf("parse seeded recipe ID: %w", err)
+		}
+		err = s.repo.SaveSeedRecipe(ctx, seedUser.ID, item.GetExternalId(), repository.SeedRecipe{
+			RecipeID:    recipeID,
+			ContentHash: hash,
+		})
+		if err != nil {
+			return nil, err
+		}
+		recipeIDs = append(recipeIDs, recipeID)
+	}
+
+	if options.Share {
+		if err := s.publishLibrary(ctx, seedUser.ID, recipeIDs); err != nil {
+			return nil, fmt.Errorf("publish seed library: %w", err)
+		}
+		summary.Published = true
 	}
 
 	s.logger.Info("database seeding complete",
-		"recipesCreated", len(seedData.Recipes),
-		"ingredientsCreated", len(createdIngredients),
-		"cuisinesCreated", len(createdCuisines),
+		"recipesCreated", len(summary.Created),
+		"recipesUpdated", len(summary.Updated),
+		"recipesUnchanged", summary.Unchanged,
+		"recipesSkipped", len(summary.Skipped),
+		"recipesFailed", len(summary.Failed),
+		"published", summary.Published,
 	)
 
-	return nil
+	return summary, nil
 }
 
-func (s *Seeder) seedRecipe(
-	ctx context.Context,
-	userID uuid.UUID,
-	data RecipeData,
-	createdIngredients map[string]*domain.Ingredient,
-	createdCuisines map[string]*domain.Cuisine,
-) error {
-	// Get or create cuisine
-	cuisine, err := s.getOrCreateCuisine(ctx, userID, data.Cuisine, createdCuisines)
+// seededBefore reports whether a recipe was seeded by an earlier version of
+// the seeder that kept no hashes: under its external ID, or with the seed ID
+// as its recipe ID.
+func (s *Seeder) seededBefore(ctx context.Context, userID, seedID uuid.UUID, externalID string) bool {
+	if _, trashed, err := s.repo.FindImportedRecipe(ctx, userID, externalID, ""); err == nil && !trashed {
+		return true
+	}
+	if seedID == uuid.Nil {
+		return false
+	}
+	recipe, err := s.repo.GetByID(ctx, userID, seedID)
+	return err == nil && recipe.UserID == userID
+}
+
+// targetUser returns the registered user to seed into, or the seed user when
+// no email is given.
+func (s *Seeder) targetUser(ctx context.Context, email string) (*domain.User, error) {
+	if email == "" {
+		return s.ensureSeedUser(ctx)
+	}
+
+	user, err := s.repo.GetUserByEmail(ctx, email)
 	if err != nil {
-		return fmt.Errorf("get or create cuisine: %w", err)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil, fmt.Errorf("no user with email %s", email)
+		}
+		return nil, err
 	}
+	return user, nil
+}
 
-	// Get or create main ingredient
-	mainIngredient, err := s.getOrCreateIngredient(ctx, userID, data.MainIngredient, createdIngredients)
+// publishLibrary puts the seeded recipes in the library collection and makes
+// it public. Changes go through the recipe service so the meal planner learns
+// about them too.
+func (s *Seeder) publishLibrary(ctx context.Context, ownerID uuid.UUID, recipeIDs []uuid.UUID) error {
+	library, err := s.libraryCollection(ctx, ownerID)
 	if err != nil {
-		return fmt.Errorf("get or create main ingredient: %w", err)
+		return err
 	}
 
-	// Get or create recipe ingredient lines
-	var ingredientLines []domain.RecipeIngredientLine
-	sortOrder := 1
-	lineIngredientIDs := make(map[uuid.UUID]struct{})
-	for _, ingData := range data.Ingredients {
-		ingredient, err := s.getOrCreateIngredient(ctx, userID, ingData, createdIngredients)
-		if err != nil {
-			return fmt.Errorf("get or create ingredient %s: %w", ingData.Name, err)
+	inLibrary := make(map[uuid.UUID]struct{}, len(library.Items))
+	for _, item := range library.Items {
+		inLibrary[item.RecipeID] = struct{}{}
+	}
+
+	for _, recipeID := range recipeIDs {
+		if _, ok := inLibrary[recipeID]; ok {
+			continue
 		}
-		ingredientLines = append(ingredientLines, domain.RecipeIngredientLine{
-			Ingredient:   *ingredient,
-			QuantityText: ingData.Quantity,
-			SortOrder:    sortOrder,
+		_, err := s.importer.AddRecipeToCollection(ctx, &pb.CollectionRecipeRequest{
+			UserId:       ownerID.String(),
+			CollectionId: library.ID.String(),
+			RecipeId:     recipeID.String(),
 		})
-		lineIngredientIDs[ingredient.ID] = struct{}{}
-		sortOrder++
+		if err != nil {
+			return fmt.Errorf("add recipe %s to library: %w", recipeID, err)
+		}
 	}
 
-	if _, exists := lineIngredientIDs[mainIngredient.ID]; !exists && mainIngredient != nil {
-		ingredientLines = append(ingredientLines, domain.RecipeIngredientLine{
-			Ingredient:   *mainIngredient,
-			QuantityText: data.MainIngredient.Quantity,
-			SortOrder:    sortOrder,
-		})
+	return s.importer.SetCollectionPublic(ctx, ownerID, library.ID, true)
+}
+
+// libraryCollection returns the library collection with its items, creating
+// it when the owner has none.
+func (s *Seeder) libraryCollection(ctx context.Context, ownerID uuid.UUID) (*domain.Collection, error) {
+	collections, err := s.repo.ListCollections(ctx, ownerID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Build recipe
-	recipeID := data.ID
-	if recipeID == uuid.Nil {
-		recipeID = uuid.New()
-	}
-
-	recipe := &domain.Recipe{
-		ID:             recipeID,
-		UserID:         userID,
-		Name:           data.Name,
-		Description:    data.Description,
-		PrepTimeMinutes:  parseMinutes(data.PrepTime),
-		CookTimeMinutes:  parseMinutes(data.CookTime),
-		TotalTimeMinutes: parseMinutes(data.PrepTime) + parseMinutes(data.CookTime),
-		Servings:         1,
-		MainIngredient:   mainIngredient,
-		Cuisine:        cuisine,
-		IngredientLines: ingredientLines,
-		Steps:           buildSteps(data.Directions),
-		Tags:            data.Metadata.Tags,
-		ImageURL:        data.Metadata.ImageURL,
-		Nutrition: domain.RecipeNutrition{
-			CaloriesTotal: data.NutritionalInfo.Calories,
-		},
-	}
-
-	// Generate vector embedding
-	recipe.SearchVector = s.vectorGen.GenerateForRecipe(recipe)
-
-	// Create recipe
-	if err := s.repo.Create(ctx, recipe); err != nil {
-		return fmt.Errorf("create recipe: %w", err)
-	}
-
-	s.logger.Debug("seeded recipe", "id", recipe.ID, "name", recipe.Name)
-
-	// Optionally publish event
-	if s.publisher != nil {
-		if err := s.publisher.PublishRecipeUpserted(ctx, recipe); err != nil {
-			s.logger.Warn("failed to publish recipe created event during seeding",
-				"error", err,
-				"recipeId", recipe.ID,
-			)
-			// Don't fail the seed operation
+	for _, collection := range collections {
+		if collection.UserID == ownerID && collection.Name == libraryCollectionName {
+			return s.repo.GetCollection(ctx, ownerID, collection.ID)
+		}
+	}
+
+	library := &domain.Collection{
+		UserID:      ownerID,
+		Name:        libraryCollectionName,
+		Description: "Recipes everyone can cook from",
+	}
+	if err := s.repo.CreateCollection(ctx, library); err != nil {
+		return nil, err
+	}
+	return library, nil
+}
+
+// contentHash fingerprints the seed data of a recipe.
+func contentHash(data RecipeData) (string, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append([]byte(seedFormatVersion+":"), encoded...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// toPortableRecipe turns a seed recipe into bulk import input. The seed ID
+// becomes the external ID, and the main ingredient is added as a line when the
+// recipe does not list it.
+func toPortableRecipe(data RecipeData) *pb.PortableRecipe {
+	input := &pb.RecipeInput{
+		Name:               data.Name,
+		Description:        data.Description,
+		PrepTimeMinutes:    int32(parseMinutes(data.PrepTime)),
+		CookTimeMinutes:    int32(parseMinutes(data.CookTime)),
+		Servings:           1,
+		MainIngredientName: data.MainIngredient.Name,
+		CuisineName:        data.Cuisine.Name,
+		Tags:               data.Metadata.Tags,
+		ImageUrl:           data.Metadata.ImageURL,
+		Nutrition:          data.NutritionalInfo.toProto(),
+	}
+
+	listsMain := false
+	for _, ingredient := range data.Ingredients {
+		input.IngredientLines = append(input.IngredientLines, toIngredientLineInput(ingredient))
+		listsMain = listsMain || strings.EqualFold(ingredient.Name, data.MainIngredient.Name)
+	}
+	if !listsMain && data.MainIngredient.Name != "" {
+		input.IngredientLines = append(input.IngredientLines, toIngredientLineInput(data.MainIngredient))
+	}
+
+	for _, direction := range data.Directions {
+		if cleaned := strings.TrimSpace(direction); cleaned != "" {
+			input.Steps = append(input.Steps, &pb.RecipeStepInput{Instruction: cleaned})
 		}
 	}
 
-	return nil
+	return &pb.PortableRecipe{
+		ExternalId: seedExternalID(data),
+		Recipe:     input,
+	}
+}
+
+// seedExternalID identifies a seed recipe across runs: by its ID, or by its
+// name when the seed data has none.
+func seedExternalID(data RecipeData) string {
+	if data.ID != uuid.Nil {
+		return data.ID.String()
+	}
+	return "seed:" + strings.ToLower(strings.TrimSpace(data.Name))
+}
+
+// toIngredientLineInput parses a seed quantity such as "1/2 cup, chopped" into
+// structured fields, keeping the raw text when it has no recognizable amount.
+func toIngredientLineInput(data IngredientData) *pb.IngredientLineInput {
+	line := &pb.IngredientLineInput{IngredientName: data.Name}
+
+	parsed := ingredientparser.ParseQuantity(data.Quantity)
+	if parsed.Quantity == nil {
+		line.QuantityText = strings.TrimSpace(data.Quantity)
+		return line
+	}
+
+	value, text := parsed.Amount()
+	if value != nil {
+		line.QuantityValue = wrapperspb.Double(*value)
+	}
+	line.QuantityText = text
+	line.Unit = parsed.Unit
+	line.IsOptional = parsed.Optional
+	line.Note = parsed.Note
+	return line
 }
 
 func (s *Seeder) ensureSeedUser(ctx context.Context) (*domain.User, error) {
@@ -233,21 +390,6 @@ func (s *Seeder) ensureSeedUser(ctx context.Context) (*domain.User, error) {
 	return user, nil
 }
 
-func buildSteps(directions []string) []domain.RecipeStep {
-	steps := make([]domain.RecipeStep, 0, len(directions))
-	for i, instruction := range directions {
-		cleaned := strings.TrimSpace(instruction)
-		if cleaned == "" {
-			continue
-		}
-		steps = append(steps, domain.RecipeStep{
-			StepIndex:   i + 1,
-			Instruction: cleaned,
-		})
-	}
-	return steps
-}
-
 func parseMinutes(input string) int {
 	var digits []rune
 	for _, r := range input {
@@ -267,87 +409,6 @@ func parseMinutes(input string) int {
 	return value
 }
 
-func (s *Seeder) getOrCreateCuisine(
-	ctx context.Context,
-	userID uuid.UUID,
-	data CuisineData,
-	cache map[string]*domain.Cuisine,
-) (*domain.Cuisine, error) {
-	// Check cache first
-	if cuisine, ok := cache[data.Name]; ok {
-		return cuisine, nil
-	}
-
-	// Try to get from database
-	cuisine, err := s.repo.GetCuisineByName(ctx, userID, data.Name)
-	if err == nil {
-		cache[data.Name] = cuisine
-		return cuisine, nil
-	}
-
-	// Create new cuisine
-	cuisineID := data.ID
-	if cuisineID == uuid.Nil {
-		cuisineID = uuid.New()
-	}
-
-	cuisine = &domain.Cuisine{
-		ID:     cuisineID,
-		UserID: userID,
-		Name:   data.Name,
-	}
-
-	if err := s.repo.CreateCuisine(ctx, cuisine); err != nil {
-		return nil, err
-	}
-
-	cache[data.Name] = cuisine
-	s.logger.Debug("created cuisine", "id", cuisine.ID, "name", cuisine.Name)
-
-	return cuisine, nil
-}
-
-func (s *Seeder) getOrCreateIngredient(
-	ctx context.Context,
-	userID uuid.UUID,
-	data IngredientData,
-	cache map[string]*domain.Ingredient,
-) (*domain.Ingredient, error) {
-	// Check cache first
-	if ingredient, ok := cache[data.Name]; ok {
-		return ingredient, nil
-	}
-
-	// Try to get from database
-	ingredient, err := s.repo.GetIngredientByName(ctx, userID, data.Name)
-	if err == nil {
-		cache[data.Name] = ingredient
-		return ingredient, nil
-	}
-
-	// Create new ingredient
-	ingredientID := data.ID
-	if ingredientID == uuid.Nil {
-		ingredientID = uuid.New()
-	}
-
-	ingredient = &domain.Ingredient{
-		ID:          ingredientID,
-		UserID:      userID,
-		Name:        data.Name,
-		Description: "",
-	}
-
-	if err := s.repo.CreateIngredient(ctx, ingredient); err != nil {
-		return nil, err
-	}
-
-	cache[data.Name] = ingredient
-	s.logger.Debug("created ingredient", "id", ingredient.ID, "name", ingredient.Name)
-
-	return ingredient, nil
-}
-
 // JSON data structures
 
 // SeedData is the root structure of the seed JSON
@@ -389,7 +450,33 @@ type MetadataData struct {
 	Tags     []string `json:"tags"`
 }
 
-// NutritionalData represents nutritional info in the seed JSON
+// NutritionalData represents nutritional info for the whole recipe in the seed
+// JSON
 type NutritionalData struct {
-	Calories int `json:"Calories"`
+	Calories      int     `json:"Calories"`
+	Protein       float64 `json:"Protein"`       // grams
+	Carbohydrates float64 `json:"Carbohydrates"` // grams
+	Fat           float64 `json:"Fat"`           // grams
+	Fiber         float64 `json:"Fiber"`         // grams
+	Sugar         float64 `json:"Sugar"`         // grams
+	Sodium        float64 `json:"Sodium"`        // milligrams
+}
+
+// toProto returns the nutrition as an override, or nil when the seed data has
+// none so that it is computed from the ingredients instead.
+func (n NutritionalData) toProto() *pb.RecipeNutrition {
+	if n == (NutritionalData{}) {
+		return nil
+	}
+	return &pb.RecipeNutrition{
+		CaloriesTotal:      int32(n.Calories),
+		CaloriesPerServing: int32(n.Calories), // seed recipes serve one
+		ProteinG:           n.Protein,
+		CarbsG:             n.Carbohydrates,
+		FatG:               n.Fat,
+		FiberG:             n.Fiber,
+		SugarG:             n.Sugar,
+		SodiumMg:           n.Sodium,
+		IsOverride:         true,
+	}
 }
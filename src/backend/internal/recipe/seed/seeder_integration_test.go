@@ -0,0 +1,204 @@
+//go:build integration
+
+package seed_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/common/vector"
+	"github.com/platepilot/backend/internal/recipe/handler"
+	pb "github.com/platepilot/backend/internal/recipe/pb"
+	"github.com/platepilot/backend/internal/recipe/repository"
+	"github.com/platepilot/backend/internal/recipe/seed"
+)
+
+// These tests run against a migrated recipe database named by RECIPE_DB_URL,
+// as `make migrate-up test-integration` sets up. Every test seeds into a user
+// of its own, which is deleted again with everything it owns.
+
+type seedContext struct {
+	ctx     context.Context
+	pool    *pgxpool.Pool
+	repo    *repository.Repository
+	handler *handler.GRPCHandler
+	seeder  *seed.Seeder
+	user    *domain.User
+}
+
+func TestSeedFromFile_Rerun_LeavesUnchangedRecipesAlone(t *testing.T) {
+	sc := givenSeeder(t)
+	recipe := givenSeedRecipe("Lentil Soup")
+	file := givenSeedFile(t, recipe)
+	whenSeeded(t, sc, file)
+
+	summary := whenSeeded(t, sc, file)
+
+	if summary.Unchanged != 1 || len(summary.Created) != 0 || len(summary.Updated) != 0 {
+		t.Fatalf("expected the recipe to be unchanged, got %+v", summary)
+	}
+}
+
+func TestSeedFromFile_ChangedRecipe_UpdatesIt(t *testing.T) {
+	sc := givenSeeder(t)
+	recipe := givenSeedRecipe("Lentil Soup")
+	whenSeeded(t, sc, givenSeedFile(t, recipe))
+	recipe.Description = "Now with smoked paprika"
+
+	summary := whenSeeded(t, sc, givenSeedFile(t, recipe))
+
+	if len(summary.Updated) != 1 || len(summary.Created) != 0 {
+		t.Fatalf("expected the recipe to be updated, got %+v", summary)
+	}
+}
+
+func TestSeedFromFile_PurgedRecipe_SeedsItAgain(t *testing.T) {
+	sc := givenSeeder(t)
+	recipe := givenSeedRecipe("Lentil Soup")
+	file := givenSeedFile(t, recipe)
+	whenSeeded(t, sc, file)
+	givenSeededRecipePurged(t, sc, recipe)
+
+	summary := whenSeeded(t, sc, file)
+
+	if len(summary.Created) != 1 {
+		t.Fatalf("expected the purged recipe to be created again, got %+v", summary)
+	}
+}
+
+func TestSeedFromFile_Share_PublishesLibraryToLaterUsers(t *testing.T) {
+	sc := givenSeeder(t)
+	recipe := givenSeedRecipe("Lentil Soup")
+	file := givenSeedFile(t, recipe)
+
+	summary, err := sc.seeder.SeedFromFile(sc.ctx, file, seed.Options{UserEmail: sc.user.Email, Share: true})
+
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	if !summary.Published {
+		t.Fatal("expected the library to be published")
+	}
+	later := givenUser(t, sc.ctx, sc.repo, sc.pool)
+	if _, err := sc.repo.GetByID(sc.ctx, later.ID, givenSeededRecipeID(t, sc, recipe)); err != nil {
+		t.Fatalf("expected a user registered later to read the library, got %v", err)
+	}
+}
+
+func givenSeeder(t *testing.T) *seedContext {
+	t.Helper()
+
+	url := os.Getenv("RECIPE_DB_URL")
+	if url == "" {
+		t.Skip("RECIPE_DB_URL is not set")
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatalf("connect to recipe database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	repo := repository.NewRepository(pool)
+	h := handler.NewGRPCHandler(repo, vector.NewHashGenerator(), nil, logger)
+
+	return &seedContext{
+		ctx:     ctx,
+		pool:    pool,
+		repo:    repo,
+		handler: h,
+		seeder:  seed.NewSeeder(repo, h, logger),
+		user:    givenUser(t, ctx, repo, pool),
+	}
+}
+
+func givenUser(t *testing.T, ctx context.Context, repo *repository.Repository, pool *pgxpool.Pool) *domain.User {
+	t.Helper()
+
+	user := &domain.User{Email: uuid.NewString() + "@example.com", DisplayName: "Integration"}
+	if err := repo.CreateUser(ctx, user); err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	t.Cleanup(func() {
+		// Everything the user owns goes with them.
+		if _, err := pool.Exec(context.Background(), `DELETE FROM users WHERE id = $1`, user.ID); err != nil {
+			t.Errorf("delete user: %v", err)
+		}
+	})
+
+	return user
+}
+
+func givenSeedRecipe(name string) seed.RecipeData {
+	return seed.RecipeData{
+		ID:             uuid.New(),
+		Name:           name,
+		Description:    "A hearty soup",
+		PrepTime:       "10 minutes",
+		CookTime:       "30 minutes",
+		MainIngredient: seed.IngredientData{Name: "lentils", Quantity: "1 cup"},
+		Cuisine:        seed.CuisineData{Name: "Middle Eastern"},
+		Ingredients: []seed.IngredientData{
+			{Name: "lentils", Quantity: "1 cup"},
+			{Name: "onion", Quantity: "1, chopped"},
+		},
+		Directions: []string{"Simmer everything until soft."},
+	}
+}
+
+func givenSeedFile(t *testing.T, recipes ...seed.RecipeData) string {
+	t.Helper()
+
+	data, err := json.Marshal(seed.SeedData{Recipes: recipes})
+	if err != nil {
+		t.Fatalf("marshal seed data: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "recipes.json")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("write seed file: %v", err)
+	}
+	return path
+}
+
+func givenSeededRecipeID(t *testing.T, sc *seedContext, recipe seed.RecipeData) uuid.UUID {
+	t.Helper()
+
+	id, _, err := sc.repo.FindImportedRecipe(sc.ctx, sc.user.ID, recipe.ID.String(), "")
+	if err != nil {
+		t.Fatalf("find seeded recipe %s: %v", recipe.Name, err)
+	}
+	return id
+}
+
+func givenSeededRecipePurged(t *testing.T, sc *seedContext, recipe seed.RecipeData) {
+	t.Helper()
+
+	recipeID := givenSeededRecipeID(t, sc, recipe).String()
+	if _, err := sc.handler.DeleteRecipe(sc.ctx, &pb.DeleteRecipeRequest{UserId: sc.user.ID.String(), RecipeId: recipeID}); err != nil {
+		t.Fatalf("delete recipe: %v", err)
+	}
+	if _, err := sc.handler.PurgeRecipe(sc.ctx, &pb.PurgeRecipeRequest{UserId: sc.user.ID.String(), RecipeId: recipeID}); err != nil {
+		t.Fatalf("purge recipe: %v", err)
+	}
+}
+
+func whenSeeded(t *testing.T, sc *seedContext, file string) *seed.Summary {
+	t.Helper()
+
+	summary, err := sc.seeder.SeedFromFile(sc.ctx, file, seed.Options{UserEmail: sc.user.Email})
+	if err != nil {
+		t.Fatalf("seed: %v", err)
+	}
+	return summary
+}
@@ -0,0 +1,132 @@
+// Package substitution maintains the ingredient substitution catalog and
+// applies substitutions to recipe ingredient lines.
+package substitution
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/fooddata"
+	"github.com/platepilot/backend/internal/recipe/scaler"
+)
+
+//go:embed substitutions.json
+var bundledCatalog []byte
+
+// Store persists the substitution catalog.
+type Store interface {
+	// SaveSubstitutions upserts substitutions by ingredient and substitute.
+	// Allergies, avoided and contained, are resolved by name.
+	SaveSubstitutions(ctx context.Context, substitutions []domain.Substitution) error
+}
+
+type catalogFile struct {
+	Substitutions []catalogEntry `json:"substitutions"`
+}
+
+type catalogEntry struct {
+	Ingredient string   `json:"ingredient"`
+	Substitute string   `json:"substitute"`
+	Ratio      float64  `json:"ratio"`
+	Notes      string   `json:"notes"`
+	Avoids     string   `json:"avoids"`
+	Contains   []string `json:"contains"`
+}
+
+// Load reads a substitution catalog in the format of the bundled
+// substitutions.json.
+func Load(r io.Reader) ([]domain.Substitution, error) {
+	var file catalogFile
+	if err := json.NewDecoder(r).Decode(&file); err != nil {
+		return nil, fmt.Errorf("decode substitution catalog: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	substitutions := make([]domain.Substitution, 0, len(file.Substitutions))
+	for i, entry := range file.Substitutions {
+		sub := domain.Substitution{
+			IngredientName: strings.TrimSpace(entry.Ingredient),
+			SubstituteName: strings.TrimSpace(entry.Substitute),
+			Ratio:          entry.Ratio,
+			Notes:          strings.TrimSpace(entry.Notes),
+		}
+		if sub.IngredientName == "" || sub.SubstituteName == "" {
+			return nil, fmt.Errorf("substitution %d: ingredient and substitute are required", i+1)
+		}
+		if sub.Ratio <= 0 {
+			return nil, fmt.Errorf("substitution %d: ratio must be positive", i+1)
+		}
+
+		pair := Key(sub.IngredientName) + "\x00" + sub.SubstituteName
+		if seen[pair] {
+			return nil, fmt.Errorf("substitution %d: %s is listed twice for %s", i+1, sub.SubstituteName, sub.IngredientName)
+		}
+		seen[pair] = true
+
+		if avoids := strings.TrimSpace(entry.Avoids); avoids != "" {
+			sub.AvoidsAllergy = &domain.Allergy{Name: avoids}
+		}
+		for _, value := range entry.Contains {
+			name := strings.TrimSpace(value)
+			if name == "" {
+				return nil, fmt.Errorf("substitution %d: contained allergy names are required", i+1)
+			}
+			if sub.AvoidsAllergy != nil && strings.EqualFold(name, sub.AvoidsAllergy.Name) {
+				return nil, fmt.Errorf("substitution %d: %s cannot both avoid and contain %s", i+1, sub.SubstituteName, name)
+			}
+			sub.ContainsAllergies = append(sub.ContainsAllergies, domain.Allergy{Name: name})
+		}
+		substitutions = append(substitutions, sub)
+	}
+
+	return substitutions, nil
+}
+
+// Seed saves the catalog bundled with the recipe API. Seeding is idempotent
+// and picks up changes to the bundled file on the next start.
+func Seed(ctx context.Context, store Store) (int, error) {
+	substitutions, err := Load(bytes.NewReader(bundledCatalog))
+	if err != nil {
+		return 0, err
+	}
+
+	if err := store.SaveSubstitutions(ctx, substitutions); err != nil {
+		return 0, fmt.Errorf("save substitutions: %w", err)
+	}
+
+	return len(substitutions), nil
+}
+
+// Key returns the normalized ingredient name substitutions are matched by,
+// so "Eggs" matches a substitution for "egg".
+func Key(name string) string {
+	return fooddata.Normalize(name)
+}
+
+// Apply returns line with its ingredient replaced by substitute. The quantity
+// is multiplied by the substitution's ratio and rounded to a measurable
+// amount, and the note records what was replaced.
+func Apply(line domain.RecipeIngredientLine, sub domain.Substitution, substitute domain.Ingredient) domain.RecipeIngredientLine {
+	original := line.Ingredient.Name
+
+	if line.QuantityValue != nil && sub.Ratio != 1 {
+		value, unit := scaler.Round(*line.QuantityValue*sub.Ratio, line.Unit)
+		line.QuantityValue = &value
+		line.Unit = unit
+	}
+
+	note := "instead of " + strings.ToLower(original)
+	if line.Note != "" {
+		note = line.Note + "; " + note
+	}
+	line.Note = note
+	line.Ingredient = substitute
+
+	return line
+}
@@ -0,0 +1,115 @@
+package substitution_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/substitution"
+)
+
+type fakeStore struct {
+	saved []domain.Substitution
+}
+
+func (s *fakeStore) SaveSubstitutions(ctx context.Context, substitutions []domain.Substitution) error {
+	s.saved = substitutions
+	return nil
+}
+
+func TestSeed_SavesBundledCatalog(t *testing.T) {
+	store := &fakeStore{}
+
+	count, err := substitution.Seed(context.Background(), store)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if count == 0 || count != len(store.saved) {
+		t.Fatalf("expected the bundled substitutions to be saved, got count %d and %d saved", count, len(store.saved))
+	}
+	for _, sub := range store.saved {
+		if sub.IngredientName == "Buttermilk" && sub.AvoidsAllergy != nil && sub.AvoidsAllergy.Name == "Dairy" {
+			return
+		}
+	}
+	t.Error("expected a dairy-free buttermilk substitution")
+}
+
+func TestLoad_RejectsInvalidEntries(t *testing.T) {
+	cases := map[string]string{
+		"missing substitute":  `{"substitutions": [{"ingredient": "Egg", "ratio": 1}]}`,
+		"zero ratio":          `{"substitutions": [{"ingredient": "Egg", "substitute": "Flax egg"}]}`,
+		"duplicate":           `{"substitutions": [{"ingredient": "Eggs", "substitute": "Flax egg", "ratio": 1}, {"ingredient": "egg", "substitute": "Flax egg", "ratio": 1}]}`,
+		"malformed":           `{"substitutions": {}}`,
+		"avoids and contains": `{"substitutions": [{"ingredient": "Milk", "substitute": "Goat milk", "ratio": 1, "avoids": "Dairy", "contains": ["dairy"]}]}`,
+	}
+
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := substitution.Load(strings.NewReader(input)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestLoad_ReadsAvoidedAllergy(t *testing.T) {
+	input := `{"substitutions": [{"ingredient": " Milk ", "substitute": "Oat milk", "ratio": 1, "avoids": "Dairy"}]}`
+
+	subs, err := substitution.Load(bytes.NewBufferString(input))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(subs) != 1 || subs[0].IngredientName != "Milk" || subs[0].AvoidsAllergy == nil || subs[0].AvoidsAllergy.Name != "Dairy" {
+		t.Fatalf("unexpected substitutions: %+v", subs)
+	}
+}
+
+func TestLoad_ReadsContainedAllergies(t *testing.T) {
+	input := `{"substitutions": [{"ingredient": "Ricotta", "substitute": "Crumbled firm tofu", "ratio": 1, "avoids": "Dairy", "contains": [" Soy "]}]}`
+
+	subs, err := substitution.Load(bytes.NewBufferString(input))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(subs) != 1 || len(subs[0].ContainsAllergies) != 1 || subs[0].ContainsAllergies[0].Name != "Soy" {
+		t.Fatalf("expected the tofu to contain soy, got %+v", subs)
+	}
+}
+
+func TestKey_MatchesPluralAndCase(t *testing.T) {
+	if substitution.Key("Eggs") != substitution.Key("egg") {
+		t.Errorf("expected %q and %q to match", substitution.Key("Eggs"), substitution.Key("egg"))
+	}
+}
+
+func TestApply_ScalesQuantityAndNotesOriginal(t *testing.T) {
+	value := 2.0
+	line := domain.RecipeIngredientLine{
+		Ingredient:    domain.Ingredient{Name: "Butter"},
+		QuantityValue: &value,
+		Unit:          "tbsp",
+		Note:          "melted",
+	}
+	sub := domain.Substitution{IngredientName: "Butter", SubstituteName: "Olive oil", Ratio: 0.75}
+
+	got := substitution.Apply(line, sub, domain.Ingredient{Name: "Olive oil"})
+
+	if got.Ingredient.Name != "Olive oil" {
+		t.Errorf("expected the substitute ingredient, got %q", got.Ingredient.Name)
+	}
+	if got.QuantityValue == nil || *got.QuantityValue != 1.5 || got.Unit != "tbsp" {
+		t.Errorf("expected 1.5 tbsp, got %v %s", got.QuantityValue, got.Unit)
+	}
+	if got.Note != "melted; instead of butter" {
+		t.Errorf("unexpected note %q", got.Note)
+	}
+	if *line.QuantityValue != 2 {
+		t.Error("expected the original line to be unchanged")
+	}
+}
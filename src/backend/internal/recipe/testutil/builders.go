@@ -131,6 +131,12 @@ func (b *IngredientBuilder) WithID(id uuid.UUID) *IngredientBuilder {
 	return b
 }
 
+// WithUserID sets the ingredient owner
+func (b *IngredientBuilder) WithUserID(id uuid.UUID) *IngredientBuilder {
+	b.ingredient.UserID = id
+	return b
+}
+
 // WithName sets the ingredient name
 func (b *IngredientBuilder) WithName(name string) *IngredientBuilder {
 	b.ingredient.Name = name
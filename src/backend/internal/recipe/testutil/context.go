@@ -17,6 +17,7 @@ type TestContext struct {
 	Repo      *FakeRecipeRepository
 	Publisher *FakeEventPublisher
 	VectorGen *FakeVectorGenerator
+	Fetcher   *FakeDocumentFetcher
 	Handler   *handler.GRPCHandler
 	Logger    *slog.Logger
 }
@@ -28,11 +29,12 @@ func NewTestContext() *TestContext {
 	repo := NewFakeRecipeRepository()
 	publisher := NewFakeEventPublisher()
 	vectorGen := NewFakeVectorGenerator()
+	fetcher := NewFakeDocumentFetcher()
 
 	// Create a silent logger for tests (writes to io.Discard)
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-	h := handler.NewGRPCHandler(repo, vectorGen, publisher, logger)
+	h := handler.NewGRPCHandler(repo, vectorGen, publisher, logger).WithDocumentFetcher(fetcher)
 
 	return &TestContext{
 		Ctx:       ctx,
@@ -40,6 +42,7 @@ func NewTestContext() *TestContext {
 		Repo:      repo,
 		Publisher: publisher,
 		VectorGen: vectorGen,
+		Fetcher:   fetcher,
 		Handler:   h,
 		Logger:    logger,
 	}
@@ -52,11 +55,12 @@ func NewTestContextWithoutPublisher() *TestContext {
 	userID := uuid.New()
 	repo := NewFakeRecipeRepository()
 	vectorGen := NewFakeVectorGenerator()
+	fetcher := NewFakeDocumentFetcher()
 
 	// Create a silent logger for tests
 	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-	h := handler.NewGRPCHandler(repo, vectorGen, nil, logger)
+	h := handler.NewGRPCHandler(repo, vectorGen, nil, logger).WithDocumentFetcher(fetcher)
 
 	return &TestContext{
 		Ctx:       ctx,
@@ -64,6 +68,7 @@ func NewTestContextWithoutPublisher() *TestContext {
 		Repo:      repo,
 		Publisher: nil, // No publisher
 		VectorGen: vectorGen,
+		Fetcher:   fetcher,
 		Handler:   h,
 		Logger:    logger,
 	}
@@ -1,14 +1,22 @@
 package testutil
 
 import (
+	"cmp"
 	"context"
 	"errors"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pgvector/pgvector-go"
 
 	"github.com/platepilot/backend/internal/common/domain"
+	"github.com/platepilot/backend/internal/recipe/dietary"
 	"github.com/platepilot/backend/internal/recipe/repository"
+	"github.com/platepilot/backend/internal/recipe/substitution"
 )
 
 // FakeRecipeRepository is an in-memory implementation of RecipeRepository for testing.
@@ -16,6 +24,23 @@ type FakeRecipeRepository struct {
 	Recipes     map[uuid.UUID]*domain.Recipe
 	Ingredients map[uuid.UUID]*domain.Ingredient
 	Cuisines    map[uuid.UUID]*domain.Cuisine
+	Nutrition   map[uuid.UUID]domain.IngredientNutrition
+	Dietary     map[uuid.UUID]domain.IngredientDietaryAttributes
+	Categories  map[uuid.UUID]*domain.IngredientCategory
+	Foods       map[uuid.UUID]domain.NutritionFood
+	FoodMatches map[uuid.UUID]*domain.IngredientFoodMatch
+	Revisions   map[uuid.UUID][]domain.RecipeRevision
+	Users       map[uuid.UUID]*domain.User
+	Shares      []domain.RecipeShare
+	Collections map[uuid.UUID]*domain.Collection
+
+	CollectionShares []domain.CollectionShare
+	CookLog          []domain.CookLogEntry
+	CookSessions     map[uuid.UUID]*domain.CookSession
+	Substitutions    []domain.Substitution
+	Allergies        map[uuid.UUID]*domain.Allergy
+	DietaryProfiles  map[uuid.UUID]*domain.DietaryProfile
+	TagGroups        map[uuid.UUID]map[string]domain.TagGroup
 
 	// Failure modes for testing error paths
 	FailOnGetByID               bool
@@ -30,6 +55,12 @@ type FakeRecipeRepository struct {
 	FailOnGetOrCreateIngredient bool
 	FailOnGetOrCreateCuisine    bool
 	FailOnGetCuisines           bool
+	FailOnGetNutrition          bool
+	FailOnIngredientMatches     bool
+	FailOnShares                bool
+	// ConflictOnCreateCookSession reports a session another device started
+	// and finished again before it could be joined.
+	ConflictOnCreateCookSession bool
 
 	// Call tracking for assertions
 	CreateCalls  []CreateCall
@@ -51,13 +82,25 @@ type UpdateCall struct {
 // NewFakeRecipeRepository creates a new fake repository.
 func NewFakeRecipeRepository() *FakeRecipeRepository {
 	return &FakeRecipeRepository{
-		Recipes:      make(map[uuid.UUID]*domain.Recipe),
-		Ingredients:  make(map[uuid.UUID]*domain.Ingredient),
-		Cuisines:     make(map[uuid.UUID]*domain.Cuisine),
-		CreateCalls:  []CreateCall{},
-		UpdateCalls:  []UpdateCall{},
-		DeleteCalls:  []uuid.UUID{},
-		GetByIDCalls: []uuid.UUID{},
+		Recipes:         make(map[uuid.UUID]*domain.Recipe),
+		Ingredients:     make(map[uuid.UUID]*domain.Ingredient),
+		Cuisines:        make(map[uuid.UUID]*domain.Cuisine),
+		Nutrition:       make(map[uuid.UUID]domain.IngredientNutrition),
+		Dietary:         make(map[uuid.UUID]domain.IngredientDietaryAttributes),
+		Categories:      make(map[uuid.UUID]*domain.IngredientCategory),
+		Foods:           make(map[uuid.UUID]domain.NutritionFood),
+		FoodMatches:     make(map[uuid.UUID]*domain.IngredientFoodMatch),
+		Revisions:       make(map[uuid.UUID][]domain.RecipeRevision),
+		Users:           make(map[uuid.UUID]*domain.User),
+		Collections:     make(map[uuid.UUID]*domain.Collection),
+		CookSessions:    make(map[uuid.UUID]*domain.CookSession),
+		Allergies:       make(map[uuid.UUID]*domain.Allergy),
+		DietaryProfiles: make(map[uuid.UUID]*domain.DietaryProfile),
+		TagGroups:       make(map[uuid.UUID]map[string]domain.TagGroup),
+		CreateCalls:     []CreateCall{},
+		UpdateCalls:     []UpdateCall{},
+		DeleteCalls:     []uuid.UUID{},
+		GetByIDCalls:    []uuid.UUID{},
 	}
 }
 
@@ -70,10 +113,23 @@ func (r *FakeRecipeRepository) GetByID(ctx context.Context, userID, id uuid.UUID
 	}
 
 	recipe, ok := r.Recipes[id]
-	if !ok || recipe.UserID != userID {
+	if !ok || !r.canRead(recipe, userID) {
 		return nil, repository.ErrRecipeNotFound
 	}
-	return recipe, nil
+	result := *recipe
+	result.CookStats = r.cookStats(userID, id)
+	result.ForkedFrom = nil
+	if recipe.ForkedFrom != nil {
+		// Like the repository, the link is dropped once the owner can no
+		// longer read the upstream.
+		fork := *recipe.ForkedFrom
+		if upstream, ok := r.Recipes[fork.RecipeID]; ok && r.canRead(upstream, recipe.UserID) {
+			fork.RecipeName = upstream.Name
+			fork.LatestRevision = len(r.Revisions[fork.RecipeID])
+			result.ForkedFrom = &fork
+		}
+	}
+	return &result, nil
 }
 
 // List retrieves recipes with pagination.
@@ -82,12 +138,29 @@ func (r *FakeRecipeRepository) List(ctx context.Context, userID uuid.UUID, filte
 		return nil, errors.New("fake repository error")
 	}
 
+	if filter.CollectionID != nil {
+		return r.listCollectionRecipes(userID, *filter.CollectionID, limit, offset), nil
+	}
+
 	recipes := make([]domain.Recipe, 0, len(r.Recipes))
 	for _, recipe := range r.Recipes {
-		if recipe.UserID == userID {
-			recipes = append(recipes, *recipe)
+		if recipe.UserID == userID && recipe.DeletedAt == nil && matchesQuery(recipe, filter.Query) && hasDietLabels(recipe, filter.DietLabels) && matchesDietaryProfile(recipe, filter.DietaryProfile) {
+			match := *recipe
+			if filter.Query != "" {
+				match.SearchScore = 1
+			}
+			match.CookStats = r.cookStats(userID, recipe.ID)
+			if filter.MinRating != nil && (match.CookStats.AverageRating == nil || *match.CookStats.AverageRating < *filter.MinRating) {
+				continue
+			}
+			recipes = append(recipes, match)
 		}
 	}
+	if keySort, order, ok := filter.KeysetSort(); ok {
+		recipes = sortByKey(recipes, keySort, order, filter.After)
+	} else {
+		sortByCookStats(recipes, filter.Sort)
+	}
 
 	if offset >= len(recipes) {
 		return []domain.Recipe{}, nil
@@ -106,13 +179,24 @@ func (r *FakeRecipeRepository) Count(ctx context.Context, userID uuid.UUID, filt
 	}
 	count := int64(0)
 	for _, recipe := range r.Recipes {
-		if recipe.UserID == userID {
+		if recipe.UserID == userID && recipe.DeletedAt == nil && matchesQuery(recipe, filter.Query) && hasDietLabels(recipe, filter.DietLabels) && matchesDietaryProfile(recipe, filter.DietaryProfile) {
 			count++
 		}
 	}
 	return count, nil
 }
 
+// matchesQuery approximates full-text search with a case-insensitive substring
+// match on the recipe name and description.
+func matchesQuery(recipe *domain.Recipe, query string) bool {
+	if query == "" {
+		return true
+	}
+	query = strings.ToLower(query)
+	return strings.Contains(strings.ToLower(recipe.Name), query) ||
+		strings.Contains(strings.ToLower(recipe.Description), query)
+}
+
 // Create creates a new recipe.
 func (r *FakeRecipeRepository) Create(ctx context.Context, recipe *domain.Recipe) error {
 	r.CreateCalls = append(r.CreateCalls, CreateCall{Recipe: recipe})
@@ -125,7 +209,13 @@ func (r *FakeRecipeRepository) Create(ctx context.Context, recipe *domain.Recipe
 		recipe.ID = uuid.New()
 	}
 
+	if recipe.ForkedFrom != nil {
+		recipe.ForkedFrom.Revision = len(r.Revisions[recipe.ForkedFrom.RecipeID])
+		recipe.ForkedFrom.LatestRevision = recipe.ForkedFrom.Revision
+	}
+
 	r.Recipes[recipe.ID] = recipe
+	r.addRevision(recipe)
 	return nil
 }
 
@@ -137,222 +227,2088 @@ func (r *FakeRecipeRepository) Update(ctx context.Context, recipe *domain.Recipe
 		return errors.New("fake repository error")
 	}
 
-	if _, ok := r.Recipes[recipe.ID]; !ok {
+	current, ok := r.Recipes[recipe.ID]
+	if !ok || current.DeletedAt != nil {
 		return repository.ErrRecipeNotFound
 	}
 
+	if len(r.Revisions[recipe.ID]) == 0 {
+		r.addRevision(current)
+	}
+	if current.ForkedFrom != nil {
+		fork := *current.ForkedFrom
+		recipe.ForkedFrom = &fork
+	}
+	recipe.ExternalID = current.ExternalID
 	r.Recipes[recipe.ID] = recipe
+	r.addRevision(recipe)
 	return nil
 }
 
-// Delete removes a recipe.
-func (r *FakeRecipeRepository) Delete(ctx context.Context, userID, id uuid.UUID) error {
-	r.DeleteCalls = append(r.DeleteCalls, id)
+// FindImportedRecipe matches a bulk import item by external ID, then by the
+// name of an active recipe.
+func (r *FakeRecipeRepository) FindImportedRecipe(ctx context.Context, userID uuid.UUID, externalID, name string) (uuid.UUID, bool, error) {
+	var byName *domain.Recipe
+	for _, recipe := range r.Recipes {
+		if recipe.UserID != userID {
+			continue
+		}
+		if externalID != "" && recipe.ExternalID == externalID {
+			return recipe.ID, recipe.DeletedAt != nil, nil
+		}
+		if recipe.DeletedAt == nil && strings.EqualFold(recipe.Name, name) &&
+			(byName == nil || recipe.CreatedAt.Before(byName.CreatedAt)) {
+			byName = recipe
+		}
+	}
+	if byName == nil {
+		return uuid.Nil, false, repository.ErrRecipeNotFound
+	}
+	return byName.ID, false, nil
+}
 
-	if r.FailOnDelete {
-		return errors.New("fake repository error")
+// SyncForkRevision records the upstream revision a duplicated recipe matches.
+func (r *FakeRecipeRepository) SyncForkRevision(ctx context.Context, recipeID uuid.UUID, revision int) error {
+	recipe, ok := r.Recipes[recipeID]
+	if !ok || recipe.ForkedFrom == nil {
+		return repository.ErrRecipeNotFound
+	}
+	recipe.ForkedFrom.Revision = revision
+	return nil
+}
+
+func (r *FakeRecipeRepository) addRevision(recipe *domain.Recipe) {
+	// Snapshots are immutable, so they must not share lines with the recipe.
+	snapshot := *recipe
+	snapshot.IngredientLines = slices.Clone(recipe.IngredientLines)
+	if recipe.MainIngredient != nil {
+		main := *recipe.MainIngredient
+		snapshot.MainIngredient = &main
 	}
 
-	recipe, ok := r.Recipes[id]
+	revisions := r.Revisions[recipe.ID]
+	r.Revisions[recipe.ID] = append(revisions, domain.RecipeRevision{
+		RecipeID: recipe.ID,
+		Revision: len(revisions) + 1,
+		Recipe:   snapshot,
+	})
+}
+
+// ListRecipeRevisions retrieves the revisions of a recipe, newest first.
+func (r *FakeRecipeRepository) ListRecipeRevisions(ctx context.Context, userID, recipeID uuid.UUID) ([]domain.RecipeRevision, error) {
+	recipe, ok := r.Recipes[recipeID]
 	if !ok || recipe.UserID != userID {
-		return repository.ErrRecipeNotFound
+		return nil, repository.ErrRecipeNotFound
 	}
 
-	delete(r.Recipes, id)
-	return nil
+	revisions := r.Revisions[recipeID]
+	result := make([]domain.RecipeRevision, len(revisions))
+	for i, revision := range revisions {
+		result[len(revisions)-1-i] = revision
+	}
+	return result, nil
 }
 
-// GetSimilar retrieves similar recipes.
-func (r *FakeRecipeRepository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID, limit int) ([]domain.Recipe, error) {
-	if r.FailOnGetSimilar {
+// GetRecipeRevision retrieves one revision of a recipe.
+func (r *FakeRecipeRepository) GetRecipeRevision(ctx context.Context, userID, recipeID uuid.UUID, revision int) (*domain.RecipeRevision, error) {
+	recipe, ok := r.Recipes[recipeID]
+	if !ok || recipe.UserID != userID {
+		return nil, repository.ErrRecipeNotFound
+	}
+
+	revisions := r.Revisions[recipeID]
+	if revision < 1 || revision > len(revisions) {
+		return nil, repository.ErrRevisionNotFound
+	}
+	result := revisions[revision-1]
+	return &result, nil
+}
+
+// ShareRecipe shares a recipe with the user registered under email.
+func (r *FakeRecipeRepository) ShareRecipe(ctx context.Context, ownerID, recipeID uuid.UUID, email string, permission domain.SharePermission) (*domain.RecipeShare, error) {
+	if r.FailOnShares {
 		return nil, errors.New("fake repository error")
 	}
 
-	if recipe, ok := r.Recipes[recipeID]; !ok || recipe.UserID != userID {
+	recipe, ok := r.Recipes[recipeID]
+	if !ok || recipe.UserID != ownerID {
 		return nil, repository.ErrRecipeNotFound
 	}
 
-	recipes := make([]domain.Recipe, 0)
-	for id, recipe := range r.Recipes {
-		if id != recipeID && recipe.UserID == userID {
-			recipes = append(recipes, *recipe)
-			if len(recipes) >= limit {
-				break
-			}
-		}
+	recipient := r.findUserByEmail(email)
+	if recipient == nil {
+		return nil, repository.ErrUserNotFound
 	}
-	return recipes, nil
+	if recipient.ID == ownerID {
+		return nil, repository.ErrShareWithOwner
+	}
+
+	share := domain.RecipeShare{
+		RecipeID:         recipeID,
+		RecipeName:       recipe.Name,
+		OwnerID:          ownerID,
+		SharedWithUserID: recipient.ID,
+		SharedWithEmail:  recipient.Email,
+		Permission:       permission,
+	}
+	if owner, ok := r.Users[ownerID]; ok {
+		share.OwnerEmail = owner.Email
+	}
+
+	if i := r.findShare(recipeID, recipient.ID); i >= 0 {
+		r.Shares[i] = share
+	} else {
+		r.Shares = append(r.Shares, share)
+	}
+	return &share, nil
 }
 
-// GetIngredientByID retrieves an ingredient by ID.
-func (r *FakeRecipeRepository) GetIngredientByID(ctx context.Context, userID, id uuid.UUID) (*domain.Ingredient, error) {
-	if r.FailOnGetIngredientByID {
+// ListSharedWithMe retrieves the shares of other users' recipes with the user.
+func (r *FakeRecipeRepository) ListSharedWithMe(ctx context.Context, userID uuid.UUID) ([]domain.RecipeShare, error) {
+	if r.FailOnShares {
 		return nil, errors.New("fake repository error")
 	}
 
-	ingredient, ok := r.Ingredients[id]
-	if !ok || ingredient.UserID != userID {
-		return nil, repository.ErrIngredientNotFound
+	var result []domain.RecipeShare
+	for _, share := range r.Shares {
+		if share.SharedWithUserID == userID {
+			result = append(result, share)
+		}
 	}
-	return ingredient, nil
+	return result, nil
 }
 
-// GetOrCreateIngredient retrieves an ingredient by name or creates it.
-func (r *FakeRecipeRepository) GetOrCreateIngredient(ctx context.Context, userID uuid.UUID, name string) (*domain.Ingredient, error) {
-	if r.FailOnGetOrCreateIngredient {
+// ListSharedByMe retrieves the shares of the user's recipes.
+func (r *FakeRecipeRepository) ListSharedByMe(ctx context.Context, ownerID uuid.UUID) ([]domain.RecipeShare, error) {
+	if r.FailOnShares {
 		return nil, errors.New("fake repository error")
 	}
 
-	for _, ingredient := range r.Ingredients {
-		if ingredient.UserID == userID && ingredient.Name == name {
-			return ingredient, nil
+	var result []domain.RecipeShare
+	for _, share := range r.Shares {
+		if share.OwnerID == ownerID {
+			result = append(result, share)
 		}
 	}
+	return result, nil
+}
+
+// RevokeShare removes a share of a recipe the user owns.
+func (r *FakeRecipeRepository) RevokeShare(ctx context.Context, ownerID, recipeID, sharedWithUserID uuid.UUID) error {
+	if r.FailOnShares {
+		return errors.New("fake repository error")
+	}
 
-	ingredient := &domain.Ingredient{
-		ID:     uuid.New(),
-		UserID: userID,
-		Name:   name,
+	i := r.findShare(recipeID, sharedWithUserID)
+	if i < 0 || r.Shares[i].OwnerID != ownerID {
+		return repository.ErrShareNotFound
 	}
-	r.Ingredients[ingredient.ID] = ingredient
-	return ingredient, nil
+	r.Shares = append(r.Shares[:i], r.Shares[i+1:]...)
+	return nil
 }
 
-// GetCuisineByID retrieves a cuisine by ID for a user.
-func (r *FakeRecipeRepository) GetCuisineByID(ctx context.Context, userID, id uuid.UUID) (*domain.Cuisine, error) {
-	if r.FailOnGetCuisineByID {
-		return nil, errors.New("fake repository error")
+// GetEditableRecipeOwner returns the owner of a recipe the user may edit.
+func (r *FakeRecipeRepository) GetEditableRecipeOwner(ctx context.Context, userID, recipeID uuid.UUID) (uuid.UUID, error) {
+	recipe, ok := r.Recipes[recipeID]
+	if !ok {
+		return uuid.Nil, repository.ErrRecipeNotFound
+	}
+	if recipe.UserID == userID {
+		return userID, nil
 	}
 
-	cuisine, ok := r.Cuisines[id]
-	if !ok || cuisine.UserID != userID {
-		return nil, repository.ErrCuisineNotFound
+	i := r.findShare(recipeID, userID)
+	if i < 0 {
+		return uuid.Nil, repository.ErrRecipeNotFound
 	}
-	return cuisine, nil
+	if r.Shares[i].Permission != domain.SharePermissionEdit {
+		return uuid.Nil, repository.ErrRecipeNotEditable
+	}
+	return recipe.UserID, nil
 }
 
-// GetOrCreateCuisine retrieves a cuisine by name or creates it.
-func (r *FakeRecipeRepository) GetOrCreateCuisine(ctx context.Context, userID uuid.UUID, name string) (*domain.Cuisine, error) {
-	if r.FailOnGetOrCreateCuisine {
-		return nil, errors.New("fake repository error")
+// canRead reports whether the user owns the recipe or has it shared with
+// them, directly or through a collection, or finds it in a public collection.
+func (r *FakeRecipeRepository) canRead(recipe *domain.Recipe, userID uuid.UUID) bool {
+	if recipe.DeletedAt != nil {
+		return false
+	}
+	if recipe.UserID == userID || r.findShare(recipe.ID, userID) >= 0 {
+		return true
+	}
+	for _, share := range r.CollectionShares {
+		if share.SharedWithUserID != userID {
+			continue
+		}
+		for _, item := range r.Collections[share.CollectionID].Items {
+			if item.RecipeID == recipe.ID {
+				return true
+			}
+		}
+	}
+	for _, collection := range r.Collections {
+		if !collection.Public {
+			continue
+		}
+		for _, item := range collection.Items {
+			if item.RecipeID == recipe.ID {
+				return true
+			}
+		}
 	}
+	return false
+}
 
-	for _, cuisine := range r.Cuisines {
-		if cuisine.UserID == userID && cuisine.Name == name {
-			return cuisine, nil
+func (r *FakeRecipeRepository) findShare(recipeID, userID uuid.UUID) int {
+	for i, share := range r.Shares {
+		if share.RecipeID == recipeID && share.SharedWithUserID == userID {
+			return i
 		}
 	}
+	return -1
+}
 
-	cuisine := &domain.Cuisine{
-		ID:     uuid.New(),
-		UserID: userID,
-		Name:   name,
+// listCollectionRecipes returns the recipes of a collection the user can see,
+// in collection order.
+func (r *FakeRecipeRepository) listCollectionRecipes(userID, collectionID uuid.UUID, limit, offset int) []domain.Recipe {
+	collection, ok := r.Collections[collectionID]
+	if !ok || (collection.UserID != userID && !collection.Public && r.findCollectionShare(collectionID, userID) < 0) {
+		return []domain.Recipe{}
 	}
-	r.Cuisines[cuisine.ID] = cuisine
-	return cuisine, nil
+
+	recipes := make([]domain.Recipe, 0, len(collection.Items))
+	for _, item := range collection.Items {
+		if recipe, ok := r.Recipes[item.RecipeID]; ok && recipe.DeletedAt == nil {
+			recipes = append(recipes, *recipe)
+		}
+	}
+
+	if offset >= len(recipes) {
+		return []domain.Recipe{}
+	}
+	return recipes[offset:min(offset+limit, len(recipes))]
 }
 
-// GetCuisines retrieves all cuisines.
-func (r *FakeRecipeRepository) GetCuisines(ctx context.Context, userID uuid.UUID) ([]domain.Cuisine, error) {
-	if r.FailOnGetCuisines {
-		return nil, errors.New("fake repository error")
+// ListCollections retrieves the user's collections, those shared with them
+// and the public ones.
+func (r *FakeRecipeRepository) ListCollections(ctx context.Context, userID uuid.UUID) ([]domain.Collection, error) {
+	var result []domain.Collection
+	for _, collection := range r.Collections {
+		if c, err := r.GetCollection(ctx, userID, collection.ID); err == nil {
+			c.Items = nil
+			result = append(result, *c)
+		}
 	}
+	return result, nil
+}
 
-	cuisines := make([]domain.Cuisine, 0, len(r.Cuisines))
-	for _, cuisine := range r.Cuisines {
-		if cuisine.UserID == userID {
-			cuisines = append(cuisines, *cuisine)
+// GetCollection retrieves a collection with its items.
+func (r *FakeRecipeRepository) GetCollection(ctx context.Context, userID, id uuid.UUID) (*domain.Collection, error) {
+	collection, ok := r.Collections[id]
+	if !ok {
+		return nil, repository.ErrCollectionNotFound
+	}
+
+	result := *collection
+	result.Items = append([]domain.CollectionItem(nil), collection.Items...)
+	result.RecipeCount = len(result.Items)
+	if collection.UserID != userID {
+		i := r.findCollectionShare(id, userID)
+		switch {
+		case i >= 0:
+			result.Permission = r.CollectionShares[i].Permission
+		case collection.Public:
+			result.Permission = domain.SharePermissionRead
+		default:
+			return nil, repository.ErrCollectionNotFound
 		}
 	}
-	return cuisines, nil
+	return &result, nil
 }
 
-// AddRecipe adds a recipe to the fake repository for test setup.
-func (r *FakeRecipeRepository) AddRecipe(recipe *domain.Recipe) {
-	r.Recipes[recipe.ID] = recipe
+// CreateCollection creates a collection.
+func (r *FakeRecipeRepository) CreateCollection(ctx context.Context, collection *domain.Collection) error {
+	if collection.ID == uuid.Nil {
+		collection.ID = uuid.New()
+	}
+	stored := *collection
+	r.Collections[collection.ID] = &stored
+	return nil
 }
 
-// AddIngredient adds an ingredient to the fake repository for test setup.
-func (r *FakeRecipeRepository) AddIngredient(ingredient *domain.Ingredient) {
-	r.Ingredients[ingredient.ID] = ingredient
+// UpdateCollection updates the details of a collection.
+func (r *FakeRecipeRepository) UpdateCollection(ctx context.Context, userID uuid.UUID, collection *domain.Collection) error {
+	stored, err := r.editableCollection(userID, collection.ID)
+	if err != nil {
+		return err
+	}
+	stored.Name = collection.Name
+	stored.Description = collection.Description
+	stored.CoverImageURL = collection.CoverImageURL
+	return nil
 }
 
-// AddCuisine adds a cuisine to the fake repository for test setup.
-func (r *FakeRecipeRepository) AddCuisine(cuisine *domain.Cuisine) {
-	r.Cuisines[cuisine.ID] = cuisine
+// SetCollectionPublic makes a collection the user owns public or private.
+func (r *FakeRecipeRepository) SetCollectionPublic(ctx context.Context, userID, id uuid.UUID, public bool) error {
+	collection, ok := r.Collections[id]
+	if !ok || collection.UserID != userID {
+		return repository.ErrCollectionNotFound
+	}
+	collection.Public = public
+	return nil
 }
 
-// FakeEventPublisher is an in-memory implementation of EventPublisher for testing.
-type FakeEventPublisher struct {
-	RecipeUpsertedEvents []*domain.Recipe
-	RecipeDeletedEvents  []DeletedEvent
+// DeleteCollection deletes a collection the user owns.
+func (r *FakeRecipeRepository) DeleteCollection(ctx context.Context, userID, id uuid.UUID) error {
+	collection, ok := r.Collections[id]
+	if !ok || collection.UserID != userID {
+		return repository.ErrCollectionNotFound
+	}
+	delete(r.Collections, id)
+	return nil
+}
 
-	FailOnPublishUpserted bool
-	FailOnPublishDeleted  bool
+// AddCollectionRecipe appends one of the owner's recipes to a collection.
+func (r *FakeRecipeRepository) AddCollectionRecipe(ctx context.Context, userID, collectionID, recipeID uuid.UUID) error {
+	collection, err := r.editableCollection(userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	recipe, ok := r.Recipes[recipeID]
+	if !ok || recipe.UserID != collection.UserID {
+		return repository.ErrRecipeNotFound
+	}
+
+	for _, item := range collection.Items {
+		if item.RecipeID == recipeID {
+			return nil
+		}
+	}
+	collection.Items = append(collection.Items, domain.CollectionItem{
+		RecipeID:   recipeID,
+		RecipeName: recipe.Name,
+		ImageURL:   recipe.ImageURL,
+		Position:   len(collection.Items) + 1,
+	})
+	return nil
 }
 
-// DeletedEvent represents a deleted recipe event in tests.
-type DeletedEvent struct {
-	RecipeID uuid.UUID
-	UserID   uuid.UUID
+// RemoveCollectionRecipe removes a recipe from a collection.
+func (r *FakeRecipeRepository) RemoveCollectionRecipe(ctx context.Context, userID, collectionID, recipeID uuid.UUID) error {
+	collection, err := r.editableCollection(userID, collectionID)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range collection.Items {
+		if item.RecipeID == recipeID {
+			collection.Items = append(collection.Items[:i], collection.Items[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrCollectionItemNotFound
 }
 
-// NewFakeEventPublisher creates a new fake event publisher.
-func NewFakeEventPublisher() *FakeEventPublisher {
-	return &FakeEventPublisher{
-		RecipeUpsertedEvents: []*domain.Recipe{},
-		RecipeDeletedEvents:  []DeletedEvent{},
+// ListRecipeCollectionIDs returns the IDs of the collections a recipe is in.
+func (r *FakeRecipeRepository) ListRecipeCollectionIDs(ctx context.Context, recipeID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, collection := range r.Collections {
+		for _, item := range collection.Items {
+			if item.RecipeID == recipeID {
+				ids = append(ids, collection.ID)
+			}
+		}
 	}
+	return ids, nil
 }
 
-// PublishRecipeUpserted records a RecipeUpsertedEvent.
-func (p *FakeEventPublisher) PublishRecipeUpserted(ctx context.Context, recipe *domain.Recipe) error {
-	if p.FailOnPublishUpserted {
-		return errors.New("fake publisher error")
+// ReorderCollection puts the items of a collection in the given order.
+func (r *FakeRecipeRepository) ReorderCollection(ctx context.Context, userID, collectionID uuid.UUID, recipeIDs []uuid.UUID) error {
+	collection, err := r.editableCollection(userID, collectionID)
+	if err != nil {
+		return err
 	}
-	p.RecipeUpsertedEvents = append(p.RecipeUpsertedEvents, recipe)
+
+	items := make(map[uuid.UUID]domain.CollectionItem, len(collection.Items))
+	for _, item := range collection.Items {
+		items[item.RecipeID] = item
+	}
+	if len(recipeIDs) != len(items) {
+		return repository.ErrCollectionOrderMismatch
+	}
+
+	reordered := make([]domain.CollectionItem, 0, len(recipeIDs))
+	for i, id := range recipeIDs {
+		item, ok := items[id]
+		if !ok {
+			return repository.ErrCollectionOrderMismatch
+		}
+		delete(items, id)
+		item.Position = i + 1
+		reordered = append(reordered, item)
+	}
+	collection.Items = reordered
 	return nil
 }
 
-// PublishRecipeDeleted records a RecipeDeletedEvent.
-func (p *FakeEventPublisher) PublishRecipeDeleted(ctx context.Context, recipeID, userID uuid.UUID) error {
-	if p.FailOnPublishDeleted {
-		return errors.New("fake publisher error")
+// ShareCollection shares a collection with the user registered under email.
+func (r *FakeRecipeRepository) ShareCollection(ctx context.Context, ownerID, collectionID uuid.UUID, email string, permission domain.SharePermission) (*domain.CollectionShare, error) {
+	collection, ok := r.Collections[collectionID]
+	if !ok || collection.UserID != ownerID {
+		return nil, repository.ErrCollectionNotFound
 	}
-	p.RecipeDeletedEvents = append(p.RecipeDeletedEvents, DeletedEvent{RecipeID: recipeID, UserID: userID})
-	return nil
+
+	recipient := r.findUserByEmail(email)
+	if recipient == nil {
+		return nil, repository.ErrUserNotFound
+	}
+	if recipient.ID == ownerID {
+		return nil, repository.ErrShareWithOwner
+	}
+
+	share := domain.CollectionShare{
+		CollectionID:     collectionID,
+		CollectionName:   collection.Name,
+		OwnerID:          ownerID,
+		SharedWithUserID: recipient.ID,
+		SharedWithEmail:  recipient.Email,
+		Permission:       permission,
+	}
+	if i := r.findCollectionShare(collectionID, recipient.ID); i >= 0 {
+		r.CollectionShares[i] = share
+	} else {
+		r.CollectionShares = append(r.CollectionShares, share)
+	}
+	return &share, nil
 }
 
-// UpsertedEventCount returns the number of RecipeUpsertedEvents published.
-func (p *FakeEventPublisher) UpsertedEventCount() int {
-	return len(p.RecipeUpsertedEvents)
+// ListCollectionShares retrieves the shares of a collection the user owns.
+func (r *FakeRecipeRepository) ListCollectionShares(ctx context.Context, ownerID, collectionID uuid.UUID) ([]domain.CollectionShare, error) {
+	collection, ok := r.Collections[collectionID]
+	if !ok || collection.UserID != ownerID {
+		return nil, repository.ErrCollectionNotFound
+	}
+
+	var result []domain.CollectionShare
+	for _, share := range r.CollectionShares {
+		if share.CollectionID == collectionID {
+			result = append(result, share)
+		}
+	}
+	return result, nil
 }
 
-// DeletedEventCount returns the number of RecipeDeletedEvents published.
-func (p *FakeEventPublisher) DeletedEventCount() int {
-	return len(p.RecipeDeletedEvents)
+// RevokeCollectionShare removes a share of a collection the user owns.
+func (r *FakeRecipeRepository) RevokeCollectionShare(ctx context.Context, ownerID, collectionID, sharedWithUserID uuid.UUID) error {
+	i := r.findCollectionShare(collectionID, sharedWithUserID)
+	if i < 0 || r.CollectionShares[i].OwnerID != ownerID {
+		return repository.ErrShareNotFound
+	}
+	r.CollectionShares = append(r.CollectionShares[:i], r.CollectionShares[i+1:]...)
+	return nil
 }
 
-// FakeVectorGenerator is a fake implementation of vector.Generator for testing.
-type FakeVectorGenerator struct {
-	FixedVector pgvector.Vector
+func (r *FakeRecipeRepository) editableCollection(userID, collectionID uuid.UUID) (*domain.Collection, error) {
+	collection, ok := r.Collections[collectionID]
+	if !ok {
+		return nil, repository.ErrCollectionNotFound
+	}
+	if collection.UserID == userID {
+		return collection, nil
+	}
+
+	i := r.findCollectionShare(collectionID, userID)
+	if i < 0 {
+		if collection.Public {
+			return nil, repository.ErrCollectionNotEditable
+		}
+		return nil, repository.ErrCollectionNotFound
+	}
+	if r.CollectionShares[i].Permission != domain.SharePermissionEdit {
+		return nil, repository.ErrCollectionNotEditable
+	}
+	return collection, nil
 }
 
-// NewFakeVectorGenerator creates a new fake vector generator.
-func NewFakeVectorGenerator() *FakeVectorGenerator {
-	dims := make([]float32, 1536)
-	dims[0] = 1.0
-	return &FakeVectorGenerator{
-		FixedVector: pgvector.NewVector(dims),
+func (r *FakeRecipeRepository) findCollectionShare(collectionID, userID uuid.UUID) int {
+	for i, share := range r.CollectionShares {
+		if share.CollectionID == collectionID && share.SharedWithUserID == userID {
+			return i
+		}
 	}
+	return -1
 }
 
-// Generate returns a fixed vector for testing.
-func (g *FakeVectorGenerator) Generate(text string) pgvector.Vector {
-	return g.FixedVector
+func (r *FakeRecipeRepository) findUserByEmail(email string) *domain.User {
+	for _, user := range r.Users {
+		if strings.EqualFold(user.Email, email) {
+			return user
+		}
+	}
+	return nil
 }
 
-// GenerateForRecipe returns a fixed vector for testing.
-func (g *FakeVectorGenerator) GenerateForRecipe(recipe *domain.Recipe) pgvector.Vector {
-	return g.FixedVector
+// LogCook records a cook log entry.
+func (r *FakeRecipeRepository) LogCook(ctx context.Context, entry *domain.CookLogEntry) error {
+	recipe, ok := r.Recipes[entry.RecipeID]
+	if !ok || !r.canRead(recipe, entry.UserID) {
+		return repository.ErrRecipeNotFound
+	}
+
+	if entry.ID == uuid.Nil {
+		entry.ID = uuid.New()
+	}
+	entry.RecipeName = recipe.Name
+	entry.CreatedAt = time.Now()
+	entry.UpdatedAt = entry.CreatedAt
+	r.CookLog = append(r.CookLog, *entry)
+	return nil
+}
+
+// ListCookLog retrieves the user's cook log, most recent first.
+func (r *FakeRecipeRepository) ListCookLog(ctx context.Context, userID uuid.UUID, recipeID *uuid.UUID, limit int) ([]domain.CookLogEntry, error) {
+	var result []domain.CookLogEntry
+	for _, entry := range r.CookLog {
+		if entry.UserID == userID && (recipeID == nil || entry.RecipeID == *recipeID) {
+			result = append(result, entry)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CookedOn.After(result[j].CookedOn)
+	})
+	return result[:min(limit, len(result))], nil
+}
+
+// UpdateCookLogEntry changes one of the user's cook log entries.
+func (r *FakeRecipeRepository) UpdateCookLogEntry(ctx context.Context, entry *domain.CookLogEntry) error {
+	for i, existing := range r.CookLog {
+		if existing.ID == entry.ID && existing.UserID == entry.UserID {
+			entry.RecipeID = existing.RecipeID
+			entry.RecipeName = existing.RecipeName
+			entry.CreatedAt = existing.CreatedAt
+			entry.UpdatedAt = time.Now()
+			r.CookLog[i] = *entry
+			return nil
+		}
+	}
+	return repository.ErrCookLogEntryNotFound
+}
+
+// DeleteCookLogEntry deletes one of the user's cook log entries.
+func (r *FakeRecipeRepository) DeleteCookLogEntry(ctx context.Context, userID, id uuid.UUID) (uuid.UUID, error) {
+	for i, entry := range r.CookLog {
+		if entry.ID == id && entry.UserID == userID {
+			r.CookLog = append(r.CookLog[:i], r.CookLog[i+1:]...)
+			return entry.RecipeID, nil
+		}
+	}
+	return uuid.Nil, repository.ErrCookLogEntryNotFound
+}
+
+// GetCookStats aggregates the user's cook log for a recipe.
+func (r *FakeRecipeRepository) GetCookStats(ctx context.Context, userID, recipeID uuid.UUID) (*domain.RecipeCookStats, error) {
+	stats := r.cookStats(userID, recipeID)
+	return &stats, nil
+}
+
+func (r *FakeRecipeRepository) cookStats(userID, recipeID uuid.UUID) domain.RecipeCookStats {
+	var stats domain.RecipeCookStats
+	ratingSum, ratingCount := 0, 0
+	for _, entry := range r.CookLog {
+		if entry.UserID != userID || entry.RecipeID != recipeID {
+			continue
+		}
+		stats.TimesCooked++
+		if stats.LastCookedOn == nil || entry.CookedOn.After(*stats.LastCookedOn) {
+			cookedOn := entry.CookedOn
+			stats.LastCookedOn = &cookedOn
+		}
+		if entry.Rating != nil {
+			ratingSum += *entry.Rating
+			ratingCount++
+		}
+	}
+	if ratingCount > 0 {
+		average := float64(ratingSum) / float64(ratingCount)
+		stats.AverageRating = &average
+	}
+	return stats
+}
+
+// CreateCookSession stores a new cook session.
+func (r *FakeRecipeRepository) CreateCookSession(ctx context.Context, session *domain.CookSession) error {
+	if r.ConflictOnCreateCookSession {
+		return repository.ErrCookSessionExists
+	}
+
+	now := time.Now()
+	for id, existing := range r.CookSessions {
+		if !existing.ExpiresAt.After(now) {
+			delete(r.CookSessions, id)
+			continue
+		}
+		if existing.UserID == session.UserID && existing.RecipeID == session.RecipeID && !existing.IsCompleted() {
+			return repository.ErrCookSessionExists
+		}
+	}
+
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+	session.Version = 1
+	session.StartedAt = now
+	session.UpdatedAt = now
+	r.CookSessions[session.ID] = copyCookSession(session)
+	return nil
+}
+
+// GetCookSession retrieves one of the user's unexpired cook sessions.
+func (r *FakeRecipeRepository) GetCookSession(ctx context.Context, userID, id uuid.UUID) (*domain.CookSession, error) {
+	session, ok := r.CookSessions[id]
+	if !ok || session.UserID != userID || !session.ExpiresAt.After(time.Now()) {
+		return nil, repository.ErrCookSessionNotFound
+	}
+	return copyCookSession(session), nil
+}
+
+// GetActiveCookSession retrieves the user's active cook session for a recipe.
+func (r *FakeRecipeRepository) GetActiveCookSession(ctx context.Context, userID, recipeID uuid.UUID) (*domain.CookSession, error) {
+	for _, session := range r.activeCookSessions(userID) {
+		if session.RecipeID == recipeID {
+			return copyCookSession(session), nil
+		}
+	}
+	return nil, repository.ErrCookSessionNotFound
+}
+
+// ListCookSessions lists the user's active cook sessions.
+func (r *FakeRecipeRepository) ListCookSessions(ctx context.Context, userID uuid.UUID) ([]domain.CookSession, error) {
+	var result []domain.CookSession
+	for _, session := range r.activeCookSessions(userID) {
+		result = append(result, *copyCookSession(session))
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].UpdatedAt.After(result[j].UpdatedAt)
+	})
+	return result, nil
+}
+
+// UpdateCookSession saves an active cook session read at session.Version.
+func (r *FakeRecipeRepository) UpdateCookSession(ctx context.Context, session *domain.CookSession) error {
+	if err := r.checkCookSessionVersion(session); err != nil {
+		return err
+	}
+
+	session.Version++
+	session.UpdatedAt = time.Now()
+	r.CookSessions[session.ID] = copyCookSession(session)
+	return nil
+}
+
+// CompleteCookSession logs the cook and marks the session completed.
+func (r *FakeRecipeRepository) CompleteCookSession(ctx context.Context, session *domain.CookSession, entry *domain.CookLogEntry) error {
+	if err := r.checkCookSessionVersion(session); err != nil {
+		return err
+	}
+	if err := r.LogCook(ctx, entry); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	session.Version++
+	session.UpdatedAt = now
+	session.CompletedAt = &now
+	session.CookLogEntryID = &entry.ID
+	r.CookSessions[session.ID] = copyCookSession(session)
+	return nil
+}
+
+// DeleteCookSession deletes one of the user's cook sessions.
+func (r *FakeRecipeRepository) DeleteCookSession(ctx context.Context, userID, id uuid.UUID) error {
+	if _, err := r.GetCookSession(ctx, userID, id); err != nil {
+		return err
+	}
+	delete(r.CookSessions, id)
+	return nil
+}
+
+func (r *FakeRecipeRepository) activeCookSessions(userID uuid.UUID) []*domain.CookSession {
+	now := time.Now()
+	var result []*domain.CookSession
+	for _, session := range r.CookSessions {
+		if session.UserID == userID && !session.IsCompleted() && session.ExpiresAt.After(now) {
+			result = append(result, session)
+		}
+	}
+	return result
+}
+
+func (r *FakeRecipeRepository) checkCookSessionVersion(session *domain.CookSession) error {
+	stored, ok := r.CookSessions[session.ID]
+	if !ok || stored.UserID != session.UserID || stored.Version != session.Version ||
+		stored.IsCompleted() || !stored.ExpiresAt.After(time.Now()) {
+		return repository.ErrCookSessionConflict
+	}
+	return nil
+}
+
+func copyCookSession(session *domain.CookSession) *domain.CookSession {
+	c := *session
+	c.Timers = append([]domain.CookTimer(nil), session.Timers...)
+	return &c
+}
+
+// ListSubstitutions lists the substitutions for the given ingredients.
+func (r *FakeRecipeRepository) ListSubstitutions(ctx context.Context, ingredientNames []string, allergyID *uuid.UUID) ([]domain.Substitution, error) {
+	keys := make(map[string]bool, len(ingredientNames))
+	for _, name := range ingredientNames {
+		keys[substitution.Key(name)] = true
+	}
+
+	var result []domain.Substitution
+	for _, sub := range r.Substitutions {
+		if !keys[substitution.Key(sub.IngredientName)] {
+			continue
+		}
+		if allergyID != nil && (sub.AvoidsAllergy == nil || sub.AvoidsAllergy.ID != *allergyID) {
+			continue
+		}
+		result = append(result, sub)
+	}
+	return result, nil
+}
+
+// GetSubstitutions retrieves substitutions by ID.
+func (r *FakeRecipeRepository) GetSubstitutions(ctx context.Context, ids []uuid.UUID) ([]domain.Substitution, error) {
+	var result []domain.Substitution
+	for _, sub := range r.Substitutions {
+		for _, id := range ids {
+			if sub.ID == id {
+				result = append(result, sub)
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// GetDietaryProfile retrieves a user's dietary profile.
+func (r *FakeRecipeRepository) GetDietaryProfile(ctx context.Context, userID uuid.UUID) (*domain.DietaryProfile, error) {
+	profile, ok := r.DietaryProfiles[userID]
+	if !ok {
+		return &domain.DietaryProfile{UserID: userID}, nil
+	}
+	result := *profile
+	return &result, nil
+}
+
+// SaveDietaryProfile replaces a user's dietary profile.
+func (r *FakeRecipeRepository) SaveDietaryProfile(ctx context.Context, profile *domain.DietaryProfile) error {
+	profile.UpdatedAt = time.Now()
+	saved := *profile
+	r.DietaryProfiles[profile.UserID] = &saved
+	return nil
+}
+
+// GetAllergyByID retrieves an allergy by ID.
+func (r *FakeRecipeRepository) GetAllergyByID(ctx context.Context, id uuid.UUID) (*domain.Allergy, error) {
+	allergy, ok := r.Allergies[id]
+	if !ok {
+		return nil, repository.ErrAllergyNotFound
+	}
+	return allergy, nil
+}
+
+// ListAllergies retrieves all allergies ordered by name.
+func (r *FakeRecipeRepository) ListAllergies(ctx context.Context) ([]domain.Allergy, error) {
+	allergies := make([]domain.Allergy, 0, len(r.Allergies))
+	for _, allergy := range r.Allergies {
+		allergies = append(allergies, *allergy)
+	}
+	sort.Slice(allergies, func(i, j int) bool {
+		return allergies[i].Name < allergies[j].Name
+	})
+	return allergies, nil
+}
+
+// AddIngredientAllergy links an allergy to an ingredient.
+func (r *FakeRecipeRepository) AddIngredientAllergy(ctx context.Context, ingredientID, allergyID uuid.UUID) error {
+	ingredient, ok := r.Ingredients[ingredientID]
+	if !ok {
+		return repository.ErrIngredientNotFound
+	}
+	if slices.ContainsFunc(ingredient.Allergies, func(allergy domain.Allergy) bool { return allergy.ID == allergyID }) {
+		return nil
+	}
+	allergy, ok := r.Allergies[allergyID]
+	if !ok {
+		return repository.ErrAllergyNotFound
+	}
+	ingredient.Allergies = append(ingredient.Allergies, *allergy)
+	return nil
+}
+
+// matchesDietaryProfile approximates the repository's dietary profile
+// conditions.
+func matchesDietaryProfile(recipe *domain.Recipe, profile *domain.DietaryProfile) bool {
+	if profile.IsEmpty() {
+		return true
+	}
+
+	for _, allergy := range recipe.Allergies() {
+		for _, avoided := range profile.Allergies {
+			if allergy.ID == avoided.ID {
+				return false
+			}
+		}
+	}
+
+	for _, diet := range profile.Diets {
+		if recipe.DietClassified && !hasDietLabels(recipe, []domain.DietLabel{diet.Label()}) {
+			return false
+		}
+	}
+
+	names := make([]string, 0, len(recipe.IngredientLines)+1)
+	if recipe.MainIngredient != nil {
+		names = append(names, recipe.MainIngredient.Name)
+	}
+	for _, line := range recipe.IngredientLines {
+		if !line.IsOptional {
+			names = append(names, line.Ingredient.Name)
+		}
+	}
+	for _, name := range names {
+		for _, disliked := range profile.DislikedIngredients {
+			if strings.EqualFold(name, disliked) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func hasDietLabels(recipe *domain.Recipe, labels []domain.DietLabel) bool {
+	for _, label := range labels {
+		if !slices.Contains(recipe.DietLabels, label) {
+			return false
+		}
+	}
+	return true
+}
+
+// sortByCookStats approximates the repository's cook log sorts.
+func sortByCookStats(recipes []domain.Recipe, order domain.RecipeSort) {
+	lastCooked := func(recipe domain.Recipe) time.Time {
+		if recipe.CookStats.LastCookedOn == nil {
+			return time.Time{}
+		}
+		return *recipe.CookStats.LastCookedOn
+	}
+	rating := func(recipe domain.Recipe) float64 {
+		if recipe.CookStats.AverageRating == nil {
+			return 0
+		}
+		return *recipe.CookStats.AverageRating
+	}
+
+	switch order {
+	case domain.RecipeSortLastCooked:
+		sort.SliceStable(recipes, func(i, j int) bool { return lastCooked(recipes[i]).After(lastCooked(recipes[j])) })
+	case domain.RecipeSortLeastRecent:
+		sort.SliceStable(recipes, func(i, j int) bool { return lastCooked(recipes[i]).Before(lastCooked(recipes[j])) })
+	case domain.RecipeSortMostCooked:
+		sort.SliceStable(recipes, func(i, j int) bool { return recipes[i].CookStats.TimesCooked > recipes[j].CookStats.TimesCooked })
+	case domain.RecipeSortTopRated:
+		sort.SliceStable(recipes, func(i, j int) bool { return rating(recipes[i]) > rating(recipes[j]) })
+	}
+}
+
+// sortByKey orders recipes by a column sort with the recipe ID as the
+// tiebreaker, keeping only those after the cursor when one is given.
+func sortByKey(recipes []domain.Recipe, keySort domain.RecipeSort, order domain.SortOrder, after *domain.RecipeCursor) []domain.Recipe {
+	cursors := make(map[uuid.UUID]domain.RecipeCursor, len(recipes))
+	for i := range recipes {
+		cursors[recipes[i].ID] = domain.NewRecipeCursor(&recipes[i], keySort, order)
+	}
+
+	if after != nil {
+		recipes = slices.DeleteFunc(recipes, func(recipe domain.Recipe) bool {
+			return compareCursors(cursors[recipe.ID], *after) <= 0
+		})
+	}
+	slices.SortFunc(recipes, func(a, b domain.Recipe) int {
+		return compareCursors(cursors[a.ID], cursors[b.ID])
+	})
+	return recipes
+}
+
+// compareCursors orders two cursors of the same sort the way the listing
+// does: by key in the sort order with missing keys last, then by ID.
+func compareCursors(a, b domain.RecipeCursor) int {
+	if a.KeyNull != b.KeyNull {
+		if a.KeyNull {
+			return 1
+		}
+		return -1
+	}
+
+	result := 0
+	if !a.KeyNull {
+		switch a.Sort {
+		case domain.RecipeSortName:
+			result = strings.Compare(strings.ToLower(a.Key), strings.ToLower(b.Key))
+		case domain.RecipeSortCreatedAt, domain.RecipeSortUpdatedAt:
+			at, _ := time.Parse(time.RFC3339Nano, a.Key)
+			bt, _ := time.Parse(time.RFC3339Nano, b.Key)
+			result = at.Compare(bt)
+		default:
+			af, _ := strconv.ParseFloat(a.Key, 64)
+			bf, _ := strconv.ParseFloat(b.Key, 64)
+			result = cmp.Compare(af, bf)
+		}
+	}
+	if result == 0 {
+		result = slices.Compare(a.ID[:], b.ID[:])
+	}
+	if a.Order == domain.SortDescending {
+		result = -result
+	}
+	return result
+}
+
+// Delete removes a recipe.
+func (r *FakeRecipeRepository) Delete(ctx context.Context, userID, id uuid.UUID) error {
+	r.DeleteCalls = append(r.DeleteCalls, id)
+
+	if r.FailOnDelete {
+		return errors.New("fake repository error")
+	}
+
+	recipe, ok := r.Recipes[id]
+	if !ok || recipe.UserID != userID || recipe.DeletedAt != nil {
+		return repository.ErrRecipeNotFound
+	}
+
+	deletedAt := time.Now()
+	recipe.DeletedAt = &deletedAt
+	return nil
+}
+
+// ListDeletedRecipes retrieves the user's deleted recipes, most recently
+// deleted first.
+func (r *FakeRecipeRepository) ListDeletedRecipes(ctx context.Context, userID uuid.UUID, limit, offset int) ([]domain.Recipe, error) {
+	var recipes []domain.Recipe
+	for _, recipe := range r.Recipes {
+		if recipe.UserID == userID && recipe.DeletedAt != nil {
+			recipes = append(recipes, *recipe)
+		}
+	}
+	sort.SliceStable(recipes, func(i, j int) bool { return recipes[i].DeletedAt.After(*recipes[j].DeletedAt) })
+
+	if offset >= len(recipes) {
+		return []domain.Recipe{}, nil
+	}
+	return recipes[offset:min(offset+limit, len(recipes))], nil
+}
+
+// CountDeletedRecipes returns the number of the user's deleted recipes.
+func (r *FakeRecipeRepository) CountDeletedRecipes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	count := int64(0)
+	for _, recipe := range r.Recipes {
+		if recipe.UserID == userID && recipe.DeletedAt != nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// RestoreRecipe moves a recipe out of the user's trash.
+func (r *FakeRecipeRepository) RestoreRecipe(ctx context.Context, userID, id uuid.UUID) error {
+	recipe, ok := r.Recipes[id]
+	if !ok || recipe.UserID != userID || recipe.DeletedAt == nil {
+		return repository.ErrRecipeNotFound
+	}
+	recipe.DeletedAt = nil
+	return nil
+}
+
+// PurgeRecipe permanently removes a recipe from the user's trash and returns
+// the image URLs no remaining recipe, revision or collection references.
+func (r *FakeRecipeRepository) PurgeRecipe(ctx context.Context, userID, id uuid.UUID) ([]string, error) {
+	recipe, ok := r.Recipes[id]
+	if !ok || recipe.UserID != userID || recipe.DeletedAt == nil {
+		return nil, repository.ErrRecipeNotFound
+	}
+
+	candidates := recipeMedia(recipe)
+	for _, rev := range r.Revisions[id] {
+		candidates = append(candidates, recipeMedia(&rev.Recipe)...)
+	}
+
+	delete(r.Recipes, id)
+	delete(r.Revisions, id)
+
+	referenced := make(map[string]bool)
+	for _, other := range r.Recipes {
+		for _, url := range recipeMedia(other) {
+			referenced[url] = true
+		}
+		for _, rev := range r.Revisions[other.ID] {
+			for _, url := range recipeMedia(&rev.Recipe) {
+				referenced[url] = true
+			}
+		}
+	}
+	for _, collection := range r.Collections {
+		referenced[collection.CoverImageURL] = true
+	}
+
+	var orphaned []string
+	for _, url := range candidates {
+		if !referenced[url] {
+			orphaned = append(orphaned, url)
+			referenced[url] = true
+		}
+	}
+	return orphaned, nil
+}
+
+func recipeMedia(recipe *domain.Recipe) []string {
+	var urls []string
+	if recipe.ImageURL != "" {
+		urls = append(urls, recipe.ImageURL)
+	}
+	for _, step := range recipe.Steps {
+		if step.MediaURL != "" {
+			urls = append(urls, step.MediaURL)
+		}
+	}
+	return urls
+}
+
+// ListRecipeShares retrieves the shares of a recipe the user owns.
+func (r *FakeRecipeRepository) ListRecipeShares(ctx context.Context, ownerID, recipeID uuid.UUID) ([]domain.RecipeShare, error) {
+	var result []domain.RecipeShare
+	for _, share := range r.Shares {
+		if share.OwnerID == ownerID && share.RecipeID == recipeID {
+			result = append(result, share)
+		}
+	}
+	return result, nil
+}
+
+// ListRecipeCookStats aggregates the cook log of every user for a recipe.
+func (r *FakeRecipeRepository) ListRecipeCookStats(ctx context.Context, recipeID uuid.UUID) (map[uuid.UUID]domain.RecipeCookStats, error) {
+	stats := make(map[uuid.UUID]domain.RecipeCookStats)
+	for _, entry := range r.CookLog {
+		if entry.RecipeID == recipeID {
+			stats[entry.UserID] = r.cookStats(entry.UserID, recipeID)
+		}
+	}
+	return stats, nil
+}
+
+// GetSimilar retrieves similar recipes.
+func (r *FakeRecipeRepository) GetSimilar(ctx context.Context, userID, recipeID uuid.UUID, profile *domain.DietaryProfile, limit int) ([]domain.Recipe, error) {
+	if r.FailOnGetSimilar {
+		return nil, errors.New("fake repository error")
+	}
+
+	if recipe, ok := r.Recipes[recipeID]; !ok || recipe.UserID != userID || recipe.DeletedAt != nil {
+		return nil, repository.ErrRecipeNotFound
+	}
+
+	recipes := make([]domain.Recipe, 0)
+	for id, recipe := range r.Recipes {
+		if id != recipeID && recipe.UserID == userID && recipe.DeletedAt == nil && matchesDietaryProfile(recipe, profile) {
+			recipes = append(recipes, *recipe)
+			if len(recipes) >= limit {
+				break
+			}
+		}
+	}
+	return recipes, nil
+}
+
+// GetIngredientByID retrieves an ingredient by ID.
+func (r *FakeRecipeRepository) GetIngredientByID(ctx context.Context, userID, id uuid.UUID) (*domain.Ingredient, error) {
+	if r.FailOnGetIngredientByID {
+		return nil, errors.New("fake repository error")
+	}
+
+	ingredient, ok := r.Ingredients[id]
+	if !ok || ingredient.UserID != userID {
+		return nil, repository.ErrIngredientNotFound
+	}
+	return ingredient, nil
+}
+
+// GetOrCreateIngredient retrieves an ingredient by name or creates it.
+func (r *FakeRecipeRepository) GetOrCreateIngredient(ctx context.Context, userID uuid.UUID, name string) (*domain.Ingredient, error) {
+	if r.FailOnGetOrCreateIngredient {
+		return nil, errors.New("fake repository error")
+	}
+
+	for _, ingredient := range r.Ingredients {
+		if ingredient.UserID == userID && ingredient.Name == name {
+			return ingredient, nil
+		}
+	}
+
+	ingredient := &domain.Ingredient{
+		ID:     uuid.New(),
+		UserID: userID,
+		Name:   name,
+	}
+	r.Ingredients[ingredient.ID] = ingredient
+
+	catalog, err := dietary.Bundled()
+	if err != nil {
+		return nil, err
+	}
+	if attributes, ok := catalog.Lookup(name); ok {
+		r.Dietary[ingredient.ID] = domain.IngredientDietaryAttributes{IngredientID: ingredient.ID, Attributes: attributes}
+	}
+	return ingredient, nil
+}
+
+// CopyIngredient retrieves the owner's ingredient named like source or
+// creates it with the allergies, nutrition and dietary attributes of source.
+func (r *FakeRecipeRepository) CopyIngredient(ctx context.Context, ownerID uuid.UUID, source *domain.Ingredient) (*domain.Ingredient, error) {
+	if r.FailOnGetOrCreateIngredient {
+		return nil, errors.New("fake repository error")
+	}
+
+	for _, ingredient := range r.Ingredients {
+		if ingredient.UserID == ownerID && ingredient.Name == source.Name {
+			return ingredient, nil
+		}
+	}
+
+	original, ok := r.Ingredients[source.ID]
+	if !ok {
+		return r.GetOrCreateIngredient(ctx, ownerID, source.Name)
+	}
+
+	ingredient := &domain.Ingredient{
+		ID:          uuid.New(),
+		UserID:      ownerID,
+		Name:        source.Name,
+		Description: original.Description,
+		Category:    original.Category,
+		Allergies:   append([]domain.Allergy(nil), original.Allergies...),
+	}
+	r.Ingredients[ingredient.ID] = ingredient
+
+	if fact, ok := r.Nutrition[original.ID]; ok {
+		fact.IngredientID = ingredient.ID
+		r.Nutrition[ingredient.ID] = fact
+	}
+	if record, ok := r.Dietary[original.ID]; ok {
+		record.IngredientID = ingredient.ID
+		record.Attributes = append([]domain.IngredientAttribute(nil), record.Attributes...)
+		r.Dietary[ingredient.ID] = record
+		return ingredient, nil
+	}
+
+	catalog, err := dietary.Bundled()
+	if err != nil {
+		return nil, err
+	}
+	if attributes, ok := catalog.Lookup(source.Name); ok {
+		r.Dietary[ingredient.ID] = domain.IngredientDietaryAttributes{IngredientID: ingredient.ID, Attributes: attributes}
+	}
+	return ingredient, nil
+}
+
+// GetIngredientNutrition retrieves the nutrition facts of the given ingredients.
+func (r *FakeRecipeRepository) GetIngredientNutrition(ctx context.Context, ingredientIDs []uuid.UUID) (map[uuid.UUID]domain.IngredientNutrition, error) {
+	if r.FailOnGetNutrition {
+		return nil, errors.New("fake repository error")
+	}
+
+	facts := make(map[uuid.UUID]domain.IngredientNutrition)
+	for _, id := range ingredientIDs {
+		if fact, ok := r.Nutrition[id]; ok {
+			facts[id] = fact
+		}
+	}
+	return facts, nil
+}
+
+// GetIngredientDietaryAttributes retrieves the dietary attributes of the given ingredients.
+func (r *FakeRecipeRepository) GetIngredientDietaryAttributes(ctx context.Context, ingredientIDs []uuid.UUID) (map[uuid.UUID]domain.IngredientDietaryAttributes, error) {
+	attributes := make(map[uuid.UUID]domain.IngredientDietaryAttributes)
+	for _, id := range ingredientIDs {
+		if record, ok := r.Dietary[id]; ok {
+			attributes[id] = record
+		}
+	}
+	return attributes, nil
+}
+
+// SaveIngredientDietaryAttributes stores the dietary attributes of an ingredient.
+func (r *FakeRecipeRepository) SaveIngredientDietaryAttributes(ctx context.Context, record *domain.IngredientDietaryAttributes) error {
+	record.UpdatedAt = time.Now()
+	r.Dietary[record.IngredientID] = *record
+	return nil
+}
+
+// ListRecipeIDsUsingIngredient returns the active recipes using an ingredient.
+func (r *FakeRecipeRepository) ListRecipeIDsUsingIngredient(ctx context.Context, ingredientID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for id, recipe := range r.Recipes {
+		if recipe.DeletedAt != nil {
+			continue
+		}
+		uses := recipe.MainIngredient != nil && recipe.MainIngredient.ID == ingredientID
+		for _, line := range recipe.IngredientLines {
+			uses = uses || line.Ingredient.ID == ingredientID
+		}
+		if uses {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+// UpdateRecipeDietLabels stores the diet labels of a recipe and whether it is
+// classified.
+func (r *FakeRecipeRepository) UpdateRecipeDietLabels(ctx context.Context, recipeID uuid.UUID, labels []domain.DietLabel, classified bool) error {
+	recipe, ok := r.Recipes[recipeID]
+	if !ok || recipe.DeletedAt != nil {
+		return repository.ErrRecipeNotFound
+	}
+	recipe.DietLabels = labels
+	recipe.DietClassified = classified
+	return nil
+}
+
+// ListUnclassifiedIngredients returns ingredients of all users, ordered by ID
+// and after the given ID, that have no dietary attributes.
+func (r *FakeRecipeRepository) ListUnclassifiedIngredients(ctx context.Context, after uuid.UUID, limit int) ([]domain.Ingredient, error) {
+	var ingredients []domain.Ingredient
+	for _, ingredient := range r.Ingredients {
+		if _, ok := r.Dietary[ingredient.ID]; ok || ingredient.ID.String() <= after.String() {
+			continue
+		}
+		ingredients = append(ingredients, *ingredient)
+	}
+	sort.Slice(ingredients, func(i, j int) bool {
+		return ingredients[i].ID.String() < ingredients[j].ID.String()
+	})
+	if len(ingredients) > limit {
+		ingredients = ingredients[:limit]
+	}
+	return ingredients, nil
+}
+
+// ListIngredients retrieves the user's ingredients ordered by name, or by
+// trigram similarity to the query.
+func (r *FakeRecipeRepository) ListIngredients(ctx context.Context, userID uuid.UUID, query string, limit, offset int) ([]domain.Ingredient, error) {
+	ingredients := r.searchIngredients(userID, query)
+	if offset >= len(ingredients) {
+		return nil, nil
+	}
+	ingredients = ingredients[offset:]
+	if len(ingredients) > limit {
+		ingredients = ingredients[:limit]
+	}
+	return ingredients, nil
+}
+
+// CountIngredients counts the user's ingredients matching the query.
+func (r *FakeRecipeRepository) CountIngredients(ctx context.Context, userID uuid.UUID, query string) (int64, error) {
+	return int64(len(r.searchIngredients(userID, query))), nil
+}
+
+func (r *FakeRecipeRepository) searchIngredients(userID uuid.UUID, query string) []domain.Ingredient {
+	query = strings.ToLower(query)
+
+	var ingredients []domain.Ingredient
+	for _, ingredient := range r.Ingredients {
+		if ingredient.UserID != userID {
+			continue
+		}
+		entry := *ingredient
+		entry.RecipeCount = r.recipeCount(ingredient.ID)
+		if query != "" {
+			name := strings.ToLower(ingredient.Name)
+			entry.SearchScore = trigramSimilarity(name, query)
+			if !strings.Contains(name, query) && entry.SearchScore < 0.3 {
+				continue
+			}
+		}
+		ingredients = append(ingredients, entry)
+	}
+
+	sort.Slice(ingredients, func(i, j int) bool {
+		if ingredients[i].SearchScore != ingredients[j].SearchScore {
+			return ingredients[i].SearchScore > ingredients[j].SearchScore
+		}
+		return strings.ToLower(ingredients[i].Name) < strings.ToLower(ingredients[j].Name)
+	})
+	return ingredients
+}
+
+func (r *FakeRecipeRepository) recipeCount(ingredientID uuid.UUID) int {
+	ids, _ := r.ListRecipeIDsUsingIngredient(context.Background(), ingredientID)
+	return len(ids)
+}
+
+// RenameIngredient renames one of the user's ingredients.
+func (r *FakeRecipeRepository) RenameIngredient(ctx context.Context, userID, id uuid.UUID, name string) error {
+	ingredient, ok := r.Ingredients[id]
+	if !ok || ingredient.UserID != userID {
+		return repository.ErrIngredientNotFound
+	}
+	for _, other := range r.Ingredients {
+		if other.ID != id && other.UserID == userID && other.Name == name {
+			return repository.ErrIngredientNameTaken
+		}
+	}
+
+	ingredient.Name = name
+	r.forEachIngredientUse(id, func(use *domain.Ingredient) { use.Name = name })
+	return nil
+}
+
+// SetIngredientCategory files one of the user's ingredients under a category.
+func (r *FakeRecipeRepository) SetIngredientCategory(ctx context.Context, userID, id uuid.UUID, categoryID *uuid.UUID) error {
+	ingredient, ok := r.Ingredients[id]
+	if !ok || ingredient.UserID != userID {
+		return repository.ErrIngredientNotFound
+	}
+	if categoryID == nil {
+		ingredient.Category = nil
+		return nil
+	}
+
+	category, ok := r.Categories[*categoryID]
+	if !ok {
+		return repository.ErrIngredientCategoryNotFound
+	}
+	ingredient.Category = category
+	return nil
+}
+
+// ListIngredientCategories retrieves the ingredient categories in display order.
+func (r *FakeRecipeRepository) ListIngredientCategories(ctx context.Context) ([]domain.IngredientCategory, error) {
+	categories := make([]domain.IngredientCategory, 0, len(r.Categories))
+	for _, category := range r.Categories {
+		categories = append(categories, *category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].DisplayOrder < categories[j].DisplayOrder
+	})
+	return categories, nil
+}
+
+// MergeIngredients replaces every use of the source ingredient with the target
+// and deletes the source.
+func (r *FakeRecipeRepository) MergeIngredients(ctx context.Context, userID, sourceID, targetID uuid.UUID) ([]uuid.UUID, error) {
+	if sourceID == targetID {
+		return nil, repository.ErrIngredientMergeSelf
+	}
+	source, ok := r.Ingredients[sourceID]
+	if !ok || source.UserID != userID {
+		return nil, repository.ErrIngredientNotFound
+	}
+	target, ok := r.Ingredients[targetID]
+	if !ok || target.UserID != userID {
+		return nil, repository.ErrIngredientNotFound
+	}
+
+	recipeIDs, _ := r.ListRecipeIDsUsingIngredient(ctx, sourceID)
+	r.forEachIngredientUse(sourceID, func(use *domain.Ingredient) { *use = *target })
+
+	for _, allergy := range source.Allergies {
+		if !slices.ContainsFunc(target.Allergies, func(own domain.Allergy) bool { return own.ID == allergy.ID }) {
+			target.Allergies = append(target.Allergies, allergy)
+		}
+	}
+	if target.Category == nil {
+		target.Category = source.Category
+	}
+	if target.Description == "" {
+		target.Description = source.Description
+	}
+	if fact, ok := r.Nutrition[sourceID]; ok {
+		if _, exists := r.Nutrition[targetID]; !exists {
+			fact.IngredientID = targetID
+			r.Nutrition[targetID] = fact
+		}
+		delete(r.Nutrition, sourceID)
+	}
+	if record, ok := r.Dietary[sourceID]; ok {
+		if _, exists := r.Dietary[targetID]; !exists {
+			record.IngredientID = targetID
+			r.Dietary[targetID] = record
+		}
+		delete(r.Dietary, sourceID)
+	}
+
+	delete(r.Ingredients, sourceID)
+	return recipeIDs, nil
+}
+
+// ListDuplicateIngredients pairs up the user's ingredients with similar names.
+func (r *FakeRecipeRepository) ListDuplicateIngredients(ctx context.Context, userID uuid.UUID, minSimilarity float64, limit int) ([]domain.IngredientDuplicate, error) {
+	ingredients := r.searchIngredients(userID, "")
+
+	var duplicates []domain.IngredientDuplicate
+	for i := range ingredients {
+		for j := i + 1; j < len(ingredients); j++ {
+			similarity := trigramSimilarity(strings.ToLower(ingredients[i].Name), strings.ToLower(ingredients[j].Name))
+			if similarity < minSimilarity {
+				continue
+			}
+			duplicate := domain.IngredientDuplicate{
+				Ingredient: ingredients[i],
+				Duplicate:  ingredients[j],
+				Similarity: similarity,
+			}
+			if duplicate.Duplicate.RecipeCount > duplicate.Ingredient.RecipeCount {
+				duplicate.Ingredient, duplicate.Duplicate = duplicate.Duplicate, duplicate.Ingredient
+			}
+			duplicates = append(duplicates, duplicate)
+		}
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Similarity > duplicates[j].Similarity
+	})
+	if len(duplicates) > limit {
+		duplicates = duplicates[:limit]
+	}
+	return duplicates, nil
+}
+
+// forEachIngredientUse calls fn with every copy of an ingredient held by
+// recipes as their main ingredient or on a line.
+func (r *FakeRecipeRepository) forEachIngredientUse(ingredientID uuid.UUID, fn func(*domain.Ingredient)) {
+	for _, recipe := range r.Recipes {
+		if recipe.MainIngredient != nil && recipe.MainIngredient.ID == ingredientID {
+			main := *recipe.MainIngredient
+			fn(&main)
+			recipe.MainIngredient = &main
+		}
+		for i := range recipe.IngredientLines {
+			if recipe.IngredientLines[i].Ingredient.ID == ingredientID {
+				fn(&recipe.IngredientLines[i].Ingredient)
+			}
+		}
+	}
+}
+
+// trigramSimilarity approximates pg_trgm similarity: the share of the
+// space-padded three-letter sequences of each word that both names have.
+func trigramSimilarity(a, b string) float64 {
+	first, second := trigrams(a), trigrams(b)
+	if len(first) == 0 || len(second) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for trigram := range first {
+		if second[trigram] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(first)+len(second)-shared)
+}
+
+func trigrams(name string) map[string]bool {
+	set := make(map[string]bool)
+	for _, word := range strings.Fields(name) {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			set[string(padded[i:i+3])] = true
+		}
+	}
+	return set
+}
+
+// ListIngredientMatches retrieves the user's ingredient matches with a status.
+func (r *FakeRecipeRepository) ListIngredientMatches(ctx context.Context, userID uuid.UUID, status domain.FoodMatchStatus) ([]domain.IngredientFoodMatch, error) {
+	if r.FailOnIngredientMatches {
+		return nil, errors.New("fake repository error")
+	}
+
+	var matches []domain.IngredientFoodMatch
+	for _, match := range r.FoodMatches {
+		if match.Ingredient.UserID == userID && match.Status == status {
+			matches = append(matches, *match)
+		}
+	}
+	return matches, nil
+}
+
+// ResolveIngredientMatch confirms or rejects the match of an ingredient.
+func (r *FakeRecipeRepository) ResolveIngredientMatch(ctx context.Context, userID, ingredientID uuid.UUID, foodID *uuid.UUID) (*domain.IngredientFoodMatch, error) {
+	if r.FailOnIngredientMatches {
+		return nil, errors.New("fake repository error")
+	}
+
+	ingredient, ok := r.Ingredients[ingredientID]
+	if !ok || ingredient.UserID != userID {
+		return nil, repository.ErrIngredientNotFound
+	}
+
+	match, ok := r.FoodMatches[ingredientID]
+	if !ok {
+		match = &domain.IngredientFoodMatch{Ingredient: *ingredient}
+		r.FoodMatches[ingredientID] = match
+	}
+
+	if foodID == nil {
+		match.Status = domain.FoodMatchRejected
+		match.Food = nil
+		return match, nil
+	}
+
+	food, ok := r.Foods[*foodID]
+	if !ok {
+		return nil, repository.ErrNutritionFoodNotFound
+	}
+	match.Status = domain.FoodMatchConfirmed
+	match.Food = &food
+	return match, nil
+}
+
+// GetCuisineByID retrieves a cuisine by ID for a user.
+func (r *FakeRecipeRepository) GetCuisineByID(ctx context.Context, userID, id uuid.UUID) (*domain.Cuisine, error) {
+	if r.FailOnGetCuisineByID {
+		return nil, errors.New("fake repository error")
+	}
+
+	cuisine, ok := r.Cuisines[id]
+	if !ok || cuisine.UserID != userID {
+		return nil, repository.ErrCuisineNotFound
+	}
+	return cuisine, nil
+}
+
+// GetOrCreateCuisine retrieves a cuisine by name or creates it.
+func (r *FakeRecipeRepository) GetOrCreateCuisine(ctx context.Context, userID uuid.UUID, name string) (*domain.Cuisine, error) {
+	if r.FailOnGetOrCreateCuisine {
+		return nil, errors.New("fake repository error")
+	}
+
+	for _, cuisine := range r.Cuisines {
+		if cuisine.UserID == userID && cuisine.Name == name {
+			return cuisine, nil
+		}
+	}
+
+	cuisine := &domain.Cuisine{
+		ID:     uuid.New(),
+		UserID: userID,
+		Name:   name,
+	}
+	r.Cuisines[cuisine.ID] = cuisine
+	return cuisine, nil
+}
+
+// GetCuisines retrieves all cuisines.
+func (r *FakeRecipeRepository) GetCuisines(ctx context.Context, userID uuid.UUID) ([]domain.Cuisine, error) {
+	if r.FailOnGetCuisines {
+		return nil, errors.New("fake repository error")
+	}
+
+	cuisines := make([]domain.Cuisine, 0, len(r.Cuisines))
+	for _, cuisine := range r.Cuisines {
+		if cuisine.UserID == userID {
+			cuisines = append(cuisines, *cuisine)
+		}
+	}
+	return cuisines, nil
+}
+
+// RenameCuisine renames one of the user's cuisines.
+func (r *FakeRecipeRepository) RenameCuisine(ctx context.Context, userID, id uuid.UUID, name string) ([]uuid.UUID, error) {
+	cuisine, ok := r.Cuisines[id]
+	if !ok || cuisine.UserID != userID {
+		return nil, repository.ErrCuisineNotFound
+	}
+	for _, other := range r.Cuisines {
+		if other.ID != id && other.UserID == userID && other.Name == name {
+			return nil, repository.ErrCuisineNameTaken
+		}
+	}
+
+	renamed := *cuisine
+	renamed.Name = name
+	r.Cuisines[id] = &renamed
+	return r.moveCuisineRecipes(id, &renamed), nil
+}
+
+// DeleteCuisine deletes one of the user's cuisines, moving its recipes to
+// reassignTo.
+func (r *FakeRecipeRepository) DeleteCuisine(ctx context.Context, userID, id uuid.UUID, reassignTo *uuid.UUID) ([]uuid.UUID, error) {
+	if reassignTo != nil && *reassignTo == id {
+		return nil, repository.ErrCuisineMergeSelf
+	}
+	cuisine, ok := r.Cuisines[id]
+	if !ok || cuisine.UserID != userID {
+		return nil, repository.ErrCuisineNotFound
+	}
+
+	var target *domain.Cuisine
+	if reassignTo != nil {
+		target, ok = r.Cuisines[*reassignTo]
+		if !ok || target.UserID != userID {
+			return nil, repository.ErrCuisineNotFound
+		}
+	}
+	for _, recipe := range r.Recipes {
+		if target == nil && recipe.Cuisine != nil && recipe.Cuisine.ID == id {
+			return nil, repository.ErrCuisineInUse
+		}
+	}
+
+	var recipeIDs []uuid.UUID
+	if target != nil {
+		recipeIDs = r.moveCuisineRecipes(id, target)
+	}
+	delete(r.Cuisines, id)
+	return recipeIDs, nil
+}
+
+// moveCuisineRecipes points the recipes of a cuisine at another and returns
+// the active ones.
+func (r *FakeRecipeRepository) moveCuisineRecipes(id uuid.UUID, to *domain.Cuisine) []uuid.UUID {
+	var recipeIDs []uuid.UUID
+	for _, recipe := range r.Recipes {
+		if recipe.Cuisine == nil || recipe.Cuisine.ID != id {
+			continue
+		}
+		cuisine := *to
+		recipe.Cuisine = &cuisine
+		if recipe.DeletedAt == nil {
+			recipeIDs = append(recipeIDs, recipe.ID)
+		}
+	}
+	return recipeIDs
+}
+
+// ListTags counts the tags on the user's active recipes, most used first.
+func (r *FakeRecipeRepository) ListTags(ctx context.Context, userID uuid.UUID, prefix string, group domain.TagGroup, limit int) ([]domain.Tag, error) {
+	counts := make(map[string]int)
+	for _, recipe := range r.Recipes {
+		if recipe.UserID != userID || recipe.DeletedAt != nil {
+			continue
+		}
+		for _, tag := range recipe.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]domain.Tag, 0, len(counts))
+	for name, count := range counts {
+		tagGroup := r.TagGroups[userID][name]
+		if !strings.HasPrefix(name, prefix) || (group != "" && tagGroup != group) {
+			continue
+		}
+		tags = append(tags, domain.Tag{Name: name, Group: tagGroup, RecipeCount: count})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].RecipeCount != tags[j].RecipeCount {
+			return tags[i].RecipeCount > tags[j].RecipeCount
+		}
+		return tags[i].Name < tags[j].Name
+	})
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags, nil
+}
+
+// RenameTag renames a tag on every recipe of the user.
+func (r *FakeRecipeRepository) RenameTag(ctx context.Context, userID uuid.UUID, tag, name string) ([]uuid.UUID, error) {
+	if tag == name {
+		return nil, repository.ErrTagMergeSelf
+	}
+	for _, recipe := range r.Recipes {
+		if recipe.UserID == userID && slices.Contains(recipe.Tags, name) {
+			return nil, repository.ErrTagNameTaken
+		}
+	}
+	delete(r.TagGroups[userID], name)
+	return r.replaceTags(userID, []string{tag}, name)
+}
+
+// MergeTags replaces the source tags with the target on every recipe of the
+// user.
+func (r *FakeRecipeRepository) MergeTags(ctx context.Context, userID uuid.UUID, sources []string, target string) ([]uuid.UUID, error) {
+	if slices.Contains(sources, target) {
+		return nil, repository.ErrTagMergeSelf
+	}
+	return r.replaceTags(userID, sources, target)
+}
+
+// DeleteTag removes a tag from every recipe of the user.
+func (r *FakeRecipeRepository) DeleteTag(ctx context.Context, userID uuid.UUID, tag string) ([]uuid.UUID, error) {
+	return r.replaceTags(userID, []string{tag}, "")
+}
+
+// SetTagGroup files a tag under a group, or ungroups it when group is empty.
+func (r *FakeRecipeRepository) SetTagGroup(ctx context.Context, userID uuid.UUID, tag string, group domain.TagGroup) (*domain.Tag, error) {
+	found := false
+	count := 0
+	for _, recipe := range r.Recipes {
+		if recipe.UserID != userID || !slices.Contains(recipe.Tags, tag) {
+			continue
+		}
+		found = true
+		if recipe.DeletedAt == nil {
+			count++
+		}
+	}
+	if !found {
+		return nil, repository.ErrTagNotFound
+	}
+
+	if group == "" {
+		delete(r.TagGroups[userID], tag)
+	} else {
+		if r.TagGroups[userID] == nil {
+			r.TagGroups[userID] = make(map[string]domain.TagGroup)
+		}
+		r.TagGroups[userID][tag] = group
+	}
+	return &domain.Tag{Name: tag, Group: group, RecipeCount: count}, nil
+}
+
+// replaceTags swaps the source tags for the target, or drops them when the
+// target is empty, and moves their group. It returns the active recipes that
+// changed.
+func (r *FakeRecipeRepository) replaceTags(userID uuid.UUID, sources []string, target string) ([]uuid.UUID, error) {
+	found := false
+	var recipeIDs []uuid.UUID
+	for _, recipe := range r.Recipes {
+		if recipe.UserID != userID || !slices.ContainsFunc(recipe.Tags, func(tag string) bool {
+			return slices.Contains(sources, tag)
+		}) {
+			continue
+		}
+		found = true
+
+		tags := make([]string, 0, len(recipe.Tags))
+		for _, tag := range recipe.Tags {
+			if slices.Contains(sources, tag) {
+				tag = target
+			}
+			if tag != "" && !slices.Contains(tags, tag) {
+				tags = append(tags, tag)
+			}
+		}
+		recipe.Tags = tags
+		if recipe.DeletedAt == nil {
+			recipeIDs = append(recipeIDs, recipe.ID)
+		}
+	}
+	if !found {
+		return nil, repository.ErrTagNotFound
+	}
+
+	groups := r.TagGroups[userID]
+	for _, source := range sources {
+		if group, ok := groups[source]; ok && target != "" {
+			if _, taken := groups[target]; !taken {
+				groups[target] = group
+			}
+		}
+		delete(groups, source)
+	}
+	return recipeIDs, nil
+}
+
+// AddRecipe adds a recipe to the fake repository for test setup.
+func (r *FakeRecipeRepository) AddRecipe(recipe *domain.Recipe) {
+	r.Recipes[recipe.ID] = recipe
+}
+
+// AddIngredient adds an ingredient to the fake repository for test setup.
+func (r *FakeRecipeRepository) AddIngredient(ingredient *domain.Ingredient) {
+	r.Ingredients[ingredient.ID] = ingredient
+}
+
+// AddIngredientNutrition adds nutrition facts for an ingredient for test setup.
+func (r *FakeRecipeRepository) AddIngredientNutrition(fact domain.IngredientNutrition) {
+	r.Nutrition[fact.IngredientID] = fact
+}
+
+// AddIngredientCategory adds an ingredient category for test setup.
+func (r *FakeRecipeRepository) AddIngredientCategory(category *domain.IngredientCategory) {
+	r.Categories[category.ID] = category
+}
+
+// AddIngredientDietaryAttributes classifies an ingredient for test setup.
+func (r *FakeRecipeRepository) AddIngredientDietaryAttributes(ingredientID uuid.UUID, attributes ...domain.IngredientAttribute) {
+	r.Dietary[ingredientID] = domain.IngredientDietaryAttributes{IngredientID: ingredientID, Attributes: attributes}
+}
+
+// AddFoodMatch adds an ingredient match and its foods for test setup.
+func (r *FakeRecipeRepository) AddFoodMatch(match *domain.IngredientFoodMatch) {
+	r.FoodMatches[match.Ingredient.ID] = match
+	if match.Food != nil {
+		r.Foods[match.Food.ID] = *match.Food
+	}
+	for _, food := range match.Candidates {
+		r.Foods[food.ID] = food
+	}
+}
+
+// AddUser adds a user to the fake repository for test setup.
+func (r *FakeRecipeRepository) AddUser(user *domain.User) {
+	r.Users[user.ID] = user
+}
+
+// AddCuisine adds a cuisine to the fake repository for test setup.
+func (r *FakeRecipeRepository) AddCuisine(cuisine *domain.Cuisine) {
+	r.Cuisines[cuisine.ID] = cuisine
+}
+
+// FakeEventPublisher is an in-memory implementation of EventPublisher for testing.
+type FakeEventPublisher struct {
+	RecipeUpsertedEvents []*domain.Recipe
+	RecipeDeletedEvents  []DeletedEvent
+	RecipeSharedEvents   []domain.RecipeShare
+	ShareRevokedEvents   []ShareRevokedEvent
+	CookStatsEvents      []CookStatsEvent
+	DietaryProfileEvents []domain.DietaryProfile
+
+	CollectionSharedEvents        []CollectionSharedEvent
+	CollectionShareRevokedEvents  []CollectionShareRevokedEvent
+	CollectionRecipeAddedEvents   []CollectionRecipeEvent
+	CollectionRecipeRemovedEvents []CollectionRecipeEvent
+	CollectionDeletedEvents       []uuid.UUID
+	CollectionVisibilityEvents    []CollectionVisibilityEvent
+
+	FailOnPublishUpserted bool
+	FailOnPublishDeleted  bool
+}
+
+// DeletedEvent represents a deleted recipe event in tests.
+type DeletedEvent struct {
+	RecipeID uuid.UUID
+	UserID   uuid.UUID
+}
+
+// ShareRevokedEvent represents a revoked recipe share event in tests.
+type ShareRevokedEvent struct {
+	RecipeID         uuid.UUID
+	UserID           uuid.UUID
+	SharedWithUserID uuid.UUID
+}
+
+// CollectionSharedEvent represents a collection shared event in tests.
+type CollectionSharedEvent struct {
+	Share     domain.CollectionShare
+	RecipeIDs []uuid.UUID
+}
+
+// CollectionShareRevokedEvent represents a revoked collection share event in
+// tests.
+type CollectionShareRevokedEvent struct {
+	CollectionID     uuid.UUID
+	UserID           uuid.UUID
+	SharedWithUserID uuid.UUID
+}
+
+// CollectionVisibilityEvent represents a collection visibility changed event
+// in tests.
+type CollectionVisibilityEvent struct {
+	CollectionID uuid.UUID
+	Public       bool
+	RecipeIDs    []uuid.UUID
+}
+
+// CollectionRecipeEvent represents a recipe added to or removed from a
+// collection in tests.
+type CollectionRecipeEvent struct {
+	CollectionID uuid.UUID
+	UserID       uuid.UUID
+	RecipeID     uuid.UUID
+}
+
+// CookStatsEvent represents a recipe cook stats updated event in tests.
+type CookStatsEvent struct {
+	RecipeID uuid.UUID
+	UserID   uuid.UUID
+	Stats    domain.RecipeCookStats
+}
+
+// NewFakeEventPublisher creates a new fake event publisher.
+func NewFakeEventPublisher() *FakeEventPublisher {
+	return &FakeEventPublisher{
+		RecipeUpsertedEvents: []*domain.Recipe{},
+		RecipeDeletedEvents:  []DeletedEvent{},
+	}
+}
+
+// PublishRecipeUpserted records a RecipeUpsertedEvent.
+func (p *FakeEventPublisher) PublishRecipeUpserted(ctx context.Context, recipe *domain.Recipe) error {
+	if p.FailOnPublishUpserted {
+		return errors.New("fake publisher error")
+	}
+	p.RecipeUpsertedEvents = append(p.RecipeUpsertedEvents, recipe)
+	return nil
+}
+
+// PublishRecipeDeleted records a RecipeDeletedEvent.
+func (p *FakeEventPublisher) PublishRecipeDeleted(ctx context.Context, recipeID, userID uuid.UUID) error {
+	if p.FailOnPublishDeleted {
+		return errors.New("fake publisher error")
+	}
+	p.RecipeDeletedEvents = append(p.RecipeDeletedEvents, DeletedEvent{RecipeID: recipeID, UserID: userID})
+	return nil
+}
+
+// PublishRecipeShared records a RecipeSharedEvent.
+func (p *FakeEventPublisher) PublishRecipeShared(ctx context.Context, share *domain.RecipeShare) error {
+	p.RecipeSharedEvents = append(p.RecipeSharedEvents, *share)
+	return nil
+}
+
+// PublishRecipeCookStatsUpdated records a RecipeCookStatsUpdatedEvent.
+func (p *FakeEventPublisher) PublishRecipeCookStatsUpdated(ctx context.Context, recipeID, userID uuid.UUID, stats *domain.RecipeCookStats) error {
+	p.CookStatsEvents = append(p.CookStatsEvents, CookStatsEvent{
+		RecipeID: recipeID,
+		UserID:   userID,
+		Stats:    *stats,
+	})
+	return nil
+}
+
+// PublishDietaryProfileUpdated records a DietaryProfileUpdatedEvent.
+func (p *FakeEventPublisher) PublishDietaryProfileUpdated(ctx context.Context, profile *domain.DietaryProfile) error {
+	p.DietaryProfileEvents = append(p.DietaryProfileEvents, *profile)
+	return nil
+}
+
+// PublishRecipeShareRevoked records a RecipeShareRevokedEvent.
+func (p *FakeEventPublisher) PublishRecipeShareRevoked(ctx context.Context, recipeID, userID, sharedWithUserID uuid.UUID) error {
+	p.ShareRevokedEvents = append(p.ShareRevokedEvents, ShareRevokedEvent{
+		RecipeID:         recipeID,
+		UserID:           userID,
+		SharedWithUserID: sharedWithUserID,
+	})
+	return nil
+}
+
+// PublishCollectionShared records a CollectionSharedEvent.
+func (p *FakeEventPublisher) PublishCollectionShared(ctx context.Context, share *domain.CollectionShare, recipeIDs []uuid.UUID) error {
+	p.CollectionSharedEvents = append(p.CollectionSharedEvents, CollectionSharedEvent{Share: *share, RecipeIDs: recipeIDs})
+	return nil
+}
+
+// PublishCollectionShareRevoked records a CollectionShareRevokedEvent.
+func (p *FakeEventPublisher) PublishCollectionShareRevoked(ctx context.Context, collectionID, userID, sharedWithUserID uuid.UUID) error {
+	p.CollectionShareRevokedEvents = append(p.CollectionShareRevokedEvents, CollectionShareRevokedEvent{
+		CollectionID:     collectionID,
+		UserID:           userID,
+		SharedWithUserID: sharedWithUserID,
+	})
+	return nil
+}
+
+// PublishCollectionRecipeAdded records a CollectionRecipeAddedEvent.
+func (p *FakeEventPublisher) PublishCollectionRecipeAdded(ctx context.Context, collectionID, userID, recipeID uuid.UUID) error {
+	p.CollectionRecipeAddedEvents = append(p.CollectionRecipeAddedEvents, CollectionRecipeEvent{
+		CollectionID: collectionID,
+		UserID:       userID,
+		RecipeID:     recipeID,
+	})
+	return nil
+}
+
+// PublishCollectionRecipeRemoved records a CollectionRecipeRemovedEvent.
+func (p *FakeEventPublisher) PublishCollectionRecipeRemoved(ctx context.Context, collectionID, userID, recipeID uuid.UUID) error {
+	p.CollectionRecipeRemovedEvents = append(p.CollectionRecipeRemovedEvents, CollectionRecipeEvent{
+		CollectionID: collectionID,
+		UserID:       userID,
+		RecipeID:     recipeID,
+	})
+	return nil
+}
+
+// PublishCollectionDeleted records a CollectionDeletedEvent.
+func (p *FakeEventPublisher) PublishCollectionDeleted(ctx context.Context, collectionID, userID uuid.UUID) error {
+	p.CollectionDeletedEvents = append(p.CollectionDeletedEvents, collectionID)
+	return nil
+}
+
+// PublishCollectionVisibilityChanged records a
+// CollectionVisibilityChangedEvent.
+func (p *FakeEventPublisher) PublishCollectionVisibilityChanged(ctx context.Context, collectionID, userID uuid.UUID, public bool, recipeIDs []uuid.UUID) error {
+	p.CollectionVisibilityEvents = append(p.CollectionVisibilityEvents, CollectionVisibilityEvent{
+		CollectionID: collectionID,
+		Public:       public,
+		RecipeIDs:    recipeIDs,
+	})
+	return nil
+}
+
+// UpsertedEventCount returns the number of RecipeUpsertedEvents published.
+func (p *FakeEventPublisher) UpsertedEventCount() int {
+	return len(p.RecipeUpsertedEvents)
+}
+
+// DeletedEventCount returns the number of RecipeDeletedEvents published.
+func (p *FakeEventPublisher) DeletedEventCount() int {
+	return len(p.RecipeDeletedEvents)
+}
+
+// FakeVectorGenerator is a fake implementation of vector.Generator for testing.
+type FakeVectorGenerator struct {
+	FixedVector pgvector.Vector
+}
+
+// NewFakeVectorGenerator creates a new fake vector generator.
+func NewFakeVectorGenerator() *FakeVectorGenerator {
+	dims := make([]float32, 1536)
+	dims[0] = 1.0
+	return &FakeVectorGenerator{
+		FixedVector: pgvector.NewVector(dims),
+	}
+}
+
+// Generate returns a fixed vector for testing.
+func (g *FakeVectorGenerator) Generate(text string) pgvector.Vector {
+	return g.FixedVector
+}
+
+// GenerateForRecipe returns a fixed vector for testing.
+func (g *FakeVectorGenerator) GenerateForRecipe(recipe *domain.Recipe) pgvector.Vector {
+	return g.FixedVector
+}
+
+// FakeDocumentFetcher serves documents from memory for import tests.
+type FakeDocumentFetcher struct {
+	Documents map[string][]byte
+	Calls     []string
+}
+
+// NewFakeDocumentFetcher creates a new fake document fetcher.
+func NewFakeDocumentFetcher() *FakeDocumentFetcher {
+	return &FakeDocumentFetcher{
+		Documents: make(map[string][]byte),
+		Calls:     []string{},
+	}
+}
+
+// Fetch returns the registered document for the URL.
+func (f *FakeDocumentFetcher) Fetch(ctx context.Context, url string) ([]byte, error) {
+	f.Calls = append(f.Calls, url)
+
+	document, ok := f.Documents[url]
+	if !ok {
+		return nil, errors.New("fake fetcher: document not found")
+	}
+	return document, nil
 }
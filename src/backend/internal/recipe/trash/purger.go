@@ -0,0 +1,98 @@
+// Package trash permanently deletes recipes that have been in the trash for
+// longer than the retention window.
+package trash
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/platepilot/backend/internal/common/blobstore"
+	"github.com/platepilot/backend/internal/common/media"
+)
+
+// Purged reports the outcome of a purge.
+type Purged struct {
+	Recipes int64
+	// OrphanedMedia lists the image URLs of the purged recipes that no
+	// remaining recipe references.
+	OrphanedMedia []string
+}
+
+// Store deletes recipes from the trash.
+type Store interface {
+	// PurgeDeletedRecipes permanently deletes recipes that were moved to the
+	// trash before the given time.
+	PurgeDeletedRecipes(ctx context.Context, deletedBefore time.Time) (*Purged, error)
+}
+
+// Purger periodically purges recipes that outlived the retention window.
+type Purger struct {
+	store     Store
+	media     blobstore.Store
+	retention time.Duration
+	interval  time.Duration
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// NewPurger creates a purger that keeps deleted recipes for retention and
+// checks for expired ones every interval.
+func NewPurger(store Store, retention, interval time.Duration, logger *slog.Logger) *Purger {
+	return &Purger{
+		store:     store,
+		retention: retention,
+		interval:  interval,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// WithMediaStore deletes the uploaded images that purged recipes leave
+// unreferenced from store.
+func (p *Purger) WithMediaStore(store blobstore.Store) *Purger {
+	p.media = store
+	return p
+}
+
+// Run purges expired recipes once and then every interval until the context
+// is cancelled.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.PurgeExpired(ctx); err != nil && ctx.Err() == nil {
+			p.logger.Error("failed to purge trash", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpired permanently deletes the recipes deleted more than the
+// retention window ago.
+func (p *Purger) PurgeExpired(ctx context.Context) (int64, error) {
+	purged, err := p.store.PurgeDeletedRecipes(ctx, p.now().Add(-p.retention))
+	if err != nil {
+		return 0, err
+	}
+
+	if purged.Recipes > 0 {
+		p.logger.Info("purged recipes from trash", "count", purged.Recipes, "retention", p.retention)
+	}
+
+	// The recipes are gone either way; images that fail to delete are only
+	// logged.
+	if p.media != nil && len(purged.OrphanedMedia) > 0 {
+		if err := media.DeleteImages(ctx, p.media, purged.OrphanedMedia); err != nil {
+			p.logger.Error("failed to delete orphaned images", "error", err)
+		}
+	}
+
+	return purged.Recipes, nil
+}
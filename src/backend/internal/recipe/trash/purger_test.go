@@ -0,0 +1,91 @@
+package trash
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/platepilot/backend/internal/common/blobstore"
+)
+
+type fakeStore struct {
+	deletedBefore []time.Time
+	orphaned      []string
+}
+
+func (s *fakeStore) PurgeDeletedRecipes(ctx context.Context, deletedBefore time.Time) (*Purged, error) {
+	s.deletedBefore = append(s.deletedBefore, deletedBefore)
+	return &Purged{Recipes: 1, OrphanedMedia: s.orphaned}, nil
+}
+
+func TestPurgeExpired_PurgesRecipesDeletedBeforeRetention(t *testing.T) {
+	store := &fakeStore{}
+	purger := NewPurger(store, 30*24*time.Hour, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	now := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+	purger.now = func() time.Time { return now }
+
+	purged, err := purger.PurgeExpired(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if purged != 1 {
+		t.Errorf("expected 1 recipe purged, got %d", purged)
+	}
+	want := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	if len(store.deletedBefore) != 1 || !store.deletedBefore[0].Equal(want) {
+		t.Errorf("expected purge of recipes deleted before %v, got %v", want, store.deletedBefore)
+	}
+}
+
+func TestRun_PurgesImmediatelyAndStopsOnCancel(t *testing.T) {
+	store := &fakeStore{}
+	purger := NewPurger(store, time.Hour, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	purger.Run(ctx)
+
+	if len(store.deletedBefore) != 1 {
+		t.Errorf("expected one purge before stopping, got %d", len(store.deletedBefore))
+	}
+}
+
+func TestPurgeExpired_DeletesOrphanedImages(t *testing.T) {
+	dir := t.TempDir()
+	mediaStore, err := blobstore.NewLocalStore(dir, "http://localhost:8080/media")
+	if err != nil {
+		t.Fatalf("failed to create store: %v", err)
+	}
+	ctx := context.Background()
+	orphanKey := "images/" + uuid.NewString() + "/large.jpg"
+	keptKey := "images/" + uuid.NewString() + "/large.jpg"
+	for _, key := range []string{orphanKey, keptKey} {
+		if err := mediaStore.Put(ctx, key, strings.NewReader("jpeg"), "image/jpeg"); err != nil {
+			t.Fatalf("failed to store %s: %v", key, err)
+		}
+	}
+
+	store := &fakeStore{orphaned: []string{mediaStore.URL(orphanKey)}}
+	purger := NewPurger(store, time.Hour, time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil))).
+		WithMediaStore(mediaStore)
+
+	if _, err := purger.PurgeExpired(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := mediaStore.Open(ctx, orphanKey); !errors.Is(err, blobstore.ErrNotFound) {
+		t.Errorf("expected orphaned image to be deleted, got %v", err)
+	}
+	kept, _, err := mediaStore.Open(ctx, keptKey)
+	if err != nil {
+		t.Fatalf("expected referenced image to be kept: %v", err)
+	}
+	kept.Close()
+}
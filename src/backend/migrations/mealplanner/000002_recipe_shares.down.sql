@@ -0,0 +1,3 @@
+-- Down migration for recipe shares
+
+DROP TABLE IF EXISTS recipe_shares;
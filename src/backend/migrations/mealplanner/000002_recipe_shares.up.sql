@@ -0,0 +1,12 @@
+-- Recipe Shares Migration
+-- Recipes shared with a user are offered in that user's suggestions alongside
+-- their own. Rows are kept in sync from recipe share events.
+
+CREATE TABLE recipe_shares (
+    recipe_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (recipe_id, user_id)
+);
+
+CREATE INDEX ix_recipe_shares_user_id ON recipe_shares (user_id);
@@ -0,0 +1,3 @@
+-- Down migration for recipe cook stats
+
+DROP TABLE IF EXISTS recipe_cook_stats;
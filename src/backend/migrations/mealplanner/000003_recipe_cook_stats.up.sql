@@ -0,0 +1,15 @@
+-- Recipe Cook Stats Migration
+-- Per-user cook history aggregates used to score suggestions. Rows are kept in
+-- sync from recipe cook stats events.
+
+CREATE TABLE recipe_cook_stats (
+    recipe_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    times_cooked INTEGER NOT NULL,
+    last_cooked_on DATE,
+    average_rating DOUBLE PRECISION,
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (recipe_id, user_id)
+);
+
+CREATE INDEX ix_recipe_cook_stats_user_id ON recipe_cook_stats (user_id);
@@ -0,0 +1,3 @@
+-- Down migration for dietary profiles
+
+DROP TABLE IF EXISTS dietary_profiles;
@@ -0,0 +1,12 @@
+-- Dietary Profiles Migration
+-- Users' allergies, diets and disliked ingredients, used to leave conflicting
+-- recipes out of suggestions. Rows are kept in sync from dietary profile
+-- events.
+
+CREATE TABLE dietary_profiles (
+    user_id UUID PRIMARY KEY,
+    allergy_ids UUID[] NOT NULL DEFAULT '{}',
+    diets TEXT[] NOT NULL DEFAULT '{}',
+    disliked_ingredients TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
@@ -0,0 +1,3 @@
+-- Down migration for recipe diet labels
+
+ALTER TABLE recipes DROP COLUMN IF EXISTS diet_labels;
@@ -0,0 +1,5 @@
+-- Recipe Diet Labels Migration
+-- Diet labels derived by the recipe service, such as vegan or gluten-free,
+-- used to filter suggestions and apply dietary profiles.
+
+ALTER TABLE recipes ADD COLUMN diet_labels TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,9 @@
+-- Recipe Diet Classification Migration
+-- Whether the recipe service could classify a recipe. Dietary profiles only
+-- leave out classified recipes that miss a label; unclassified ones are
+-- unknown. Recipes with labels are classified; the rest are corrected as the
+-- recipe service republishes them.
+
+ALTER TABLE recipes ADD COLUMN diet_classified BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE recipes SET diet_classified = TRUE WHERE cardinality(diet_labels) > 0;
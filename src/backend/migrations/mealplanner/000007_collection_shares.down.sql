@@ -0,0 +1,4 @@
+-- Down migration for collection shares
+
+DROP TABLE IF EXISTS collection_recipes;
+DROP TABLE IF EXISTS collection_shares;
@@ -0,0 +1,23 @@
+-- Collection Shares Migration
+-- Sharing a collection shares the recipes in it, so those recipes are offered
+-- in the suggestions of the users it is shared with. Rows are kept in sync
+-- from collection events; membership is mirrored for every collection so a
+-- later share needs nothing else.
+
+CREATE TABLE collection_shares (
+    collection_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (collection_id, user_id)
+);
+
+CREATE INDEX ix_collection_shares_user_id ON collection_shares (user_id);
+
+CREATE TABLE collection_recipes (
+    collection_id UUID NOT NULL,
+    recipe_id UUID NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (collection_id, recipe_id)
+);
+
+CREATE INDEX ix_collection_recipes_recipe_id ON collection_recipes (recipe_id);
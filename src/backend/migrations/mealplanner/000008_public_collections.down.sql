@@ -0,0 +1,3 @@
+-- Down migration for public collections
+
+DROP TABLE IF EXISTS public_collections;
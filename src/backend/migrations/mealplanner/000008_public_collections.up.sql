@@ -0,0 +1,9 @@
+-- Public Collections Migration
+-- The recipes in a public collection, such as the seeded library, are offered
+-- in the suggestions of every user. Rows are kept in sync from collection
+-- visibility events.
+
+CREATE TABLE public_collections (
+    collection_id UUID PRIMARY KEY,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
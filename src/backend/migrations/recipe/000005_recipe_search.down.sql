@@ -0,0 +1,5 @@
+-- Down migration for recipe search
+
+DROP INDEX IF EXISTS ix_recipes_search_document;
+DROP FUNCTION IF EXISTS recipe_search_document(UUID);
+ALTER TABLE recipes DROP COLUMN IF EXISTS search_document;
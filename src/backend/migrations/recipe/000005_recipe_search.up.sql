@@ -0,0 +1,32 @@
+-- Recipe Search Migration
+-- Adds a full-text search document to recipes for hybrid (text + vector) search
+
+ALTER TABLE recipes ADD COLUMN search_document TSVECTOR NOT NULL DEFAULT ''::tsvector;
+
+-- Builds the weighted search document for a recipe from its name, description,
+-- ingredient names and step instructions. The 'simple' configuration is used so
+-- that non-English recipes are not mangled by an English stemmer.
+CREATE OR REPLACE FUNCTION recipe_search_document(p_recipe_id UUID)
+RETURNS TSVECTOR AS $$
+    SELECT
+        setweight(to_tsvector('simple', coalesce(r.name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(r.description, '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce((
+            SELECT string_agg(i.name, ' ')
+            FROM recipe_ingredient_lines ril
+            JOIN ingredients i ON i.id = ril.ingredient_id
+            WHERE ril.recipe_id = r.id
+        ), '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce((
+            SELECT string_agg(s.instruction, ' ')
+            FROM recipe_steps s
+            WHERE s.recipe_id = r.id
+        ), '')), 'C')
+    FROM recipes r
+    WHERE r.id = p_recipe_id
+$$ LANGUAGE sql STABLE;
+
+-- Backfill existing recipes
+UPDATE recipes SET search_document = recipe_search_document(id);
+
+CREATE INDEX ix_recipes_search_document ON recipes USING GIN (search_document);
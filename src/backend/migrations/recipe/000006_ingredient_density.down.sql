@@ -0,0 +1,3 @@
+-- Down migration for ingredient density
+
+ALTER TABLE ingredients DROP COLUMN IF EXISTS density_g_per_ml;
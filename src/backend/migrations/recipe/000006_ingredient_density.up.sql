@@ -0,0 +1,6 @@
+-- Ingredient Density Migration
+-- Adds an optional density to ingredients so volume and mass quantities can be
+-- converted into each other when aggregating shopping lists
+
+ALTER TABLE ingredients ADD COLUMN density_g_per_ml NUMERIC(8,4)
+    CHECK (density_g_per_ml IS NULL OR density_g_per_ml > 0);
@@ -0,0 +1,4 @@
+-- Down migration for nutrition calculation
+
+ALTER TABLE recipe_ingredient_lines DROP COLUMN IF EXISTS nutrition_unresolved;
+ALTER TABLE recipe_nutrition DROP COLUMN IF EXISTS is_override;
@@ -0,0 +1,10 @@
+-- Nutrition Calculation Migration
+-- Recipe nutrition is now computed from ingredient_nutrition unless the user
+-- overrides it; everything stored so far was typed in by hand
+
+ALTER TABLE recipe_nutrition ADD COLUMN is_override BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE recipe_nutrition SET is_override = TRUE;
+
+-- Lines that could not be counted in the computed nutrition
+ALTER TABLE recipe_ingredient_lines ADD COLUMN nutrition_unresolved BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,8 @@
+-- Down migration for nutrition reference
+
+ALTER TABLE ingredient_nutrition DROP COLUMN IF EXISTS food_id;
+DROP TABLE IF EXISTS ingredient_food_matches;
+DROP TABLE IF EXISTS nutrition_imports;
+DROP TABLE IF EXISTS nutrition_food_synonyms;
+DROP TRIGGER IF EXISTS update_nutrition_foods_updated_at ON nutrition_foods;
+DROP TABLE IF EXISTS nutrition_foods;
@@ -0,0 +1,63 @@
+-- Nutrition Reference Migration
+-- Adds a global nutrition reference table loaded from FoodData Central or
+-- CIQUAL dumps, and links user ingredients to it by name
+
+CREATE TABLE nutrition_foods (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    source TEXT NOT NULL,
+    source_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    normalized_name TEXT NOT NULL,
+    calories NUMERIC(8,2) NOT NULL DEFAULT 0,
+    protein_g NUMERIC(8,2) NOT NULL DEFAULT 0,
+    carbs_g NUMERIC(8,2) NOT NULL DEFAULT 0,
+    fat_g NUMERIC(8,2) NOT NULL DEFAULT 0,
+    fiber_g NUMERIC(8,2) NOT NULL DEFAULT 0,
+    sugar_g NUMERIC(8,2) NOT NULL DEFAULT 0,
+    sodium_mg NUMERIC(10,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (source, source_id)
+);
+
+CREATE INDEX ix_nutrition_foods_normalized_name ON nutrition_foods (normalized_name);
+
+CREATE TRIGGER update_nutrition_foods_updated_at
+    BEFORE UPDATE ON nutrition_foods
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Normalized alternative names used for matching
+CREATE TABLE nutrition_food_synonyms (
+    food_id UUID NOT NULL REFERENCES nutrition_foods(id) ON DELETE CASCADE,
+    synonym TEXT NOT NULL,
+    PRIMARY KEY (food_id, synonym)
+);
+
+CREATE INDEX ix_nutrition_food_synonyms_synonym ON nutrition_food_synonyms (synonym);
+
+-- Progress of file imports so an interrupted import can resume
+CREATE TABLE nutrition_imports (
+    source TEXT NOT NULL,
+    file_name TEXT NOT NULL,
+    file_size BIGINT NOT NULL,
+    rows_done BIGINT NOT NULL DEFAULT 0,
+    completed_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (source, file_name, file_size)
+);
+
+-- Links between user ingredients and reference foods
+CREATE TABLE ingredient_food_matches (
+    ingredient_id UUID PRIMARY KEY REFERENCES ingredients(id) ON DELETE CASCADE,
+    status TEXT NOT NULL CHECK (status IN ('matched', 'ambiguous', 'unmatched', 'confirmed', 'rejected')),
+    food_id UUID REFERENCES nutrition_foods(id) ON DELETE SET NULL,
+    candidate_food_ids UUID[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX ix_ingredient_food_matches_status ON ingredient_food_matches (status);
+
+-- Ingredient nutrition copied from a reference food keeps a link to it so it
+-- follows re-imports
+ALTER TABLE ingredient_nutrition ADD COLUMN food_id UUID REFERENCES nutrition_foods(id) ON DELETE SET NULL;
@@ -0,0 +1,5 @@
+-- Down migration for recipe revisions
+
+DROP TRIGGER IF EXISTS recipe_revisions_immutable ON recipe_revisions;
+DROP FUNCTION IF EXISTS reject_recipe_revision_update();
+DROP TABLE IF EXISTS recipe_revisions;
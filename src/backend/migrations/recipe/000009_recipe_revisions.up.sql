@@ -0,0 +1,24 @@
+-- Recipe Revisions Migration
+-- Every saved version of a recipe is kept as an immutable JSON snapshot so
+-- edits can be compared and undone
+
+CREATE TABLE recipe_revisions (
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    revision INTEGER NOT NULL,
+    snapshot JSONB NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (recipe_id, revision)
+);
+
+-- Revisions are append-only
+CREATE OR REPLACE FUNCTION reject_recipe_revision_update()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'recipe revisions are immutable';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER recipe_revisions_immutable
+    BEFORE UPDATE ON recipe_revisions
+    FOR EACH ROW
+    EXECUTE FUNCTION reject_recipe_revision_update();
@@ -0,0 +1,3 @@
+-- Down migration for recipe share permissions
+
+ALTER TABLE recipe_shares DROP COLUMN IF EXISTS permission;
@@ -0,0 +1,6 @@
+-- Recipe Share Permissions Migration
+-- A share grants either read-only access or permission to edit the recipe
+
+ALTER TABLE recipe_shares
+    ADD COLUMN permission TEXT NOT NULL DEFAULT 'read'
+    CHECK (permission IN ('read', 'edit'));
@@ -0,0 +1,5 @@
+-- Down migration for recipe collections
+
+DROP TABLE IF EXISTS collection_shares;
+DROP TABLE IF EXISTS recipe_collection_items;
+DROP TABLE IF EXISTS recipe_collections;
@@ -0,0 +1,41 @@
+-- Recipe Collections Migration
+-- Users group their recipes into ordered collections ("Weeknight",
+-- "Christmas") that can be shared like recipes
+
+CREATE TABLE recipe_collections (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT,
+    cover_image_url TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX ix_recipe_collections_user_id ON recipe_collections (user_id);
+
+CREATE TRIGGER update_recipe_collections_updated_at
+    BEFORE UPDATE ON recipe_collections
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE recipe_collection_items (
+    collection_id UUID NOT NULL REFERENCES recipe_collections(id) ON DELETE CASCADE,
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    added_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (collection_id, recipe_id)
+);
+
+CREATE INDEX ix_recipe_collection_items_recipe_id ON recipe_collection_items (recipe_id);
+
+-- A collection share also grants read access to the recipes in the collection
+CREATE TABLE collection_shares (
+    collection_id UUID NOT NULL REFERENCES recipe_collections(id) ON DELETE CASCADE,
+    shared_with_user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    permission TEXT NOT NULL DEFAULT 'read' CHECK (permission IN ('read', 'edit')),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (collection_id, shared_with_user_id)
+);
+
+CREATE INDEX ix_collection_shares_user_id ON collection_shares (shared_with_user_id);
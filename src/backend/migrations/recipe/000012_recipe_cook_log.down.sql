@@ -0,0 +1,4 @@
+-- Down migration for the recipe cook log
+
+DROP VIEW IF EXISTS recipe_cook_stats;
+DROP TABLE IF EXISTS recipe_cook_log;
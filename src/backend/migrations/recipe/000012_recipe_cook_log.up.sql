@@ -0,0 +1,33 @@
+-- Recipe Cook Log Migration
+-- Users record when they cooked a recipe, how many servings, a 1-5 rating and
+-- notes. Aggregates are per user: a shared recipe has separate stats for the
+-- owner and every user cooking it.
+
+CREATE TABLE recipe_cook_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    cooked_on DATE NOT NULL,
+    servings INTEGER CHECK (servings > 0),
+    rating SMALLINT CHECK (rating BETWEEN 1 AND 5),
+    notes TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX ix_recipe_cook_log_user_recipe ON recipe_cook_log (user_id, recipe_id, cooked_on DESC);
+
+CREATE TRIGGER update_recipe_cook_log_updated_at
+    BEFORE UPDATE ON recipe_cook_log
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE VIEW recipe_cook_stats AS
+SELECT
+    recipe_id,
+    user_id,
+    COUNT(*)::int AS times_cooked,
+    MAX(cooked_on) AS last_cooked_on,
+    AVG(rating)::float8 AS average_rating
+FROM recipe_cook_log
+GROUP BY recipe_id, user_id;
@@ -0,0 +1,6 @@
+-- Down migration for recipe forks
+
+DROP INDEX IF EXISTS ix_recipes_forked_from_recipe_id;
+ALTER TABLE recipes
+    DROP COLUMN IF EXISTS forked_from_revision,
+    DROP COLUMN IF EXISTS forked_from_recipe_id;
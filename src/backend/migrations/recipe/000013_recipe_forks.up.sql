@@ -0,0 +1,10 @@
+-- Recipe Forks Migration
+-- A duplicated recipe remembers the recipe it was copied from and the
+-- revision it was copied at, so newer upstream revisions can be pulled in
+
+ALTER TABLE recipes
+    ADD COLUMN forked_from_recipe_id UUID REFERENCES recipes(id) ON DELETE SET NULL,
+    ADD COLUMN forked_from_revision INTEGER;
+
+CREATE INDEX ix_recipes_forked_from_recipe_id ON recipes (forked_from_recipe_id)
+    WHERE forked_from_recipe_id IS NOT NULL;
@@ -0,0 +1,3 @@
+-- Down migration for cook sessions
+
+DROP TABLE IF EXISTS cook_sessions;
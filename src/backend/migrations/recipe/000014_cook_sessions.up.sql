@@ -0,0 +1,25 @@
+-- Cook Sessions Migration
+-- A cook session tracks a user cooking a recipe step by step, with timers for
+-- timed steps. It is stored server-side so every device of the user shows the
+-- same step and timers. Sessions expire after a period of inactivity.
+
+CREATE TABLE cook_sessions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    servings INTEGER NOT NULL CHECK (servings >= 0),
+    current_step INTEGER NOT NULL,
+    timers JSONB NOT NULL DEFAULT '[]',
+    -- Incremented on every change so concurrent updates from two devices
+    -- cannot overwrite each other
+    version INTEGER NOT NULL DEFAULT 1,
+    cook_log_entry_id UUID REFERENCES recipe_cook_log(id) ON DELETE SET NULL,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+-- One running session per recipe and user
+CREATE UNIQUE INDEX ux_cook_sessions_active ON cook_sessions (user_id, recipe_id) WHERE completed_at IS NULL;
+CREATE INDEX ix_cook_sessions_expires_at ON cook_sessions (expires_at);
@@ -0,0 +1,3 @@
+-- Down migration for ingredient substitutions
+
+DROP TABLE IF EXISTS ingredient_substitutions;
@@ -0,0 +1,21 @@
+-- Ingredient Substitutions Migration
+-- A global catalog of ingredient substitutions, seeded from the data file
+-- bundled with the recipe API. Substitutions are matched to ingredients by
+-- their normalized name, so they apply to every user's catalog.
+
+CREATE TABLE ingredient_substitutions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    -- Normalized ingredient name, e.g. "egg" for "Eggs"
+    ingredient_key VARCHAR(200) NOT NULL,
+    ingredient_name VARCHAR(200) NOT NULL,
+    substitute_name VARCHAR(200) NOT NULL,
+    -- Amount of substitute per unit of the ingredient
+    ratio DOUBLE PRECISION NOT NULL CHECK (ratio > 0),
+    notes TEXT NOT NULL DEFAULT '',
+    avoids_allergy_id UUID REFERENCES allergies(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX ux_ingredient_substitutions_pair ON ingredient_substitutions (ingredient_key, substitute_name);
+CREATE INDEX ix_ingredient_substitutions_avoids_allergy_id ON ingredient_substitutions (avoids_allergy_id);
@@ -0,0 +1,4 @@
+-- Down migration for dietary profiles
+
+DROP TABLE IF EXISTS dietary_profile_allergies;
+DROP TABLE IF EXISTS dietary_profiles;
@@ -0,0 +1,28 @@
+-- Dietary Profiles Migration
+-- Each user can declare allergies, diets and disliked ingredients. Recipe
+-- listings and suggestions leave out recipes conflicting with the profile
+-- unless asked to ignore it.
+
+CREATE TABLE dietary_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    diets TEXT[] NOT NULL DEFAULT '{}',
+    -- Ingredient names, matched case-insensitively
+    disliked_ingredients TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+ALTER TABLE dietary_profiles
+    ADD CONSTRAINT dietary_profiles_diets_check
+    CHECK (diets <@ ARRAY['vegetarian', 'vegan', 'pescatarian']::TEXT[]);
+
+CREATE TRIGGER update_dietary_profiles_updated_at
+    BEFORE UPDATE ON dietary_profiles
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TABLE dietary_profile_allergies (
+    user_id UUID NOT NULL REFERENCES dietary_profiles(user_id) ON DELETE CASCADE,
+    allergy_id UUID NOT NULL REFERENCES allergies(id) ON DELETE CASCADE,
+    PRIMARY KEY (user_id, allergy_id)
+);
@@ -0,0 +1,5 @@
+-- Down migration for dietary classification
+
+DROP INDEX IF EXISTS ix_recipes_diet_labels;
+ALTER TABLE recipes DROP COLUMN IF EXISTS diet_labels;
+DROP TABLE IF EXISTS ingredient_dietary_attributes;
@@ -0,0 +1,24 @@
+-- Dietary Classification Migration
+-- Ingredients record what they contain; recipes are labeled with the diets
+-- their required ingredients fit. Ingredients without a row are unclassified
+-- and keep the recipes using them unlabeled.
+
+CREATE TABLE ingredient_dietary_attributes (
+    ingredient_id UUID PRIMARY KEY REFERENCES ingredients(id) ON DELETE CASCADE,
+    attributes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+ALTER TABLE ingredient_dietary_attributes
+    ADD CONSTRAINT ingredient_dietary_attributes_check
+    CHECK (attributes <@ ARRAY['meat', 'fish', 'dairy', 'egg', 'animal_product', 'gluten', 'nuts']::TEXT[]);
+
+CREATE TRIGGER update_ingredient_dietary_attributes_updated_at
+    BEFORE UPDATE ON ingredient_dietary_attributes
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE recipes ADD COLUMN diet_labels TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX ix_recipes_diet_labels ON recipes USING GIN (diet_labels);
@@ -0,0 +1,3 @@
+-- Down migration for ingredient catalog
+
+DROP INDEX IF EXISTS ix_ingredients_name_trgm;
@@ -0,0 +1,8 @@
+-- Ingredient Catalog Migration
+-- Ingredients are created on the fly from recipe input, so the same food ends
+-- up under several names. Trigram indexes let the catalog be searched with
+-- fuzzy matching and near-duplicate names be found for merging.
+
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX ix_ingredients_name_trgm ON ingredients USING GIN (lower(name) gin_trgm_ops);
@@ -0,0 +1,5 @@
+-- Down migration for recipe external IDs
+
+DROP INDEX IF EXISTS ux_recipes_user_id_external_id;
+
+ALTER TABLE recipes DROP COLUMN IF EXISTS external_id;
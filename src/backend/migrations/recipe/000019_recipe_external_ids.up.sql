@@ -0,0 +1,8 @@
+-- Recipe External IDs Migration
+-- Bulk imports remember the ID a recipe had in the system it came from, so
+-- importing the same library again updates or skips it instead of creating a
+-- copy.
+
+ALTER TABLE recipes ADD COLUMN external_id TEXT;
+
+CREATE UNIQUE INDEX ux_recipes_user_id_external_id ON recipes (user_id, external_id) WHERE external_id IS NOT NULL;
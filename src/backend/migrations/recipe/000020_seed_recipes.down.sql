@@ -0,0 +1,3 @@
+-- Down migration for seed recipes
+
+DROP TABLE IF EXISTS seed_recipes;
@@ -0,0 +1,14 @@
+-- Seed Recipes Migration
+-- The seeder remembers a content hash of every recipe it imported, so re-runs
+-- only create or update the recipes whose seed data changed.
+
+CREATE TABLE seed_recipes (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    external_id TEXT NOT NULL,
+    recipe_id UUID NOT NULL REFERENCES recipes(id) ON DELETE CASCADE,
+    content_hash TEXT NOT NULL,
+    seeded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, external_id)
+);
+
+CREATE INDEX ix_seed_recipes_recipe_id ON seed_recipes (recipe_id);
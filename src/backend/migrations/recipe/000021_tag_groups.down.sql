@@ -0,0 +1,3 @@
+-- Down migration for tag groups
+
+DROP TABLE IF EXISTS tag_groups;
@@ -0,0 +1,10 @@
+-- Tag Groups Migration
+-- Tags stay free strings on recipes; users can file a tag under a group
+-- (course, occasion, technique) to browse their recipes by facet.
+
+CREATE TABLE tag_groups (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    tag_group TEXT NOT NULL CHECK (tag_group IN ('course', 'occasion', 'technique')),
+    PRIMARY KEY (user_id, tag)
+);
@@ -0,0 +1,3 @@
+-- Down migration for recipe diet classification
+
+ALTER TABLE recipes DROP COLUMN IF EXISTS diet_classified;
@@ -0,0 +1,10 @@
+-- Recipe Diet Classification Migration
+-- Recipes using an unclassified ingredient carry no diet labels without being
+-- known to break any diet, so dietary profiles must not leave them out. Only
+-- a classified recipe can have labels, so those with labels are classified;
+-- the rest are reclassified once the recipe API backfills the attributes of
+-- the ingredients it knows.
+
+ALTER TABLE recipes ADD COLUMN diet_classified BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE recipes SET diet_classified = TRUE WHERE cardinality(diet_labels) > 0;
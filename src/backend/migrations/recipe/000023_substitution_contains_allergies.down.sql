@@ -0,0 +1,3 @@
+-- Down migration for substitution contained allergies
+
+ALTER TABLE ingredient_substitutions DROP COLUMN IF EXISTS contains_allergy_ids;
@@ -0,0 +1,7 @@
+-- Substitution Contained Allergies Migration
+-- A substitute can bring allergens of its own, like the soy in tofu or the
+-- dairy in yogurt. The catalog records them so a substituted recipe keeps
+-- warning about them.
+
+ALTER TABLE ingredient_substitutions
+    ADD COLUMN contains_allergy_ids UUID[] NOT NULL DEFAULT '{}';
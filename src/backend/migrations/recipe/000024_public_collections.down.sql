@@ -0,0 +1,4 @@
+-- Down migration for public collections
+
+DROP INDEX IF EXISTS ix_recipe_collections_public;
+ALTER TABLE recipe_collections DROP COLUMN IF EXISTS is_public;
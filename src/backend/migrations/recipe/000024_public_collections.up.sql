@@ -0,0 +1,8 @@
+-- Public Collections Migration
+-- A public collection, like the seeded recipe library, can be read by every
+-- user, including users who register after it was published, and so can the
+-- recipes in it.
+
+ALTER TABLE recipe_collections ADD COLUMN is_public BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX ix_recipe_collections_public ON recipe_collections (id) WHERE is_public;